@@ -18,6 +18,7 @@
 package app
 
 import (
+	"context"
 	"github.com/google/uuid"
 	"github.com/kiwiscript/kiwiscript_go/providers/oauth"
 	"github.com/kiwiscript/kiwiscript_go/utils"
@@ -27,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/encryptcookie"
 	"github.com/gofiber/fiber/v2/middleware/helmet"
 	"github.com/gofiber/fiber/v2/middleware/limiter"
@@ -34,16 +36,21 @@ import (
 	"github.com/gofiber/storage/redis/v3"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kiwiscript/kiwiscript_go/controllers"
+	"github.com/kiwiscript/kiwiscript_go/paths"
 	cc "github.com/kiwiscript/kiwiscript_go/providers/cache"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
 	"github.com/kiwiscript/kiwiscript_go/providers/email"
 	stg "github.com/kiwiscript/kiwiscript_go/providers/object_storage"
+	"github.com/kiwiscript/kiwiscript_go/providers/payments"
+	"github.com/kiwiscript/kiwiscript_go/providers/telemetry"
 	"github.com/kiwiscript/kiwiscript_go/providers/tokens"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
 	"github.com/kiwiscript/kiwiscript_go/routers"
 	"github.com/kiwiscript/kiwiscript_go/services"
 )
 
 func CreateApp(
+	lifecycle *Lifecycle,
 	log *slog.Logger,
 	storage *redis.Storage,
 	dbConnPool *pgxpool.Pool,
@@ -52,6 +59,10 @@ func CreateApp(
 	tokensConfig *TokensConfig,
 	limiterConfig *LimiterConfig,
 	oauthProvidersConfig *OAuthProviders,
+	accountDeletionConfig *AccountDeletionConfig,
+	engagementConfig *EngagementConfig,
+	telemetryConfig *TelemetryConfig,
+	paymentsConfig *PaymentsConfig,
 	s3Bucket,
 	backendDomain,
 	frontendDomain,
@@ -79,6 +90,7 @@ func CreateApp(
 		},
 		ContextKey: utils.RequestIDKey,
 	}))
+	app.Use(telemetry.FiberMiddleware(utils.RequestIDKey))
 	app.Use(limiter.New(limiter.Config{
 		Max:               int(limiterConfig.Max),
 		Expiration:        time.Duration(limiterConfig.ExpSec) * time.Second,
@@ -90,6 +102,16 @@ func CreateApp(
 	}))
 	appLog.Info("Finished loading common middlewares")
 
+	if telemetryConfig.MetricsEnabled {
+		appLog.Info("Loading metrics endpoint...")
+		if err := telemetry.RegisterDBPoolCollector(dbConnPool); err != nil {
+			appLog.Error("Failed to register database pool collector", "error", err)
+			panic(err)
+		}
+		app.Get(paths.MetricsPath, adaptor.HTTPHandler(telemetry.MetricsHandler()))
+		appLog.Info("Successfully loaded metrics endpoint")
+	}
+
 	database := db.NewDatabase(dbConnPool)
 	cache := cc.NewCache(log, storage)
 	objStg := stg.NewObjectStorage(log, s3Client, s3Bucket)
@@ -117,6 +139,18 @@ func CreateApp(
 		oauthProvidersConfig.Google.ClientSecret,
 		backendDomain,
 	)
+	webhooksProv := webhooks.NewWebhooks(log)
+	paymentsProv, err := payments.NewPayments(
+		log,
+		paymentsConfig.Provider,
+		paymentsConfig.SecretKey,
+		paymentsConfig.WebhookSecret,
+		frontendDomain,
+	)
+	if err != nil {
+		appLog.Error("Failed to build payments provider", "error", err)
+		panic(err)
+	}
 
 	// Validators
 	appLog.Info("Loading validators...")
@@ -141,9 +175,52 @@ func CreateApp(
 
 	// Build service
 	appLog.Info("Building services...")
-	srvs := services.NewServices(log, database, cache, objStg, mailer, tokenProv, oauthProviders)
+	srvs := services.NewServices(log, database, cache, objStg, mailer, tokenProv, oauthProviders, webhooksProv, paymentsProv)
 	appLog.Info("Successfully built services")
 
+	// Readiness starts failing as soon as the lifecycle context is cancelled
+	go func() {
+		<-lifecycle.Context().Done()
+		appLog.Info("Lifecycle context cancelled, failing readiness checks...")
+		srvs.BeginShutdown()
+	}()
+
+	// Background workers
+	appLog.Info("Starting webhook deliveries worker...")
+	lifecycle.Go("webhook deliveries", func(ctx context.Context) {
+		srvs.RunWebhookDeliveriesWorker(ctx, time.Minute)
+	})
+	appLog.Info("Successfully started webhook deliveries worker")
+	appLog.Info("Starting user data exports worker...")
+	lifecycle.Go("user data exports", func(ctx context.Context) {
+		srvs.RunUserDataExportsWorker(ctx, time.Minute)
+	})
+	appLog.Info("Successfully started user data exports worker")
+	appLog.Info("Starting deleted users purge worker...")
+	lifecycle.Go("deleted users purge", func(ctx context.Context) {
+		srvs.RunDeletedUsersPurgeWorker(
+			ctx,
+			time.Hour,
+			time.Duration(accountDeletionConfig.GraceDays)*24*time.Hour,
+		)
+	})
+	appLog.Info("Successfully started deleted users purge worker")
+	appLog.Info("Starting scheduled releases worker...")
+	lifecycle.Go("scheduled releases", func(ctx context.Context) {
+		srvs.RunScheduledReleasesWorker(ctx, time.Minute)
+	})
+	appLog.Info("Successfully started scheduled releases worker")
+	appLog.Info("Starting series analytics worker...")
+	lifecycle.Go("series analytics", func(ctx context.Context) {
+		srvs.RunSeriesAnalyticsWorker(ctx, 15*time.Minute)
+	})
+	appLog.Info("Successfully started series analytics worker")
+	appLog.Info("Starting lesson engagement worker...")
+	lifecycle.Go("lesson engagement", func(ctx context.Context) {
+		srvs.RunLessonEngagementWorker(ctx, time.Hour, int(engagementConfig.RetentionMonths))
+	})
+	appLog.Info("Successfully started lesson engagement worker")
+
 	// Build controllers
 	appLog.Info("Building controllers...")
 	ctrls := controllers.NewControllers(log, srvs, vld, frontendDomain, backendDomain, refreshCookieName)
@@ -160,6 +237,7 @@ func CreateApp(
 
 	// Build routes, public routes need to be defined before private ones
 	appLog.Info("Loading public routes...")
+	rtr.SlugRedirectRoutes()
 	rtr.HealthRoutes()
 	rtr.AuthPublicRoutes()
 	rtr.OAuthPublicRoutes()
@@ -167,12 +245,15 @@ func CreateApp(
 	rtr.SeriesPublicRoutes()
 	rtr.SeriesDiscoveryRoutes()
 	rtr.SeriesPicturesPublicRoutes()
+	rtr.SeriesReviewsPublicRoutes()
 	rtr.SectionPublicRoutes()
 	rtr.LessonsPublicRoutes()
 	rtr.LessonArticlePublicRoutes()
 	rtr.LessonVideoPublicRoutes()
 	rtr.LessonFilesPublicRoutes()
+	rtr.LessonCommentsPublicRoutes()
 	rtr.CertificatesPublicRoutes()
+	rtr.PaymentsPublicRoutes()
 	appLog.Info("Successfully loaded public routes")
 
 	// User
@@ -185,27 +266,56 @@ func CreateApp(
 	rtr.AuthPrivateRoutes()
 	rtr.LanguageProgressPrivateRoutes()
 	rtr.SeriesProgressPrivateRoutes()
+	rtr.SeriesVersionsPrivateRoutes()
+	rtr.SeriesReviewsPrivateRoutes()
 	rtr.SectionProgressPrivateRoutes()
 	rtr.LessonProgressPrivateRoutes()
+	rtr.LessonCommentsPrivateRoutes()
+	rtr.LessonNotesPrivateRoutes()
+	rtr.LessonEventsPrivateRoutes()
+	rtr.BookmarksPrivateRoutes()
 	rtr.CertificatesPrivateRoutes()
+	rtr.OrganizationsPrivateRoutes()
+	rtr.EnrollmentsPrivateRoutes()
 	appLog.Info("Successfully loaded private routes")
 
 	// Staff routes
 	appLog.Info("Loading staff routes...")
 	rtr.SeriesStaffRoutes()
 	rtr.SeriesPicturesStaffRoutes()
+	rtr.SeriesReviewsStaffRoutes()
 	rtr.SectionStaffRoutes()
 	rtr.LessonsStaffRoutes()
 	rtr.LessonArticleStaffRoutes()
 	rtr.LessonVideoStaffRoutes()
 	rtr.LessonFilesStaffRoutes()
+	rtr.LessonCommentsStaffRoutes()
+	rtr.ScheduledReleasesStaffRoutes()
+	rtr.EditorialStaffRoutes()
+	rtr.SeriesVersionsStaffRoutes()
+	rtr.SeriesAnalyticsStaffRoutes()
 	appLog.Info("Successfully loaded staff routes")
 
 	// Admin Routes
 	appLog.Info("Loading admin routes...")
 	rtr.LanguageAdminRoutes()
+	rtr.WebhooksAdminRoutes()
+	rtr.CouponsAdminRoutes()
 	appLog.Info("Successfully loaded admin routes")
 
+	// Docs routes, the document is built last so it sees every other route
+	appLog.Info("Loading docs routes...")
+	rtr.DocsRoutes()
+	document, drift := rtr.BuildOpenAPIDocument(backendDomain)
+	for _, d := range drift {
+		appLog.Warn("OpenAPI document drift", "drift", d)
+	}
+	if err := ctrls.SetOpenAPIDocument(document); err != nil {
+		appLog.Error("Failed to serialize OpenAPI document", "error", err)
+		panic(err)
+	}
+	appLog.Info("Successfully loaded docs routes")
+
 	appLog.Info("Successfully built the app")
 	return app
 }
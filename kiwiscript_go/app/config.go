@@ -65,6 +65,34 @@ type ObjectStorageConfig struct {
 	Host      string
 }
 
+type TelemetryConfig struct {
+	ServiceName       string
+	TracesExporter    string
+	TracesEndpoint    string
+	TracesInsecure    bool
+	TracesSampleRatio float64
+	MetricsEnabled    bool
+}
+
+type ShutdownConfig struct {
+	DrainSec   int64
+	TimeoutSec int64
+}
+
+type PaymentsConfig struct {
+	Provider      string
+	SecretKey     string
+	WebhookSecret string
+}
+
+type AccountDeletionConfig struct {
+	GraceDays int64
+}
+
+type EngagementConfig struct {
+	RetentionMonths int64
+}
+
 type OAuthProvider struct {
 	ClientID     string
 	ClientSecret string
@@ -90,9 +118,14 @@ type Config struct {
 	Limiter           LimiterConfig
 	ObjectStorage     ObjectStorageConfig
 	OAuthProviders    OAuthProviders
+	AccountDeletion   AccountDeletionConfig
+	Engagement        EngagementConfig
+	Telemetry         TelemetryConfig
+	Shutdown          ShutdownConfig
+	Payments          PaymentsConfig
 }
 
-var variables = [38]string{
+var variables = [39]string{
 	"PORT",
 	"ENV",
 	"DEBUG",
@@ -131,6 +164,7 @@ var variables = [38]string{
 	"GITHUB_CLIENT_SECRET",
 	"GOOGLE_CLIENT_ID",
 	"GOOGLE_CLIENT_SECRET",
+	"PAYMENTS_WEBHOOK_SECRET",
 }
 
 var numerics = [7]string{
@@ -143,6 +177,21 @@ var numerics = [7]string{
 	"LIMITER_EXP_SEC",
 }
 
+var optionals = map[string]string{
+	"OTEL_SERVICE_NAME":              "kiwiscript",
+	"OTEL_TRACES_EXPORTER":           "none",
+	"OTEL_EXPORTER_OTLP_ENDPOINT":    "localhost:4318",
+	"OTEL_EXPORTER_OTLP_INSECURE":    "false",
+	"OTEL_TRACES_SAMPLER_ARG":        "1",
+	"METRICS_ENABLED":                "true",
+	"SHUTDOWN_DRAIN_SEC":             "5",
+	"SHUTDOWN_TIMEOUT_SEC":           "30",
+	"PAYMENTS_PROVIDER":              "fake",
+	"PAYMENTS_SECRET_KEY":            "",
+	"LESSON_EVENTS_RETENTION_MONTHS": "12",
+	"ACCOUNT_DELETION_GRACE_DAYS":    "30",
+}
+
 func NewConfig(log *slog.Logger, envPath string) *Config {
 	err := godotenv.Load(envPath)
 	if err != nil {
@@ -159,6 +208,14 @@ func NewConfig(log *slog.Logger, envPath string) *Config {
 		variablesMap[variable] = value
 	}
 
+	for variable, defaultValue := range optionals {
+		value := os.Getenv(variable)
+		if value == "" {
+			value = defaultValue
+		}
+		variablesMap[variable] = value
+	}
+
 	intMap := make(map[string]int64)
 	for _, numeric := range numerics {
 		value, err := strconv.ParseInt(variablesMap[numeric], 10, 0)
@@ -168,6 +225,52 @@ func NewConfig(log *slog.Logger, envPath string) *Config {
 		}
 		intMap[numeric] = value
 	}
+
+	for _, numeric := range [2]string{"SHUTDOWN_DRAIN_SEC", "SHUTDOWN_TIMEOUT_SEC"} {
+		value, err := strconv.ParseInt(variablesMap[numeric], 10, 0)
+		if err != nil || value < 0 {
+			log.Error(numeric + " is not a positive integer")
+			panic(numeric + " is not a positive integer")
+		}
+		intMap[numeric] = value
+	}
+
+	graceDays, err := strconv.ParseInt(variablesMap["ACCOUNT_DELETION_GRACE_DAYS"], 10, 0)
+	if err != nil || graceDays < 0 {
+		log.Error("ACCOUNT_DELETION_GRACE_DAYS is not a positive integer")
+		panic("ACCOUNT_DELETION_GRACE_DAYS is not a positive integer")
+	}
+
+	retentionMonths, err := strconv.ParseInt(variablesMap["LESSON_EVENTS_RETENTION_MONTHS"], 10, 0)
+	if err != nil || retentionMonths < 1 {
+		log.Error("LESSON_EVENTS_RETENTION_MONTHS must be an integer of at least 1")
+		panic("LESSON_EVENTS_RETENTION_MONTHS must be an integer of at least 1")
+	}
+
+	sampleRatio, err := strconv.ParseFloat(variablesMap["OTEL_TRACES_SAMPLER_ARG"], 64)
+	if err != nil || sampleRatio < 0 || sampleRatio > 1 {
+		log.Error("OTEL_TRACES_SAMPLER_ARG must be a number between 0 and 1")
+		panic("OTEL_TRACES_SAMPLER_ARG must be a number between 0 and 1")
+	}
+
+	env := strings.ToLower(variablesMap["ENV"])
+	paymentsProvider := strings.ToLower(variablesMap["PAYMENTS_PROVIDER"])
+	switch paymentsProvider {
+	case "fake":
+		if env != "development" && env != "test" {
+			log.Error("PAYMENTS_PROVIDER fake is only allowed in development and test")
+			panic("PAYMENTS_PROVIDER fake is only allowed in development and test")
+		}
+	case "stripe":
+		if variablesMap["PAYMENTS_SECRET_KEY"] == "" {
+			log.Error("PAYMENTS_SECRET_KEY is required for the stripe provider")
+			panic("PAYMENTS_SECRET_KEY is required for the stripe provider")
+		}
+	default:
+		log.Error("PAYMENTS_PROVIDER must be either stripe or fake")
+		panic("PAYMENTS_PROVIDER must be either stripe or fake")
+	}
+
 	return &Config{
 		MaxProcs:          intMap["MAX_PROCS"],
 		Port:              variablesMap["PORT"],
@@ -178,7 +281,7 @@ func NewConfig(log *slog.Logger, envPath string) *Config {
 		CookieSecret:      variablesMap["COOKIE_SECRET"],
 		RefreshCookieName: variablesMap["REFRESH_COOKIE_NAME"],
 		Logger: LoggerConfig{
-			Env:   strings.ToLower(variablesMap["ENV"]),
+			Env:   env,
 			Debug: strings.ToLower(variablesMap["DEBUG"]) == "true",
 		},
 		Email: EmailConfig{
@@ -231,5 +334,28 @@ func NewConfig(log *slog.Logger, envPath string) *Config {
 				ClientSecret: variablesMap["GOOGLE_CLIENT_SECRET"],
 			},
 		},
+		AccountDeletion: AccountDeletionConfig{
+			GraceDays: graceDays,
+		},
+		Engagement: EngagementConfig{
+			RetentionMonths: retentionMonths,
+		},
+		Telemetry: TelemetryConfig{
+			ServiceName:       variablesMap["OTEL_SERVICE_NAME"],
+			TracesExporter:    strings.ToLower(variablesMap["OTEL_TRACES_EXPORTER"]),
+			TracesEndpoint:    variablesMap["OTEL_EXPORTER_OTLP_ENDPOINT"],
+			TracesInsecure:    strings.ToLower(variablesMap["OTEL_EXPORTER_OTLP_INSECURE"]) == "true",
+			TracesSampleRatio: sampleRatio,
+			MetricsEnabled:    strings.ToLower(variablesMap["METRICS_ENABLED"]) == "true",
+		},
+		Shutdown: ShutdownConfig{
+			DrainSec:   intMap["SHUTDOWN_DRAIN_SEC"],
+			TimeoutSec: intMap["SHUTDOWN_TIMEOUT_SEC"],
+		},
+		Payments: PaymentsConfig{
+			Provider:      paymentsProvider,
+			SecretKey:     variablesMap["PAYMENTS_SECRET_KEY"],
+			WebhookSecret: variablesMap["PAYMENTS_WEBHOOK_SECRET"],
+		},
 	}
 }
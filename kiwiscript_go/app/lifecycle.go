@@ -0,0 +1,170 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/utils"
+)
+
+type shutdownHook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Lifecycle owns the context shared by the app and its background workers and
+// tears everything down in a fixed order once a termination signal arrives:
+// fail readiness, stop accepting connections, wait for in-flight handlers and
+// workers, then run the shutdown hooks in the order they were registered.
+type Lifecycle struct {
+	log     *slog.Logger
+	ctx     context.Context
+	cancel  context.CancelFunc
+	workers sync.WaitGroup
+	hooks   []shutdownHook
+	drain   time.Duration
+	timeout time.Duration
+}
+
+func NewLifecycle(log *slog.Logger, shutdownConfig *ShutdownConfig) *Lifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Lifecycle{
+		log:     log,
+		ctx:     ctx,
+		cancel:  cancel,
+		drain:   time.Duration(shutdownConfig.DrainSec) * time.Second,
+		timeout: time.Duration(shutdownConfig.TimeoutSec) * time.Second,
+	}
+}
+
+// Context is cancelled as soon as shutdown starts.
+func (l *Lifecycle) Context() context.Context {
+	return l.ctx
+}
+
+// Go runs a background worker that is awaited during shutdown, the worker must
+// return once the lifecycle context is cancelled.
+func (l *Lifecycle) Go(name string, worker func(ctx context.Context)) {
+	l.workers.Add(1)
+	go func() {
+		defer l.workers.Done()
+		worker(l.ctx)
+		l.buildLogger("Go").Info("Background worker stopped", "worker", name)
+	}()
+}
+
+// OnShutdown registers a hook that runs after the server and the workers have
+// stopped, hooks run in registration order.
+func (l *Lifecycle) OnShutdown(name string, fn func(ctx context.Context) error) {
+	l.hooks = append(l.hooks, shutdownHook{name: name, fn: fn})
+}
+
+// Run starts the fiber app and blocks until it has been shutdown, either by a
+// SIGINT/SIGTERM or by a listener error.
+func (l *Lifecycle) Run(fiberApp *fiber.App, addr string) error {
+	log := l.buildLogger("Run")
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- fiberApp.Listen(addr)
+	}()
+
+	var err error
+	select {
+	case sig := <-signals:
+		log.Info("Shutdown signal received", "signal", sig.String())
+	case err = <-listenErr:
+		log.Error("Fiber app stopped listening", "error", err)
+	}
+
+	return errors.Join(err, l.shutdown(fiberApp, err == nil))
+}
+
+func (l *Lifecycle) shutdown(fiberApp *fiber.App, listening bool) error {
+	log := l.buildLogger("shutdown")
+	ctx, cancel := context.WithTimeout(context.Background(), l.drain+l.timeout)
+	defer cancel()
+
+	log.Info("Failing readiness checks and stopping background workers...", "drain", l.drain)
+	l.cancel()
+
+	var errs []error
+	if listening {
+		select {
+		case <-time.After(l.drain):
+		case <-ctx.Done():
+		}
+
+		log.Info("Stopping the fiber app and waiting for in-flight requests...")
+		if err := fiberApp.ShutdownWithContext(ctx); err != nil {
+			log.Error("Failed to shutdown the fiber app", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	log.Info("Waiting for background workers...")
+	workersDone := make(chan struct{})
+	go func() {
+		l.workers.Wait()
+		close(workersDone)
+	}()
+	select {
+	case <-workersDone:
+		log.Info("Background workers stopped")
+	case <-ctx.Done():
+		log.Error("Timed out waiting for background workers")
+		errs = append(errs, ctx.Err())
+	}
+
+	// Hooks get their own deadline so resources are always released, even if
+	// draining used up the whole timeout.
+	hooksCtx, hooksCancel := context.WithTimeout(context.Background(), l.timeout)
+	defer hooksCancel()
+	for _, hook := range l.hooks {
+		log.Info("Running shutdown hook...", "hook", hook.name)
+		if err := hook.fn(hooksCtx); err != nil {
+			log.Error("Shutdown hook failed", "hook", hook.name, "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	log.Info("Finished shutting down")
+	return errors.Join(errs...)
+}
+
+func (l *Lifecycle) buildLogger(function string) *slog.Logger {
+	return utils.BuildLogger(l.log, utils.LoggerOptions{
+		Layer:     utils.AppLogLayer,
+		Location:  "lifecycle",
+		Function:  function,
+		RequestID: "lifecycle",
+	})
+}
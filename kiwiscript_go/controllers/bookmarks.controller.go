@@ -0,0 +1,297 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const bookmarksLocation string = "bookmarks"
+
+func (c *Controllers) CreateSeriesBookmark(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, bookmarksLocation, "CreateSeriesBookmark").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Creating series bookmark...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	bookmark, serviceErr := c.services.CreateSeriesBookmark(userCtx, services.SeriesBookmarkOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewSeriesBookmarkResponse(c.backendDomain, bookmark))
+}
+
+func (c *Controllers) DeleteSeriesBookmark(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, bookmarksLocation, "DeleteSeriesBookmark").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Deleting series bookmark...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	serviceErr = c.services.DeleteSeriesBookmark(userCtx, services.SeriesBookmarkOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) CreateLessonBookmark(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, bookmarksLocation, "CreateLessonBookmark").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Creating lesson bookmark...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	bookmark, serviceErr := c.services.CreateLessonBookmark(userCtx, services.LessonBookmarkOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewLessonBookmarkResponse(c.backendDomain, bookmark))
+}
+
+func (c *Controllers) DeleteLessonBookmark(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, bookmarksLocation, "DeleteLessonBookmark").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Deleting lesson bookmark...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.DeleteLessonBookmark(userCtx, services.LessonBookmarkOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) GetMySeriesBookmarks(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, bookmarksLocation, "GetMySeriesBookmarks")
+	log.InfoContext(userCtx, "Getting my series bookmarks...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	bookmarks, count, serviceErr := c.services.FindPaginatedSeriesBookmarks(
+		userCtx,
+		services.FindPaginatedBookmarksOptions{
+			RequestID: requestID,
+			UserID:    user.ID,
+			Offset:    queryParams.Offset,
+			Limit:     queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf("%s%s%s%s", paths.UsersPathV1, paths.MePath, paths.BookmarksPath, paths.SeriesPath),
+		&queryParams,
+		count,
+		bookmarks,
+		func(m *db.SeriesBookmarkModel) *dtos.SeriesBookmarkResponse {
+			return dtos.NewSeriesBookmarkResponse(c.backendDomain, m)
+		},
+	))
+}
+
+func (c *Controllers) GetMyLessonBookmarks(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, bookmarksLocation, "GetMyLessonBookmarks")
+	log.InfoContext(userCtx, "Getting my lesson bookmarks...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	bookmarks, count, serviceErr := c.services.FindPaginatedLessonBookmarks(
+		userCtx,
+		services.FindPaginatedBookmarksOptions{
+			RequestID: requestID,
+			UserID:    user.ID,
+			Offset:    queryParams.Offset,
+			Limit:     queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf("%s%s%s%s", paths.UsersPathV1, paths.MePath, paths.BookmarksPath, paths.LessonsPath),
+		&queryParams,
+		count,
+		bookmarks,
+		func(m *db.LessonBookmarkModel) *dtos.LessonBookmarkResponse {
+			return dtos.NewLessonBookmarkResponse(c.backendDomain, m)
+		},
+	))
+}
@@ -0,0 +1,474 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const cohortsLocation string = "cohorts"
+
+func parseCohortIDParams(organizationID, cohortID string) (int32, int32, *exceptions.FieldError) {
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", organizationID)
+	if fieldErr != nil {
+		return 0, 0, fieldErr
+	}
+
+	parsedCohortID, fieldErr := parseOrganizationIDParam("cohortId", cohortID)
+	if fieldErr != nil {
+		return 0, 0, fieldErr
+	}
+
+	return parsedOrganizationID, parsedCohortID, nil
+}
+
+func (c *Controllers) CreateCohort(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "CreateCohort").With(
+		"organizationId", organizationID,
+	)
+	log.InfoContext(userCtx, "Creating cohort...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.OrganizationPathParams{OrganizationID: organizationID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.CohortBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	cohort, serviceErr := c.services.CreateCohort(userCtx, services.CreateCohortOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		Name:           strings.TrimSpace(request.Name),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewCohortResponse(c.backendDomain, cohort.ToCohortModel()))
+}
+
+func (c *Controllers) GetCohorts(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "GetCohorts").With(
+		"organizationId", organizationID,
+	)
+	log.InfoContext(userCtx, "Getting cohorts...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.OrganizationPathParams{OrganizationID: organizationID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	cohorts, count, serviceErr := c.services.FindPaginatedCohorts(userCtx, services.FindPaginatedCohortsOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		Offset:         queryParams.Offset,
+		Limit:          queryParams.Limit,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf("%s/%d%s", paths.OrganizationsV1, parsedOrganizationID, paths.CohortsPath),
+		&queryParams,
+		count,
+		cohorts,
+		func(co *db.Cohort) *dtos.CohortResponse {
+			return dtos.NewCohortResponse(c.backendDomain, co.ToCohortModel())
+		},
+	))
+}
+
+func (c *Controllers) AddCohortMember(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	cohortID := ctx.Params("cohortID")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "AddCohortMember").With(
+		"organizationId", organizationID,
+		"cohortId", cohortID,
+	)
+	log.InfoContext(userCtx, "Adding cohort member...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.CohortPathParams{
+		OrganizationID: organizationID,
+		CohortID:       cohortID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.CohortMemberBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, parsedCohortID, fieldErr := parseCohortIDParams(params.OrganizationID, params.CohortID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.AddCohortMember(userCtx, services.AddCohortMemberOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		CohortID:       parsedCohortID,
+		MemberID:       request.UserID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) RemoveCohortMember(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	cohortID := ctx.Params("cohortID")
+	userID := ctx.Params("userID")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "RemoveCohortMember").With(
+		"organizationId", organizationID,
+		"cohortId", cohortID,
+		"memberId", userID,
+	)
+	log.InfoContext(userCtx, "Removing cohort member...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.CohortMemberPathParams{
+		OrganizationID: organizationID,
+		CohortID:       cohortID,
+		UserID:         userID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, parsedCohortID, fieldErr := parseCohortIDParams(params.OrganizationID, params.CohortID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedUserID, fieldErr := parseOrganizationIDParam("userId", params.UserID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.RemoveCohortMember(userCtx, services.RemoveCohortMemberOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		CohortID:       parsedCohortID,
+		MemberID:       parsedUserID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) AssignCohortSeries(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	cohortID := ctx.Params("cohortID")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "AssignCohortSeries").With(
+		"organizationId", organizationID,
+		"cohortId", cohortID,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Assigning cohort series...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.CohortSeriesPathParams{
+		OrganizationID: organizationID,
+		CohortID:       cohortID,
+		SeriesSlug:     seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.CohortSeriesBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var dueAt *time.Time
+	if request.DueAt != "" {
+		parsedDueAt, err := time.Parse(time.RFC3339, request.DueAt)
+		if err != nil {
+			return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+		}
+		dueAt = &parsedDueAt
+	}
+
+	parsedOrganizationID, parsedCohortID, fieldErr := parseCohortIDParams(params.OrganizationID, params.CohortID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	cohortSeries, serviceErr := c.services.AssignCohortSeries(userCtx, services.AssignCohortSeriesOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		CohortID:       parsedCohortID,
+		LanguageSlug:   request.LanguageSlug,
+		SeriesSlug:     params.SeriesSlug,
+		DueAt:          dueAt,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewCohortSeriesResponse(
+		c.backendDomain,
+		parsedOrganizationID,
+		cohortSeries.ToCohortSeriesModel(),
+	))
+}
+
+func (c *Controllers) UnassignCohortSeries(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	cohortID := ctx.Params("cohortID")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "UnassignCohortSeries").With(
+		"organizationId", organizationID,
+		"cohortId", cohortID,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Unassigning cohort series...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.CohortSeriesPathParams{
+		OrganizationID: organizationID,
+		CohortID:       cohortID,
+		SeriesSlug:     seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, parsedCohortID, fieldErr := parseCohortIDParams(params.OrganizationID, params.CohortID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.UnassignCohortSeries(userCtx, services.UnassignCohortSeriesOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		CohortID:       parsedCohortID,
+		SeriesSlug:     params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) GetCohortProgress(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	cohortID := ctx.Params("cohortID")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "GetCohortProgress").With(
+		"organizationId", organizationID,
+		"cohortId", cohortID,
+	)
+	log.InfoContext(userCtx, "Getting cohort progress...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.CohortPathParams{
+		OrganizationID: organizationID,
+		CohortID:       cohortID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, parsedCohortID, fieldErr := parseCohortIDParams(params.OrganizationID, params.CohortID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	progress, serviceErr := c.services.FindCohortProgress(userCtx, services.FindCohortProgressOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		CohortID:       parsedCohortID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	response := make([]*dtos.CohortProgressResponse, len(progress))
+	for i := range progress {
+		response[i] = dtos.NewCohortProgressResponse(c.backendDomain, &progress[i])
+	}
+
+	return ctx.JSON(response)
+}
+
+func (c *Controllers) ExportCohortProgress(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	cohortID := ctx.Params("cohortID")
+	log := c.buildLogger(ctx, requestID, cohortsLocation, "ExportCohortProgress").With(
+		"organizationId", organizationID,
+		"cohortId", cohortID,
+	)
+	log.InfoContext(userCtx, "Exporting cohort progress...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.CohortPathParams{
+		OrganizationID: organizationID,
+		CohortID:       cohortID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, parsedCohortID, fieldErr := parseCohortIDParams(params.OrganizationID, params.CohortID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	export, serviceErr := c.services.ExportCohortProgress(userCtx, services.FindCohortProgressOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		CohortID:       parsedCohortID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Set(
+		fiber.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="cohort-%d-progress.csv"`, parsedCohortID),
+	)
+	return ctx.SendString(export)
+}
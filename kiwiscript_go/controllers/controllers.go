@@ -31,6 +31,7 @@ type Controllers struct {
 	frontendDomain    string
 	backendDomain     string
 	refreshCookieName string
+	openAPIDocument   []byte
 }
 
 func NewControllers(log *slog.Logger, services *services.Services, validate *validator.Validate, frontendDomain, backendDomain, refreshCookieName string) *Controllers {
@@ -0,0 +1,166 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const couponsLocation string = "coupons"
+
+func (c *Controllers) CreateCoupon(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, couponsLocation, "CreateCoupon")
+	log.InfoContext(userCtx, "Creating coupon...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	var request dtos.CouponBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var expiresAt *time.Time
+	if request.ExpiresAt != "" {
+		parsedExpiresAt, err := time.Parse(time.RFC3339, request.ExpiresAt)
+		if err != nil {
+			return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+		}
+		expiresAt = &parsedExpiresAt
+	}
+
+	coupon, serviceErr := c.services.CreateCoupon(userCtx, services.CreateCouponOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		Code:           strings.TrimSpace(request.Code),
+		LanguageSlug:   request.LanguageSlug,
+		SeriesSlug:     request.SeriesSlug,
+		PercentOff:     request.PercentOff,
+		MaxRedemptions: request.MaxRedemptions,
+		ExpiresAt:      expiresAt,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewCouponResponse(c.backendDomain, coupon.ToCouponModel()))
+}
+
+func (c *Controllers) GetCoupons(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, couponsLocation, "GetCoupons")
+	log.InfoContext(userCtx, "Getting coupons...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	coupons, count, serviceErr := c.services.FindPaginatedCoupons(userCtx, services.FindPaginatedCouponsOptions{
+		RequestID: requestID,
+		Offset:    queryParams.Offset,
+		Limit:     queryParams.Limit,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		paths.CouponsV1,
+		&queryParams,
+		count,
+		coupons,
+		func(cp *db.Coupon) *dtos.CouponResponse {
+			return dtos.NewCouponResponse(c.backendDomain, cp.ToCouponModel())
+		},
+	))
+}
+
+func (c *Controllers) UpdateCouponIsActive(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	couponID := ctx.Params("couponID")
+	log := c.buildLogger(ctx, requestID, couponsLocation, "UpdateCouponIsActive").With("couponId", couponID)
+	log.InfoContext(userCtx, "Updating coupon is active...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.CouponPathParams{CouponID: couponID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedCouponID, err := strconv.Atoi(params.CouponID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "couponId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.CouponID,
+			}}))
+	}
+
+	var request dtos.CouponIsActiveBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	coupon, serviceErr := c.services.UpdateCouponIsActive(userCtx, services.UpdateCouponIsActiveOptions{
+		RequestID: requestID,
+		CouponID:  int32(parsedCouponID),
+		IsActive:  request.IsActive,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewCouponResponse(c.backendDomain, coupon.ToCouponModel()))
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/openapi"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+)
+
+const docsLocation string = "docs"
+
+const docsHTML string = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <meta name="viewport" content="width=device-width, initial-scale=1" />
+  <title>KiwiScript API</title>
+  <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/swagger-ui-dist@5.17.14/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://cdn.jsdelivr.net/npm/swagger-ui-dist@5.17.14/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "/api` + paths.OpenAPIPath + `", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
+
+// SetOpenAPIDocument caches the serialized document, it is built once all
+// routes are loaded so it can't be passed to NewControllers.
+func (c *Controllers) SetOpenAPIDocument(document *openapi.Document) error {
+	body, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	c.openAPIDocument = body
+	return nil
+}
+
+func (c *Controllers) GetOpenAPIDocument(ctx *fiber.Ctx) error {
+	c.buildLogger(ctx, c.requestID(ctx), docsLocation, "GetOpenAPIDocument").InfoContext(
+		ctx.UserContext(),
+		"Getting OpenAPI document...",
+	)
+
+	ctx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	return ctx.Send(c.openAPIDocument)
+}
+
+func (c *Controllers) GetAPIDocs(ctx *fiber.Ctx) error {
+	c.buildLogger(ctx, c.requestID(ctx), docsLocation, "GetAPIDocs").InfoContext(
+		ctx.UserContext(),
+		"Getting API docs...",
+	)
+
+	// The docs UI is loaded from a CDN, which the default helmet policies block
+	ctx.Set("Cross-Origin-Embedder-Policy", "unsafe-none")
+	ctx.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return ctx.SendString(docsHTML)
+}
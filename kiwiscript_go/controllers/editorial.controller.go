@@ -0,0 +1,411 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const editorialLocation string = "editorial"
+
+func (c *Controllers) GetSeriesEditorial(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, editorialLocation, "GetSeriesEditorial").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Getting series editorial...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	editorial, serviceErr := c.services.FindSeriesEditorial(userCtx, services.FindSeriesEditorialOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesEditorialResponse(c.backendDomain, editorial))
+}
+
+func (c *Controllers) UpdateSeriesEditorialStatus(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, editorialLocation, "UpdateSeriesEditorialStatus").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Updating series editorial status...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesEditorialStatusBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	editorial, serviceErr := c.services.UpdateSeriesEditorialStatus(userCtx, services.UpdateSeriesEditorialStatusOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		Status:       request.Status,
+		Comment:      request.Comment,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesEditorialResponse(c.backendDomain, editorial))
+}
+
+func (c *Controllers) AddSeriesEditorialReviewer(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, editorialLocation, "AddSeriesEditorialReviewer").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Adding series editorial reviewer...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesEditorialReviewerBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	reviewer, serviceErr := c.services.AddSeriesEditorialReviewer(userCtx, services.AddSeriesEditorialReviewerOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		ReviewerID:   request.UserID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.
+		Status(fiber.StatusCreated).
+		JSON(dtos.NewSeriesEditorialReviewerResponse(c.backendDomain, reviewer))
+}
+
+func (c *Controllers) RemoveSeriesEditorialReviewer(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	reviewerID := ctx.Params("userID")
+	log := c.buildLogger(ctx, requestID, editorialLocation, "RemoveSeriesEditorialReviewer").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"reviewerId", reviewerID,
+	)
+	log.InfoContext(userCtx, "Removing series editorial reviewer...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesEditorialReviewerPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		UserID:       reviewerID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedReviewerID, err := strconv.Atoi(params.UserID)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(exceptions.NewRequestValidationError(
+			exceptions.RequestValidationLocationParams,
+			[]exceptions.FieldError{{
+				Param:   "userId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.UserID,
+			}},
+		))
+	}
+
+	serviceErr = c.services.RemoveSeriesEditorialReviewer(userCtx, services.RemoveSeriesEditorialReviewerOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		ReviewerID:   int32(parsedReviewerID),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) GetLessonEditorialComments(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, editorialLocation, "GetLessonEditorialComments").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Getting lesson editorial comments...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comments, serviceErr := c.services.FindLessonEditorialComments(userCtx, services.FindLessonEditorialCommentsOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	responses := make([]dtos.LessonEditorialCommentResponse, 0, len(comments))
+	for i := range comments {
+		responses = append(
+			responses,
+			*dtos.NewLessonEditorialCommentResponse(c.backendDomain, parsedSectionID, &comments[i]),
+		)
+	}
+
+	return ctx.JSON(responses)
+}
+
+func (c *Controllers) CreateLessonEditorialComment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, editorialLocation, "CreateLessonEditorialComment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Creating lesson editorial comment...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonEditorialCommentBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.CreateLessonEditorialComment(userCtx, services.CreateLessonEditorialCommentOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		Body:         request.Body,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.
+		Status(fiber.StatusCreated).
+		JSON(dtos.NewLessonEditorialCommentResponse(c.backendDomain, parsedSectionID, comment))
+}
+
+func (c *Controllers) UpdateLessonEditorialCommentIsResolved(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, editorialLocation, "UpdateLessonEditorialCommentIsResolved").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Updating lesson editorial comment is resolved...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonEditorialCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonEditorialCommentIsResolvedBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.UpdateLessonEditorialCommentIsResolved(
+		userCtx,
+		services.UpdateLessonEditorialCommentIsResolvedOptions{
+			RequestID:    requestID,
+			UserID:       user.ID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    parsedSectionID,
+			LessonID:     parsedLessonID,
+			CommentID:    parsedCommentID,
+			IsResolved:   request.IsResolved,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonEditorialCommentResponse(c.backendDomain, parsedSectionID, comment))
+}
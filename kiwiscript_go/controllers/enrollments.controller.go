@@ -0,0 +1,191 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/payments"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const enrollmentsLocation string = "enrollments"
+
+func (c *Controllers) GetEnrollment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, enrollmentsLocation, "GetEnrollment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Getting enrollment...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	enrollment, serviceErr := c.services.FindEnrollment(userCtx, services.FindEnrollmentOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewEnrollmentResponse(c.backendDomain, enrollment.ToEnrollmentModel()))
+}
+
+func (c *Controllers) CreateEnrollment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, enrollmentsLocation, "CreateEnrollment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Creating enrollment...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	enrollment, serviceErr := c.services.CreateEnrollment(userCtx, services.CreateEnrollmentOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.
+		Status(fiber.StatusCreated).
+		JSON(dtos.NewEnrollmentResponse(c.backendDomain, enrollment.ToEnrollmentModel()))
+}
+
+func (c *Controllers) CreateCheckout(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, enrollmentsLocation, "CreateCheckout").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Creating checkout...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.CheckoutBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	checkout, serviceErr := c.services.CreateCheckout(userCtx, services.CreateCheckoutOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		CouponCode:   request.CouponCode,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	var enrollment *db.EnrollmentModel
+	if checkout.Enrollment != nil {
+		enrollment = checkout.Enrollment.ToEnrollmentModel()
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewCheckoutResponse(
+		c.backendDomain,
+		checkout.SessionID,
+		checkout.URL,
+		checkout.Amount,
+		checkout.Currency,
+		enrollment,
+	))
+}
+
+func (c *Controllers) HandlePaymentWebhook(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, enrollmentsLocation, "HandlePaymentWebhook")
+	log.InfoContext(userCtx, "Handling payment webhook...")
+
+	signature := ctx.Get(payments.HeaderSignature)
+	if signature == "" {
+		log.WarnContext(userCtx, "Missing webhook signature")
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestError(exceptions.NewValidationError("Missing signature")))
+	}
+
+	if serviceErr := c.services.HandlePaymentWebhook(userCtx, services.HandlePaymentWebhookOptions{
+		RequestID: requestID,
+		Payload:   ctx.Body(),
+		Signature: signature,
+	}); serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
@@ -17,12 +17,48 @@
 
 package controllers
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const healthLocation string = "health"
 
 func (c *Controllers) HealthCheck(ctx *fiber.Ctx) error {
-	c.buildLogger(ctx, c.requestID(ctx), "health", "HealthCheck").InfoContext(
+	c.buildLogger(ctx, c.requestID(ctx), healthLocation, "HealthCheck").InfoContext(
 		ctx.UserContext(),
 		"Performing API health check...",
 	)
 	return ctx.SendStatus(fiber.StatusOK)
 }
+
+func (c *Controllers) LivenessCheck(ctx *fiber.Ctx) error {
+	c.buildLogger(ctx, c.requestID(ctx), healthLocation, "LivenessCheck").InfoContext(
+		ctx.UserContext(),
+		"Performing API liveness check...",
+	)
+	return ctx.JSON(dtos.NewLivenessResponse(services.HealthStatusUp))
+}
+
+func (c *Controllers) ReadinessCheck(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, healthLocation, "ReadinessCheck")
+	log.InfoContext(userCtx, "Performing API readiness check...")
+
+	report := c.services.CheckReadiness(userCtx, requestID)
+	dependencies := make([]dtos.DependencyHealthResponse, 0, len(report.Dependencies))
+	for _, d := range report.Dependencies {
+		dependencies = append(dependencies, dtos.NewDependencyHealthResponse(d.Name, d.Status, d.Latency, d.Error))
+	}
+
+	if !report.Ready {
+		log.WarnContext(userCtx, "API is not ready", "shuttingDown", report.ShuttingDown)
+		return ctx.
+			Status(fiber.StatusServiceUnavailable).
+			JSON(dtos.NewReadinessResponse(services.HealthStatusDown, report.ShuttingDown, dependencies))
+	}
+
+	return ctx.JSON(dtos.NewReadinessResponse(services.HealthStatusUp, report.ShuttingDown, dependencies))
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	"log/slog"
 
@@ -89,3 +90,33 @@ func (c *Controllers) serviceErrorResponse(serviceErr *exceptions.ServiceError,
 		Status(exceptions.NewRequestErrorStatus(serviceErr.Code)).
 		JSON(exceptions.NewRequestError(serviceErr))
 }
+
+func cursorPaginationQueryParams(ctx *fiber.Ctx) dtos.CursorPaginationQueryParams {
+	return dtos.CursorPaginationQueryParams{
+		Offset:    int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:     int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+		Cursor:    ctx.Query("cursor"),
+		WithCount: ctx.QueryBool("withCount", false),
+	}
+}
+
+func isCursorPagination(ctx *fiber.Ctx) bool {
+	return ctx.Context().QueryArgs().Has("cursor")
+}
+
+func (c *Controllers) invalidCursorErrorResponse(
+	log *slog.Logger,
+	userCtx context.Context,
+	err error,
+	ctx *fiber.Ctx,
+	cursor string,
+) error {
+	log.WarnContext(userCtx, "Invalid cursor", "error", err)
+	return ctx.
+		Status(fiber.StatusBadRequest).
+		JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationQuery, []exceptions.FieldError{{
+			Param:   "cursor",
+			Message: exceptions.StrFieldErrMessageCursor,
+			Value:   cursor,
+		}}))
+}
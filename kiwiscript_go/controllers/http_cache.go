@@ -0,0 +1,161 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const (
+	httpCacheLocation string = "http_cache"
+
+	responseCacheTTL    time.Duration = 10 * time.Minute
+	privateCacheControl string        = "private, no-cache"
+	cacheStatusHeader   string        = "X-Cache"
+)
+
+type CacheScope uint8
+
+const (
+	// CacheScopeLanguages covers the languages themselves
+	CacheScopeLanguages CacheScope = iota
+	// CacheScopeLanguage covers everything under the route's languageSlug
+	CacheScopeLanguage
+)
+
+type CachePolicy struct {
+	Scope  CacheScope
+	MaxAge time.Duration
+}
+
+func (p *CachePolicy) cacheControl() string {
+	return fmt.Sprintf("public, max-age=%d", int(p.MaxAge.Seconds()))
+}
+
+func (p *CachePolicy) scope(ctx *fiber.Ctx) string {
+	if p.Scope == CacheScopeLanguage {
+		return services.CatalogLanguageScope(ctx.Params("languageSlug"))
+	}
+
+	return services.CatalogLanguagesScope
+}
+
+// newCatalogCacheKey identifies the cached response of the request URL at the
+// scope's catalog version, any write to the scope moves reads to a new key.
+func newCatalogCacheKey(scope string, version int64, method, url string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%s %s", scope, version, method, url)))
+	return hex.EncodeToString(hash[:16])
+}
+
+// newCatalogETag derives a strong ETag from the response body, so it only
+// changes with the content itself and never outlives it.
+func newCatalogETag(body []byte) string {
+	hash := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(hash[:16]) + `"`
+}
+
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CatalogCacheMiddleware serves anonymous catalog reads from the Redis
+// response cache and answers If-None-Match with 304 when the served body is
+// unchanged, authenticated requests get per user content so they are never
+// shared.
+func (c *Controllers) CatalogCacheMiddleware(policy CachePolicy) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		ctx.Vary(fiber.HeaderAuthorization)
+		if _, serviceErr := c.GetUserClaims(ctx); serviceErr == nil {
+			ctx.Set(fiber.HeaderCacheControl, privateCacheControl)
+			return ctx.Next()
+		}
+
+		requestID := c.requestID(ctx)
+		userCtx := ctx.UserContext()
+		scope := policy.scope(ctx)
+		log := c.buildLogger(ctx, requestID, httpCacheLocation, "CatalogCacheMiddleware").With(
+			"scope", scope,
+		)
+
+		version, serviceErr := c.services.FindCatalogVersion(userCtx, services.FindCatalogVersionOptions{
+			RequestID: requestID,
+			Scope:     scope,
+		})
+		if serviceErr != nil {
+			log.WarnContext(userCtx, "Skipping response cache", "serviceError", serviceErr)
+			return ctx.Next()
+		}
+
+		key := newCatalogCacheKey(scope, version, ctx.Method(), ctx.OriginalURL())
+		body, serviceErr := c.services.FindCachedResponse(userCtx, services.FindCachedResponseOptions{
+			RequestID: requestID,
+			Key:       key,
+		})
+		if serviceErr == nil && body != nil {
+			etag := newCatalogETag(body)
+			ctx.Set(fiber.HeaderETag, etag)
+			ctx.Set(fiber.HeaderCacheControl, policy.cacheControl())
+			ctx.Set(cacheStatusHeader, "HIT")
+			if matchesETag(ctx.Get(fiber.HeaderIfNoneMatch), etag) {
+				return ctx.SendStatus(fiber.StatusNotModified)
+			}
+
+			ctx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+			return ctx.Send(body)
+		}
+
+		if err := ctx.Next(); err != nil {
+			return err
+		}
+		if ctx.Response().StatusCode() != fiber.StatusOK {
+			return nil
+		}
+
+		body = append([]byte(nil), ctx.Response().Body()...)
+		etag := newCatalogETag(body)
+		ctx.Set(fiber.HeaderETag, etag)
+		ctx.Set(fiber.HeaderCacheControl, policy.cacheControl())
+		ctx.Set(cacheStatusHeader, "MISS")
+		c.services.CacheResponse(userCtx, services.CacheResponseOptions{
+			RequestID: requestID,
+			Key:       key,
+			Body:      body,
+			TTL:       responseCacheTTL,
+		})
+
+		if matchesETag(ctx.Get(fiber.HeaderIfNoneMatch), etag) {
+			ctx.Response().ResetBody()
+			return ctx.SendStatus(fiber.StatusNotModified)
+		}
+		return nil
+	}
+}
@@ -227,6 +227,45 @@ func (c *Controllers) UpdateLanguage(ctx *fiber.Ctx) error {
 	return ctx.JSON(dtos.NewLanguageResponse(c.backendDomain, language.ToLanguageModel()))
 }
 
+func (c *Controllers) UpdateLanguageRequiresReview(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	slug := ctx.Params("languageSlug")
+	log := c.buildLogger(ctx, requestID, languagesLocation, "UpdateLanguageRequiresReview").With(
+		"slug", slug,
+	)
+	log.InfoContext(userCtx, "Updating language requires review...")
+
+	user, err := c.GetUserClaims(ctx)
+	if err != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LanguagePathParams{LanguageSlug: slug}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LanguageRequiresReviewBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	language, serviceErr := c.services.UpdateLanguageRequiresReview(userCtx, services.UpdateLanguageRequiresReviewOptions{
+		RequestID:      requestID,
+		Slug:           params.LanguageSlug,
+		RequiresReview: request.RequiresReview,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLanguageResponse(c.backendDomain, language.ToLanguageModel()))
+}
+
 func (c *Controllers) DeleteLanguage(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
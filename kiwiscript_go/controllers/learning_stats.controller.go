@@ -0,0 +1,156 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const learningStatsLocation string = "learning_stats"
+
+func (c *Controllers) GetMyLearningStats(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, learningStatsLocation, "GetMyLearningStats")
+	log.InfoContext(userCtx, "Getting my learning stats...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	stats, serviceErr := c.services.FindUserLearningStats(userCtx, services.FindUserLearningStatsOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLearningStatsResponse(c.backendDomain, stats))
+}
+
+func (c *Controllers) UpdateMyLearningStatsTimezone(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, learningStatsLocation, "UpdateMyLearningStatsTimezone")
+	log.InfoContext(userCtx, "Updating my learning stats timezone...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	var request dtos.LearningStatsTimezoneBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	stats, serviceErr := c.services.UpdateUserLearningStatsTimezone(
+		userCtx,
+		services.UpdateUserLearningStatsTimezoneOptions{
+			RequestID: requestID,
+			UserID:    user.ID,
+			Timezone:  strings.TrimSpace(request.Timezone),
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLearningStatsResponse(c.backendDomain, stats))
+}
+
+func (c *Controllers) GetMyXpEvents(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, learningStatsLocation, "GetMyXpEvents")
+	log.InfoContext(userCtx, "Getting my xp events...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	events, count, serviceErr := c.services.FindPaginatedXpEvents(userCtx, services.FindPaginatedXpEventsOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+		Offset:    queryParams.Offset,
+		Limit:     queryParams.Limit,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf("%s%s%s", paths.UsersPathV1, paths.MePath, paths.XpPath),
+		&queryParams,
+		count,
+		events,
+		func(e *db.XpEvent) *dtos.XpEventResponse {
+			return dtos.NewXpEventResponse(c.backendDomain, e)
+		},
+	))
+}
+
+func (c *Controllers) GetMyAchievements(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, learningStatsLocation, "GetMyAchievements")
+	log.InfoContext(userCtx, "Getting my achievements...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	achievements, serviceErr := c.services.FindUserAchievements(userCtx, services.FindUserAchievementsOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	responses := make([]dtos.AchievementResponse, 0, len(achievements))
+	for _, a := range achievements {
+		responses = append(responses, *dtos.NewAchievementResponse(&a))
+	}
+
+	return ctx.JSON(responses)
+}
@@ -18,6 +18,7 @@
 package controllers
 
 import (
+	"context"
 	"github.com/gofiber/fiber/v2"
 	"github.com/kiwiscript/kiwiscript_go/dtos"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
@@ -109,6 +110,7 @@ func (c *Controllers) CreateLessonArticle(ctx *fiber.Ctx) error {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(article.Version))
 	return ctx.
 		Status(fiber.StatusCreated).
 		JSON(
@@ -177,13 +179,18 @@ func (c *Controllers) GetLessonArticle(ctx *fiber.Ctx) error {
 
 	sectionIDi32 := int32(parsedSectionID)
 	lectureIDi32 := int32(parsedLessonID)
+	var userID int32
 	isPublished := false
 	if user, serviceErr := c.GetUserClaims(ctx); serviceErr != nil || !user.IsStaff {
 		isPublished = true
+		if serviceErr == nil {
+			userID = user.ID
+		}
 	}
 
 	article, serviceErr := c.services.FindLessonArticle(userCtx, services.FindLessonArticleOptions{
 		RequestID:    requestID,
+		UserID:       userID,
 		LanguageSlug: params.LanguageSlug,
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    sectionIDi32,
@@ -191,9 +198,18 @@ func (c *Controllers) GetLessonArticle(ctx *fiber.Ctx) error {
 		IsPublished:  isPublished,
 	})
 	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
+		return c.lessonContentErrorResponse(userCtx, ctx, serviceErr, services.FindLessonOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    sectionIDi32,
+			LessonID:     lectureIDi32,
+		})
 	}
 
+	if !isPublished {
+		ctx.Set(fiber.HeaderETag, versionETag(article.Version))
+	}
 	return ctx.
 		Status(fiber.StatusOK).
 		JSON(
@@ -207,6 +223,29 @@ func (c *Controllers) GetLessonArticle(ctx *fiber.Ctx) error {
 		)
 }
 
+func (c *Controllers) lessonArticlePreconditionFailedResponse(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindLessonArticleOptions,
+) error {
+	article, serviceErr := c.services.FindLessonArticle(userCtx, opts)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return preconditionFailedResponse(
+		ctx,
+		article.Version,
+		dtos.NewLessonArticleResponse(
+			c.backendDomain,
+			opts.LanguageSlug,
+			opts.SeriesSlug,
+			opts.SectionID,
+			article,
+		),
+	)
+}
+
 func (c *Controllers) UpdateLessonArticle(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -274,6 +313,11 @@ func (c *Controllers) UpdateLessonArticle(ctx *fiber.Ctx) error {
 		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	sectionIDi32 := int32(parsedSectionID)
 	lectureIDi32 := int32(parsedLessonID)
 	article, serviceErr := c.services.UpdateLessonArticle(userCtx, services.UpdateLessonArticleOptions{
@@ -284,11 +328,23 @@ func (c *Controllers) UpdateLessonArticle(ctx *fiber.Ctx) error {
 		SectionID:    sectionIDi32,
 		LessonID:     lectureIDi32,
 		Content:      request.Content,
+		Version:      version,
 	})
 	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.lessonArticlePreconditionFailedResponse(userCtx, ctx, services.FindLessonArticleOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    sectionIDi32,
+				LessonID:     lectureIDi32,
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(article.Version))
 	return ctx.
 		JSON(
 			dtos.NewLessonArticleResponse(
@@ -360,6 +416,11 @@ func (c *Controllers) DeleteLessonArticle(ctx *fiber.Ctx) error {
 			))
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	sectionIDi32 := int32(parsedSectionID)
 	lectureIDi32 := int32(parsedLessonID)
 	opts := services.DeleteLessonArticleOptions{
@@ -369,8 +430,19 @@ func (c *Controllers) DeleteLessonArticle(ctx *fiber.Ctx) error {
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    sectionIDi32,
 		LessonID:     lectureIDi32,
+		Version:      version,
 	}
 	if serviceErr := c.services.DeleteLessonArticle(userCtx, opts); serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.lessonArticlePreconditionFailedResponse(userCtx, ctx, services.FindLessonArticleOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    sectionIDi32,
+				LessonID:     lectureIDi32,
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
@@ -0,0 +1,868 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const lessonCommentsLocation string = "lesson_comments"
+
+func parseLessonCommentIDParam(param, value string) (int32, *exceptions.FieldError) {
+	parsedID, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, &exceptions.FieldError{
+			Param:   param,
+			Message: exceptions.StrFieldErrMessageNumber,
+			Value:   value,
+		}
+	}
+
+	return int32(parsedID), nil
+}
+
+func parseLessonCommentLessonIDs(sectionID, lessonID string) (int32, int32, *exceptions.FieldError) {
+	parsedSectionID, fieldErr := parseLessonCommentIDParam("sectionId", sectionID)
+	if fieldErr != nil {
+		return 0, 0, fieldErr
+	}
+
+	parsedLessonID, fieldErr := parseLessonCommentIDParam("lessonId", lessonID)
+	if fieldErr != nil {
+		return 0, 0, fieldErr
+	}
+
+	return parsedSectionID, parsedLessonID, nil
+}
+
+func (c *Controllers) lessonCommentParamsErrorResponse(ctx *fiber.Ctx, fieldErr *exceptions.FieldError) error {
+	return ctx.
+		Status(fiber.StatusBadRequest).
+		JSON(exceptions.NewRequestValidationError(
+			exceptions.RequestValidationLocationParams,
+			[]exceptions.FieldError{*fieldErr},
+		))
+}
+
+func lessonCommentsPath(languageSlug, seriesSlug string, sectionID, lessonID int32) string {
+	return fmt.Sprintf(
+		"%s/%s%s/%s%s/%d%s/%d%s",
+		paths.LanguagePathV1,
+		languageSlug,
+		paths.SeriesPath,
+		seriesSlug,
+		paths.SectionsPath,
+		sectionID,
+		paths.LessonsPath,
+		lessonID,
+		paths.CommentsPath,
+	)
+}
+
+func (c *Controllers) isStaffUser(ctx *fiber.Ctx) bool {
+	user, serviceErr := c.GetUserClaims(ctx)
+	return serviceErr == nil && user.IsStaff
+}
+
+func (c *Controllers) CreateLessonComment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "CreateLessonComment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Creating lesson comment...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonCommentBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.CreateLessonComment(userCtx, services.CreateLessonCommentOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		UserID:       user.ID,
+		Body:         strings.TrimSpace(request.Body),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) GetLessonComments(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "GetLessonComments").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Getting lesson comments...")
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comments, count, serviceErr := c.services.FindPaginatedLessonComments(
+		userCtx,
+		services.FindPaginatedLessonCommentsOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    parsedSectionID,
+			LessonID:     parsedLessonID,
+			IsStaff:      c.isStaffUser(ctx),
+			Offset:       queryParams.Offset,
+			Limit:        queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		lessonCommentsPath(params.LanguageSlug, params.SeriesSlug, parsedSectionID, parsedLessonID),
+		&queryParams,
+		count,
+		comments,
+		func(m *db.LessonCommentModel) *dtos.LessonCommentResponse {
+			return dtos.NewLessonCommentResponse(c.backendDomain, m)
+		},
+	))
+}
+
+func (c *Controllers) GetLessonComment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "GetLessonComment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Getting lesson comment...")
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.FindLessonComment(userCtx, services.FindLessonCommentOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		CommentID:    parsedCommentID,
+		IsStaff:      c.isStaffUser(ctx),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) CreateLessonCommentReply(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "CreateLessonCommentReply").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Creating lesson comment reply...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonCommentBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.CreateLessonComment(userCtx, services.CreateLessonCommentOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		UserID:       user.ID,
+		ParentID:     parsedCommentID,
+		Body:         strings.TrimSpace(request.Body),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) GetLessonCommentReplies(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "GetLessonCommentReplies").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Getting lesson comment replies...")
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comments, count, serviceErr := c.services.FindPaginatedLessonCommentReplies(
+		userCtx,
+		services.FindPaginatedLessonCommentRepliesOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    parsedSectionID,
+			LessonID:     parsedLessonID,
+			CommentID:    parsedCommentID,
+			IsStaff:      c.isStaffUser(ctx),
+			Offset:       queryParams.Offset,
+			Limit:        queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf("%s/%d%s", lessonCommentsPath(params.LanguageSlug, params.SeriesSlug, parsedSectionID, parsedLessonID), parsedCommentID, paths.RepliesPath),
+		&queryParams,
+		count,
+		comments,
+		func(m *db.LessonCommentModel) *dtos.LessonCommentResponse {
+			return dtos.NewLessonCommentResponse(c.backendDomain, m)
+		},
+	))
+}
+
+func (c *Controllers) UpdateLessonComment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "UpdateLessonComment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Updating lesson comment...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonCommentBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.UpdateLessonComment(userCtx, services.UpdateLessonCommentOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		UserID:       user.ID,
+		CommentID:    parsedCommentID,
+		Body:         strings.TrimSpace(request.Body),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) DeleteLessonComment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "DeleteLessonComment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Deleting lesson comment...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.DeleteLessonComment(userCtx, services.DeleteLessonCommentOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		UserID:       user.ID,
+		IsStaff:      user.IsStaff,
+		CommentID:    parsedCommentID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) UpvoteLessonComment(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "UpvoteLessonComment").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Upvoting lesson comment...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.UpvoteLessonComment(userCtx, services.UpvoteLessonCommentOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		UserID:       user.ID,
+		CommentID:    parsedCommentID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) RemoveLessonCommentUpvote(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "RemoveLessonCommentUpvote").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Removing lesson comment upvote...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.RemoveLessonCommentUpvote(userCtx, services.UpvoteLessonCommentOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		UserID:       user.ID,
+		CommentID:    parsedCommentID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) UpdateLessonCommentIsAnswer(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "UpdateLessonCommentIsAnswer").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Updating lesson comment is answer...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonCommentIsAnswerBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.UpdateLessonCommentIsAnswer(userCtx, services.UpdateLessonCommentIsAnswerOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		UserID:       user.ID,
+		CommentID:    parsedCommentID,
+		IsAnswer:     request.IsAnswer,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) UpdateLessonCommentIsHidden(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "UpdateLessonCommentIsHidden").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Updating lesson comment is hidden...")
+
+	if user, serviceErr := c.GetUserClaims(ctx); serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonCommentIsHiddenBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.UpdateLessonCommentIsHidden(userCtx, services.UpdateLessonCommentIsHiddenOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		CommentID:    parsedCommentID,
+		IsHidden:     request.IsHidden,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
+
+func (c *Controllers) UpdateLessonCommentIsLocked(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	commentID := ctx.Params("commentID")
+	log := c.buildLogger(ctx, requestID, lessonCommentsLocation, "UpdateLessonCommentIsLocked").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"commentId", commentID,
+	)
+	log.InfoContext(userCtx, "Updating lesson comment is locked...")
+
+	if user, serviceErr := c.GetUserClaims(ctx); serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonCommentPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		CommentID:    commentID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonCommentIsLockedBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedCommentID, fieldErr := parseLessonCommentIDParam("commentId", params.CommentID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	comment, serviceErr := c.services.UpdateLessonCommentIsLocked(userCtx, services.UpdateLessonCommentIsLockedOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		CommentID:    parsedCommentID,
+		IsLocked:     request.IsLocked,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonCommentResponse(c.backendDomain, comment))
+}
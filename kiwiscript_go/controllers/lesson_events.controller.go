@@ -0,0 +1,128 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const lessonEventsLocation string = "lesson_events"
+
+// newLessonEvents maps an already validated body, so the UUIDs and
+// timestamps are known to parse.
+func newLessonEvents(body *dtos.LessonEventsBody) []services.LessonEvent {
+	events := make([]services.LessonEvent, 0, len(body.Events))
+	for _, event := range body.Events {
+		clientEventID, _ := uuid.Parse(event.ID)
+		occurredAt, _ := time.Parse(time.RFC3339, event.OccurredAt)
+
+		var fileID uuid.UUID
+		if event.FileID != "" {
+			fileID, _ = uuid.Parse(event.FileID)
+		}
+
+		events = append(events, services.LessonEvent{
+			ClientEventID:        clientEventID,
+			Type:                 event.Type,
+			ScrollDepth:          event.ScrollDepth,
+			VideoPositionSeconds: event.VideoPosition,
+			FileID:               fileID,
+			OccurredAt:           occurredAt,
+		})
+	}
+	return events
+}
+
+func (c *Controllers) CreateLessonEvents(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, lessonEventsLocation, "CreateLessonEvents").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Creating lesson events...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	if user.IsStaff || user.IsAdmin {
+		log.WarnContext(userCtx, "Staff users do not track lesson engagement")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonEventsBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	receipt, serviceErr := c.services.CreateLessonEvents(userCtx, services.CreateLessonEventsOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		Events:       newLessonEvents(&request),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusAccepted).JSON(dtos.NewLessonEventsResponse(
+		c.backendDomain,
+		params.LanguageSlug,
+		params.SeriesSlug,
+		parsedSectionID,
+		parsedLessonID,
+		receipt.Received,
+		receipt.Recorded,
+	))
+}
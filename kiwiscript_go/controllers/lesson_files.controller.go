@@ -118,22 +118,13 @@ func (c *Controllers) UploadLessonFile(ctx *fiber.Ctx) error {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
-	fileUrl, serviceErr := c.services.FindFileURL(userCtx, services.FindFileURLOptions{
-		UserID:  user.ID,
-		FileID:  lessonFile.ID,
-		FileExt: lessonFile.Ext,
-	})
-	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
-	}
-
 	return ctx.Status(fiber.StatusCreated).JSON(
 		dtos.NewLessonFileResponse(
 			c.backendDomain,
 			params.LanguageSlug,
 			params.SeriesSlug,
 			sectionIDi32,
-			lessonFile.ToLessonFileModel(fileUrl),
+			lessonFile.ToLessonFileModel(),
 		),
 	)
 }
@@ -187,13 +178,18 @@ func (c *Controllers) GetLessonFiles(ctx *fiber.Ctx) error {
 
 	sectionIDi32 := int32(parsedSectionID)
 	lessonIDi32 := int32(parsedLessonID)
+	var userID int32
 	isPublished := false
 	if user, serviceErr := c.GetUserClaims(ctx); serviceErr != nil || !user.IsStaff {
 		isPublished = true
+		if serviceErr == nil {
+			userID = user.ID
+		}
 	}
 
 	lessonFiles, serviceErr := c.services.FindLessonFiles(userCtx, services.FindLessonFilesOptions{
 		RequestID:    requestID,
+		UserID:       userID,
 		LanguageSlug: params.LanguageSlug,
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    sectionIDi32,
@@ -201,7 +197,13 @@ func (c *Controllers) GetLessonFiles(ctx *fiber.Ctx) error {
 		IsPublished:  isPublished,
 	})
 	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
+		return c.lessonContentErrorResponse(userCtx, ctx, serviceErr, services.FindLessonOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    sectionIDi32,
+			LessonID:     lessonIDi32,
+		})
 	}
 
 	filesLen := len(lessonFiles)
@@ -209,28 +211,8 @@ func (c *Controllers) GetLessonFiles(ctx *fiber.Ctx) error {
 		return ctx.JSON(make([]db.LessonFileModel, 0))
 	}
 
-	optsList := make([]services.FindFileURLOptions, 0, filesLen)
+	responses := make([]dtos.LessonFileResponse, 0, filesLen)
 	for _, lessonFile := range lessonFiles {
-		optsList = append(optsList, services.FindFileURLOptions{
-			RequestID: requestID,
-			UserID:    lessonFile.AuthorID,
-			FileID:    lessonFile.ID,
-			FileExt:   lessonFile.Ext,
-		})
-	}
-
-	fileUrls, serviceErr := c.services.FindFileURLs(userCtx, optsList)
-	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
-	}
-
-	responses := make([]dtos.LessonFileResponse, 0, len(lessonFiles))
-	for _, lessonFile := range lessonFiles {
-		url, ok := fileUrls.Get(lessonFile.ID)
-		if !ok {
-			log.WarnContext(userCtx, "Could not find URL for lesson file", "fileId", lessonFile.ID)
-			continue
-		}
 		responses = append(
 			responses,
 			*dtos.NewLessonFileResponse(
@@ -238,7 +220,7 @@ func (c *Controllers) GetLessonFiles(ctx *fiber.Ctx) error {
 				params.LanguageSlug,
 				params.SeriesSlug,
 				sectionIDi32,
-				lessonFile.ToLessonFileModel(url),
+				lessonFile.ToLessonFileModel(),
 			),
 		)
 	}
@@ -312,13 +294,18 @@ func (c *Controllers) GetLessonFile(ctx *fiber.Ctx) error {
 
 	sectionIDi32 := int32(parsedSectionID)
 	lessonIDi32 := int32(parsedLessonID)
+	var userID int32
 	isPublished := false
 	if user, serviceErr := c.GetUserClaims(ctx); serviceErr != nil || !user.IsStaff {
 		isPublished = true
+		if serviceErr == nil {
+			userID = user.ID
+		}
 	}
 
 	lessonFile, serviceErr := c.services.FindLessonFile(userCtx, services.FindLessonFileOptions{
 		RequestID:    requestID,
+		UserID:       userID,
 		LanguageSlug: params.LanguageSlug,
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    sectionIDi32,
@@ -327,17 +314,13 @@ func (c *Controllers) GetLessonFile(ctx *fiber.Ctx) error {
 		IsPublished:  isPublished,
 	})
 	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
-	}
-
-	fileUrl, serviceErr := c.services.FindFileURL(userCtx, services.FindFileURLOptions{
-		RequestID: requestID,
-		UserID:    lessonFile.AuthorID,
-		FileID:    lessonFile.ID,
-		FileExt:   lessonFile.Ext,
-	})
-	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
+		return c.lessonContentErrorResponse(userCtx, ctx, serviceErr, services.FindLessonOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    sectionIDi32,
+			LessonID:     lessonIDi32,
+		})
 	}
 
 	return ctx.JSON(
@@ -346,11 +329,118 @@ func (c *Controllers) GetLessonFile(ctx *fiber.Ctx) error {
 			params.LanguageSlug,
 			params.SeriesSlug,
 			sectionIDi32,
-			lessonFile.ToLessonFileModel(fileUrl),
+			lessonFile.ToLessonFileModel(),
 		),
 	)
 }
 
+func (c *Controllers) DownloadLessonFile(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	fileID := ctx.Params("fileID")
+	log := c.buildLogger(ctx, requestID, lessonFilesLocation, "DownloadLessonFile").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"fileId", fileID,
+	)
+	log.InfoContext(userCtx, "Downloading lesson file...")
+
+	params := dtos.LessonFilePathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		FileID:       fileID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, err := strconv.Atoi(params.SectionID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "sectionId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.SectionID,
+			}}))
+	}
+
+	parsedLessonID, err := strconv.Atoi(params.LessonID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "lessonId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.LessonID,
+			}}))
+	}
+
+	parsedFileID, err := uuid.Parse(params.FileID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(
+				exceptions.RequestValidationLocationParams,
+				[]exceptions.FieldError{{
+					Param:   "fileId",
+					Message: exceptions.StrFieldErrMessageUUID,
+					Value:   params.FileID,
+				}},
+			))
+	}
+
+	sectionIDi32 := int32(parsedSectionID)
+	lessonIDi32 := int32(parsedLessonID)
+	var userID int32
+	isStaff := false
+	if user, serviceErr := c.GetUserClaims(ctx); serviceErr == nil {
+		userID = user.ID
+		isStaff = user.IsStaff
+	}
+
+	download, serviceErr := c.services.CreateLessonFileDownload(userCtx, services.CreateLessonFileDownloadOptions{
+		RequestID:    requestID,
+		UserID:       userID,
+		IsStaff:      isStaff,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    sectionIDi32,
+		LessonID:     lessonIDi32,
+		File:         parsedFileID,
+	})
+	if serviceErr != nil {
+		return c.lessonContentErrorResponse(userCtx, ctx, serviceErr, services.FindLessonOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    sectionIDi32,
+			LessonID:     lessonIDi32,
+		})
+	}
+
+	// The presigned URL is short lived, neither browsers nor proxies should keep it
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	return ctx.JSON(dtos.NewLessonFileDownloadResponse(
+		c.backendDomain,
+		params.LanguageSlug,
+		params.SeriesSlug,
+		sectionIDi32,
+		download.File.ToLessonFileModel(),
+		download.URL,
+		download.ExpiresAt,
+		download.Downloads,
+	))
+}
+
 func (c *Controllers) DeleteLessonFile(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -533,23 +623,13 @@ func (c *Controllers) UpdateLessonFile(ctx *fiber.Ctx) error {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
-	fileUrl, serviceErr := c.services.FindFileURL(userCtx, services.FindFileURLOptions{
-		RequestID: requestID,
-		UserID:    user.ID,
-		FileID:    lessonFile.ID,
-		FileExt:   lessonFile.Ext,
-	})
-	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
-	}
-
 	return ctx.JSON(
 		dtos.NewLessonFileResponse(
 			c.backendDomain,
 			params.LanguageSlug,
 			params.SeriesSlug,
 			sectionIDi32,
-			lessonFile.ToLessonFileModel(fileUrl),
+			lessonFile.ToLessonFileModel(),
 		),
 	)
 }
@@ -0,0 +1,476 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const lessonNotesLocation string = "lesson_notes"
+
+func (c *Controllers) CreateLessonNote(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, lessonNotesLocation, "CreateLessonNote").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Creating lesson note...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonNoteBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	note, serviceErr := c.services.CreateLessonNote(userCtx, services.CreateLessonNoteOptions{
+		RequestID:     requestID,
+		UserID:        user.ID,
+		LanguageSlug:  params.LanguageSlug,
+		SeriesSlug:    params.SeriesSlug,
+		SectionID:     parsedSectionID,
+		LessonID:      parsedLessonID,
+		Body:          strings.TrimSpace(request.Body),
+		AnchorHeading: strings.TrimSpace(request.AnchorHeading),
+		AnchorSeconds: request.AnchorSeconds,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewLessonNoteResponse(c.backendDomain, note))
+}
+
+func (c *Controllers) GetLessonNotes(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, lessonNotesLocation, "GetLessonNotes").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Getting lesson notes...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	notes, count, serviceErr := c.services.FindPaginatedLessonNotes(
+		userCtx,
+		services.FindPaginatedLessonNotesOptions{
+			RequestID:    requestID,
+			UserID:       user.ID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    parsedSectionID,
+			LessonID:     parsedLessonID,
+			Offset:       queryParams.Offset,
+			Limit:        queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf(
+			"%s/%s%s/%s%s/%d%s/%d%s",
+			paths.LanguagePathV1,
+			params.LanguageSlug,
+			paths.SeriesPath,
+			params.SeriesSlug,
+			paths.SectionsPath,
+			parsedSectionID,
+			paths.LessonsPath,
+			parsedLessonID,
+			paths.NotesPath,
+		),
+		&queryParams,
+		count,
+		notes,
+		func(m *db.LessonNoteModel) *dtos.LessonNoteResponse {
+			return dtos.NewLessonNoteResponse(c.backendDomain, m)
+		},
+	))
+}
+
+func (c *Controllers) GetLessonNote(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	noteID := ctx.Params("noteID")
+	log := c.buildLogger(ctx, requestID, lessonNotesLocation, "GetLessonNote").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"noteId", noteID,
+	)
+	log.InfoContext(userCtx, "Getting lesson note...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonNotePathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		NoteID:       noteID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedNoteID, fieldErr := parseLessonCommentIDParam("noteId", params.NoteID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	note, serviceErr := c.services.FindLessonNote(userCtx, services.FindLessonNoteOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		NoteID:       parsedNoteID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonNoteResponse(c.backendDomain, note))
+}
+
+func (c *Controllers) UpdateLessonNote(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	noteID := ctx.Params("noteID")
+	log := c.buildLogger(ctx, requestID, lessonNotesLocation, "UpdateLessonNote").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"noteId", noteID,
+	)
+	log.InfoContext(userCtx, "Updating lesson note...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonNotePathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		NoteID:       noteID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.LessonNoteBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedNoteID, fieldErr := parseLessonCommentIDParam("noteId", params.NoteID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	note, serviceErr := c.services.UpdateLessonNote(userCtx, services.UpdateLessonNoteOptions{
+		RequestID:     requestID,
+		UserID:        user.ID,
+		LanguageSlug:  params.LanguageSlug,
+		SeriesSlug:    params.SeriesSlug,
+		SectionID:     parsedSectionID,
+		LessonID:      parsedLessonID,
+		NoteID:        parsedNoteID,
+		Body:          strings.TrimSpace(request.Body),
+		AnchorHeading: strings.TrimSpace(request.AnchorHeading),
+		AnchorSeconds: request.AnchorSeconds,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewLessonNoteResponse(c.backendDomain, note))
+}
+
+func (c *Controllers) DeleteLessonNote(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	noteID := ctx.Params("noteID")
+	log := c.buildLogger(ctx, requestID, lessonNotesLocation, "DeleteLessonNote").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+		"noteId", noteID,
+	)
+	log.InfoContext(userCtx, "Deleting lesson note...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.LessonNotePathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+		NoteID:       noteID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, parsedLessonID, fieldErr := parseLessonCommentLessonIDs(params.SectionID, params.LessonID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedNoteID, fieldErr := parseLessonCommentIDParam("noteId", params.NoteID)
+	if fieldErr != nil {
+		return c.lessonCommentParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.DeleteLessonNote(userCtx, services.FindLessonNoteOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    parsedSectionID,
+		LessonID:     parsedLessonID,
+		NoteID:       parsedNoteID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) GetSeriesLessonNotes(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, lessonNotesLocation, "GetSeriesLessonNotes").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Getting series lesson notes...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	notes, count, serviceErr := c.services.FindPaginatedSeriesLessonNotes(
+		userCtx,
+		services.FindPaginatedSeriesLessonNotesOptions{
+			RequestID:    requestID,
+			UserID:       user.ID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			Offset:       queryParams.Offset,
+			Limit:        queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf(
+			"%s/%s%s/%s%s",
+			paths.LanguagePathV1,
+			params.LanguageSlug,
+			paths.SeriesPath,
+			params.SeriesSlug,
+			paths.NotesPath,
+		),
+		&queryParams,
+		count,
+		notes,
+		func(m *db.LessonNoteModel) *dtos.LessonNoteResponse {
+			return dtos.NewLessonNoteResponse(c.backendDomain, m)
+		},
+	))
+}
+
+func (c *Controllers) ExportSeriesLessonNotes(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, lessonNotesLocation, "ExportSeriesLessonNotes").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Exporting series lesson notes...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	markdown, serviceErr := c.services.ExportSeriesLessonNotes(userCtx, services.ExportSeriesLessonNotesOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/markdown; charset=utf-8")
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s-notes.md"`, params.SeriesSlug))
+	return ctx.SendString(markdown)
+}
@@ -166,13 +166,18 @@ func (c *Controllers) GetLessonVideo(ctx *fiber.Ctx) error {
 
 	sectionIDi32 := int32(parsedSectionID)
 	lectureIDi32 := int32(parsedLessonID)
+	var userID int32
 	isPublished := false
 	if user, serviceErr := c.GetUserClaims(ctx); serviceErr != nil || !user.IsStaff {
 		isPublished = true
+		if serviceErr == nil {
+			userID = user.ID
+		}
 	}
 
 	video, serviceErr := c.services.FindLessonVideo(userCtx, services.FindLessonVideoOptions{
 		RequestID:    requestID,
+		UserID:       userID,
 		LanguageSlug: params.LanguageSlug,
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    sectionIDi32,
@@ -180,7 +185,13 @@ func (c *Controllers) GetLessonVideo(ctx *fiber.Ctx) error {
 		IsPublished:  isPublished,
 	})
 	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
+		return c.lessonContentErrorResponse(userCtx, ctx, serviceErr, services.FindLessonOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    sectionIDi32,
+			LessonID:     lectureIDi32,
+		})
 	}
 
 	return ctx.
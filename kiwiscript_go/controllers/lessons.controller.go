@@ -24,11 +24,13 @@ import (
 	"github.com/kiwiscript/kiwiscript_go/dtos"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"log/slog"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/kiwiscript/kiwiscript_go/paths"
 	"github.com/kiwiscript/kiwiscript_go/services"
+	"github.com/kiwiscript/kiwiscript_go/utils"
 )
 
 const lessonLocation string = "lesson"
@@ -93,39 +95,69 @@ func (c *Controllers) CreateLesson(ctx *fiber.Ctx) error {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(lesson.Version))
 	return ctx.
 		Status(fiber.StatusCreated).
 		JSON(dtos.NewLessonResponse(c.backendDomain, lesson.ToLessonModel()))
 }
 
-func (c *Controllers) findLessonFiles(
+// lessonContentErrorResponse answers anonymous visitors of members only
+// lessons with the lesson teaser next to the sign in required error.
+func (c *Controllers) lessonContentErrorResponse(
 	userCtx context.Context,
-	lessonID int32,
-) ([]db.LessonFile, *services.FileURLsContainer, *exceptions.ServiceError) {
-	lessonFiles, serviceErr := c.services.FindLessonFilesWithNoCheck(userCtx, lessonID)
+	ctx *fiber.Ctx,
+	serviceErr *exceptions.ServiceError,
+	opts services.FindLessonOptions,
+) error {
+	if serviceErr.Code != exceptions.CodeSignInRequired {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	teaser, teaserErr := c.services.FindLessonTeaser(userCtx, opts)
+	if teaserErr != nil {
+		return c.serviceErrorResponse(teaserErr, ctx)
+	}
+
+	requestErr := exceptions.NewRequestError(serviceErr)
+	return ctx.Status(fiber.StatusForbidden).JSON(
+		dtos.NewSignInRequiredResponse(
+			c.backendDomain,
+			requestErr.Code,
+			requestErr.Message,
+			teaser.Lesson.ToLessonModel(),
+			teaser.Outline,
+		),
+	)
+}
+
+func (c *Controllers) staffLessonResponse(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	status int,
+	opts services.FindLessonOptions,
+) error {
+	lesson, serviceErr := c.services.FindLessonWithArticleAndVideo(userCtx, opts)
 	if serviceErr != nil {
-		return nil, nil, serviceErr
-	}
-
-	filesLen := len(lessonFiles)
-	var fileUrls *services.FileURLsContainer
-	if filesLen > 0 {
-		optsList := make([]services.FindFileURLOptions, 0, filesLen)
-		for _, lessonFile := range lessonFiles {
-			optsList = append(optsList, services.FindFileURLOptions{
-				UserID:  lessonFile.AuthorID,
-				FileID:  lessonFile.ID,
-				FileExt: lessonFile.Ext,
-			})
-		}
-		var serviceErr *exceptions.ServiceError
-		fileUrls, serviceErr = c.services.FindFileURLs(userCtx, optsList)
-		if serviceErr != nil {
-			return nil, nil, serviceErr
-		}
+		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
-	return lessonFiles, fileUrls, nil
+	files, serviceErr := c.services.FindLessonFilesWithNoCheck(userCtx, opts.LessonID)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	ctx.Set(fiber.HeaderETag, versionETag(lesson.Version))
+	return ctx.Status(status).JSON(
+		dtos.NewLessonResponseWithEmbeddedOptions(
+			c.backendDomain,
+			lesson.ToLessonModel(),
+			lesson.LessonActicleID,
+			lesson.LessonVideoID,
+			lesson.LessonArticleContent.String,
+			lesson.LessonVideoUrl.String,
+			files,
+		),
+	)
 }
 
 func (c *Controllers) GetLesson(ctx *fiber.Ctx) error {
@@ -179,34 +211,13 @@ func (c *Controllers) GetLesson(ctx *fiber.Ctx) error {
 	lessonIDi32 := int32(parsedLessonID)
 	if user, serviceErr := c.GetUserClaims(ctx); serviceErr == nil {
 		if user.IsStaff {
-			lesson, serviceErr := c.services.FindLessonWithArticleAndVideo(userCtx, services.FindLessonOptions{
+			return c.staffLessonResponse(userCtx, ctx, fiber.StatusOK, services.FindLessonOptions{
 				RequestID:    requestID,
 				LanguageSlug: params.LanguageSlug,
 				SeriesSlug:   params.SeriesSlug,
 				SectionID:    sectionIDi32,
 				LessonID:     lessonIDi32,
 			})
-			if serviceErr != nil {
-				return c.serviceErrorResponse(serviceErr, ctx)
-			}
-
-			files, fileUrls, serviceErr := c.findLessonFiles(userCtx, lessonIDi32)
-			if serviceErr != nil {
-				return c.serviceErrorResponse(serviceErr, ctx)
-			}
-
-			return ctx.JSON(
-				dtos.NewLessonResponseWithEmbeddedOptions(
-					c.backendDomain,
-					lesson.ToLessonModel(),
-					lesson.LessonActicleID,
-					lesson.LessonVideoID,
-					lesson.LessonArticleContent.String,
-					lesson.LessonVideoUrl.String,
-					files,
-					fileUrls,
-				),
-			)
 		}
 
 		lesson, serviceErr := c.services.FindPublishedLessonWithProgressArticleAndVideo(
@@ -224,7 +235,7 @@ func (c *Controllers) GetLesson(ctx *fiber.Ctx) error {
 			return c.serviceErrorResponse(serviceErr, ctx)
 		}
 
-		files, fileUrls, serviceErr := c.findLessonFiles(userCtx, lessonIDi32)
+		files, serviceErr := c.services.FindLessonFilesWithNoCheck(userCtx, lessonIDi32)
 		if serviceErr != nil {
 			return c.serviceErrorResponse(serviceErr, ctx)
 		}
@@ -238,23 +249,23 @@ func (c *Controllers) GetLesson(ctx *fiber.Ctx) error {
 				lesson.LessonArticleContent.String,
 				lesson.LessonVideoUrl.String,
 				files,
-				fileUrls,
 			),
 		)
 	}
 
-	lesson, serviceErr := c.services.FindPublishedLessonWithArticleAndVideo(userCtx, services.FindLessonOptions{
+	findOpts := services.FindLessonOptions{
 		RequestID:    requestID,
 		LanguageSlug: params.LanguageSlug,
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    sectionIDi32,
 		LessonID:     lessonIDi32,
-	})
+	}
+	lesson, serviceErr := c.services.FindPublishedLessonWithArticleAndVideo(userCtx, findOpts)
 	if serviceErr != nil {
-		return c.serviceErrorResponse(serviceErr, ctx)
+		return c.lessonContentErrorResponse(userCtx, ctx, serviceErr, findOpts)
 	}
 
-	files, fileUrls, serviceErr := c.findLessonFiles(userCtx, lessonIDi32)
+	files, serviceErr := c.services.FindLessonFilesWithNoCheck(userCtx, lessonIDi32)
 	if serviceErr != nil {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
@@ -268,7 +279,6 @@ func (c *Controllers) GetLesson(ctx *fiber.Ctx) error {
 			lesson.LessonArticleContent.String,
 			lesson.LessonVideoUrl.String,
 			files,
-			fileUrls,
 		),
 	)
 }
@@ -306,15 +316,16 @@ func (c *Controllers) GetLessons(ctx *fiber.Ctx) error {
 			}}))
 	}
 
-	queryParams := dtos.PaginationQueryParams{
-		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
-		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
-	}
+	queryParams := cursorPaginationQueryParams(ctx)
 	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
 		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
 	}
 
 	sectionIDi32 := int32(parsedSectionID)
+	if isCursorPagination(ctx) {
+		return c.getLessonsByCursor(ctx, log, requestID, &params, sectionIDi32, &queryParams)
+	}
+
 	if user, serviceErr := c.GetUserClaims(ctx); serviceErr == nil {
 		if user.IsStaff {
 			lessons, count, serviceErr := c.services.FindPaginatedLessons(userCtx, services.FindPaginatedLessonsOptions{
@@ -328,6 +339,14 @@ func (c *Controllers) GetLessons(ctx *fiber.Ctx) error {
 			if serviceErr != nil {
 				return c.serviceErrorResponse(serviceErr, ctx)
 			}
+			if serviceErr := c.setLessonsOrderETag(userCtx, ctx, services.FindLessonsOrderOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    sectionIDi32,
+			}); serviceErr != nil {
+				return c.serviceErrorResponse(serviceErr, ctx)
+			}
 
 			return ctx.JSON(
 				dtos.NewPaginatedResponse(
@@ -429,6 +448,100 @@ func (c *Controllers) GetLessons(ctx *fiber.Ctx) error {
 	)
 }
 
+func (c *Controllers) getLessonsByCursor(
+	ctx *fiber.Ctx,
+	log *slog.Logger,
+	requestID string,
+	params *dtos.SectionPathParams,
+	sectionID int32,
+	queryParams *dtos.CursorPaginationQueryParams,
+) error {
+	userCtx := ctx.UserContext()
+
+	var cursor *utils.Cursor
+	if queryParams.Cursor != "" {
+		var err error
+		if cursor, err = utils.DecodeCursor(queryParams.Cursor); err != nil {
+			return c.invalidCursorErrorResponse(log, userCtx, err, ctx, queryParams.Cursor)
+		}
+	}
+
+	var page services.CursorPage[db.LessonModel]
+	var serviceErr *exceptions.ServiceError
+	if user, err := c.GetUserClaims(ctx); err == nil {
+		if user.IsStaff {
+			page, serviceErr = c.services.FindLessonsByCursor(userCtx, services.FindLessonsByCursorOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    sectionID,
+				Cursor:       cursor,
+				Limit:        queryParams.Limit,
+				WithCount:    queryParams.WithCount,
+			})
+			if serviceErr == nil {
+				serviceErr = c.setLessonsOrderETag(userCtx, ctx, services.FindLessonsOrderOptions{
+					RequestID:    requestID,
+					LanguageSlug: params.LanguageSlug,
+					SeriesSlug:   params.SeriesSlug,
+					SectionID:    sectionID,
+				})
+			}
+		} else {
+			page, serviceErr = c.services.FindPublishedLessonsWithProgressByCursor(
+				userCtx,
+				services.FindPublishedLessonsWithProgressByCursorOptions{
+					RequestID:    requestID,
+					UserID:       user.ID,
+					LanguageSlug: params.LanguageSlug,
+					SeriesSlug:   params.SeriesSlug,
+					SectionID:    sectionID,
+					Cursor:       cursor,
+					Limit:        queryParams.Limit,
+					WithCount:    queryParams.WithCount,
+				},
+			)
+		}
+	} else {
+		page, serviceErr = c.services.FindPublishedLessonsByCursor(userCtx, services.FindLessonsByCursorOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			SectionID:    sectionID,
+			Cursor:       cursor,
+			Limit:        queryParams.Limit,
+			WithCount:    queryParams.WithCount,
+		})
+	}
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(
+		dtos.NewCursorPaginatedResponse(
+			c.backendDomain,
+			fmt.Sprintf(
+				"%s/%s%s/%s%s/%d%s",
+				paths.LanguagePathV1,
+				params.LanguageSlug,
+				paths.SeriesPath,
+				params.SeriesSlug,
+				paths.SectionsPath,
+				sectionID,
+				paths.LessonsPath,
+			),
+			queryParams,
+			page.Count,
+			page.Items,
+			page.NextCursor(),
+			page.PrevCursor(),
+			func(l *db.LessonModel) *dtos.LessonResponse {
+				return dtos.NewLessonResponse(c.backendDomain, l)
+			},
+		),
+	)
+}
+
 func (c *Controllers) UpdateLesson(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -490,6 +603,11 @@ func (c *Controllers) UpdateLesson(ctx *fiber.Ctx) error {
 		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	sectionIDi32 := int32(parsedSectionID)
 	lessonIDi32 := int32(parsedLessonID)
 	lesson, serviceErr := c.services.UpdateLesson(userCtx, services.UpdateLessonOptions{
@@ -501,8 +619,19 @@ func (c *Controllers) UpdateLesson(ctx *fiber.Ctx) error {
 		LessonID:     lessonIDi32,
 		Title:        request.Title,
 		Position:     request.Position,
+		Version:      version,
 	})
 	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.staffLessonResponse(userCtx, ctx, fiber.StatusPreconditionFailed, services.FindLessonOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    sectionIDi32,
+				LessonID:     lessonIDi32,
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
@@ -547,11 +676,12 @@ func (c *Controllers) UpdateLesson(ctx *fiber.Ctx) error {
 		videoURL = video.Url
 	}
 
-	files, fileUrls, serviceErr := c.findLessonFiles(userCtx, lessonIDi32)
+	files, serviceErr := c.services.FindLessonFilesWithNoCheck(userCtx, lessonIDi32)
 	if serviceErr != nil {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(lesson.Version))
 	return ctx.JSON(
 		dtos.NewLessonResponseWithEmbeddedOptions(
 			c.backendDomain,
@@ -561,7 +691,6 @@ func (c *Controllers) UpdateLesson(ctx *fiber.Ctx) error {
 			articleContent,
 			videoURL,
 			files,
-			fileUrls,
 		),
 	)
 }
@@ -627,6 +756,11 @@ func (c *Controllers) UpdateLessonIsPublished(ctx *fiber.Ctx) error {
 		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	sectionIDi32 := int32(parsedSectionID)
 	lessonIDi32 := int32(parsedLessonID)
 	lesson, serviceErr := c.services.UpdateLessonIsPublished(userCtx, services.UpdateLessonIsPublishedOptions{
@@ -637,8 +771,19 @@ func (c *Controllers) UpdateLessonIsPublished(ctx *fiber.Ctx) error {
 		SectionID:    sectionIDi32,
 		LessonID:     lessonIDi32,
 		IsPublished:  request.IsPublished,
+		Version:      version,
 	})
 	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.staffLessonResponse(userCtx, ctx, fiber.StatusPreconditionFailed, services.FindLessonOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    sectionIDi32,
+				LessonID:     lessonIDi32,
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
@@ -683,11 +828,12 @@ func (c *Controllers) UpdateLessonIsPublished(ctx *fiber.Ctx) error {
 		videoURL = video.Url
 	}
 
-	files, fileUrls, serviceErr := c.findLessonFiles(userCtx, lessonIDi32)
+	files, serviceErr := c.services.FindLessonFilesWithNoCheck(userCtx, lessonIDi32)
 	if serviceErr != nil {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(lesson.Version))
 	return ctx.JSON(
 		dtos.NewLessonResponseWithEmbeddedOptions(
 			c.backendDomain,
@@ -697,11 +843,103 @@ func (c *Controllers) UpdateLessonIsPublished(ctx *fiber.Ctx) error {
 			articleContent,
 			videoURL,
 			files,
-			fileUrls,
 		),
 	)
 }
 
+func (c *Controllers) UpdateLessonIsPreview(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, lessonLocation, "UpdateLessonIsPreview").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonID", lessonID,
+	)
+	log.InfoContext(userCtx, "Updating lesson is preview...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, err := strconv.Atoi(params.SectionID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "sectionId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.SectionID,
+			}}))
+	}
+
+	parsedLessonID, err := strconv.Atoi(params.LessonID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "lessonId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.LessonID,
+			}}))
+	}
+
+	var request dtos.LessonPreviewBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
+	findOpts := services.FindLessonOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    int32(parsedSectionID),
+		LessonID:     int32(parsedLessonID),
+	}
+	if _, serviceErr := c.services.UpdateLessonIsPreview(userCtx, services.UpdateLessonIsPreviewOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: findOpts.LanguageSlug,
+		SeriesSlug:   findOpts.SeriesSlug,
+		SectionID:    findOpts.SectionID,
+		LessonID:     findOpts.LessonID,
+		IsPreview:    request.IsPreview,
+		Version:      version,
+	}); serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.staffLessonResponse(userCtx, ctx, fiber.StatusPreconditionFailed, findOpts)
+		}
+
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return c.staffLessonResponse(userCtx, ctx, fiber.StatusOK, findOpts)
+}
+
 func (c *Controllers) DeleteLesson(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -755,6 +993,11 @@ func (c *Controllers) DeleteLesson(ctx *fiber.Ctx) error {
 			}}))
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	sectionIDi32 := int32(parsedSectionID)
 	lessonIDi32 := int32(parsedLessonID)
 	opts := services.DeleteLessonOptions{
@@ -764,14 +1007,140 @@ func (c *Controllers) DeleteLesson(ctx *fiber.Ctx) error {
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    sectionIDi32,
 		LessonID:     lessonIDi32,
+		Version:      version,
 	}
 	if serviceErr := c.services.DeleteLesson(userCtx, opts); serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.staffLessonResponse(userCtx, ctx, fiber.StatusPreconditionFailed, services.FindLessonOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    sectionIDi32,
+				LessonID:     lessonIDi32,
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
 	return ctx.SendStatus(fiber.StatusNoContent)
 }
 
+func (c *Controllers) ReorderLessons(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	log := c.buildLogger(ctx, requestID, lessonLocation, "ReorderLessons").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+	)
+	log.InfoContext(userCtx, "Reordering lessons...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SectionPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, err := strconv.Atoi(params.SectionID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "sectionId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.SectionID,
+			}}))
+	}
+
+	var request dtos.ReorderLessonsBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
+	lessons, orderVersion, serviceErr := c.services.ReorderLessons(userCtx, services.ReorderLessonsOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    int32(parsedSectionID),
+		LessonIDs:    request.LessonIDs,
+		Version:      version,
+	})
+	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.lessonsOrderPreconditionFailedResponse(userCtx, ctx, services.FindLessonsOrderOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    int32(parsedSectionID),
+			})
+		}
+
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	ctx.Set(fiber.HeaderETag, versionETag(orderVersion))
+	return ctx.JSON(c.newLessonResponses(lessons))
+}
+
+func (c *Controllers) newLessonResponses(lessons []db.Lesson) []dtos.LessonResponse {
+	responses := make([]dtos.LessonResponse, 0, len(lessons))
+	for _, lesson := range lessons {
+		responses = append(responses, *dtos.NewLessonResponse(c.backendDomain, lesson.ToLessonModel()))
+	}
+
+	return responses
+}
+
+// setLessonsOrderETag exposes the version that reordering the section lessons expects
+func (c *Controllers) setLessonsOrderETag(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindLessonsOrderOptions,
+) *exceptions.ServiceError {
+	_, orderVersion, serviceErr := c.services.FindLessonsOrder(userCtx, opts)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	ctx.Set(fiber.HeaderETag, versionETag(orderVersion))
+	return nil
+}
+
+func (c *Controllers) lessonsOrderPreconditionFailedResponse(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindLessonsOrderOptions,
+) error {
+	lessons, orderVersion, serviceErr := c.services.FindLessonsOrder(userCtx, opts)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return preconditionFailedResponse(ctx, orderVersion, c.newLessonResponses(lessons))
+}
+
 func (c *Controllers) GetCurrentLesson(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -824,7 +1193,7 @@ func (c *Controllers) GetCurrentLesson(ctx *fiber.Ctx) error {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
-	files, fileUrls, serviceErr := c.findLessonFiles(userCtx, lesson.ID)
+	files, serviceErr := c.services.FindLessonFilesWithNoCheck(userCtx, lesson.ID)
 	if serviceErr != nil {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
@@ -838,7 +1207,6 @@ func (c *Controllers) GetCurrentLesson(ctx *fiber.Ctx) error {
 			lesson.LessonArticleContent.String,
 			lesson.LessonVideoUrl.String,
 			files,
-			fileUrls,
 		),
 	)
 }
@@ -0,0 +1,121 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const organizationLicensesLocation string = "organization_licenses"
+
+func (c *Controllers) UpsertOrganizationLicense(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, organizationLicensesLocation, "UpsertOrganizationLicense").With(
+		"organizationId", organizationID,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Upserting organization license...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.OrganizationLicensePathParams{
+		OrganizationID: organizationID,
+		SeriesSlug:     seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.OrganizationLicenseBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	license, serviceErr := c.services.UpsertOrganizationLicense(userCtx, services.UpsertOrganizationLicenseOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		OrganizationID: parsedOrganizationID,
+		LanguageSlug:   request.LanguageSlug,
+		SeriesSlug:     params.SeriesSlug,
+		Seats:          request.Seats,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewOrganizationLicenseResponse(c.backendDomain, license.ToOrganizationLicenseModel()))
+}
+
+func (c *Controllers) DeleteOrganizationLicense(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, organizationLicensesLocation, "DeleteOrganizationLicense").With(
+		"organizationId", organizationID,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Deleting organization license...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.OrganizationLicensePathParams{
+		OrganizationID: organizationID,
+		SeriesSlug:     seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.DeleteOrganizationLicense(userCtx, services.DeleteOrganizationLicenseOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		OrganizationID: parsedOrganizationID,
+		SeriesSlug:     params.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
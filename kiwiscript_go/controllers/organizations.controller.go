@@ -0,0 +1,377 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const organizationsLocation string = "organizations"
+
+func parseOrganizationIDParam(param, value string) (int32, *exceptions.FieldError) {
+	parsedID, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, &exceptions.FieldError{
+			Param:   param,
+			Message: exceptions.StrFieldErrMessageNumber,
+			Value:   value,
+		}
+	}
+
+	return int32(parsedID), nil
+}
+
+func (c *Controllers) organizationParamsErrorResponse(ctx *fiber.Ctx, fieldErr *exceptions.FieldError) error {
+	return ctx.
+		Status(fiber.StatusBadRequest).
+		JSON(exceptions.NewRequestValidationError(
+			exceptions.RequestValidationLocationParams,
+			[]exceptions.FieldError{*fieldErr},
+		))
+}
+
+func (c *Controllers) CreateOrganization(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, organizationsLocation, "CreateOrganization")
+	log.InfoContext(userCtx, "Creating organization...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	var request dtos.OrganizationBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	organization, serviceErr := c.services.CreateOrganization(userCtx, services.CreateOrganizationOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+		Name:      strings.TrimSpace(request.Name),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(
+		dtos.NewOrganizationResponse(c.backendDomain, organization.ToOrganizationModel()),
+	)
+}
+
+func (c *Controllers) GetOrganizations(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, organizationsLocation, "GetOrganizations")
+	log.InfoContext(userCtx, "Getting organizations...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	organizations, count, serviceErr := c.services.FindPaginatedOrganizations(
+		userCtx,
+		services.FindPaginatedOrganizationsOptions{
+			RequestID: requestID,
+			UserID:    user.ID,
+			Offset:    queryParams.Offset,
+			Limit:     queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		paths.OrganizationsV1,
+		&queryParams,
+		count,
+		organizations,
+		func(o *db.Organization) *dtos.OrganizationResponse {
+			return dtos.NewOrganizationResponse(c.backendDomain, o.ToOrganizationModel())
+		},
+	))
+}
+
+func (c *Controllers) GetOrganization(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	log := c.buildLogger(ctx, requestID, organizationsLocation, "GetOrganization").With(
+		"organizationId", organizationID,
+	)
+	log.InfoContext(userCtx, "Getting organization...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.OrganizationPathParams{OrganizationID: organizationID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	organization, serviceErr := c.services.FindOrganization(userCtx, services.FindOrganizationOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewOrganizationResponse(c.backendDomain, organization.ToOrganizationModel()))
+}
+
+func (c *Controllers) GetOrganizationMembers(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	log := c.buildLogger(ctx, requestID, organizationsLocation, "GetOrganizationMembers").With(
+		"organizationId", organizationID,
+	)
+	log.InfoContext(userCtx, "Getting organization members...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.OrganizationPathParams{OrganizationID: organizationID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	members, count, serviceErr := c.services.FindPaginatedOrganizationMembers(
+		userCtx,
+		services.FindPaginatedOrganizationMembersOptions{
+			RequestID:      requestID,
+			UserID:         user.ID,
+			IsAdmin:        user.IsAdmin,
+			OrganizationID: parsedOrganizationID,
+			Offset:         queryParams.Offset,
+			Limit:          queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf("%s/%d%s", paths.OrganizationsV1, parsedOrganizationID, paths.MembersPath),
+		&queryParams,
+		count,
+		members,
+		func(m *db.FindPaginatedOrganizationMembersWithUserRow) *dtos.OrganizationMemberResponse {
+			return dtos.NewOrganizationMemberResponse(c.backendDomain, m.ToOrganizationMemberModel())
+		},
+	))
+}
+
+func (c *Controllers) DeleteOrganizationMember(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	userID := ctx.Params("userID")
+	log := c.buildLogger(ctx, requestID, organizationsLocation, "DeleteOrganizationMember").With(
+		"organizationId", organizationID,
+		"memberId", userID,
+	)
+	log.InfoContext(userCtx, "Deleting organization member...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.OrganizationMemberPathParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	parsedUserID, fieldErr := parseOrganizationIDParam("userId", params.UserID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	serviceErr = c.services.DeleteOrganizationMember(userCtx, services.DeleteOrganizationMemberOptions{
+		RequestID:      requestID,
+		UserID:         user.ID,
+		IsAdmin:        user.IsAdmin,
+		OrganizationID: parsedOrganizationID,
+		MemberID:       parsedUserID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) CreateOrganizationInvitation(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	organizationID := ctx.Params("organizationID")
+	log := c.buildLogger(ctx, requestID, organizationsLocation, "CreateOrganizationInvitation").With(
+		"organizationId", organizationID,
+	)
+	log.InfoContext(userCtx, "Creating organization invitation...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.OrganizationPathParams{OrganizationID: organizationID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.OrganizationInvitationBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedOrganizationID, fieldErr := parseOrganizationIDParam("organizationId", params.OrganizationID)
+	if fieldErr != nil {
+		return c.organizationParamsErrorResponse(ctx, fieldErr)
+	}
+
+	invitation, serviceErr := c.services.CreateOrganizationInvitation(
+		userCtx,
+		services.CreateOrganizationInvitationOptions{
+			RequestID:      requestID,
+			UserID:         user.ID,
+			IsAdmin:        user.IsAdmin,
+			OrganizationID: parsedOrganizationID,
+			Email:          request.Email,
+			Role:           request.Role,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(
+		dtos.NewOrganizationInvitationResponse(c.backendDomain, invitation.ToOrganizationInvitationModel()),
+	)
+}
+
+func (c *Controllers) AcceptOrganizationInvitation(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	token := ctx.Params("token")
+	log := c.buildLogger(ctx, requestID, organizationsLocation, "AcceptOrganizationInvitation")
+	log.InfoContext(userCtx, "Accepting organization invitation...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.OrganizationInvitationPathParams{Token: token}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedToken, err := uuid.Parse(params.Token)
+	if err != nil {
+		return c.organizationParamsErrorResponse(ctx, &exceptions.FieldError{
+			Param:   "token",
+			Message: exceptions.StrFieldErrMessageUUID,
+			Value:   params.Token,
+		})
+	}
+
+	organization, serviceErr := c.services.AcceptOrganizationInvitation(
+		userCtx,
+		services.AcceptOrganizationInvitationOptions{
+			RequestID: requestID,
+			UserID:    user.ID,
+			Token:     parsedToken,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewOrganizationResponse(c.backendDomain, organization.ToOrganizationModel()))
+}
@@ -0,0 +1,361 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const scheduledReleasesLocation string = "scheduled_releases"
+
+func parseScheduleTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func parseScheduleBody(body *dtos.UpdateScheduleBody) (time.Time, time.Time, error) {
+	publishAt, err := parseScheduleTime(body.PublishAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	unpublishAt, err := parseScheduleTime(body.UnpublishAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return publishAt, unpublishAt, nil
+}
+
+func (c *Controllers) UpdateSeriesSchedule(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, scheduledReleasesLocation, "UpdateSeriesSchedule").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Updating series schedule...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.UpdateScheduleBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	publishAt, unpublishAt, err := parseScheduleBody(&request)
+	if err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	series, serviceErr := c.services.UpdateSeriesSchedule(userCtx, services.UpdateSeriesScheduleOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		PublishAt:    publishAt,
+		UnpublishAt:  unpublishAt,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesScheduleResponse(c.backendDomain, series))
+}
+
+func (c *Controllers) sectionSchedulePreconditionFailedResponse(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindSectionBySlugsAndIDOptions,
+) error {
+	section, serviceErr := c.services.FindSectionBySlugsAndID(userCtx, opts)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return preconditionFailedResponse(ctx, section.Version, dtos.NewSectionScheduleResponse(c.backendDomain, section))
+}
+
+func (c *Controllers) UpdateSectionSchedule(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	log := c.buildLogger(ctx, requestID, scheduledReleasesLocation, "UpdateSectionSchedule").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+	)
+	log.InfoContext(userCtx, "Updating section schedule...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SectionPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, err := strconv.Atoi(params.SectionID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "sectionId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.SectionID,
+			}}))
+	}
+
+	var request dtos.UpdateScheduleBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	publishAt, unpublishAt, err := parseScheduleBody(&request)
+	if err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
+	section, serviceErr := c.services.UpdateSectionSchedule(userCtx, services.UpdateSectionScheduleOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    int32(parsedSectionID),
+		PublishAt:    publishAt,
+		UnpublishAt:  unpublishAt,
+		Version:      version,
+	})
+	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.sectionSchedulePreconditionFailedResponse(userCtx, ctx, services.FindSectionBySlugsAndIDOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    int32(parsedSectionID),
+			})
+		}
+
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	ctx.Set(fiber.HeaderETag, versionETag(section.Version))
+	return ctx.JSON(dtos.NewSectionScheduleResponse(c.backendDomain, section))
+}
+
+func (c *Controllers) lessonSchedulePreconditionFailedResponse(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindLessonOptions,
+) error {
+	lesson, serviceErr := c.services.FindLessonBySlugsAndIDs(userCtx, opts)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return preconditionFailedResponse(ctx, lesson.Version, dtos.NewLessonScheduleResponse(c.backendDomain, lesson))
+}
+
+func (c *Controllers) UpdateLessonSchedule(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	sectionID := ctx.Params("sectionID")
+	lessonID := ctx.Params("lessonID")
+	log := c.buildLogger(ctx, requestID, scheduledReleasesLocation, "UpdateLessonSchedule").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"sectionId", sectionID,
+		"lessonId", lessonID,
+	)
+	log.InfoContext(userCtx, "Updating lesson schedule...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.LessonPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+		LessonID:     lessonID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedSectionID, err := strconv.Atoi(params.SectionID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "sectionId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.SectionID,
+			}}))
+	}
+
+	parsedLessonID, err := strconv.Atoi(params.LessonID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "lessonId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.LessonID,
+			}}))
+	}
+
+	var request dtos.UpdateScheduleBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	publishAt, unpublishAt, err := parseScheduleBody(&request)
+	if err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
+	lesson, serviceErr := c.services.UpdateLessonSchedule(userCtx, services.UpdateLessonScheduleOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionID:    int32(parsedSectionID),
+		LessonID:     int32(parsedLessonID),
+		PublishAt:    publishAt,
+		UnpublishAt:  unpublishAt,
+		Version:      version,
+	})
+	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.lessonSchedulePreconditionFailedResponse(userCtx, ctx, services.FindLessonOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    int32(parsedSectionID),
+				LessonID:     int32(parsedLessonID),
+			})
+		}
+
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	ctx.Set(fiber.HeaderETag, versionETag(lesson.Version))
+	return ctx.JSON(dtos.NewLessonScheduleResponse(c.backendDomain, lesson))
+}
+
+func (c *Controllers) GetScheduledReleases(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, scheduledReleasesLocation, "GetScheduledReleases")
+	log.InfoContext(userCtx, "Getting scheduled releases...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	releases, count, serviceErr := c.services.FindPaginatedScheduledReleases(
+		userCtx,
+		services.FindPaginatedScheduledReleasesOptions{
+			RequestID: requestID,
+			Offset:    queryParams.Offset,
+			Limit:     queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		paths.ScheduledV1,
+		&queryParams,
+		count,
+		releases,
+		func(r *db.FindPaginatedScheduledReleasesRow) *dtos.ScheduledReleaseResponse {
+			return dtos.NewScheduledReleaseResponse(c.backendDomain, r.ToScheduledReleaseModel())
+		},
+	))
+}
@@ -18,6 +18,7 @@
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"github.com/kiwiscript/kiwiscript_go/dtos"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
@@ -76,11 +77,29 @@ func (c *Controllers) CreateSection(ctx *fiber.Ctx) error {
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(section.Version))
 	return ctx.Status(fiber.StatusCreated).JSON(
 		dtos.NewSectionResponse(c.backendDomain, section.ToSectionModel()),
 	)
 }
 
+func (c *Controllers) sectionPreconditionFailedResponse(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindSectionBySlugsAndIDOptions,
+) error {
+	section, serviceErr := c.services.FindSectionBySlugsAndID(userCtx, opts)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return preconditionFailedResponse(
+		ctx,
+		section.Version,
+		dtos.NewSectionResponse(c.backendDomain, section.ToSectionModel()),
+	)
+}
+
 func (c *Controllers) GetSection(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -127,6 +146,7 @@ func (c *Controllers) GetSection(ctx *fiber.Ctx) error {
 				return c.serviceErrorResponse(serviceErr, ctx)
 			}
 
+			ctx.Set(fiber.HeaderETag, versionETag(section.Version))
 			return ctx.JSON(dtos.NewSectionResponse(c.backendDomain, section.ToSectionModel()))
 		}
 
@@ -202,6 +222,13 @@ func (c *Controllers) GetSections(ctx *fiber.Ctx) error {
 			if serviceErr != nil {
 				return c.serviceErrorResponse(serviceErr, ctx)
 			}
+			if serviceErr := c.setSectionsOrderETag(userCtx, ctx, services.FindSectionsOrderOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+			}); serviceErr != nil {
+				return c.serviceErrorResponse(serviceErr, ctx)
+			}
 
 			return ctx.JSON(
 				dtos.NewPaginatedResponse(
@@ -342,6 +369,11 @@ func (c *Controllers) UpdateSection(ctx *fiber.Ctx) error {
 		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	section, serviceErr := c.services.UpdateSection(userCtx, services.UpdateSectionOptions{
 		RequestID:    requestID,
 		UserID:       user.ID,
@@ -351,11 +383,22 @@ func (c *Controllers) UpdateSection(ctx *fiber.Ctx) error {
 		Title:        request.Title,
 		Description:  request.Description,
 		Position:     request.Position,
+		Version:      version,
 	})
 	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.sectionPreconditionFailedResponse(userCtx, ctx, services.FindSectionBySlugsAndIDOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    int32(parsedSectionID),
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(section.Version))
 	return ctx.JSON(dtos.NewSectionResponse(c.backendDomain, section.ToSectionModel()))
 }
 
@@ -406,6 +449,11 @@ func (c *Controllers) UpdateSectionIsPublished(ctx *fiber.Ctx) error {
 		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	section, serviceErr := c.services.UpdateSectionIsPublished(userCtx, services.UpdateSectionIsPublishedOptions{
 		RequestID:    requestID,
 		UserID:       user.ID,
@@ -413,11 +461,22 @@ func (c *Controllers) UpdateSectionIsPublished(ctx *fiber.Ctx) error {
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    int32(parsedSectionID),
 		IsPublished:  request.IsPublished,
+		Version:      version,
 	})
 	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.sectionPreconditionFailedResponse(userCtx, ctx, services.FindSectionBySlugsAndIDOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    int32(parsedSectionID),
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
+	ctx.Set(fiber.HeaderETag, versionETag(section.Version))
 	return ctx.JSON(dtos.NewSectionResponse(c.backendDomain, section.ToSectionModel()))
 }
 
@@ -460,20 +519,134 @@ func (c *Controllers) DeleteSection(ctx *fiber.Ctx) error {
 			}}))
 	}
 
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
 	serviceErr = c.services.DeleteSection(userCtx, services.DeleteSectionOptions{
 		RequestID:    requestID,
 		UserID:       user.ID,
 		LanguageSlug: params.LanguageSlug,
 		SeriesSlug:   params.SeriesSlug,
 		SectionID:    int32(parsedSectionID),
+		Version:      version,
 	})
 	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.sectionPreconditionFailedResponse(userCtx, ctx, services.FindSectionBySlugsAndIDOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+				SectionID:    int32(parsedSectionID),
+			})
+		}
+
 		return c.serviceErrorResponse(serviceErr, ctx)
 	}
 
 	return ctx.SendStatus(fiber.StatusNoContent)
 }
 
+func (c *Controllers) ReorderSections(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, sectionsLocation, "ReorderSections").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Reordering sections...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.ReorderSectionsBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	version, ok := ifMatchVersion(ctx)
+	if !ok {
+		return c.preconditionRequiredResponse(log, userCtx, ctx)
+	}
+
+	sections, orderVersion, serviceErr := c.services.ReorderSections(userCtx, services.ReorderSectionsOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		SectionIDs:   request.SectionIDs,
+		Version:      version,
+	})
+	if serviceErr != nil {
+		if isPreconditionFailed(serviceErr) {
+			return c.sectionsOrderPreconditionFailedResponse(userCtx, ctx, services.FindSectionsOrderOptions{
+				RequestID:    requestID,
+				LanguageSlug: params.LanguageSlug,
+				SeriesSlug:   params.SeriesSlug,
+			})
+		}
+
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	ctx.Set(fiber.HeaderETag, versionETag(orderVersion))
+	return ctx.JSON(c.newSectionResponses(sections))
+}
+
+func (c *Controllers) newSectionResponses(sections []db.Section) []dtos.SectionResponse {
+	responses := make([]dtos.SectionResponse, 0, len(sections))
+	for _, section := range sections {
+		responses = append(responses, *dtos.NewSectionResponse(c.backendDomain, section.ToSectionModel()))
+	}
+
+	return responses
+}
+
+// setSectionsOrderETag exposes the version that reordering the series parts expects
+func (c *Controllers) setSectionsOrderETag(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindSectionsOrderOptions,
+) *exceptions.ServiceError {
+	_, orderVersion, serviceErr := c.services.FindSectionsOrder(userCtx, opts)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	ctx.Set(fiber.HeaderETag, versionETag(orderVersion))
+	return nil
+}
+
+func (c *Controllers) sectionsOrderPreconditionFailedResponse(
+	userCtx context.Context,
+	ctx *fiber.Ctx,
+	opts services.FindSectionsOrderOptions,
+) error {
+	sections, orderVersion, serviceErr := c.services.FindSectionsOrder(userCtx, opts)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return preconditionFailedResponse(ctx, orderVersion, c.newSectionResponses(sections))
+}
+
 func (c *Controllers) GetCurrentSection(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -24,6 +24,7 @@ import (
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
 	"github.com/kiwiscript/kiwiscript_go/utils"
+	"log/slog"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/kiwiscript/kiwiscript_go/paths"
@@ -288,6 +289,7 @@ func (c *Controllers) GetPaginatedSeries(ctx *fiber.Ctx) error {
 	}
 
 	sortBySlug := utils.Lowered(queryParams.SortBy) == "slug"
+	sortByRating := utils.Lowered(queryParams.SortBy) == "rating"
 	paginationPath := fmt.Sprintf("%s/%s%s", paths.LanguagePathV1, languageSlug, paths.SeriesPath)
 
 	var seriesModels []db.SeriesModel
@@ -306,6 +308,7 @@ func (c *Controllers) GetPaginatedSeries(ctx *fiber.Ctx) error {
 						Offset:       queryParams.Offset,
 						Limit:        queryParams.Limit,
 						SortBySlug:   sortBySlug,
+						SortByRating: sortByRating,
 					},
 				)
 			} else {
@@ -317,6 +320,7 @@ func (c *Controllers) GetPaginatedSeries(ctx *fiber.Ctx) error {
 						Offset:       queryParams.Offset,
 						Limit:        queryParams.Limit,
 						SortBySlug:   sortBySlug,
+						SortByRating: sortByRating,
 					},
 				)
 			}
@@ -369,6 +373,7 @@ func (c *Controllers) GetPaginatedSeries(ctx *fiber.Ctx) error {
 					Offset:       queryParams.Offset,
 					Limit:        queryParams.Limit,
 					SortBySlug:   sortBySlug,
+					SortByRating: sortByRating,
 				},
 			)
 		} else {
@@ -381,6 +386,7 @@ func (c *Controllers) GetPaginatedSeries(ctx *fiber.Ctx) error {
 					Offset:       queryParams.Offset,
 					Limit:        queryParams.Limit,
 					SortBySlug:   sortBySlug,
+					SortByRating: sortByRating,
 				},
 			)
 		}
@@ -432,6 +438,7 @@ func (c *Controllers) GetPaginatedSeries(ctx *fiber.Ctx) error {
 				Offset:       queryParams.Offset,
 				Limit:        queryParams.Limit,
 				SortBySlug:   sortBySlug,
+				SortByRating: sortByRating,
 			},
 		)
 	} else {
@@ -442,6 +449,7 @@ func (c *Controllers) GetPaginatedSeries(ctx *fiber.Ctx) error {
 				Offset:       queryParams.Offset,
 				Limit:        queryParams.Limit,
 				SortBySlug:   sortBySlug,
+				SortByRating: sortByRating,
 			},
 		)
 	}
@@ -654,6 +662,181 @@ func (c *Controllers) UpdateSeriesIsPublished(ctx *fiber.Ctx) error {
 	)
 }
 
+func (c *Controllers) UpdateSeriesPrice(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, seriesLocation, "UpdateSeriesPrice").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Updating series price...")
+
+	user, err := c.GetUserClaims(ctx)
+	if err != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesPriceBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	series, serviceErr := c.services.UpdateSeriesPrice(userCtx, services.UpdateSeriesPriceOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		Price:        request.Price,
+		Currency:     request.Currency,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	picture, serviceErr := c.services.FindSeriesPictureBySeriesID(userCtx, services.FindSeriesPictureBySeriesIDOptions{
+		RequestID: requestID,
+		SeriesID:  series.ID,
+	})
+	if serviceErr != nil {
+		if serviceErr.Code != exceptions.CodeNotFound {
+			return c.serviceErrorResponse(serviceErr, ctx)
+		}
+
+		return ctx.JSON(
+			dtos.NewSeriesResponse(
+				c.backendDomain,
+				series.ToSeriesModelWithAuthor(user.ID, user.FirstName, user.LastName),
+				"",
+			),
+		)
+	}
+
+	fileUrl, serviceErr := c.services.FindFileURL(userCtx, services.FindFileURLOptions{
+		RequestID: requestID,
+		UserID:    picture.AuthorID,
+		FileID:    picture.ID,
+		FileExt:   picture.Ext,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(
+		dtos.NewSeriesResponse(
+			c.backendDomain,
+			series.ToSeriesModelWithAuthorAndPicture(
+				user.ID,
+				user.FirstName,
+				user.LastName,
+				picture.ID,
+				picture.Ext,
+			),
+			fileUrl,
+		),
+	)
+}
+
+func (c *Controllers) UpdateSeriesIsMembersOnly(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, seriesLocation, "UpdateSeriesIsMembersOnly").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Updating series is members only...")
+
+	user, err := c.GetUserClaims(ctx)
+	if err != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesMembersOnlyBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	series, serviceErr := c.services.UpdateSeriesIsMembersOnly(userCtx, services.UpdateSeriesIsMembersOnlyOptions{
+		RequestID:     requestID,
+		UserID:        user.ID,
+		LanguageSlug:  params.LanguageSlug,
+		SeriesSlug:    params.SeriesSlug,
+		IsMembersOnly: request.IsMembersOnly,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	picture, serviceErr := c.services.FindSeriesPictureBySeriesID(userCtx, services.FindSeriesPictureBySeriesIDOptions{
+		RequestID: requestID,
+		SeriesID:  series.ID,
+	})
+	if serviceErr != nil {
+		if serviceErr.Code != exceptions.CodeNotFound {
+			return c.serviceErrorResponse(serviceErr, ctx)
+		}
+
+		return ctx.JSON(
+			dtos.NewSeriesResponse(
+				c.backendDomain,
+				series.ToSeriesModelWithAuthor(user.ID, user.FirstName, user.LastName),
+				"",
+			),
+		)
+	}
+
+	fileUrl, serviceErr := c.services.FindFileURL(userCtx, services.FindFileURLOptions{
+		RequestID: requestID,
+		UserID:    picture.AuthorID,
+		FileID:    picture.ID,
+		FileExt:   picture.Ext,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(
+		dtos.NewSeriesResponse(
+			c.backendDomain,
+			series.ToSeriesModelWithAuthorAndPicture(
+				user.ID,
+				user.FirstName,
+				user.LastName,
+				picture.ID,
+				picture.Ext,
+			),
+			fileUrl,
+		),
+	)
+}
+
 func (c *Controllers) DeleteSeries(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
@@ -714,14 +897,15 @@ func (c *Controllers) GetPaginatedViewedSeries(ctx *fiber.Ctx) error {
 		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
 	}
 
-	queryParams := dtos.PaginationQueryParams{
-		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
-		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
-	}
+	queryParams := cursorPaginationQueryParams(ctx)
 	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
 		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
 	}
 
+	if isCursorPagination(ctx) {
+		return c.getViewedSeriesByCursor(ctx, log, requestID, user.ID, params.LanguageSlug, &queryParams)
+	}
+
 	seriesModels, count, serviceErr := c.services.FindPaginatedViewedSeriesWithProgress(
 		userCtx,
 		services.FindPaginatedViewedSeriesWithProgressOptions{
@@ -771,6 +955,64 @@ func (c *Controllers) GetPaginatedViewedSeries(ctx *fiber.Ctx) error {
 	)
 }
 
+func (c *Controllers) getViewedSeriesByCursor(
+	ctx *fiber.Ctx,
+	log *slog.Logger,
+	requestID string,
+	userID int32,
+	languageSlug string,
+	queryParams *dtos.CursorPaginationQueryParams,
+) error {
+	userCtx := ctx.UserContext()
+
+	var cursor *utils.Cursor
+	if queryParams.Cursor != "" {
+		var err error
+		if cursor, err = utils.DecodeCursor(queryParams.Cursor); err != nil {
+			return c.invalidCursorErrorResponse(log, userCtx, err, ctx, queryParams.Cursor)
+		}
+	}
+
+	page, serviceErr := c.services.FindViewedSeriesWithProgressByCursor(
+		userCtx,
+		services.FindViewedSeriesWithProgressByCursorOptions{
+			RequestID:    requestID,
+			UserID:       userID,
+			LanguageSlug: languageSlug,
+			Cursor:       cursor,
+			Limit:        queryParams.Limit,
+			WithCount:    queryParams.WithCount,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	fileURLs, serviceErr := c.findSeriesPictureURLs(userCtx, page.Items)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(
+		dtos.NewCursorPaginatedResponse(
+			c.backendDomain,
+			fmt.Sprintf("%s/%s%s%s", paths.LanguagePathV1, languageSlug, paths.SeriesPath, paths.ProgressPath),
+			queryParams,
+			page.Count,
+			page.Items,
+			page.NextCursor(),
+			page.PrevCursor(),
+			func(model *db.SeriesModel) *dtos.SeriesResponse {
+				if fileURLs == nil {
+					return dtos.NewSeriesResponse(c.backendDomain, model, "")
+				}
+
+				return mapSeriesResponse(c.backendDomain, model, fileURLs)
+			},
+		),
+	)
+}
+
 func (c *Controllers) GetDiscoverySeries(ctx *fiber.Ctx) error {
 	requestID := c.requestID(ctx)
 	userCtx := ctx.UserContext()
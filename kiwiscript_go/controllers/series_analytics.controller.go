@@ -0,0 +1,198 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const seriesAnalyticsLocation string = "series_analytics"
+
+// parseAnalyticsDates parses the already validated from and to query
+// parameters, missing dates are returned as zero times.
+func parseAnalyticsDates(from, to string) (time.Time, time.Time) {
+	var fromDate, toDate time.Time
+	if from != "" {
+		fromDate, _ = time.Parse(time.DateOnly, from)
+	}
+	if to != "" {
+		toDate, _ = time.Parse(time.DateOnly, to)
+	}
+	return fromDate, toDate
+}
+
+func (c *Controllers) GetSeriesAnalytics(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, seriesAnalyticsLocation, "GetSeriesAnalytics").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Getting series analytics...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.SeriesAnalyticsQueryParams{
+		From: ctx.Query("from"),
+		To:   ctx.Query("to"),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	from, to := parseAnalyticsDates(queryParams.From, queryParams.To)
+	analytics, serviceErr := c.services.FindSeriesAnalytics(userCtx, services.FindSeriesAnalyticsOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		From:         from,
+		To:           to,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesAnalyticsResponse(c.backendDomain, params.LanguageSlug, params.SeriesSlug, analytics))
+}
+
+func (c *Controllers) GetSeriesAnalyticsTimeline(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, seriesAnalyticsLocation, "GetSeriesAnalyticsTimeline").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Getting series analytics timeline...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.SeriesAnalyticsTimelineQueryParams{
+		From:     ctx.Query("from"),
+		To:       ctx.Query("to"),
+		Interval: ctx.Query("interval", services.AnalyticsIntervalDay),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	from, to := parseAnalyticsDates(queryParams.From, queryParams.To)
+	timeline, serviceErr := c.services.FindSeriesAnalyticsTimeline(
+		userCtx,
+		services.FindSeriesAnalyticsTimelineOptions{
+			RequestID:    requestID,
+			UserID:       user.ID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			From:         from,
+			To:           to,
+			Interval:     queryParams.Interval,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(
+		dtos.NewSeriesAnalyticsTimelineResponse(c.backendDomain, params.LanguageSlug, params.SeriesSlug, timeline),
+	)
+}
+
+func (c *Controllers) GetSeriesAnalyticsFunnel(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, seriesAnalyticsLocation, "GetSeriesAnalyticsFunnel").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Getting series analytics funnel...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.SeriesAnalyticsQueryParams{
+		From: ctx.Query("from"),
+		To:   ctx.Query("to"),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	from, to := parseAnalyticsDates(queryParams.From, queryParams.To)
+	funnel, serviceErr := c.services.FindSeriesAnalyticsFunnel(userCtx, services.FindSeriesAnalyticsFunnelOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		From:         from,
+		To:           to,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(
+		dtos.NewSeriesAnalyticsFunnelResponse(c.backendDomain, params.LanguageSlug, params.SeriesSlug, funnel),
+	)
+}
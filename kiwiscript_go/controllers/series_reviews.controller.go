@@ -0,0 +1,439 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const seriesReviewsLocation string = "series_reviews"
+
+func (c *Controllers) CreateSeriesReview(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, seriesReviewsLocation, "CreateSeriesReview").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Creating series review...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesReviewBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	review, serviceErr := c.services.CreateSeriesReview(userCtx, services.CreateSeriesReviewOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		Rating:       request.Rating,
+		Review:       strings.TrimSpace(request.Review),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewSeriesReviewResponse(c.backendDomain, review))
+}
+
+func (c *Controllers) GetSeriesReviews(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	log := c.buildLogger(ctx, requestID, seriesReviewsLocation, "GetSeriesReviews").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+	log.InfoContext(userCtx, "Getting series reviews...")
+
+	params := dtos.SeriesPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	isStaff := false
+	if user, serviceErr := c.GetUserClaims(ctx); serviceErr == nil {
+		isStaff = user.IsStaff
+	}
+
+	reviews, count, serviceErr := c.services.FindPaginatedSeriesReviews(
+		userCtx,
+		services.FindPaginatedSeriesReviewsOptions{
+			RequestID:    requestID,
+			LanguageSlug: params.LanguageSlug,
+			SeriesSlug:   params.SeriesSlug,
+			IsStaff:      isStaff,
+			Offset:       queryParams.Offset,
+			Limit:        queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf(
+			"%s/%s%s/%s%s",
+			paths.LanguagePathV1,
+			params.LanguageSlug,
+			paths.SeriesPath,
+			params.SeriesSlug,
+			paths.ReviewsPath,
+		),
+		&queryParams,
+		count,
+		reviews,
+		func(r *db.SeriesReviewModel) *dtos.SeriesReviewResponse {
+			return dtos.NewSeriesReviewResponse(c.backendDomain, r)
+		},
+	))
+}
+
+func (c *Controllers) GetSeriesReview(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	reviewID := ctx.Params("reviewID")
+	log := c.buildLogger(ctx, requestID, seriesReviewsLocation, "GetSeriesReview").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"reviewId", reviewID,
+	)
+	log.InfoContext(userCtx, "Getting series review...")
+
+	params := dtos.SeriesReviewPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		ReviewID:     reviewID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedReviewID, err := strconv.Atoi(params.ReviewID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "reviewId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.ReviewID,
+			}}))
+	}
+
+	isStaff := false
+	if user, serviceErr := c.GetUserClaims(ctx); serviceErr == nil {
+		isStaff = user.IsStaff
+	}
+
+	review, serviceErr := c.services.FindSeriesReview(userCtx, services.FindSeriesReviewOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		ReviewID:     int32(parsedReviewID),
+		IsStaff:      isStaff,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesReviewResponse(c.backendDomain, review))
+}
+
+func (c *Controllers) UpdateSeriesReview(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	reviewID := ctx.Params("reviewID")
+	log := c.buildLogger(ctx, requestID, seriesReviewsLocation, "UpdateSeriesReview").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"reviewId", reviewID,
+	)
+	log.InfoContext(userCtx, "Updating series review...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesReviewPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		ReviewID:     reviewID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesReviewBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedReviewID, err := strconv.Atoi(params.ReviewID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "reviewId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.ReviewID,
+			}}))
+	}
+
+	review, serviceErr := c.services.UpdateSeriesReview(userCtx, services.UpdateSeriesReviewOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		ReviewID:     int32(parsedReviewID),
+		Rating:       request.Rating,
+		Review:       strings.TrimSpace(request.Review),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesReviewResponse(c.backendDomain, review))
+}
+
+func (c *Controllers) DeleteSeriesReview(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	reviewID := ctx.Params("reviewID")
+	log := c.buildLogger(ctx, requestID, seriesReviewsLocation, "DeleteSeriesReview").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"reviewId", reviewID,
+	)
+	log.InfoContext(userCtx, "Deleting series review...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		log.ErrorContext(userCtx, "User is not logged in, should not have reached here")
+		return ctx.Status(fiber.StatusUnauthorized).JSON(exceptions.NewRequestError(exceptions.NewUnauthorizedError()))
+	}
+
+	params := dtos.SeriesReviewPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		ReviewID:     reviewID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedReviewID, err := strconv.Atoi(params.ReviewID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "reviewId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.ReviewID,
+			}}))
+	}
+
+	serviceErr = c.services.DeleteSeriesReview(userCtx, services.DeleteSeriesReviewOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		IsStaff:      user.IsStaff,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		ReviewID:     int32(parsedReviewID),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) ReplySeriesReview(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	reviewID := ctx.Params("reviewID")
+	log := c.buildLogger(ctx, requestID, seriesReviewsLocation, "ReplySeriesReview").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"reviewId", reviewID,
+	)
+	log.InfoContext(userCtx, "Replying to series review...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesReviewPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		ReviewID:     reviewID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesReviewReplyBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedReviewID, err := strconv.Atoi(params.ReviewID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "reviewId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.ReviewID,
+			}}))
+	}
+
+	review, serviceErr := c.services.ReplySeriesReview(userCtx, services.ReplySeriesReviewOptions{
+		RequestID:    requestID,
+		UserID:       user.ID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		ReviewID:     int32(parsedReviewID),
+		Reply:        strings.TrimSpace(request.Reply),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesReviewResponse(c.backendDomain, review))
+}
+
+func (c *Controllers) UpdateSeriesReviewIsHidden(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	reviewID := ctx.Params("reviewID")
+	log := c.buildLogger(ctx, requestID, seriesReviewsLocation, "UpdateSeriesReviewIsHidden").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+		"reviewId", reviewID,
+	)
+	log.InfoContext(userCtx, "Updating series review is hidden...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsStaff {
+		log.ErrorContext(userCtx, "User is not staff, should not have reached here")
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.SeriesReviewPathParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		ReviewID:     reviewID,
+	}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.SeriesReviewIsHiddenBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedReviewID, err := strconv.Atoi(params.ReviewID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "reviewId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.ReviewID,
+			}}))
+	}
+
+	review, serviceErr := c.services.UpdateSeriesReviewIsHidden(userCtx, services.UpdateSeriesReviewIsHiddenOptions{
+		RequestID:    requestID,
+		LanguageSlug: params.LanguageSlug,
+		SeriesSlug:   params.SeriesSlug,
+		ReviewID:     int32(parsedReviewID),
+		IsHidden:     request.IsHidden,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewSeriesReviewResponse(c.backendDomain, review))
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const slugRedirectsLocation string = "slug_redirects"
+
+// SlugRedirectMiddleware permanently redirects requests made with renamed
+// language or series slugs to their canonical URL. Slugs are only resolved
+// once the route answers 404, so current slugs never pay for the lookup.
+func (c *Controllers) SlugRedirectMiddleware(ctx *fiber.Ctx) error {
+	// Params and route are captured before they are overwritten by the next handlers
+	languageSlug := ctx.Params("languageSlug")
+	seriesSlug := ctx.Params("seriesSlug")
+	routePath := ctx.Route().Path
+
+	if err := ctx.Next(); err != nil {
+		return err
+	}
+	if ctx.Response().StatusCode() != fiber.StatusNotFound || languageSlug == "" {
+		return nil
+	}
+
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, slugRedirectsLocation, "SlugRedirectMiddleware").With(
+		"languageSlug", languageSlug,
+		"seriesSlug", seriesSlug,
+	)
+
+	canonicalLanguageSlug, canonicalSeriesSlug, serviceErr := c.services.FindCanonicalSlugs(
+		userCtx,
+		services.FindCanonicalSlugsOptions{
+			RequestID:    requestID,
+			LanguageSlug: languageSlug,
+			SeriesSlug:   seriesSlug,
+		},
+	)
+	if serviceErr != nil {
+		return nil
+	}
+
+	oldPrefix := strings.NewReplacer(":languageSlug", languageSlug, ":seriesSlug", seriesSlug).Replace(routePath)
+	url := ctx.OriginalURL()
+	if !strings.HasPrefix(url, oldPrefix) {
+		log.WarnContext(userCtx, "Request URL does not match the route path", "routePath", routePath)
+		return nil
+	}
+
+	location := strings.NewReplacer(
+		":languageSlug", canonicalLanguageSlug,
+		":seriesSlug", canonicalSeriesSlug,
+	).Replace(routePath) + strings.TrimPrefix(url, oldPrefix)
+
+	// 308 keeps the method and body of writes, reads use the widely cached 301
+	status := fiber.StatusPermanentRedirect
+	if ctx.Method() == fiber.MethodGet || ctx.Method() == fiber.MethodHead {
+		status = fiber.StatusMovedPermanently
+	}
+
+	log.InfoContext(userCtx, "Redirecting to canonical slugs", "location", location, "status", status)
+	ctx.Response().ResetBody()
+	ctx.Response().Header.Del(fiber.HeaderContentType)
+	return ctx.Redirect(location, status)
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const userDataExportsLocation string = "user_data_exports"
+
+func (c *Controllers) RequestMyDataExport(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, userDataExportsLocation, "RequestMyDataExport")
+	log.InfoContext(userCtx, "Requesting my data export...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	export, serviceErr := c.services.RequestUserDataExport(userCtx, services.RequestUserDataExportOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusAccepted).JSON(dtos.NewUserDataExportResponse(c.backendDomain, export))
+}
+
+func (c *Controllers) GetMyDataExport(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, userDataExportsLocation, "GetMyDataExport")
+	log.InfoContext(userCtx, "Getting my data export...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(exceptions.NewUnauthorizedError(), ctx)
+	}
+
+	export, serviceErr := c.services.FindLatestUserDataExport(userCtx, services.FindLatestUserDataExportOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewUserDataExportResponse(c.backendDomain, export))
+}
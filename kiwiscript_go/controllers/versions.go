@@ -0,0 +1,70 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+)
+
+// unmatchedVersion is never stored, so malformed entity tags always fail the check
+const unmatchedVersion int32 = -1
+
+func versionETag(version int32) string {
+	return fmt.Sprintf(`"v%d"`, version)
+}
+
+// ifMatchVersion parses the If-Match header into a content version, the
+// second return value is false when the header is missing or is a wildcard,
+// content edits always have to name the version they were based on.
+func ifMatchVersion(ctx *fiber.Ctx) (int32, bool) {
+	ifMatch := strings.TrimSpace(ctx.Get(fiber.HeaderIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, false
+	}
+	if !strings.HasPrefix(ifMatch, `"v`) || !strings.HasSuffix(ifMatch, `"`) {
+		return unmatchedVersion, true
+	}
+
+	version, err := strconv.ParseInt(ifMatch[2:len(ifMatch)-1], 10, 32)
+	if err != nil || version <= 0 {
+		return unmatchedVersion, true
+	}
+
+	return int32(version), true
+}
+
+func (c *Controllers) preconditionRequiredResponse(log *slog.Logger, userCtx context.Context, ctx *fiber.Ctx) error {
+	log.WarnContext(userCtx, "Missing If-Match version")
+	return c.serviceErrorResponse(exceptions.NewPreconditionRequiredError(), ctx)
+}
+
+func isPreconditionFailed(serviceErr *exceptions.ServiceError) bool {
+	return serviceErr.Code == exceptions.CodePreconditionFailed
+}
+
+func preconditionFailedResponse(ctx *fiber.Ctx, version int32, body interface{}) error {
+	ctx.Set(fiber.HeaderETag, versionETag(version))
+	return ctx.Status(fiber.StatusPreconditionFailed).JSON(body)
+}
@@ -0,0 +1,407 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const webhooksLocation string = "webhooks"
+
+func (c *Controllers) CreateWebhook(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "CreateWebhook")
+	log.InfoContext(userCtx, "Creating webhook...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	var request dtos.CreateWebhookBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	webhook, serviceErr := c.services.CreateWebhook(userCtx, services.CreateWebhookOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+		URL:       strings.TrimSpace(request.URL),
+		Secret:    request.Secret,
+		Events:    request.Events,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewWebhookResponse(c.backendDomain, webhook.ToWebhookModel()))
+}
+
+func (c *Controllers) GetWebhooks(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "GetWebhooks")
+	log.InfoContext(userCtx, "Getting webhooks...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	webhooks, count, serviceErr := c.services.FindPaginatedWebhooks(userCtx, services.FindPaginatedWebhooksOptions{
+		RequestID: requestID,
+		Offset:    queryParams.Offset,
+		Limit:     queryParams.Limit,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		paths.WebhooksV1,
+		&queryParams,
+		count,
+		webhooks,
+		func(w *db.Webhook) *dtos.WebhookResponse {
+			return dtos.NewWebhookResponse(c.backendDomain, w.ToWebhookModel())
+		},
+	))
+}
+
+func (c *Controllers) GetWebhook(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	webhookID := ctx.Params("webhookID")
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "GetWebhook").With("webhookId", webhookID)
+	log.InfoContext(userCtx, "Getting webhook...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.WebhookPathParams{WebhookID: webhookID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedWebhookID, err := strconv.Atoi(params.WebhookID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "webhookId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.WebhookID,
+			}}))
+	}
+
+	webhook, serviceErr := c.services.FindWebhookByID(userCtx, services.FindWebhookByIDOptions{
+		RequestID: requestID,
+		WebhookID: int32(parsedWebhookID),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewWebhookResponse(c.backendDomain, webhook.ToWebhookModel()))
+}
+
+func (c *Controllers) UpdateWebhook(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	webhookID := ctx.Params("webhookID")
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "UpdateWebhook").With("webhookId", webhookID)
+	log.InfoContext(userCtx, "Updating webhook...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.WebhookPathParams{WebhookID: webhookID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	var request dtos.UpdateWebhookBody
+	if err := ctx.BodyParser(&request); err != nil {
+		return c.parseRequestErrorResponse(log, userCtx, err, ctx)
+	}
+	if err := c.validate.StructCtx(userCtx, request); err != nil {
+		return c.validateRequestErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedWebhookID, err := strconv.Atoi(params.WebhookID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "webhookId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.WebhookID,
+			}}))
+	}
+
+	webhook, serviceErr := c.services.UpdateWebhook(userCtx, services.UpdateWebhookOptions{
+		RequestID: requestID,
+		WebhookID: int32(parsedWebhookID),
+		URL:       strings.TrimSpace(request.URL),
+		Secret:    request.Secret,
+		Events:    request.Events,
+		IsActive:  request.IsActive,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewWebhookResponse(c.backendDomain, webhook.ToWebhookModel()))
+}
+
+func (c *Controllers) DeleteWebhook(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	webhookID := ctx.Params("webhookID")
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "DeleteWebhook").With("webhookId", webhookID)
+	log.InfoContext(userCtx, "Deleting webhook...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.WebhookPathParams{WebhookID: webhookID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedWebhookID, err := strconv.Atoi(params.WebhookID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "webhookId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.WebhookID,
+			}}))
+	}
+
+	serviceErr = c.services.DeleteWebhook(userCtx, services.DeleteWebhookOptions{
+		RequestID: requestID,
+		WebhookID: int32(parsedWebhookID),
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *Controllers) GetWebhookDeliveries(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	webhookID := ctx.Params("webhookID")
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "GetWebhookDeliveries").With("webhookId", webhookID)
+	log.InfoContext(userCtx, "Getting webhook deliveries...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.WebhookPathParams{WebhookID: webhookID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	queryParams := dtos.PaginationQueryParams{
+		Offset: int32(ctx.QueryInt("offset", dtos.OffsetDefault)),
+		Limit:  int32(ctx.QueryInt("limit", dtos.LimitDefault)),
+	}
+	if err := c.validate.StructCtx(userCtx, queryParams); err != nil {
+		return c.validateQueryErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedWebhookID, err := strconv.Atoi(params.WebhookID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "webhookId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.WebhookID,
+			}}))
+	}
+
+	deliveries, count, serviceErr := c.services.FindPaginatedWebhookDeliveries(
+		userCtx,
+		services.FindPaginatedWebhookDeliveriesOptions{
+			RequestID: requestID,
+			WebhookID: int32(parsedWebhookID),
+			Offset:    queryParams.Offset,
+			Limit:     queryParams.Limit,
+		},
+	)
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewPaginatedResponse(
+		c.backendDomain,
+		fmt.Sprintf("%s/%d%s", paths.WebhooksV1, parsedWebhookID, paths.DeliveriesPath),
+		&queryParams,
+		count,
+		deliveries,
+		func(d *db.WebhookDelivery) *dtos.WebhookDeliveryResponse {
+			return dtos.NewWebhookDeliveryResponse(c.backendDomain, d.ToWebhookDeliveryModel())
+		},
+	))
+}
+
+func (c *Controllers) GetWebhookDelivery(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	webhookID := ctx.Params("webhookID")
+	deliveryID := ctx.Params("deliveryID")
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "GetWebhookDelivery").With(
+		"webhookId", webhookID,
+		"deliveryId", deliveryID,
+	)
+	log.InfoContext(userCtx, "Getting webhook delivery...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.WebhookDeliveryPathParams{WebhookID: webhookID, DeliveryID: deliveryID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedWebhookID, err := strconv.Atoi(params.WebhookID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "webhookId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.WebhookID,
+			}}))
+	}
+
+	parsedDeliveryID, err := uuid.Parse(params.DeliveryID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "deliveryId",
+				Message: exceptions.StrFieldErrMessageUUID,
+				Value:   params.DeliveryID,
+			}}))
+	}
+
+	delivery, serviceErr := c.services.FindWebhookDelivery(userCtx, services.FindWebhookDeliveryOptions{
+		RequestID:  requestID,
+		WebhookID:  int32(parsedWebhookID),
+		DeliveryID: parsedDeliveryID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.JSON(dtos.NewWebhookDeliveryResponse(c.backendDomain, delivery.ToWebhookDeliveryModel()))
+}
+
+func (c *Controllers) RedeliverWebhookDelivery(ctx *fiber.Ctx) error {
+	requestID := c.requestID(ctx)
+	userCtx := ctx.UserContext()
+	webhookID := ctx.Params("webhookID")
+	deliveryID := ctx.Params("deliveryID")
+	log := c.buildLogger(ctx, requestID, webhooksLocation, "RedeliverWebhookDelivery").With(
+		"webhookId", webhookID,
+		"deliveryId", deliveryID,
+	)
+	log.InfoContext(userCtx, "Redelivering webhook delivery...")
+
+	user, serviceErr := c.GetUserClaims(ctx)
+	if serviceErr != nil || !user.IsAdmin {
+		return ctx.Status(fiber.StatusForbidden).JSON(exceptions.NewRequestError(exceptions.NewForbiddenError()))
+	}
+
+	params := dtos.WebhookDeliveryPathParams{WebhookID: webhookID, DeliveryID: deliveryID}
+	if err := c.validate.StructCtx(userCtx, params); err != nil {
+		return c.validateParamsErrorResponse(log, userCtx, err, ctx)
+	}
+
+	parsedWebhookID, err := strconv.Atoi(params.WebhookID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "webhookId",
+				Message: exceptions.StrFieldErrMessageNumber,
+				Value:   params.WebhookID,
+			}}))
+	}
+
+	parsedDeliveryID, err := uuid.Parse(params.DeliveryID)
+	if err != nil {
+		return ctx.
+			Status(fiber.StatusBadRequest).
+			JSON(exceptions.NewRequestValidationError(exceptions.RequestValidationLocationParams, []exceptions.FieldError{{
+				Param:   "deliveryId",
+				Message: exceptions.StrFieldErrMessageUUID,
+				Value:   params.DeliveryID,
+			}}))
+	}
+
+	delivery, serviceErr := c.services.RedeliverWebhookDelivery(userCtx, services.RedeliverWebhookDeliveryOptions{
+		RequestID:  requestID,
+		WebhookID:  int32(parsedWebhookID),
+		DeliveryID: parsedDeliveryID,
+	})
+	if serviceErr != nil {
+		return c.serviceErrorResponse(serviceErr, ctx)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(dtos.NewWebhookDeliveryResponse(c.backendDomain, delivery.ToWebhookDeliveryModel()))
+}
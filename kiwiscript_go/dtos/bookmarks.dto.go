@@ -0,0 +1,85 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Responses
+
+type SeriesBookmarkResponse struct {
+	ID          int32            `json:"id"`
+	SeriesTitle string           `json:"seriesTitle"`
+	CreatedAt   string           `json:"createdAt"`
+	Links       SelfLinkResponse `json:"_links"`
+}
+
+func NewSeriesBookmarkResponse(backendDomain string, model *db.SeriesBookmarkModel) *SeriesBookmarkResponse {
+	return &SeriesBookmarkResponse{
+		ID:          model.ID,
+		SeriesTitle: model.SeriesTitle,
+		CreatedAt:   model.CreatedAt,
+		Links: SelfLinkResponse{
+			LinkResponse{
+				fmt.Sprintf(
+					"https://%s/api%s/%s%s/%s",
+					backendDomain,
+					paths.LanguagePathV1,
+					model.LanguageSlug,
+					paths.SeriesPath,
+					model.SeriesSlug,
+				),
+			},
+		},
+	}
+}
+
+type LessonBookmarkResponse struct {
+	ID          int32            `json:"id"`
+	LessonTitle string           `json:"lessonTitle"`
+	CreatedAt   string           `json:"createdAt"`
+	Links       SelfLinkResponse `json:"_links"`
+}
+
+func NewLessonBookmarkResponse(backendDomain string, model *db.LessonBookmarkModel) *LessonBookmarkResponse {
+	return &LessonBookmarkResponse{
+		ID:          model.ID,
+		LessonTitle: model.LessonTitle,
+		CreatedAt:   model.CreatedAt,
+		Links: SelfLinkResponse{
+			LinkResponse{
+				fmt.Sprintf(
+					"https://%s/api%s/%s%s/%s%s/%d%s/%d",
+					backendDomain,
+					paths.LanguagePathV1,
+					model.LanguageSlug,
+					paths.SeriesPath,
+					model.SeriesSlug,
+					paths.SectionsPath,
+					model.SectionID,
+					paths.LessonsPath,
+					model.LessonID,
+				),
+			},
+		},
+	}
+}
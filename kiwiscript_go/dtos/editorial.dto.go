@@ -0,0 +1,201 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type SeriesEditorialStatusBody struct {
+	Status  string `json:"status" validate:"required,oneof=draft in_review changes_requested approved"`
+	Comment string `json:"comment" validate:"omitempty,min=2,max=5000"`
+}
+
+type SeriesEditorialReviewerBody struct {
+	UserID int32 `json:"userId" validate:"required,gte=1"`
+}
+
+type LessonEditorialCommentBody struct {
+	Body string `json:"body" validate:"required,min=2,max=5000"`
+}
+
+type LessonEditorialCommentIsResolvedBody struct {
+	IsResolved bool `json:"isResolved"`
+}
+
+// Path Params
+
+type SeriesEditorialReviewerPathParams struct {
+	LanguageSlug string `validate:"required,min=2,max=50,slug"`
+	SeriesSlug   string `validate:"required,min=2,max=100,slug"`
+	UserID       string `validate:"required,number,min=1"`
+}
+
+type LessonEditorialCommentPathParams struct {
+	LanguageSlug string `validate:"required,min=2,max=50,slug"`
+	SeriesSlug   string `validate:"required,min=2,max=100,slug"`
+	SectionID    string `validate:"required,number,min=1"`
+	LessonID     string `validate:"required,number,min=1"`
+	CommentID    string `validate:"required,number,min=1"`
+}
+
+// Responses
+
+type EditorialUserEmbedded struct {
+	ID        int32            `json:"id"`
+	FirstName string           `json:"firstName"`
+	LastName  string           `json:"lastName"`
+	Links     SelfLinkResponse `json:"_links"`
+}
+
+func newEditorialUserEmbedded(backendDomain string, user *db.EditorialUser) EditorialUserEmbedded {
+	return EditorialUserEmbedded{
+		ID:        user.ID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Links: SelfLinkResponse{
+			LinkResponse{
+				fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.UsersPathV1, user.ID),
+			},
+		},
+	}
+}
+
+type SeriesEditorialReviewerResponse struct {
+	ID        int32                 `json:"id"`
+	CreatedAt string                `json:"createdAt"`
+	User      EditorialUserEmbedded `json:"user"`
+}
+
+func NewSeriesEditorialReviewerResponse(
+	backendDomain string,
+	model *db.SeriesEditorialReviewerModel,
+) *SeriesEditorialReviewerResponse {
+	return &SeriesEditorialReviewerResponse{
+		ID:        model.ID,
+		CreatedAt: model.CreatedAt,
+		User:      newEditorialUserEmbedded(backendDomain, &model.User),
+	}
+}
+
+type SeriesChangeRequestResponse struct {
+	ID         int32                 `json:"id"`
+	Comment    string                `json:"comment"`
+	ResolvedAt string                `json:"resolvedAt,omitempty"`
+	CreatedAt  string                `json:"createdAt"`
+	Reviewer   EditorialUserEmbedded `json:"reviewer"`
+}
+
+type SeriesEditorialEmbedded struct {
+	Reviewers      []SeriesEditorialReviewerResponse `json:"reviewers"`
+	ChangeRequests []SeriesChangeRequestResponse     `json:"changeRequests"`
+}
+
+type SeriesEditorialLinks struct {
+	Self      LinkResponse `json:"self"`
+	Series    LinkResponse `json:"series"`
+	Reviewers LinkResponse `json:"reviewers"`
+}
+
+type SeriesEditorialResponse struct {
+	Status         string                  `json:"status"`
+	RequiresReview bool                    `json:"requiresReview"`
+	IsPublished    bool                    `json:"isPublished"`
+	Embedded       SeriesEditorialEmbedded `json:"_embedded"`
+	Links          SeriesEditorialLinks    `json:"_links"`
+}
+
+func NewSeriesEditorialResponse(backendDomain string, model *db.SeriesEditorialModel) *SeriesEditorialResponse {
+	reviewers := make([]SeriesEditorialReviewerResponse, 0, len(model.Reviewers))
+	for i := range model.Reviewers {
+		reviewers = append(reviewers, *NewSeriesEditorialReviewerResponse(backendDomain, &model.Reviewers[i]))
+	}
+
+	changeRequests := make([]SeriesChangeRequestResponse, 0, len(model.ChangeRequests))
+	for i := range model.ChangeRequests {
+		changeRequest := &model.ChangeRequests[i]
+		changeRequests = append(changeRequests, SeriesChangeRequestResponse{
+			ID:         changeRequest.ID,
+			Comment:    changeRequest.Comment,
+			ResolvedAt: changeRequest.ResolvedAt,
+			CreatedAt:  changeRequest.CreatedAt,
+			Reviewer:   newEditorialUserEmbedded(backendDomain, &changeRequest.Reviewer),
+		})
+	}
+
+	seriesHref := newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)
+	selfHref := seriesHref + paths.EditorialPath
+	return &SeriesEditorialResponse{
+		Status:         model.Status,
+		RequiresReview: model.RequiresReview,
+		IsPublished:    model.IsPublished,
+		Embedded: SeriesEditorialEmbedded{
+			Reviewers:      reviewers,
+			ChangeRequests: changeRequests,
+		},
+		Links: SeriesEditorialLinks{
+			Self:      LinkResponse{selfHref},
+			Series:    LinkResponse{seriesHref},
+			Reviewers: LinkResponse{selfHref + paths.ReviewersPath},
+		},
+	}
+}
+
+type LessonEditorialCommentLinks struct {
+	Lesson  LinkResponse `json:"lesson"`
+	Resolve LinkResponse `json:"resolve"`
+}
+
+type LessonEditorialCommentResponse struct {
+	ID         int32                       `json:"id"`
+	Body       string                      `json:"body"`
+	IsResolved bool                        `json:"isResolved"`
+	CreatedAt  string                      `json:"createdAt"`
+	UpdatedAt  string                      `json:"updatedAt"`
+	Author     EditorialUserEmbedded       `json:"author"`
+	Links      LessonEditorialCommentLinks `json:"_links"`
+}
+
+// NewLessonEditorialCommentResponse takes the section ID from the request
+// as editorial comments are only linked to their lesson.
+func NewLessonEditorialCommentResponse(
+	backendDomain string,
+	sectionID int32,
+	model *db.LessonEditorialCommentModel,
+) *LessonEditorialCommentResponse {
+	lessonHref := newLessonHref(backendDomain, model.LanguageSlug, model.SeriesSlug, sectionID, model.LessonID)
+	resolveHref := fmt.Sprintf("%s%s/%d%s", lessonHref, paths.EditorialCommentsPath, model.ID, paths.ResolvePath)
+
+	return &LessonEditorialCommentResponse{
+		ID:         model.ID,
+		Body:       model.Body,
+		IsResolved: model.IsResolved,
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+		Author:     newEditorialUserEmbedded(backendDomain, &model.Author),
+		Links: LessonEditorialCommentLinks{
+			Lesson:  LinkResponse{lessonHref},
+			Resolve: LinkResponse{resolveHref},
+		},
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type CheckoutBody struct {
+	CouponCode string `json:"couponCode" validate:"omitempty,min=3,max=50,alphanum"`
+}
+
+type SeriesPriceBody struct {
+	Price    int32  `json:"price" validate:"gte=0,lte=1000000"`
+	Currency string `json:"currency" validate:"required,len=3,alpha"`
+}
+
+type LessonPreviewBody struct {
+	IsPreview bool `json:"isPreview"`
+}
+
+type CouponBody struct {
+	Code           string `json:"code" validate:"required,min=3,max=50,alphanum"`
+	LanguageSlug   string `json:"languageSlug" validate:"required_with=SeriesSlug,omitempty,min=2,max=50,slug"`
+	SeriesSlug     string `json:"seriesSlug" validate:"omitempty,min=2,max=100,slug"`
+	PercentOff     int16  `json:"percentOff" validate:"required,gte=1,lte=100"`
+	MaxRedemptions int32  `json:"maxRedemptions" validate:"gte=0"`
+	ExpiresAt      string `json:"expiresAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type CouponIsActiveBody struct {
+	IsActive bool `json:"isActive"`
+}
+
+// Path Params
+
+type CouponPathParams struct {
+	CouponID string `validate:"required,number,min=1"`
+}
+
+// Responses
+
+type EnrollmentLinks struct {
+	Self   LinkResponse `json:"self"`
+	Series LinkResponse `json:"series"`
+}
+
+type EnrollmentResponse struct {
+	ID             int32           `json:"id"`
+	Source         string          `json:"source"`
+	OrganizationID int32           `json:"organizationId,omitempty"`
+	CreatedAt      string          `json:"createdAt"`
+	Links          EnrollmentLinks `json:"_links"`
+}
+
+func NewEnrollmentResponse(backendDomain string, model *db.EnrollmentModel) *EnrollmentResponse {
+	seriesHref := newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)
+	return &EnrollmentResponse{
+		ID:             model.ID,
+		Source:         model.Source,
+		OrganizationID: model.OrganizationID,
+		CreatedAt:      model.CreatedAt,
+		Links: EnrollmentLinks{
+			Self:   LinkResponse{seriesHref + paths.EnrollmentPath},
+			Series: LinkResponse{seriesHref},
+		},
+	}
+}
+
+type CheckoutEmbedded struct {
+	Enrollment *EnrollmentResponse `json:"enrollment,omitempty"`
+}
+
+type CheckoutResponse struct {
+	SessionID   string            `json:"sessionId"`
+	CheckoutURL string            `json:"checkoutUrl,omitempty"`
+	Amount      int32             `json:"amount"`
+	Currency    string            `json:"currency"`
+	Embedded    *CheckoutEmbedded `json:"_embedded,omitempty"`
+}
+
+func NewCheckoutResponse(
+	backendDomain,
+	sessionID,
+	checkoutURL string,
+	amount int32,
+	currency string,
+	enrollment *db.EnrollmentModel,
+) *CheckoutResponse {
+	var embedded *CheckoutEmbedded
+	if enrollment != nil {
+		embedded = &CheckoutEmbedded{
+			Enrollment: NewEnrollmentResponse(backendDomain, enrollment),
+		}
+	}
+
+	return &CheckoutResponse{
+		SessionID:   sessionID,
+		CheckoutURL: checkoutURL,
+		Amount:      amount,
+		Currency:    currency,
+		Embedded:    embedded,
+	}
+}
+
+type CouponLinks struct {
+	Self LinkResponse `json:"self"`
+}
+
+type CouponResponse struct {
+	ID               int32       `json:"id"`
+	Code             string      `json:"code"`
+	SeriesSlug       string      `json:"seriesSlug,omitempty"`
+	PercentOff       int16       `json:"percentOff"`
+	MaxRedemptions   int32       `json:"maxRedemptions"`
+	RedemptionsCount int32       `json:"redemptionsCount"`
+	ExpiresAt        string      `json:"expiresAt,omitempty"`
+	IsActive         bool        `json:"isActive"`
+	CreatedAt        string      `json:"createdAt"`
+	UpdatedAt        string      `json:"updatedAt"`
+	Links            CouponLinks `json:"_links"`
+}
+
+func NewCouponResponse(backendDomain string, model *db.CouponModel) *CouponResponse {
+	return &CouponResponse{
+		ID:               model.ID,
+		Code:             model.Code,
+		SeriesSlug:       model.SeriesSlug,
+		PercentOff:       model.PercentOff,
+		MaxRedemptions:   model.MaxRedemptions,
+		RedemptionsCount: model.RedemptionsCount,
+		ExpiresAt:        model.ExpiresAt,
+		IsActive:         model.IsActive,
+		CreatedAt:        model.CreatedAt,
+		UpdatedAt:        model.UpdatedAt,
+		Links: CouponLinks{
+			Self: LinkResponse{fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.CouponsV1, model.ID)},
+		},
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import "time"
+
+type DependencyHealthResponse struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+func NewDependencyHealthResponse(name, status string, latency time.Duration, err string) DependencyHealthResponse {
+	return DependencyHealthResponse{
+		Name:      name,
+		Status:    status,
+		LatencyMs: float64(latency.Microseconds()) / 1000,
+		Error:     err,
+	}
+}
+
+type HealthResponse struct {
+	Status       string                     `json:"status"`
+	ShuttingDown bool                       `json:"shuttingDown"`
+	Dependencies []DependencyHealthResponse `json:"dependencies,omitempty"`
+}
+
+func NewLivenessResponse(status string) *HealthResponse {
+	return &HealthResponse{Status: status}
+}
+
+func NewReadinessResponse(
+	status string,
+	shuttingDown bool,
+	dependencies []DependencyHealthResponse,
+) *HealthResponse {
+	return &HealthResponse{
+		Status:       status,
+		ShuttingDown: shuttingDown,
+		Dependencies: dependencies,
+	}
+}
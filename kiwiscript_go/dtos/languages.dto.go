@@ -31,6 +31,10 @@ type LanguageBody struct {
 	Icon string `json:"icon" validate:"required,svg"`
 }
 
+type LanguageRequiresReviewBody struct {
+	RequiresReview bool `json:"requiresReview"`
+}
+
 // Path Params
 
 type LanguagePathParams struct {
@@ -92,6 +96,7 @@ type LanguageResponse struct {
 	Icon            string        `json:"icon"`
 	CompletedSeries int16         `json:"completedSeries"`
 	TotalSeries     int16         `json:"totalSeries"`
+	RequiresReview  bool          `json:"requiresReview"`
 	ViewedAt        string        `json:"viewedAt,omitempty"`
 	Links           LanguageLinks `json:"_links"`
 }
@@ -104,6 +109,7 @@ func NewLanguageResponse(backendDomain string, model *db.LanguageModel) *Languag
 		Icon:            model.Icon,
 		CompletedSeries: model.CompletedSeries,
 		TotalSeries:     model.TotalSeries,
+		RequiresReview:  model.RequiresReview,
 		ViewedAt:        model.ViewedAt,
 		Links:           newLanguageLinks(backendDomain, model.Slug),
 	}
@@ -0,0 +1,123 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type LearningStatsTimezoneBody struct {
+	Timezone string `json:"timezone" validate:"required,max=50,timezone"`
+}
+
+// Responses
+
+type LearningStatsLinks struct {
+	Self         LinkResponse `json:"self"`
+	Xp           LinkResponse `json:"xp"`
+	Achievements LinkResponse `json:"achievements"`
+}
+
+type LearningStatsResponse struct {
+	Timezone         string             `json:"timezone"`
+	CurrentStreak    int32              `json:"currentStreak"`
+	LongestStreak    int32              `json:"longestStreak"`
+	LastActivityDate string             `json:"lastActivityDate,omitempty"`
+	XpTotal          int32              `json:"xpTotal"`
+	Links            LearningStatsLinks `json:"_links"`
+}
+
+func NewLearningStatsResponse(backendDomain string, stats *db.UserLearningStat) *LearningStatsResponse {
+	var lastActivityDate string
+	if stats.LastActivityDate.Valid {
+		lastActivityDate = stats.LastActivityDate.Time.Format(time.DateOnly)
+	}
+
+	meHref := fmt.Sprintf("https://%s/api%s%s", backendDomain, paths.UsersPathV1, paths.MePath)
+	return &LearningStatsResponse{
+		Timezone:         stats.Timezone,
+		CurrentStreak:    stats.CurrentStreak,
+		LongestStreak:    stats.LongestStreak,
+		LastActivityDate: lastActivityDate,
+		XpTotal:          stats.XpTotal,
+		Links: LearningStatsLinks{
+			Self:         LinkResponse{meHref + paths.StreakPath},
+			Xp:           LinkResponse{meHref + paths.XpPath},
+			Achievements: LinkResponse{meHref + paths.AchievementsPath},
+		},
+	}
+}
+
+type XpEventLinks struct {
+	Series LinkResponse `json:"series"`
+}
+
+type XpEventResponse struct {
+	ID        int32        `json:"id"`
+	Source    string       `json:"source"`
+	Reference string       `json:"reference"`
+	Points    int32        `json:"points"`
+	CreatedAt string       `json:"createdAt"`
+	Links     XpEventLinks `json:"_links"`
+}
+
+func NewXpEventResponse(backendDomain string, event *db.XpEvent) *XpEventResponse {
+	return &XpEventResponse{
+		ID:        event.ID,
+		Source:    event.Source,
+		Reference: event.Reference,
+		Points:    event.Points,
+		CreatedAt: event.CreatedAt.Time.Format(time.RFC3339),
+		Links: XpEventLinks{
+			Series: LinkResponse{
+				fmt.Sprintf(
+					"https://%s/api%s/%s%s/%s",
+					backendDomain,
+					paths.LanguagePathV1,
+					event.LanguageSlug,
+					paths.SeriesPath,
+					event.SeriesSlug,
+				),
+			},
+		},
+	}
+}
+
+type AchievementResponse struct {
+	Slug        string `json:"slug"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsUnlocked  bool   `json:"isUnlocked"`
+	UnlockedAt  string `json:"unlockedAt,omitempty"`
+}
+
+func NewAchievementResponse(model *db.AchievementModel) *AchievementResponse {
+	return &AchievementResponse{
+		Slug:        model.Slug,
+		Name:        model.Name,
+		Description: model.Description,
+		IsUnlocked:  model.IsUnlocked,
+		UnlockedAt:  model.UnlockedAt,
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type LessonCommentBody struct {
+	Body string `json:"body" validate:"required,min=2,max=5000"`
+}
+
+type LessonCommentIsAnswerBody struct {
+	IsAnswer bool `json:"isAnswer"`
+}
+
+type LessonCommentIsHiddenBody struct {
+	IsHidden bool `json:"isHidden"`
+}
+
+type LessonCommentIsLockedBody struct {
+	IsLocked bool `json:"isLocked"`
+}
+
+// Path Params
+
+type LessonCommentPathParams struct {
+	LanguageSlug string `validate:"required,min=2,max=50,slug"`
+	SeriesSlug   string `validate:"required,min=2,max=100,slug"`
+	SectionID    string `validate:"required,number,min=1"`
+	LessonID     string `validate:"required,number,min=1"`
+	CommentID    string `validate:"required,number,min=1"`
+}
+
+// Responses
+
+type LessonCommentLinks struct {
+	Self    LinkResponse  `json:"self"`
+	Lesson  LinkResponse  `json:"lesson"`
+	Upvote  LinkResponse  `json:"upvote"`
+	Replies *LinkResponse `json:"replies,omitempty"`
+	Parent  *LinkResponse `json:"parent,omitempty"`
+}
+
+func newLessonCommentLinks(
+	backendDomain,
+	languageSlug,
+	seriesSlug string,
+	sectionID,
+	lessonID,
+	commentID,
+	parentID int32,
+) LessonCommentLinks {
+	lessonHref := fmt.Sprintf(
+		"https://%s/api%s/%s%s/%s%s/%d%s/%d",
+		backendDomain,
+		paths.LanguagePathV1,
+		languageSlug,
+		paths.SeriesPath,
+		seriesSlug,
+		paths.SectionsPath,
+		sectionID,
+		paths.LessonsPath,
+		lessonID,
+	)
+	selfHref := fmt.Sprintf("%s%s/%d", lessonHref, paths.CommentsPath, commentID)
+
+	var replies, parent *LinkResponse
+	if parentID == 0 {
+		replies = &LinkResponse{
+			Href: selfHref + paths.RepliesPath,
+		}
+	} else {
+		parent = &LinkResponse{
+			Href: fmt.Sprintf("%s%s/%d", lessonHref, paths.CommentsPath, parentID),
+		}
+	}
+
+	return LessonCommentLinks{
+		Self:    LinkResponse{selfHref},
+		Lesson:  LinkResponse{lessonHref},
+		Upvote:  LinkResponse{selfHref + paths.UpvotePath},
+		Replies: replies,
+		Parent:  parent,
+	}
+}
+
+type LessonCommentAuthorEmbedded struct {
+	ID        int32            `json:"id"`
+	FirstName string           `json:"firstName"`
+	LastName  string           `json:"lastName"`
+	Links     SelfLinkResponse `json:"_links"`
+}
+
+type LessonCommentEmbedded struct {
+	Author LessonCommentAuthorEmbedded `json:"author"`
+}
+
+type LessonCommentResponse struct {
+	ID           int32                 `json:"id"`
+	Body         string                `json:"body"`
+	RepliesCount int32                 `json:"repliesCount"`
+	UpvotesCount int32                 `json:"upvotesCount"`
+	IsAnswer     bool                  `json:"isAnswer"`
+	IsAnswered   bool                  `json:"isAnswered"`
+	IsHidden     bool                  `json:"isHidden"`
+	IsLocked     bool                  `json:"isLocked"`
+	CreatedAt    string                `json:"createdAt"`
+	UpdatedAt    string                `json:"updatedAt"`
+	Embedded     LessonCommentEmbedded `json:"_embedded"`
+	Links        LessonCommentLinks    `json:"_links"`
+}
+
+func NewLessonCommentResponse(backendDomain string, model *db.LessonCommentModel) *LessonCommentResponse {
+	return &LessonCommentResponse{
+		ID:           model.ID,
+		Body:         model.Body,
+		RepliesCount: model.RepliesCount,
+		UpvotesCount: model.UpvotesCount,
+		IsAnswer:     model.IsAnswer,
+		IsAnswered:   model.IsAnswered,
+		IsHidden:     model.IsHidden,
+		IsLocked:     model.IsLocked,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+		Embedded: LessonCommentEmbedded{
+			Author: LessonCommentAuthorEmbedded{
+				ID:        model.Author.ID,
+				FirstName: model.Author.FirstName,
+				LastName:  model.Author.LastName,
+				Links: SelfLinkResponse{
+					LinkResponse{
+						fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.UsersPathV1, model.Author.ID),
+					},
+				},
+			},
+		},
+		Links: newLessonCommentLinks(
+			backendDomain,
+			model.LanguageSlug,
+			model.SeriesSlug,
+			model.SectionID,
+			model.LessonID,
+			model.ID,
+			model.ParentID,
+		),
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+)
+
+// Bodies
+
+type LessonEventBody struct {
+	ID            string `json:"id" validate:"required,uuid"`
+	Type          string `json:"type" validate:"required,oneof=lesson_open scroll video_play video_pause video_seek file_download"`
+	ScrollDepth   int16  `json:"scrollDepth" validate:"gte=0,lte=100"`
+	VideoPosition int32  `json:"videoPosition" validate:"gte=0"`
+	FileID        string `json:"fileId" validate:"omitempty,uuid"`
+	OccurredAt    string `json:"occurredAt" validate:"required,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type LessonEventsBody struct {
+	Events []LessonEventBody `json:"events" validate:"required,min=1,max=100,dive"`
+}
+
+// Responses
+
+type LessonEventsLinks struct {
+	Lesson   LinkResponse `json:"lesson"`
+	Progress LinkResponse `json:"progress"`
+}
+
+type LessonEventsResponse struct {
+	Received int               `json:"received"`
+	Recorded int               `json:"recorded"`
+	Links    LessonEventsLinks `json:"_links"`
+}
+
+func NewLessonEventsResponse(
+	backendDomain,
+	languageSlug,
+	seriesSlug string,
+	sectionID,
+	lessonID int32,
+	received,
+	recorded int,
+) *LessonEventsResponse {
+	lessonHref := fmt.Sprintf(
+		"https://%s/api%s/%s%s/%s%s/%d%s/%d",
+		backendDomain,
+		paths.LanguagePathV1,
+		languageSlug,
+		paths.SeriesPath,
+		seriesSlug,
+		paths.SectionsPath,
+		sectionID,
+		paths.LessonsPath,
+		lessonID,
+	)
+
+	return &LessonEventsResponse{
+		Received: received,
+		Recorded: recorded,
+		Links: LessonEventsLinks{
+			Lesson:   LinkResponse{lessonHref},
+			Progress: LinkResponse{lessonHref + paths.ProgressPath},
+		},
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/kiwiscript/kiwiscript_go/paths"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"time"
 )
 
 // Path params
@@ -44,6 +45,7 @@ type LessonFileBody struct {
 
 type LessonFileLinks struct {
 	Self        LinkResponse `json:"self"`
+	Download    LinkResponse `json:"download"`
 	LessonFiles LinkResponse `json:"lessonFiles"`
 	Lesson      LinkResponse `json:"lesson"`
 }
@@ -56,23 +58,24 @@ func newLessonFileLinks(
 	lessonID int32,
 	fileID uuid.UUID,
 ) LessonFileLinks {
+	fileHref := fmt.Sprintf(
+		"https://%s/api%s/%s%s/%s%s/%d%s/%d%s/%s",
+		backendDomain,
+		paths.LanguagePathV1,
+		languageSlug,
+		paths.SeriesPath,
+		seriesSlug,
+		paths.SectionsPath,
+		sectionID,
+		paths.LessonsPath,
+		lessonID,
+		paths.FilesPath,
+		fileID.String(),
+	)
+
 	return LessonFileLinks{
-		Self: LinkResponse{
-			Href: fmt.Sprintf(
-				"https://%s/api%s/%s%s/%s%s/%d%s/%d%s/%s",
-				backendDomain,
-				paths.LanguagePathV1,
-				languageSlug,
-				paths.SeriesPath,
-				seriesSlug,
-				paths.SectionsPath,
-				sectionID,
-				paths.LessonsPath,
-				lessonID,
-				paths.FilesPath,
-				fileID.String(),
-			),
-		},
+		Self:     LinkResponse{Href: fileHref},
+		Download: LinkResponse{Href: fileHref + paths.DownloadPath},
 		LessonFiles: LinkResponse{
 			Href: fmt.Sprintf(
 				"https://%s/api%s/%s%s/%s%s/%d%s/%d%s",
@@ -106,11 +109,12 @@ func newLessonFileLinks(
 }
 
 type LessonFileResponse struct {
-	ID    uuid.UUID       `json:"id"`
-	Name  string          `json:"name"`
-	Ext   string          `json:"ext"`
-	URL   string          `json:"url"`
-	Links LessonFileLinks `json:"_links"`
+	ID            uuid.UUID       `json:"id"`
+	Name          string          `json:"name"`
+	Ext           string          `json:"ext"`
+	URL           string          `json:"url"`
+	DownloadCount int32           `json:"downloadCount"`
+	Links         LessonFileLinks `json:"_links"`
 }
 
 func NewLessonFileResponse(
@@ -120,18 +124,65 @@ func NewLessonFileResponse(
 	sectionID int32,
 	file *db.LessonFileModel,
 ) *LessonFileResponse {
+	links := newLessonFileLinks(
+		backendDomain,
+		languageSlug,
+		seriesSlug,
+		sectionID,
+		file.LessonID,
+		file.ID,
+	)
+
 	return &LessonFileResponse{
-		ID:   file.ID,
-		Name: file.Name,
-		Ext:  file.Ext,
-		URL:  file.URL,
-		Links: newLessonFileLinks(
-			backendDomain,
-			languageSlug,
-			seriesSlug,
-			sectionID,
-			file.LessonID,
-			file.ID,
-		),
+		ID:            file.ID,
+		Name:          file.Name,
+		Ext:           file.Ext,
+		URL:           links.Download.Href,
+		DownloadCount: file.DownloadCount,
+		Links:         links,
+	}
+}
+
+type LessonFileDownloadLinks struct {
+	Self   LinkResponse `json:"self"`
+	File   LinkResponse `json:"file"`
+	Lesson LinkResponse `json:"lesson"`
+}
+
+type LessonFileDownloadResponse struct {
+	URL       string                  `json:"url"`
+	ExpiresAt string                  `json:"expiresAt"`
+	Downloads int32                   `json:"downloads,omitempty"`
+	Links     LessonFileDownloadLinks `json:"_links"`
+}
+
+func NewLessonFileDownloadResponse(
+	backendDomain,
+	languageSlug,
+	seriesSlug string,
+	sectionID int32,
+	file *db.LessonFileModel,
+	url string,
+	expiresAt time.Time,
+	downloads int32,
+) *LessonFileDownloadResponse {
+	fileLinks := newLessonFileLinks(
+		backendDomain,
+		languageSlug,
+		seriesSlug,
+		sectionID,
+		file.LessonID,
+		file.ID,
+	)
+
+	return &LessonFileDownloadResponse{
+		URL:       url,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+		Downloads: downloads,
+		Links: LessonFileDownloadLinks{
+			Self:   fileLinks.Download,
+			File:   fileLinks.Self,
+			Lesson: fileLinks.Lesson,
+		},
 	}
 }
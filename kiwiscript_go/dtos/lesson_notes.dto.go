@@ -0,0 +1,116 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type LessonNoteBody struct {
+	Body          string `json:"body" validate:"required,min=1,max=10000"`
+	AnchorHeading string `json:"anchorHeading" validate:"omitempty,max=250"`
+	AnchorSeconds int32  `json:"anchorSeconds" validate:"omitempty,gte=0"`
+}
+
+// Path Params
+
+type LessonNotePathParams struct {
+	LanguageSlug string `validate:"required,min=2,max=50,slug"`
+	SeriesSlug   string `validate:"required,min=2,max=100,slug"`
+	SectionID    string `validate:"required,number,min=1"`
+	LessonID     string `validate:"required,number,min=1"`
+	NoteID       string `validate:"required,number,min=1"`
+}
+
+// Responses
+
+type LessonNoteLinks struct {
+	Self   LinkResponse `json:"self"`
+	Lesson LinkResponse `json:"lesson"`
+	Series LinkResponse `json:"series"`
+}
+
+func newLessonNoteLinks(
+	backendDomain,
+	languageSlug,
+	seriesSlug string,
+	sectionID,
+	lessonID,
+	noteID int32,
+) LessonNoteLinks {
+	seriesHref := fmt.Sprintf(
+		"https://%s/api%s/%s%s/%s",
+		backendDomain,
+		paths.LanguagePathV1,
+		languageSlug,
+		paths.SeriesPath,
+		seriesSlug,
+	)
+	lessonHref := fmt.Sprintf(
+		"%s%s/%d%s/%d",
+		seriesHref,
+		paths.SectionsPath,
+		sectionID,
+		paths.LessonsPath,
+		lessonID,
+	)
+
+	return LessonNoteLinks{
+		Self:   LinkResponse{fmt.Sprintf("%s%s/%d", lessonHref, paths.NotesPath, noteID)},
+		Lesson: LinkResponse{lessonHref},
+		Series: LinkResponse{seriesHref},
+	}
+}
+
+type LessonNoteResponse struct {
+	ID            int32           `json:"id"`
+	Body          string          `json:"body"`
+	AnchorHeading string          `json:"anchorHeading,omitempty"`
+	AnchorSeconds int32           `json:"anchorSeconds,omitempty"`
+	SectionTitle  string          `json:"sectionTitle,omitempty"`
+	LessonTitle   string          `json:"lessonTitle,omitempty"`
+	CreatedAt     string          `json:"createdAt"`
+	UpdatedAt     string          `json:"updatedAt"`
+	Links         LessonNoteLinks `json:"_links"`
+}
+
+func NewLessonNoteResponse(backendDomain string, model *db.LessonNoteModel) *LessonNoteResponse {
+	return &LessonNoteResponse{
+		ID:            model.ID,
+		Body:          model.Body,
+		AnchorHeading: model.AnchorHeading,
+		AnchorSeconds: model.AnchorSeconds,
+		SectionTitle:  model.SectionTitle,
+		LessonTitle:   model.LessonTitle,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+		Links: newLessonNoteLinks(
+			backendDomain,
+			model.LanguageSlug,
+			model.SeriesSlug,
+			model.SectionID,
+			model.LessonID,
+			model.ID,
+		),
+	}
+}
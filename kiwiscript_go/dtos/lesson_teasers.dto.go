@@ -0,0 +1,75 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import db "github.com/kiwiscript/kiwiscript_go/providers/database"
+
+type LessonTeaserResponse struct {
+	ID        int32       `json:"id"`
+	Title     string      `json:"title"`
+	Duration  int32       `json:"duration"`
+	WatchTime int32       `json:"watchTime"`
+	ReadTime  int32       `json:"readTime"`
+	Outline   []string    `json:"outline"`
+	Links     LessonLinks `json:"_links"`
+}
+
+func NewLessonTeaserResponse(
+	backendDomain string,
+	lesson *db.LessonModel,
+	outline []string,
+) *LessonTeaserResponse {
+	return &LessonTeaserResponse{
+		ID:        lesson.ID,
+		Title:     lesson.Title,
+		Duration:  lesson.WatchTimeSeconds + lesson.ReadTimeSeconds,
+		WatchTime: lesson.WatchTimeSeconds,
+		ReadTime:  lesson.ReadTimeSeconds,
+		Outline:   outline,
+		Links: newLessonLinks(
+			backendDomain,
+			lesson.LanguageSlug,
+			lesson.SeriesSlug,
+			lesson.SectionID,
+			lesson.ID,
+			0,
+			0,
+			nil,
+		),
+	}
+}
+
+type SignInRequiredResponse struct {
+	Code    string                `json:"code"`
+	Message string                `json:"message"`
+	Teaser  *LessonTeaserResponse `json:"teaser"`
+}
+
+func NewSignInRequiredResponse(
+	backendDomain,
+	code,
+	message string,
+	lesson *db.LessonModel,
+	outline []string,
+) *SignInRequiredResponse {
+	return &SignInRequiredResponse{
+		Code:    code,
+		Message: message,
+		Teaser:  NewLessonTeaserResponse(backendDomain, lesson, outline),
+	}
+}
@@ -23,7 +23,6 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/kiwiscript/kiwiscript_go/paths"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
-	"github.com/kiwiscript/kiwiscript_go/services"
 	"time"
 )
 
@@ -47,6 +46,10 @@ type UpdateLessonBody struct {
 	Position int16  `json:"position" validate:"required,gte=1"`
 }
 
+type ReorderLessonsBody struct {
+	LessonIDs []int32 `json:"lessonIds" validate:"required,min=1,max=500,unique,dive,gte=1"`
+}
+
 // Responses
 
 type LessonLinks struct {
@@ -232,30 +235,29 @@ func newFileEmbedded(
 	backendDomain string,
 	lesson *db.LessonModel,
 	file *db.LessonFile,
-	url string,
 ) LessonFileEmbedded {
+	fileHref := fmt.Sprintf(
+		"https://%s/api%s/%s%s/%s%s/%d%s/%d%s/%s",
+		backendDomain,
+		paths.LanguagePathV1,
+		lesson.LanguageSlug,
+		paths.SeriesPath,
+		lesson.SeriesSlug,
+		paths.SectionsPath,
+		lesson.SectionID,
+		paths.LessonsPath,
+		lesson.ID,
+		paths.FilesPath,
+		file.ID.String(),
+	)
+
 	return LessonFileEmbedded{
 		ID:   file.ID,
 		Name: file.Name,
 		Ext:  file.Ext,
-		URL:  url,
+		URL:  fileHref + paths.DownloadPath,
 		Links: SelfLinkResponse{
-			Self: LinkResponse{
-				Href: fmt.Sprintf(
-					"https://%s/api%s/%s%s/%s%s/%d%s/%d%s/%s",
-					backendDomain,
-					paths.LanguagePathV1,
-					lesson.LanguageSlug,
-					paths.SeriesPath,
-					lesson.SeriesSlug,
-					paths.SectionsPath,
-					lesson.SectionID,
-					paths.LessonsPath,
-					lesson.ID,
-					paths.FilesPath,
-					file.ID.String(),
-				),
-			},
+			Self: LinkResponse{Href: fileHref},
 		},
 	}
 }
@@ -334,9 +336,11 @@ type LessonResponse struct {
 	Position    int16           `json:"position"`
 	IsCompleted bool            `json:"isCompleted"`
 	IsPublished bool            `json:"isPublished"`
+	IsPreview   bool            `json:"isPreview"`
 	WatchTime   int32           `json:"watchTime"`
 	ReadTime    int32           `json:"readTime"`
 	ViewedAt    string          `json:"viewedAt,omitempty"`
+	TimeOnTask  int32           `json:"timeOnTask,omitempty"`
 	Embedded    *LessonEmbedded `json:"_embedded,omitempty"`
 	Links       LessonLinks     `json:"_links"`
 }
@@ -348,9 +352,11 @@ func NewLessonResponse(backendDomain string, lesson *db.LessonModel) *LessonResp
 		Position:    lesson.Position,
 		IsCompleted: lesson.IsCompleted,
 		IsPublished: lesson.IsPublished,
+		IsPreview:   lesson.IsPreview,
 		WatchTime:   lesson.WatchTimeSeconds,
 		ReadTime:    lesson.ReadTimeSeconds,
 		ViewedAt:    lesson.ViewedAt,
+		TimeOnTask:  lesson.TimeOnTask,
 		Links: newLessonLinks(
 			backendDomain,
 			lesson.LanguageSlug,
@@ -372,17 +378,14 @@ func NewLessonResponseWithEmbeddedOptions(
 	articleContent,
 	videoURL string,
 	files []db.LessonFile,
-	fileURLs *services.FileURLsContainer,
 ) *LessonResponse {
 	var embeddedFiles []LessonFileEmbedded
 	filesLen := len(files)
-	if filesLen > 0 && fileURLs != nil {
+	if filesLen > 0 {
 		embeddedFiles = make([]LessonFileEmbedded, 0, filesLen)
 
 		for _, f := range files {
-			if url, ok := fileURLs.Get(f.ID); ok {
-				embeddedFiles = append(embeddedFiles, newFileEmbedded(backendDomain, lesson, &f, url))
-			}
+			embeddedFiles = append(embeddedFiles, newFileEmbedded(backendDomain, lesson, &f))
 		}
 	}
 
@@ -392,9 +395,11 @@ func NewLessonResponseWithEmbeddedOptions(
 		Position:    lesson.Position,
 		IsCompleted: lesson.IsCompleted,
 		IsPublished: lesson.IsPublished,
+		IsPreview:   lesson.IsPreview,
 		WatchTime:   lesson.WatchTimeSeconds,
 		ReadTime:    lesson.ReadTimeSeconds,
 		ViewedAt:    lesson.ViewedAt,
+		TimeOnTask:  lesson.TimeOnTask,
 		Links: newLessonLinks(
 			backendDomain,
 			lesson.LanguageSlug,
@@ -424,9 +429,11 @@ func NewLessonResponseWithCertificate(
 		Position:    lesson.Position,
 		IsCompleted: lesson.IsCompleted,
 		IsPublished: lesson.IsPublished,
+		IsPreview:   lesson.IsPreview,
 		WatchTime:   lesson.WatchTimeSeconds,
 		ReadTime:    lesson.ReadTimeSeconds,
 		ViewedAt:    lesson.ViewedAt,
+		TimeOnTask:  lesson.TimeOnTask,
 		Links: newLessonLinks(
 			backendDomain,
 			lesson.LanguageSlug,
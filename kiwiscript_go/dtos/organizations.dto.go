@@ -0,0 +1,352 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type OrganizationBody struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+type OrganizationInvitationBody struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"required,oneof=admin member"`
+}
+
+type CohortBody struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+type CohortMemberBody struct {
+	UserID int32 `json:"userId" validate:"required,gte=1"`
+}
+
+type CohortSeriesBody struct {
+	LanguageSlug string `json:"languageSlug" validate:"required,min=2,max=50,slug"`
+	DueAt        string `json:"dueAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+type OrganizationLicenseBody struct {
+	LanguageSlug string `json:"languageSlug" validate:"required,min=2,max=50,slug"`
+	Seats        int32  `json:"seats" validate:"required,gte=1,lte=100000"`
+}
+
+// Path Params
+
+type OrganizationPathParams struct {
+	OrganizationID string `validate:"required,number,min=1"`
+}
+
+type OrganizationMemberPathParams struct {
+	OrganizationID string `validate:"required,number,min=1"`
+	UserID         string `validate:"required,number,min=1"`
+}
+
+type OrganizationInvitationPathParams struct {
+	Token string `validate:"required,uuid"`
+}
+
+type CohortPathParams struct {
+	OrganizationID string `validate:"required,number,min=1"`
+	CohortID       string `validate:"required,number,min=1"`
+}
+
+type CohortMemberPathParams struct {
+	OrganizationID string `validate:"required,number,min=1"`
+	CohortID       string `validate:"required,number,min=1"`
+	UserID         string `validate:"required,number,min=1"`
+}
+
+type CohortSeriesPathParams struct {
+	OrganizationID string `validate:"required,number,min=1"`
+	CohortID       string `validate:"required,number,min=1"`
+	SeriesSlug     string `validate:"required,min=2,max=100,slug"`
+}
+
+type OrganizationLicensePathParams struct {
+	OrganizationID string `validate:"required,number,min=1"`
+	SeriesSlug     string `validate:"required,min=2,max=100,slug"`
+}
+
+// Responses
+
+func newOrganizationHref(backendDomain string, organizationID int32) string {
+	return fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.OrganizationsV1, organizationID)
+}
+
+func newCohortHref(backendDomain string, organizationID, cohortID int32) string {
+	return fmt.Sprintf("%s%s/%d", newOrganizationHref(backendDomain, organizationID), paths.CohortsPath, cohortID)
+}
+
+type OrganizationLinks struct {
+	Self    LinkResponse `json:"self"`
+	Members LinkResponse `json:"members"`
+	Cohorts LinkResponse `json:"cohorts"`
+}
+
+type OrganizationResponse struct {
+	ID        int32             `json:"id"`
+	Name      string            `json:"name"`
+	OwnerID   int32             `json:"ownerId"`
+	CreatedAt string            `json:"createdAt"`
+	UpdatedAt string            `json:"updatedAt"`
+	Links     OrganizationLinks `json:"_links"`
+}
+
+func NewOrganizationResponse(backendDomain string, model *db.OrganizationModel) *OrganizationResponse {
+	organizationHref := newOrganizationHref(backendDomain, model.ID)
+	return &OrganizationResponse{
+		ID:        model.ID,
+		Name:      model.Name,
+		OwnerID:   model.OwnerID,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+		Links: OrganizationLinks{
+			Self:    LinkResponse{organizationHref},
+			Members: LinkResponse{organizationHref + paths.MembersPath},
+			Cohorts: LinkResponse{organizationHref + paths.CohortsPath},
+		},
+	}
+}
+
+type OrganizationMemberLinks struct {
+	Self         LinkResponse `json:"self"`
+	Organization LinkResponse `json:"organization"`
+}
+
+type OrganizationMemberResponse struct {
+	UserID    int32                   `json:"userId"`
+	FirstName string                  `json:"firstName"`
+	LastName  string                  `json:"lastName"`
+	Email     string                  `json:"email"`
+	Role      string                  `json:"role"`
+	CreatedAt string                  `json:"createdAt"`
+	Links     OrganizationMemberLinks `json:"_links"`
+}
+
+func NewOrganizationMemberResponse(
+	backendDomain string,
+	model *db.OrganizationMemberModel,
+) *OrganizationMemberResponse {
+	organizationHref := newOrganizationHref(backendDomain, model.OrganizationID)
+	return &OrganizationMemberResponse{
+		UserID:    model.UserID,
+		FirstName: model.FirstName,
+		LastName:  model.LastName,
+		Email:     model.Email,
+		Role:      model.Role,
+		CreatedAt: model.CreatedAt,
+		Links: OrganizationMemberLinks{
+			Self:         LinkResponse{fmt.Sprintf("%s%s/%d", organizationHref, paths.MembersPath, model.UserID)},
+			Organization: LinkResponse{organizationHref},
+		},
+	}
+}
+
+type OrganizationInvitationLinks struct {
+	Organization LinkResponse `json:"organization"`
+}
+
+type OrganizationInvitationResponse struct {
+	Email     string                      `json:"email"`
+	Role      string                      `json:"role"`
+	ExpiresAt string                      `json:"expiresAt"`
+	CreatedAt string                      `json:"createdAt"`
+	Links     OrganizationInvitationLinks `json:"_links"`
+}
+
+func NewOrganizationInvitationResponse(
+	backendDomain string,
+	model *db.OrganizationInvitationModel,
+) *OrganizationInvitationResponse {
+	return &OrganizationInvitationResponse{
+		Email:     model.Email,
+		Role:      model.Role,
+		ExpiresAt: model.ExpiresAt,
+		CreatedAt: model.CreatedAt,
+		Links: OrganizationInvitationLinks{
+			Organization: LinkResponse{newOrganizationHref(backendDomain, model.OrganizationID)},
+		},
+	}
+}
+
+type CohortLinks struct {
+	Self         LinkResponse `json:"self"`
+	Progress     LinkResponse `json:"progress"`
+	Export       LinkResponse `json:"export"`
+	Organization LinkResponse `json:"organization"`
+}
+
+type CohortResponse struct {
+	ID        int32       `json:"id"`
+	Name      string      `json:"name"`
+	CreatedAt string      `json:"createdAt"`
+	UpdatedAt string      `json:"updatedAt"`
+	Links     CohortLinks `json:"_links"`
+}
+
+func NewCohortResponse(backendDomain string, model *db.CohortModel) *CohortResponse {
+	cohortHref := newCohortHref(backendDomain, model.OrganizationID, model.ID)
+	return &CohortResponse{
+		ID:        model.ID,
+		Name:      model.Name,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+		Links: CohortLinks{
+			Self:         LinkResponse{cohortHref},
+			Progress:     LinkResponse{cohortHref + paths.ProgressPath},
+			Export:       LinkResponse{cohortHref + paths.ProgressPath + paths.ExportPath},
+			Organization: LinkResponse{newOrganizationHref(backendDomain, model.OrganizationID)},
+		},
+	}
+}
+
+type CohortSeriesLinks struct {
+	Self   LinkResponse `json:"self"`
+	Series LinkResponse `json:"series"`
+}
+
+type CohortSeriesResponse struct {
+	LanguageSlug string            `json:"languageSlug"`
+	SeriesSlug   string            `json:"seriesSlug"`
+	DueAt        string            `json:"dueAt,omitempty"`
+	CreatedAt    string            `json:"createdAt"`
+	UpdatedAt    string            `json:"updatedAt"`
+	Links        CohortSeriesLinks `json:"_links"`
+}
+
+func NewCohortSeriesResponse(
+	backendDomain string,
+	organizationID int32,
+	model *db.CohortSeriesModel,
+) *CohortSeriesResponse {
+	return &CohortSeriesResponse{
+		LanguageSlug: model.LanguageSlug,
+		SeriesSlug:   model.SeriesSlug,
+		DueAt:        model.DueAt,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+		Links: CohortSeriesLinks{
+			Self: LinkResponse{fmt.Sprintf(
+				"%s%s/%s",
+				newCohortHref(backendDomain, organizationID, model.CohortID),
+				paths.SeriesPath,
+				model.SeriesSlug,
+			)},
+			Series: LinkResponse{newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)},
+		},
+	}
+}
+
+type CohortProgressLinks struct {
+	Series      LinkResponse  `json:"series"`
+	Certificate *LinkResponse `json:"certificate,omitempty"`
+}
+
+type CohortProgressResponse struct {
+	UserID            int32               `json:"userId"`
+	FirstName         string              `json:"firstName"`
+	LastName          string              `json:"lastName"`
+	Email             string              `json:"email"`
+	LanguageSlug      string              `json:"languageSlug"`
+	SeriesSlug        string              `json:"seriesSlug"`
+	SeriesTitle       string              `json:"seriesTitle"`
+	DueAt             string              `json:"dueAt,omitempty"`
+	IsOverdue         bool                `json:"isOverdue"`
+	StartedSections   int16               `json:"startedSections"`
+	CompletedSections int16               `json:"completedSections"`
+	TotalSections     int16               `json:"totalSections"`
+	CompletedLessons  int16               `json:"completedLessons"`
+	TotalLessons      int16               `json:"totalLessons"`
+	CompletedAt       string              `json:"completedAt,omitempty"`
+	CertificateID     string              `json:"certificateId,omitempty"`
+	Links             CohortProgressLinks `json:"_links"`
+}
+
+func NewCohortProgressResponse(backendDomain string, model *db.CohortProgressModel) *CohortProgressResponse {
+	var certificateLink *LinkResponse
+	if model.CertificateID != "" {
+		certificateLink = &LinkResponse{
+			fmt.Sprintf("https://%s/api%s/%s", backendDomain, paths.CertificatesV1, model.CertificateID),
+		}
+	}
+
+	return &CohortProgressResponse{
+		UserID:            model.UserID,
+		FirstName:         model.FirstName,
+		LastName:          model.LastName,
+		Email:             model.Email,
+		LanguageSlug:      model.LanguageSlug,
+		SeriesSlug:        model.SeriesSlug,
+		SeriesTitle:       model.SeriesTitle,
+		DueAt:             model.DueAt,
+		IsOverdue:         model.IsOverdue,
+		StartedSections:   model.StartedSections,
+		CompletedSections: model.CompletedSections,
+		TotalSections:     model.TotalSections,
+		CompletedLessons:  model.CompletedLessons,
+		TotalLessons:      model.TotalLessons,
+		CompletedAt:       model.CompletedAt,
+		CertificateID:     model.CertificateID,
+		Links: CohortProgressLinks{
+			Series:      LinkResponse{newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)},
+			Certificate: certificateLink,
+		},
+	}
+}
+
+type OrganizationLicenseLinks struct {
+	Self         LinkResponse `json:"self"`
+	Organization LinkResponse `json:"organization"`
+	Series       LinkResponse `json:"series"`
+}
+
+type OrganizationLicenseResponse struct {
+	LanguageSlug string                   `json:"languageSlug"`
+	SeriesSlug   string                   `json:"seriesSlug"`
+	Seats        int32                    `json:"seats"`
+	CreatedAt    string                   `json:"createdAt"`
+	UpdatedAt    string                   `json:"updatedAt"`
+	Links        OrganizationLicenseLinks `json:"_links"`
+}
+
+func NewOrganizationLicenseResponse(
+	backendDomain string,
+	model *db.OrganizationLicenseModel,
+) *OrganizationLicenseResponse {
+	organizationHref := newOrganizationHref(backendDomain, model.OrganizationID)
+	return &OrganizationLicenseResponse{
+		LanguageSlug: model.LanguageSlug,
+		SeriesSlug:   model.SeriesSlug,
+		Seats:        model.Seats,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+		Links: OrganizationLicenseLinks{
+			Self:         LinkResponse{fmt.Sprintf("%s%s/%s", organizationHref, paths.LicensesPath, model.SeriesSlug)},
+			Organization: LinkResponse{organizationHref},
+			Series:       LinkResponse{newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)},
+		},
+	}
+}
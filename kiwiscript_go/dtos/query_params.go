@@ -17,6 +17,8 @@
 
 package dtos
 
+import "net/url"
+
 const (
 	OffsetDefault int = 0
 	LimitDefault  int = 25
@@ -42,3 +44,31 @@ func (p *PaginationQueryParams) GetLimit() int32 {
 func (p *PaginationQueryParams) GetOffset() int32 {
 	return p.Offset
 }
+
+// CursorPaginationQueryParams switches to keyset pagination when the cursor
+// query key is present, an empty cursor requests the first page.
+type CursorPaginationQueryParams struct {
+	Limit     int32  `validate:"omitempty,gte=1,lte=100"`
+	Offset    int32  `validate:"omitempty,gte=0"`
+	Cursor    string `validate:"omitempty,max=500"`
+	WithCount bool
+}
+
+func (p *CursorPaginationQueryParams) ToQueryString() string {
+	return ""
+}
+func (p *CursorPaginationQueryParams) GetLimit() int32 {
+	return p.Limit
+}
+func (p *CursorPaginationQueryParams) GetOffset() int32 {
+	return p.Offset
+}
+func (p *CursorPaginationQueryParams) ToCursorQueryString() string {
+	params := make(url.Values)
+
+	if p.WithCount {
+		params.Add("withCount", "true")
+	}
+
+	return params.Encode()
+}
@@ -17,7 +17,10 @@
 
 package dtos
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
 
 type LinkResponse struct {
 	Href string `json:"href"`
@@ -97,3 +100,54 @@ func NewPaginatedResponse[T any, V any](
 		Results: results,
 	}
 }
+
+type CursorPaginatedResponse[T any] struct {
+	Count   *int64                 `json:"count,omitempty"`
+	Links   PaginatedResponseLinks `json:"_links"`
+	Results []T                    `json:"results"`
+}
+
+func newCursorNavigationURL(backendDomain, path string, params string, limit int32, cursor string) LinkResponse {
+	query := fmt.Sprintf("limit=%d&cursor=%s", limit, url.QueryEscape(cursor))
+	if params != "" {
+		query = params + "&" + query
+	}
+
+	return LinkResponse{fmt.Sprintf("https://%s/api%s?%s", backendDomain, path, query)}
+}
+
+func NewCursorPaginatedResponse[T any, V any](
+	backendDomain,
+	path string,
+	params *CursorPaginationQueryParams,
+	count *int64,
+	entities []V,
+	nextCursor,
+	prevCursor string,
+	mapper func(*V) T,
+) CursorPaginatedResponse[T] {
+	results := make([]T, 0, len(entities))
+
+	for _, entity := range entities {
+		results = append(results, mapper(&entity))
+	}
+
+	queryString := params.ToCursorQueryString()
+	self := newCursorNavigationURL(backendDomain, path, queryString, params.Limit, params.Cursor)
+
+	var next LinkResponse
+	if nextCursor != "" {
+		next = newCursorNavigationURL(backendDomain, path, queryString, params.Limit, nextCursor)
+	}
+
+	var prev LinkResponse
+	if prevCursor != "" {
+		prev = newCursorNavigationURL(backendDomain, path, queryString, params.Limit, prevCursor)
+	}
+
+	return CursorPaginatedResponse[T]{
+		Count:   count,
+		Links:   PaginatedResponseLinks{Self: self, Next: next.ToRef(), Prev: prev.ToRef()},
+		Results: results,
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+// UpdateScheduleBody replaces the whole schedule, an empty date clears it.
+type UpdateScheduleBody struct {
+	PublishAt   string `json:"publishAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	UnpublishAt string `json:"unpublishAt" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// Responses
+
+type ScheduleResponse struct {
+	PublishAt   string           `json:"publishAt,omitempty"`
+	UnpublishAt string           `json:"unpublishAt,omitempty"`
+	Links       SelfLinkResponse `json:"_links"`
+}
+
+func newSeriesHref(backendDomain, languageSlug, seriesSlug string) string {
+	return fmt.Sprintf(
+		"https://%s/api%s/%s%s/%s",
+		backendDomain,
+		paths.LanguagePathV1,
+		languageSlug,
+		paths.SeriesPath,
+		seriesSlug,
+	)
+}
+
+func newSectionHref(backendDomain, languageSlug, seriesSlug string, sectionID int32) string {
+	return fmt.Sprintf(
+		"%s%s/%d",
+		newSeriesHref(backendDomain, languageSlug, seriesSlug),
+		paths.SectionsPath,
+		sectionID,
+	)
+}
+
+func newLessonHref(backendDomain, languageSlug, seriesSlug string, sectionID, lessonID int32) string {
+	return fmt.Sprintf(
+		"%s%s/%d",
+		newSectionHref(backendDomain, languageSlug, seriesSlug, sectionID),
+		paths.LessonsPath,
+		lessonID,
+	)
+}
+
+func newScheduleResponse(href string, model *db.ScheduleModel) *ScheduleResponse {
+	return &ScheduleResponse{
+		PublishAt:   model.PublishAt,
+		UnpublishAt: model.UnpublishAt,
+		Links: SelfLinkResponse{
+			Self: LinkResponse{href + paths.SchedulePath},
+		},
+	}
+}
+
+func NewSeriesScheduleResponse(backendDomain string, series *db.Series) *ScheduleResponse {
+	return newScheduleResponse(
+		newSeriesHref(backendDomain, series.LanguageSlug, series.Slug),
+		series.ToScheduleModel(),
+	)
+}
+
+func NewSectionScheduleResponse(backendDomain string, section *db.Section) *ScheduleResponse {
+	return newScheduleResponse(
+		newSectionHref(backendDomain, section.LanguageSlug, section.SeriesSlug, section.ID),
+		section.ToScheduleModel(),
+	)
+}
+
+func NewLessonScheduleResponse(backendDomain string, lesson *db.Lesson) *ScheduleResponse {
+	return newScheduleResponse(
+		newLessonHref(backendDomain, lesson.LanguageSlug, lesson.SeriesSlug, lesson.SectionID, lesson.ID),
+		lesson.ToScheduleModel(),
+	)
+}
+
+type ScheduledReleaseResponse struct {
+	Kind         string           `json:"kind"`
+	Action       string           `json:"action"`
+	ID           int32            `json:"id"`
+	Title        string           `json:"title"`
+	LanguageSlug string           `json:"languageSlug"`
+	SeriesSlug   string           `json:"seriesSlug"`
+	SectionID    int32            `json:"sectionId,omitempty"`
+	ScheduledAt  string           `json:"scheduledAt"`
+	Links        SelfLinkResponse `json:"_links"`
+}
+
+func NewScheduledReleaseResponse(backendDomain string, model *db.ScheduledReleaseModel) *ScheduledReleaseResponse {
+	var href string
+	switch model.Kind {
+	case db.ScheduledReleaseKindSection:
+		href = newSectionHref(backendDomain, model.LanguageSlug, model.SeriesSlug, model.ID)
+	case db.ScheduledReleaseKindLesson:
+		href = newLessonHref(backendDomain, model.LanguageSlug, model.SeriesSlug, model.SectionID, model.ID)
+	default:
+		href = newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)
+	}
+
+	return &ScheduledReleaseResponse{
+		Kind:         model.Kind,
+		Action:       model.Action,
+		ID:           model.ID,
+		Title:        model.Title,
+		LanguageSlug: model.LanguageSlug,
+		SeriesSlug:   model.SeriesSlug,
+		SectionID:    model.SectionID,
+		ScheduledAt:  model.ScheduledAt,
+		Links: SelfLinkResponse{
+			Self: LinkResponse{href},
+		},
+	}
+}
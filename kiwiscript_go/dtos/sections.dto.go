@@ -44,6 +44,10 @@ type UpdateSectionBody struct {
 	Position    int16  `json:"position" validate:"required,gte=1"`
 }
 
+type ReorderSectionsBody struct {
+	SectionIDs []int32 `json:"sectionIds" validate:"required,min=1,max=500,unique,dive,gte=1"`
+}
+
 type SectionLinks struct {
 	Self     LinkResponse `json:"self"`
 	Series   LinkResponse `json:"series"`
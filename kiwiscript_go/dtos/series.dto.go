@@ -37,6 +37,10 @@ type UpdateSeriesBody struct {
 	Description string `json:"description" validate:"required,min=2"`
 }
 
+type SeriesMembersOnlyBody struct {
+	IsMembersOnly bool `json:"isMembersOnly"`
+}
+
 // Path Params
 
 type SeriesPathParams struct {
@@ -50,7 +54,7 @@ type SeriesQueryParams struct {
 	Search string `validate:"omitempty,min=1,max=100"`
 	Limit  int32  `validate:"omitempty,gte=1,lte=100"`
 	Offset int32  `validate:"omitempty,gte=0"`
-	SortBy string `validate:"omitempty,oneof=slug date"`
+	SortBy string `validate:"omitempty,oneof=slug date rating"`
 }
 
 func (p *SeriesQueryParams) ToQueryString() string {
@@ -99,6 +103,7 @@ type SeriesLinks struct {
 	Author   LinkResponse  `json:"author"`
 	Language LinkResponse  `json:"language"`
 	Sections LinkResponse  `json:"parts"`
+	Reviews  LinkResponse  `json:"reviews"`
 	Picture  *LinkResponse `json:"picture,omitempty"`
 }
 
@@ -146,6 +151,17 @@ func newSeriesLinks(backendDomain, languageSlug, seriesSlug string, authorID int
 				paths.SectionsPath,
 			),
 		},
+		Reviews: LinkResponse{
+			fmt.Sprintf(
+				"https://%s/api%s/%s%s/%s%s",
+				backendDomain,
+				paths.LanguagePathV1,
+				languageSlug,
+				paths.SeriesPath,
+				seriesSlug,
+				paths.ReviewsPath,
+			),
+		},
 		Picture: picture,
 	}
 }
@@ -227,6 +243,11 @@ type SeriesResponse struct {
 	ViewedAt          string         `json:"viewedAt,omitempty"`
 	CompletedAt       string         `json:"completedAt,omitempty"`
 	IsPublished       bool           `json:"isPublished"`
+	Price             int32          `json:"price"`
+	Currency          string         `json:"currency"`
+	IsMembersOnly     bool           `json:"isMembersOnly"`
+	Rating            float32        `json:"rating"`
+	ReviewsCount      int32          `json:"reviewsCount"`
 	Embedded          SeriesEmbedded `json:"_embedded"`
 	Links             SeriesLinks    `json:"_links"`
 }
@@ -238,6 +259,9 @@ func NewSeriesResponse(backendDomain string, model *db.SeriesModel, pictureURL s
 		Slug:              model.Slug,
 		Description:       model.Description,
 		IsPublished:       model.IsPublished,
+		Price:             model.Price,
+		Currency:          model.Currency,
+		IsMembersOnly:     model.IsMembersOnly,
 		CompletedSections: model.CompletedSections,
 		TotalSections:     model.TotalSections,
 		CompletedLessons:  model.CompletedLessons,
@@ -246,6 +270,8 @@ func NewSeriesResponse(backendDomain string, model *db.SeriesModel, pictureURL s
 		ReadTime:          model.ReadTime,
 		ViewedAt:          model.ViewedAt,
 		CompletedAt:       model.CompletedAt,
+		Rating:            model.Rating,
+		ReviewsCount:      model.ReviewsCount,
 		Embedded: newSeriesEmbedded(
 			backendDomain,
 			&model.Author,
@@ -0,0 +1,227 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"time"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Query params
+
+type SeriesAnalyticsQueryParams struct {
+	From string `validate:"omitempty,datetime=2006-01-02"`
+	To   string `validate:"omitempty,datetime=2006-01-02"`
+}
+
+type SeriesAnalyticsTimelineQueryParams struct {
+	From     string `validate:"omitempty,datetime=2006-01-02"`
+	To       string `validate:"omitempty,datetime=2006-01-02"`
+	Interval string `validate:"required,oneof=day week month"`
+}
+
+// Responses
+
+type LessonAnalyticsResponse struct {
+	ID            int32   `json:"id"`
+	SectionID     int32   `json:"sectionId"`
+	Title         string  `json:"title"`
+	Position      int16   `json:"position"`
+	Learners      int32   `json:"learners"`
+	Completions   int32   `json:"completions"`
+	DropOffs      int32   `json:"dropOffs"`
+	DropOffRate   float32 `json:"dropOffRate"`
+	Opens         int32   `json:"opens"`
+	TimeOnTask    int32   `json:"timeOnTask"`
+	AvgTimeOnTask int32   `json:"avgTimeOnTask"`
+}
+
+func newLessonAnalyticsResponses(lessons []db.LessonFunnelStatsModel) []LessonAnalyticsResponse {
+	responses := make([]LessonAnalyticsResponse, 0, len(lessons))
+	for _, lesson := range lessons {
+		responses = append(responses, LessonAnalyticsResponse{
+			ID:            lesson.ID,
+			SectionID:     lesson.SectionID,
+			Title:         lesson.Title,
+			Position:      lesson.Position,
+			Learners:      lesson.Learners,
+			Completions:   lesson.Completions,
+			DropOffs:      lesson.DropOffs(),
+			DropOffRate:   lesson.DropOffRate(),
+			Opens:         lesson.Opens,
+			TimeOnTask:    lesson.TimeOnTaskSeconds,
+			AvgTimeOnTask: lesson.AverageTimeOnTask(),
+		})
+	}
+	return responses
+}
+
+type SeriesAnalyticsLinks struct {
+	Self     LinkResponse `json:"self"`
+	Series   LinkResponse `json:"series"`
+	Timeline LinkResponse `json:"timeline"`
+	Funnel   LinkResponse `json:"funnel"`
+}
+
+type SeriesAnalyticsResponse struct {
+	From                 string                    `json:"from"`
+	To                   string                    `json:"to"`
+	Learners             int32                     `json:"learners"`
+	Enrollments          int32                     `json:"enrollments"`
+	Completions          int32                     `json:"completions"`
+	CompletionRate       float32                   `json:"completionRate"`
+	Certificates         int32                     `json:"certificates"`
+	MedianTimeToComplete int32                     `json:"medianTimeToComplete"`
+	TopViewedLessons     []LessonAnalyticsResponse `json:"topViewedLessons"`
+	DropOffLessons       []LessonAnalyticsResponse `json:"dropOffLessons"`
+	RefreshedAt          string                    `json:"refreshedAt,omitempty"`
+	Links                SeriesAnalyticsLinks      `json:"_links"`
+}
+
+func NewSeriesAnalyticsResponse(
+	backendDomain,
+	languageSlug,
+	seriesSlug string,
+	analytics *db.SeriesAnalyticsModel,
+) *SeriesAnalyticsResponse {
+	var completionRate float32
+	if analytics.Learners > 0 {
+		completionRate = float32(analytics.Completions) / float32(analytics.Learners)
+	}
+
+	var refreshedAt string
+	if !analytics.RefreshedAt.IsZero() {
+		refreshedAt = analytics.RefreshedAt.Format(time.RFC3339)
+	}
+
+	seriesHref := newSeriesHref(backendDomain, languageSlug, seriesSlug)
+	analyticsHref := seriesHref + paths.AnalyticsPath
+	return &SeriesAnalyticsResponse{
+		From:                 analytics.From.Format(time.DateOnly),
+		To:                   analytics.To.Format(time.DateOnly),
+		Learners:             analytics.Learners,
+		Enrollments:          analytics.Enrollments,
+		Completions:          analytics.Completions,
+		CompletionRate:       completionRate,
+		Certificates:         analytics.Certificates,
+		MedianTimeToComplete: analytics.MedianCompletionSeconds,
+		TopViewedLessons:     newLessonAnalyticsResponses(analytics.TopViewedLessons),
+		DropOffLessons:       newLessonAnalyticsResponses(analytics.DropOffLessons),
+		RefreshedAt:          refreshedAt,
+		Links: SeriesAnalyticsLinks{
+			Self:     LinkResponse{analyticsHref},
+			Series:   LinkResponse{seriesHref},
+			Timeline: LinkResponse{analyticsHref + paths.TimelinePath},
+			Funnel:   LinkResponse{analyticsHref + paths.FunnelPath},
+		},
+	}
+}
+
+type SeriesAnalyticsBucketResponse struct {
+	Start        string `json:"start"`
+	Learners     int32  `json:"learners"`
+	Enrollments  int32  `json:"enrollments"`
+	Completions  int32  `json:"completions"`
+	Certificates int32  `json:"certificates"`
+}
+
+type SeriesAnalyticsTimelineResponse struct {
+	From     string                          `json:"from"`
+	To       string                          `json:"to"`
+	Interval string                          `json:"interval"`
+	Buckets  []SeriesAnalyticsBucketResponse `json:"buckets"`
+	Links    SelfLinkResponse                `json:"_links"`
+}
+
+func NewSeriesAnalyticsTimelineResponse(
+	backendDomain,
+	languageSlug,
+	seriesSlug string,
+	timeline *db.SeriesAnalyticsTimelineModel,
+) *SeriesAnalyticsTimelineResponse {
+	buckets := make([]SeriesAnalyticsBucketResponse, 0, len(timeline.Buckets))
+	for _, bucket := range timeline.Buckets {
+		buckets = append(buckets, SeriesAnalyticsBucketResponse{
+			Start:        bucket.Start.Format(time.DateOnly),
+			Learners:     bucket.Learners,
+			Enrollments:  bucket.Enrollments,
+			Completions:  bucket.Completions,
+			Certificates: bucket.Certificates,
+		})
+	}
+
+	return &SeriesAnalyticsTimelineResponse{
+		From:     timeline.From.Format(time.DateOnly),
+		To:       timeline.To.Format(time.DateOnly),
+		Interval: timeline.Interval,
+		Buckets:  buckets,
+		Links: SelfLinkResponse{
+			Self: LinkResponse{
+				newSeriesHref(backendDomain, languageSlug, seriesSlug) + paths.AnalyticsPath + paths.TimelinePath,
+			},
+		},
+	}
+}
+
+type SectionAnalyticsResponse struct {
+	ID          int32                     `json:"id"`
+	Title       string                    `json:"title"`
+	Position    int16                     `json:"position"`
+	Learners    int32                     `json:"learners"`
+	Completions int32                     `json:"completions"`
+	Lessons     []LessonAnalyticsResponse `json:"lessons"`
+}
+
+type SeriesAnalyticsFunnelResponse struct {
+	From     string                     `json:"from"`
+	To       string                     `json:"to"`
+	Sections []SectionAnalyticsResponse `json:"sections"`
+	Links    SelfLinkResponse           `json:"_links"`
+}
+
+func NewSeriesAnalyticsFunnelResponse(
+	backendDomain,
+	languageSlug,
+	seriesSlug string,
+	funnel *db.SeriesAnalyticsFunnelModel,
+) *SeriesAnalyticsFunnelResponse {
+	sections := make([]SectionAnalyticsResponse, 0, len(funnel.Sections))
+	for _, section := range funnel.Sections {
+		sections = append(sections, SectionAnalyticsResponse{
+			ID:          section.ID,
+			Title:       section.Title,
+			Position:    section.Position,
+			Learners:    section.Learners,
+			Completions: section.Completions,
+			Lessons:     newLessonAnalyticsResponses(section.Lessons),
+		})
+	}
+
+	return &SeriesAnalyticsFunnelResponse{
+		From:     funnel.From.Format(time.DateOnly),
+		To:       funnel.To.Format(time.DateOnly),
+		Sections: sections,
+		Links: SelfLinkResponse{
+			Self: LinkResponse{
+				newSeriesHref(backendDomain, languageSlug, seriesSlug) + paths.AnalyticsPath + paths.FunnelPath,
+			},
+		},
+	}
+}
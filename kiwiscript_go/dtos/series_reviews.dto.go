@@ -0,0 +1,142 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type SeriesReviewBody struct {
+	Rating int16  `json:"rating" validate:"required,gte=1,lte=5"`
+	Review string `json:"review" validate:"required,min=2,max=2000"`
+}
+
+type SeriesReviewReplyBody struct {
+	Reply string `json:"reply" validate:"required,min=2,max=2000"`
+}
+
+type SeriesReviewIsHiddenBody struct {
+	IsHidden bool `json:"isHidden"`
+}
+
+// Path Params
+
+type SeriesReviewPathParams struct {
+	LanguageSlug string `validate:"required,min=2,max=50,slug"`
+	SeriesSlug   string `validate:"required,min=2,max=100,slug"`
+	ReviewID     string `validate:"required,number,min=1"`
+}
+
+// Responses
+
+type SeriesReviewLinks struct {
+	Self   LinkResponse `json:"self"`
+	Series LinkResponse `json:"series"`
+	Author LinkResponse `json:"author"`
+}
+
+func newSeriesReviewLinks(backendDomain, languageSlug, seriesSlug string, reviewID, authorID int32) SeriesReviewLinks {
+	return SeriesReviewLinks{
+		Self: LinkResponse{
+			fmt.Sprintf(
+				"https://%s/api%s/%s%s/%s%s/%d",
+				backendDomain,
+				paths.LanguagePathV1,
+				languageSlug,
+				paths.SeriesPath,
+				seriesSlug,
+				paths.ReviewsPath,
+				reviewID,
+			),
+		},
+		Series: LinkResponse{
+			fmt.Sprintf(
+				"https://%s/api%s/%s%s/%s",
+				backendDomain,
+				paths.LanguagePathV1,
+				languageSlug,
+				paths.SeriesPath,
+				seriesSlug,
+			),
+		},
+		Author: LinkResponse{
+			fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.UsersPathV1, authorID),
+		},
+	}
+}
+
+type SeriesReviewAuthorEmbedded struct {
+	ID        int32            `json:"id"`
+	FirstName string           `json:"firstName"`
+	LastName  string           `json:"lastName"`
+	Links     SelfLinkResponse `json:"_links"`
+}
+
+type SeriesReviewEmbedded struct {
+	Author SeriesReviewAuthorEmbedded `json:"author"`
+}
+
+type SeriesReviewResponse struct {
+	ID        int32                `json:"id"`
+	Rating    int16                `json:"rating"`
+	Review    string               `json:"review"`
+	Reply     string               `json:"reply,omitempty"`
+	RepliedAt string               `json:"repliedAt,omitempty"`
+	IsHidden  bool                 `json:"isHidden"`
+	CreatedAt string               `json:"createdAt"`
+	UpdatedAt string               `json:"updatedAt"`
+	Embedded  SeriesReviewEmbedded `json:"_embedded"`
+	Links     SeriesReviewLinks    `json:"_links"`
+}
+
+func NewSeriesReviewResponse(backendDomain string, model *db.SeriesReviewModel) *SeriesReviewResponse {
+	return &SeriesReviewResponse{
+		ID:        model.ID,
+		Rating:    model.Rating,
+		Review:    model.Review,
+		Reply:     model.Reply,
+		RepliedAt: model.RepliedAt,
+		IsHidden:  model.IsHidden,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+		Embedded: SeriesReviewEmbedded{
+			Author: SeriesReviewAuthorEmbedded{
+				ID:        model.Author.ID,
+				FirstName: model.Author.FirstName,
+				LastName:  model.Author.LastName,
+				Links: SelfLinkResponse{
+					LinkResponse{
+						fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.UsersPathV1, model.Author.ID),
+					},
+				},
+			},
+		},
+		Links: newSeriesReviewLinks(
+			backendDomain,
+			model.LanguageSlug,
+			model.SeriesSlug,
+			model.ID,
+			model.Author.ID,
+		),
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Responses
+
+type SeriesVersionResponse struct {
+	ID            int32                      `json:"id"`
+	Version       int16                      `json:"version"`
+	Title         string                     `json:"title"`
+	SectionsCount int16                      `json:"sectionsCount"`
+	LessonsCount  int16                      `json:"lessonsCount"`
+	WatchTime     int32                      `json:"watchTime"`
+	ReadTime      int32                      `json:"readTime"`
+	CreatedAt     string                     `json:"createdAt"`
+	Links         SeriesVersionLinksResponse `json:"_links"`
+}
+
+type SeriesVersionLinksResponse struct {
+	Self   LinkResponse `json:"self"`
+	Series LinkResponse `json:"series"`
+}
+
+func NewSeriesVersionResponse(backendDomain string, model *db.SeriesVersionModel) *SeriesVersionResponse {
+	seriesHref := newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)
+	return &SeriesVersionResponse{
+		ID:            model.ID,
+		Version:       model.Version,
+		Title:         model.Title,
+		SectionsCount: model.SectionsCount,
+		LessonsCount:  model.LessonsCount,
+		WatchTime:     model.WatchTime,
+		ReadTime:      model.ReadTime,
+		CreatedAt:     model.CreatedAt,
+		Links: SeriesVersionLinksResponse{
+			Self:   LinkResponse{fmt.Sprintf("%s%s/%d", seriesHref, paths.VersionsPath, model.Version)},
+			Series: LinkResponse{seriesHref},
+		},
+	}
+}
+
+type SeriesProgressVersionResponse struct {
+	Version           int16                              `json:"version"`
+	LatestVersion     int16                              `json:"latestVersion"`
+	CanMigrate        bool                               `json:"canMigrate"`
+	CompletedSections int16                              `json:"completedSections"`
+	TotalSections     int16                              `json:"totalSections"`
+	CompletedLessons  int16                              `json:"completedLessons"`
+	TotalLessons      int16                              `json:"totalLessons"`
+	CompletedAt       string                             `json:"completedAt,omitempty"`
+	Links             SeriesProgressVersionLinksResponse `json:"_links"`
+}
+
+type SeriesProgressVersionLinksResponse struct {
+	Self   LinkResponse `json:"self"`
+	Series LinkResponse `json:"series"`
+}
+
+func NewSeriesProgressVersionResponse(
+	backendDomain string,
+	model *db.SeriesProgressVersionModel,
+) *SeriesProgressVersionResponse {
+	seriesHref := newSeriesHref(backendDomain, model.LanguageSlug, model.SeriesSlug)
+	return &SeriesProgressVersionResponse{
+		Version:           model.Version,
+		LatestVersion:     model.LatestVersion,
+		CanMigrate:        model.LatestVersion > model.Version,
+		CompletedSections: model.CompletedSections,
+		TotalSections:     model.TotalSections,
+		CompletedLessons:  model.CompletedLessons,
+		TotalLessons:      model.TotalLessons,
+		CompletedAt:       model.CompletedAt,
+		Links: SeriesProgressVersionLinksResponse{
+			Self:   LinkResponse{seriesHref + paths.ProgressPath + paths.VersionPath},
+			Series: LinkResponse{seriesHref},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+type UserDataExportResponse struct {
+	ID        string           `json:"id"`
+	Status    string           `json:"status"`
+	ExpiresAt string           `json:"expiresAt,omitempty"`
+	CreatedAt string           `json:"createdAt"`
+	Links     SelfLinkResponse `json:"_links"`
+}
+
+func NewUserDataExportResponse(backendDomain string, export *db.UserDataExport) *UserDataExportResponse {
+	var expiresAt string
+	if export.ExpiresAt.Valid {
+		expiresAt = export.ExpiresAt.Time.Format(time.RFC3339)
+	}
+
+	return &UserDataExportResponse{
+		ID:        export.ID.String(),
+		Status:    export.Status,
+		ExpiresAt: expiresAt,
+		CreatedAt: export.CreatedAt.Time.Format(time.RFC3339),
+		Links: SelfLinkResponse{
+			Self: LinkResponse{
+				fmt.Sprintf(
+					"https://%s/api%s%s%s",
+					backendDomain,
+					paths.UsersPathV1,
+					paths.MePath,
+					paths.DataExportPath,
+				),
+			},
+		},
+	}
+}
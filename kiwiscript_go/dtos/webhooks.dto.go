@@ -0,0 +1,155 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package dtos
+
+import (
+	"fmt"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+// Bodies
+
+type CreateWebhookBody struct {
+	URL    string   `json:"url" validate:"required,url,max=250"`
+	Secret string   `json:"secret" validate:"required,min=16,max=100"`
+	Events []string `json:"events" validate:"required,min=1,unique,dive,oneof=certificate.issued series.published user.registered"`
+}
+
+type UpdateWebhookBody struct {
+	URL      string   `json:"url" validate:"required,url,max=250"`
+	Secret   string   `json:"secret" validate:"omitempty,min=16,max=100"`
+	Events   []string `json:"events" validate:"required,min=1,unique,dive,oneof=certificate.issued series.published user.registered"`
+	IsActive bool     `json:"isActive"`
+}
+
+// Path Params
+
+type WebhookPathParams struct {
+	WebhookID string `validate:"required,number,min=1"`
+}
+
+type WebhookDeliveryPathParams struct {
+	WebhookID  string `validate:"required,number,min=1"`
+	DeliveryID string `validate:"required,uuid"`
+}
+
+// Responses
+
+type WebhookLinks struct {
+	Self       LinkResponse `json:"self"`
+	Deliveries LinkResponse `json:"deliveries"`
+}
+
+func newWebhookLinks(backendDomain string, webhookID int32) WebhookLinks {
+	return WebhookLinks{
+		Self: LinkResponse{
+			fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.WebhooksV1, webhookID),
+		},
+		Deliveries: LinkResponse{
+			fmt.Sprintf("https://%s/api%s/%d%s", backendDomain, paths.WebhooksV1, webhookID, paths.DeliveriesPath),
+		},
+	}
+}
+
+type WebhookResponse struct {
+	ID        int32        `json:"id"`
+	URL       string       `json:"url"`
+	Events    []string     `json:"events"`
+	IsActive  bool         `json:"isActive"`
+	CreatedAt string       `json:"createdAt"`
+	UpdatedAt string       `json:"updatedAt"`
+	Links     WebhookLinks `json:"_links"`
+}
+
+func NewWebhookResponse(backendDomain string, model *db.WebhookModel) *WebhookResponse {
+	return &WebhookResponse{
+		ID:        model.ID,
+		URL:       model.URL,
+		Events:    model.Events,
+		IsActive:  model.IsActive,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+		Links:     newWebhookLinks(backendDomain, model.ID),
+	}
+}
+
+type WebhookDeliveryLinks struct {
+	Self      LinkResponse `json:"self"`
+	Webhook   LinkResponse `json:"webhook"`
+	Redeliver LinkResponse `json:"redeliver"`
+}
+
+func newWebhookDeliveryLinks(backendDomain string, webhookID int32, deliveryID string) WebhookDeliveryLinks {
+	return WebhookDeliveryLinks{
+		Self: LinkResponse{
+			fmt.Sprintf(
+				"https://%s/api%s/%d%s/%s",
+				backendDomain,
+				paths.WebhooksV1,
+				webhookID,
+				paths.DeliveriesPath,
+				deliveryID,
+			),
+		},
+		Webhook: LinkResponse{
+			fmt.Sprintf("https://%s/api%s/%d", backendDomain, paths.WebhooksV1, webhookID),
+		},
+		Redeliver: LinkResponse{
+			fmt.Sprintf(
+				"https://%s/api%s/%d%s/%s%s",
+				backendDomain,
+				paths.WebhooksV1,
+				webhookID,
+				paths.DeliveriesPath,
+				deliveryID,
+				paths.RedeliverPath,
+			),
+		},
+	}
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string               `json:"id"`
+	Event          string               `json:"event"`
+	Payload        string               `json:"payload"`
+	Status         string               `json:"status"`
+	Attempts       int16                `json:"attempts"`
+	ResponseStatus int16                `json:"responseStatus"`
+	ResponseBody   string               `json:"responseBody"`
+	NextAttemptAt  string               `json:"nextAttemptAt,omitempty"`
+	DeliveredAt    string               `json:"deliveredAt,omitempty"`
+	CreatedAt      string               `json:"createdAt"`
+	Links          WebhookDeliveryLinks `json:"_links"`
+}
+
+func NewWebhookDeliveryResponse(backendDomain string, model *db.WebhookDeliveryModel) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             model.ID,
+		Event:          model.Event,
+		Payload:        model.Payload,
+		Status:         model.Status,
+		Attempts:       model.Attempts,
+		ResponseStatus: model.ResponseStatus,
+		ResponseBody:   model.ResponseBody,
+		NextAttemptAt:  model.NextAttemptAt,
+		DeliveredAt:    model.DeliveredAt,
+		CreatedAt:      model.CreatedAt,
+		Links:          newWebhookDeliveryLinks(backendDomain, model.WebhookID, model.ID),
+	}
+}
@@ -32,6 +32,11 @@ const (
 	StatusUnauthorized string = "Unauthorized"
 	StatusForbidden    string = "Forbidden"
 	StatusValidation   string = "Validation"
+
+	StatusPreconditionFailed   string = "PreconditionFailed"
+	StatusPreconditionRequired string = "PreconditionRequired"
+	StatusPaymentRequired      string = "PaymentRequired"
+	StatusSignInRequired       string = "SignInRequired"
 )
 
 type RequestError struct {
@@ -76,6 +81,26 @@ func NewRequestError(err *ServiceError) RequestError {
 			Code:    StatusForbidden,
 			Message: StatusForbidden,
 		}
+	case CodePreconditionFailed:
+		return RequestError{
+			Code:    StatusPreconditionFailed,
+			Message: err.Message,
+		}
+	case CodePreconditionRequired:
+		return RequestError{
+			Code:    StatusPreconditionRequired,
+			Message: err.Message,
+		}
+	case CodePaymentRequired:
+		return RequestError{
+			Code:    StatusPaymentRequired,
+			Message: err.Message,
+		}
+	case CodeSignInRequired:
+		return RequestError{
+			Code:    StatusSignInRequired,
+			Message: err.Message,
+		}
 	default:
 		return RequestError{
 			Code:    StatusUnknown,
@@ -156,6 +181,7 @@ const (
 	StrFieldErrMessageSlug        string = "must be a valid slug"
 	StrFieldErrMessageNumber      string = "must be a number"
 	StrFieldErrMessageUUID        string = "must be a valid UUID"
+	StrFieldErrMessageCursor      string = "must be a valid cursor"
 
 	IntFieldErrMessageLte string = "must be less"
 	IntFieldErrMessageGte string = "must be greater"
@@ -267,10 +293,16 @@ func NewRequestErrorStatus(code string) int {
 		return 400
 	case CodeNotFound:
 		return 404
-	case CodeForbidden:
+	case CodeForbidden, CodeSignInRequired:
 		return 403
 	case CodeUnauthorized:
 		return 401
+	case CodePreconditionFailed:
+		return 412
+	case CodePreconditionRequired:
+		return 428
+	case CodePaymentRequired:
+		return 402
 	case CodeUnknown:
 		return 500
 	default:
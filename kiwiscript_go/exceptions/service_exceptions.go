@@ -33,6 +33,11 @@ const (
 	CodeServerError  string = "SERVER_ERROR"
 	CodeUnauthorized string = "UNAUTHORIZED"
 	CodeForbidden    string = "FORBIDDEN"
+
+	CodePreconditionFailed   string = "PRECONDITION_FAILED"
+	CodePreconditionRequired string = "PRECONDITION_REQUIRED"
+	CodePaymentRequired      string = "PAYMENT_REQUIRED"
+	CodeSignInRequired       string = "SIGN_IN_REQUIRED"
 )
 
 const (
@@ -41,6 +46,11 @@ const (
 	MessageUnknown      string = "Something went wrong"
 	MessageUnauthorized string = "Unauthorized"
 	MessageForbidden    string = "Forbidden"
+
+	MessagePreconditionFailed   string = "Resource has been modified"
+	MessagePreconditionRequired string = "If-Match header is required"
+	MessagePaymentRequired      string = "Enrollment required"
+	MessageSignInRequired       string = "Sign in to access the lesson"
 )
 
 type ServiceError struct {
@@ -79,6 +89,22 @@ func NewForbiddenError() *ServiceError {
 	return NewError(CodeForbidden, MessageForbidden)
 }
 
+func NewPreconditionFailedError() *ServiceError {
+	return NewError(CodePreconditionFailed, MessagePreconditionFailed)
+}
+
+func NewPreconditionRequiredError() *ServiceError {
+	return NewError(CodePreconditionRequired, MessagePreconditionRequired)
+}
+
+func NewPaymentRequiredError() *ServiceError {
+	return NewError(CodePaymentRequired, MessagePaymentRequired)
+}
+
+func NewSignInRequiredError() *ServiceError {
+	return NewError(CodeSignInRequired, MessageSignInRequired)
+}
+
 func (e *ServiceError) Error() string {
 	return e.Message
 }
@@ -20,6 +20,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"runtime"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -29,6 +30,8 @@ import (
 	"github.com/gofiber/storage/redis/v3"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kiwiscript/kiwiscript_go/app"
+	"github.com/kiwiscript/kiwiscript_go/providers/telemetry"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws"
 )
 
 func main() {
@@ -48,6 +51,22 @@ func main() {
 	log = app.GetLogger(cfg.Logger.Env, cfg.Logger.Debug)
 	log.Info("Finished updating logger")
 
+	// Build tracer provider
+	log.Info("Building tracer provider...", "exporter", cfg.Telemetry.TracesExporter)
+	tracerProvider, err := telemetry.NewTracerProvider(ctx, telemetry.TracerProviderOptions{
+		ServiceName: cfg.Telemetry.ServiceName,
+		Environment: cfg.Logger.Env,
+		Exporter:    cfg.Telemetry.TracesExporter,
+		Endpoint:    cfg.Telemetry.TracesEndpoint,
+		Insecure:    cfg.Telemetry.TracesInsecure,
+		SampleRatio: cfg.Telemetry.TracesSampleRatio,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to build tracer provider", "error", err)
+		panic(err)
+	}
+	log.Info("Finished building tracer provider")
+
 	// Build storages/models
 	log.Info("Building redis connection...")
 	storage := redis.New(redis.Config{
@@ -57,7 +76,13 @@ func main() {
 
 	// Build database connection
 	log.Info("Building database connection...")
-	dbConnPool, err := pgxpool.New(ctx, cfg.PostgresURL)
+	dbConfig, err := pgxpool.ParseConfig(cfg.PostgresURL)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to parse database URL", "error", err)
+		panic(err)
+	}
+	dbConfig.ConnConfig.Tracer = telemetry.NewQueryTracer()
+	dbConnPool, err := pgxpool.NewWithConfig(ctx, dbConfig)
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to connect to database", "error", err)
 		panic(err)
@@ -75,6 +100,7 @@ func main() {
 		log.ErrorContext(ctx, "Failed to load s3 config", "error", err)
 		panic(err)
 	}
+	otelaws.AppendMiddlewares(&s3Cfg.APIOptions)
 	s3Client := s3.NewFromConfig(s3Cfg, func(o *s3.Options) {
 		o.UsePathStyle = true
 
@@ -88,7 +114,9 @@ func main() {
 
 	// Build the fiberApp
 	log.Info("Building the fiberApp...")
+	lifecycle := app.NewLifecycle(log, &cfg.Shutdown)
 	fiberApp := app.CreateApp(
+		lifecycle,
 		log,
 		storage,
 		dbConnPool,
@@ -97,6 +125,10 @@ func main() {
 		&cfg.Tokens,
 		&cfg.Limiter,
 		&cfg.OAuthProviders,
+		&cfg.AccountDeletion,
+		&cfg.Engagement,
+		&cfg.Telemetry,
+		&cfg.Payments,
 		cfg.ObjectStorage.Bucket,
 		cfg.BackendDomain,
 		cfg.FrontendDomain,
@@ -105,8 +137,21 @@ func main() {
 	)
 	log.Info("Finished building the fiberApp")
 
+	// Resources are released once requests and workers have finished
+	lifecycle.OnShutdown("tracer provider", tracerProvider.Shutdown)
+	lifecycle.OnShutdown("database connection pool", func(context.Context) error {
+		dbConnPool.Close()
+		return nil
+	})
+	lifecycle.OnShutdown("redis connection", func(context.Context) error {
+		return storage.Close()
+	})
+
 	// Start the fiberApp
 	log.Info("Starting the fiberApp...")
-	err = fiberApp.Listen(":" + cfg.Port)
-	log.ErrorContext(ctx, "Failed to start the fiberApp", "error", err)
+	if err := lifecycle.Run(fiberApp, ":"+cfg.Port); err != nil {
+		log.ErrorContext(ctx, "Failed to gracefully run the fiberApp", "error", err)
+		os.Exit(1)
+	}
+	log.Info("Finished shutting down the fiberApp")
 }
@@ -0,0 +1,401 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	Version string = "3.1.0"
+
+	bearerAuthScheme string = "bearerAuth"
+	jsonContentType  string = "application/json"
+	formContentType  string = "multipart/form-data"
+)
+
+// Response documents one possible response of an operation, a nil Body means
+// the response has no content.
+type Response struct {
+	Status      int
+	Description string
+	ContentType string
+	Body        any
+}
+
+// Operation is the hand written part of an operation, everything else (path,
+// method, path parameters and security) is read from the registered routes.
+type Operation struct {
+	Summary     string
+	Description string
+	Tags        []string
+	Query       any
+	Body        any
+	Multipart   bool
+	Responses   []Response
+
+	// Authenticated marks handlers that check the access token themselves
+	// instead of relying on one of the auth middlewares
+	Authenticated bool
+
+	// IfMatch marks handlers that require the resource version in the
+	// If-Match header, they answer with a 428 when it is missing
+	IfMatch bool
+}
+
+type Spec struct {
+	Title       string
+	Version     string
+	Description string
+	ServerURL   string
+	PathPrefix  string
+
+	// Operations are keyed by the name of the route's final handler
+	Operations map[string]Operation
+
+	// AuthMiddlewares reject anonymous requests with a 401, RoleMiddlewares
+	// additionally reject authenticated users without the right role with a 403
+	AuthMiddlewares []string
+	RoleMiddlewares []string
+
+	ErrorBody           any
+	ValidationErrorBody any
+}
+
+type useRoute struct {
+	segments    []string
+	middlewares []string
+}
+
+// HandlerName returns the method or function name of a fiber handler.
+func HandlerName(handler fiber.Handler) string {
+	name := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+	if dot := strings.LastIndexByte(name, '.'); dot >= 0 {
+		name = name[dot+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func isPathParam(segment string) bool {
+	return strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*")
+}
+
+func hasPathPrefix(path, prefix []string) bool {
+	if len(prefix) == 1 && prefix[0] == "" {
+		return true
+	}
+	if len(prefix) > len(path) {
+		return false
+	}
+
+	for i, segment := range prefix {
+		if segment != path[i] && !(isPathParam(segment) && isPathParam(path[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// specPath converts a fiber path into an OpenAPI path template.
+func specPath(path, prefix string) string {
+	segments := splitPath(strings.TrimPrefix(path, prefix))
+	for i, segment := range segments {
+		if isPathParam(segment) {
+			segments[i] = "{" + strings.TrimRight(segment[1:], "?+*") + "}"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func defaultSummary(handler string) string {
+	var b strings.Builder
+	for i, r := range handler {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte(' ')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func contentOf(registry *schemaRegistry, contentType string, body any) map[string]*MediaType {
+	if contentType == "" {
+		contentType = jsonContentType
+	}
+	return map[string]*MediaType{contentType: {Schema: registry.schemaOf(reflect.TypeOf(body))}}
+}
+
+func multipartSchema(registry *schemaRegistry, body any) *Schema {
+	schema := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"file": {Type: "string", ContentMediaType: "application/octet-stream"},
+		},
+		Required: []string{"file"},
+	}
+
+	if body != nil {
+		t := reflect.TypeOf(body)
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		registry.addFields(schema, t)
+	}
+
+	return schema
+}
+
+func errorResponse(registry *schemaRegistry, status int, body any) *ResponseObject {
+	response := &ResponseObject{Description: http.StatusText(status)}
+	if body != nil {
+		response.Content = contentOf(registry, jsonContentType, body)
+	}
+	return response
+}
+
+func sameRoute(a, b fiber.Route) bool {
+	if a.Method != b.Method || a.Path != b.Path || len(a.Handlers) != len(b.Handlers) {
+		return false
+	}
+	for i := range a.Handlers {
+		if reflect.ValueOf(a.Handlers[i]).Pointer() != reflect.ValueOf(b.Handlers[i]).Pointer() {
+			return false
+		}
+	}
+	return true
+}
+
+// Build generates the OpenAPI document from the routes registered in fiber,
+// it also returns every mismatch between the routes and spec.Operations.
+func Build(app *fiber.App, spec Spec) (*Document, []string) {
+	registry := newSchemaRegistry()
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       spec.Title,
+			Version:     spec.Version,
+			Description: spec.Description,
+			License:     &License{Name: "GPL-3.0-or-later", Identifier: "GPL-3.0-or-later"},
+		},
+		Servers: []Server{{URL: spec.ServerURL}},
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			SecuritySchemes: map[string]*SecurityScheme{
+				bearerAuthScheme: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	var drift []string
+	documented := make(map[string]bool)
+	tags := make(map[string]bool)
+	usesByMethod := make(map[string][]useRoute)
+	prefix := splitPath(spec.PathPrefix)
+
+	// Both lists keep the stack order, so the routes missing from the filtered
+	// one are the middlewares registered with Use on every method stack
+	routes := app.GetRoutes(true)
+	next := 0
+	for _, route := range app.GetRoutes(false) {
+		isUse := next >= len(routes) || !sameRoute(route, routes[next])
+		if !isUse {
+			next++
+		}
+		if len(route.Handlers) == 0 || route.Method == fiber.MethodHead {
+			continue
+		}
+
+		segments := splitPath(route.Path)
+		handlers := make([]string, 0, len(route.Handlers))
+		for _, handler := range route.Handlers {
+			handlers = append(handlers, HandlerName(handler))
+		}
+
+		if isUse {
+			usesByMethod[route.Method] = append(usesByMethod[route.Method], useRoute{
+				segments:    segments,
+				middlewares: handlers,
+			})
+			continue
+		}
+		if !hasPathPrefix(segments, prefix) {
+			continue
+		}
+
+		path := specPath(route.Path, spec.PathPrefix)
+		handler := handlers[len(handlers)-1]
+		operation, ok := spec.Operations[handler]
+		if !ok {
+			drift = append(drift, fmt.Sprintf("%s %s: handler %s is not documented", route.Method, path, handler))
+			continue
+		}
+		documented[handler] = true
+
+		middlewares := handlers[:len(handlers)-1]
+		for _, use := range usesByMethod[route.Method] {
+			if hasPathPrefix(segments, use.segments) {
+				middlewares = append(middlewares, use.middlewares...)
+			}
+		}
+
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		slot := item.operation(route.Method)
+		if slot == nil {
+			drift = append(drift, fmt.Sprintf("%s %s: method is not supported", route.Method, path))
+			continue
+		}
+		if *slot != nil {
+			drift = append(drift, fmt.Sprintf("%s %s: route is registered twice", route.Method, path))
+			continue
+		}
+		*slot = buildOperation(registry, spec, handler, operation, route.Params, middlewares)
+
+		for _, tag := range operation.Tags {
+			tags[tag] = true
+		}
+	}
+
+	for handler := range spec.Operations {
+		if !documented[handler] {
+			drift = append(drift, fmt.Sprintf("operation %s is documented but not routed", handler))
+		}
+	}
+	sort.Strings(drift)
+
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, Tag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(i, j int) bool {
+		return doc.Tags[i].Name < doc.Tags[j].Name
+	})
+
+	doc.Components.Schemas = registry.components
+	return doc, drift
+}
+
+func buildOperation(
+	registry *schemaRegistry,
+	spec Spec,
+	handler string,
+	operation Operation,
+	pathParams []string,
+	middlewares []string,
+) *OperationObject {
+	summary := operation.Summary
+	if summary == "" {
+		summary = defaultSummary(handler)
+	}
+
+	op := &OperationObject{
+		OperationID: handler,
+		Summary:     summary,
+		Description: operation.Description,
+		Tags:        operation.Tags,
+		Responses:   make(map[string]*ResponseObject),
+	}
+
+	for _, param := range pathParams {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     param,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	if operation.Query != nil {
+		op.Parameters = append(op.Parameters, registry.queryParameters(reflect.TypeOf(operation.Query))...)
+	}
+	if operation.IfMatch {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     fiber.HeaderIfMatch,
+			In:       "header",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	if operation.Multipart {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				formContentType: {Schema: multipartSchema(registry, operation.Body)},
+			},
+		}
+	} else if operation.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  contentOf(registry, jsonContentType, operation.Body),
+		}
+	}
+
+	for _, response := range operation.Responses {
+		description := response.Description
+		if description == "" {
+			description = http.StatusText(response.Status)
+		}
+
+		responseObject := &ResponseObject{Description: description}
+		if response.Body != nil {
+			responseObject.Content = contentOf(registry, response.ContentType, response.Body)
+		}
+		op.Responses[strconv.Itoa(response.Status)] = responseObject
+	}
+
+	if len(op.Parameters) > 0 || op.RequestBody != nil {
+		op.Responses[strconv.Itoa(fiber.StatusBadRequest)] = errorResponse(registry, fiber.StatusBadRequest, spec.ValidationErrorBody)
+	}
+	if operation.Authenticated ||
+		slices.ContainsFunc(middlewares, func(m string) bool { return slices.Contains(spec.AuthMiddlewares, m) }) {
+		op.Security = []map[string][]string{{bearerAuthScheme: {}}}
+		op.Responses[strconv.Itoa(fiber.StatusUnauthorized)] = errorResponse(registry, fiber.StatusUnauthorized, spec.ErrorBody)
+	}
+	if slices.ContainsFunc(middlewares, func(m string) bool { return slices.Contains(spec.RoleMiddlewares, m) }) {
+		op.Responses[strconv.Itoa(fiber.StatusForbidden)] = errorResponse(registry, fiber.StatusForbidden, spec.ErrorBody)
+	}
+	if len(pathParams) > 0 {
+		op.Responses[strconv.Itoa(fiber.StatusNotFound)] = errorResponse(registry, fiber.StatusNotFound, spec.ErrorBody)
+	}
+	if operation.IfMatch {
+		op.Responses[strconv.Itoa(fiber.StatusPreconditionRequired)] = errorResponse(
+			registry,
+			fiber.StatusPreconditionRequired,
+			spec.ErrorBody,
+		)
+	}
+
+	return op
+}
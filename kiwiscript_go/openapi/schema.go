@@ -0,0 +1,320 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const componentsSchemasRef string = "#/components/schemas/"
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	uuidType       = reflect.TypeOf(uuid.UUID{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// Patterns mirror the custom validators registered in app/validators.go.
+var validatorPatterns = map[string]string{
+	"alphanum":    `^[a-zA-Z0-9]+$`,
+	"hexadecimal": `^(0[xX])?[0-9a-fA-F]+$`,
+	"number":      `^[0-9]+$`,
+	"extalphanum": `^[a-zA-Z0-9 #+]+$`,
+	"slug":        `^[a-z\d]+(?:(\.|-)[a-z\d]+)*$`,
+}
+
+var validatorFormats = map[string]string{
+	"email":    "email",
+	"url":      "uri",
+	"uuid":     "uuid",
+	"jwt":      "jwt",
+	"timezone": "timezone",
+	"markdown": "markdown",
+	"svg":      "svg",
+}
+
+type schemaRegistry struct {
+	components map[string]*Schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{components: make(map[string]*Schema)}
+}
+
+// componentName flattens generic instantiations, so PaginatedResponse[dtos.LanguageResponse]
+// becomes PaginatedResponseOfLanguageResponse.
+func componentName(t reflect.Type) string {
+	name := t.Name()
+	start := strings.IndexByte(name, '[')
+	if start < 0 {
+		return name
+	}
+
+	args := strings.Split(strings.TrimSuffix(name[start+1:], "]"), ",")
+	for i, arg := range args {
+		arg = strings.TrimLeft(arg, "*[]")
+		if dot := strings.LastIndexByte(arg, '.'); dot >= 0 {
+			arg = arg[dot+1:]
+		}
+		args[i] = arg
+	}
+
+	return name[:start] + "Of" + strings.Join(args, "And")
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: componentsSchemasRef + name}
+}
+
+func (r *schemaRegistry) schemaOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaOf(t.Elem())}
+	case reflect.Struct:
+		name := componentName(t)
+		if _, ok := r.components[name]; !ok {
+			// Register a placeholder first so recursive types resolve to a ref
+			r.components[name] = &Schema{}
+			*r.components[name] = *r.objectSchema(t)
+		}
+		return ref(name)
+	default:
+		return &Schema{}
+	}
+}
+
+func (r *schemaRegistry) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	r.addFields(schema, t)
+	return schema
+}
+
+func (r *schemaRegistry) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				r.addFields(schema, embedded)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name, omitEmpty, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		fieldSchema, required := r.fieldSchema(field)
+		if !required && !omitEmpty && field.Tag.Get("validate") == "" && field.Type.Kind() != reflect.Pointer {
+			required = true
+		}
+
+		schema.Properties[name] = fieldSchema
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, strings.Contains(options, "omitempty"), false
+}
+
+// fieldSchema builds the schema of a struct field and applies its validate
+// tag, it also reports whether the validator requires the field.
+func (r *schemaRegistry) fieldSchema(field reflect.StructField) (*Schema, bool) {
+	schema := r.schemaOf(field.Type)
+	rules := strings.Split(field.Tag.Get("validate"), ",")
+
+	required := false
+	target := schema
+	for _, rule := range rules {
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			required = target == schema
+		case "dive":
+			if target.Items == nil {
+				return schema, required
+			}
+			target = target.Items
+		default:
+			applyRule(target, key, value)
+		}
+	}
+
+	return schema, required
+}
+
+func applyRule(schema *Schema, key, value string) {
+	if schema.Ref != "" {
+		return
+	}
+
+	if format, ok := validatorFormats[key]; ok {
+		schema.Format = format
+		return
+	}
+	if pattern, ok := validatorPatterns[key]; ok {
+		schema.Pattern = pattern
+		return
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if key == "oneof" {
+		for _, option := range strings.Fields(value) {
+			if schema.Type == "integer" {
+				if n, err := strconv.ParseInt(option, 10, 64); err == nil {
+					schema.Enum = append(schema.Enum, n)
+					continue
+				}
+			}
+			schema.Enum = append(schema.Enum, option)
+		}
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	switch schema.Type {
+	case "string":
+		length := int64(number)
+		switch key {
+		case "min", "gte":
+			schema.MinLength = &length
+		case "max", "lte":
+			schema.MaxLength = &length
+		case "len":
+			schema.MinLength = &length
+			schema.MaxLength = &length
+		}
+	case "array":
+		length := int64(number)
+		switch key {
+		case "min", "gte":
+			schema.MinItems = &length
+		case "max", "lte":
+			schema.MaxItems = &length
+		case "len":
+			schema.MinItems = &length
+			schema.MaxItems = &length
+		}
+	case "integer", "number":
+		switch key {
+		case "min", "gte":
+			schema.Minimum = &number
+		case "max", "lte":
+			schema.Maximum = &number
+		case "gt":
+			schema.ExclusiveMinimum = &number
+		case "lt":
+			schema.ExclusiveMaximum = &number
+		case "len":
+			schema.Minimum = &number
+			schema.Maximum = &number
+		}
+	}
+}
+
+// queryParameters describes each field of a query params struct, the query
+// key is the lower camel case field name unless a query tag overrides it.
+func (r *schemaRegistry) queryParameters(t reflect.Type) []Parameter {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	params := make([]Parameter, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Tag.Get("query")
+		if name == "" {
+			runes := []rune(field.Name)
+			runes[0] = unicode.ToLower(runes[0])
+			name = string(runes)
+		}
+
+		schema, required := r.fieldSchema(field)
+		params = append(params, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: required,
+			Schema:   schema,
+		})
+	}
+
+	return params
+}
@@ -1,21 +1,73 @@
 package paths
 
 const (
-	HealthPath     = "/health"
-	AuthPath       = "/auth"
-	UsersPathV1    = "/v1/users"
-	MePath         = "/me"
-	LanguagePathV1 = "/v1/languages"
-	SeriesPath     = "/series"
-	SectionsPath   = "/sections"
-	LessonsPath    = "/lessons"
-	VideoPath      = "/video"
-	ArticlePath    = "/article"
-	FilesPath      = "/files"
-	ProgressPath   = "/progress"
-	CertificatesV1 = "/v1/certificates"
-	PicturePath    = "/picture"
-	ProfilePath    = "/profile"
+	HealthPath            = "/health"
+	LivePath              = "/live"
+	ReadyPath             = "/ready"
+	MetricsPath           = "/metrics"
+	OpenAPIPath           = "/openapi.json"
+	DocsPath              = "/docs"
+	AuthPath              = "/auth"
+	UsersPathV1           = "/v1/users"
+	MePath                = "/me"
+	LanguagePathV1        = "/v1/languages"
+	SeriesPath            = "/series"
+	SectionsPath          = "/sections"
+	LessonsPath           = "/lessons"
+	VideoPath             = "/video"
+	ArticlePath           = "/article"
+	FilesPath             = "/files"
+	ProgressPath          = "/progress"
+	CertificatesV1        = "/v1/certificates"
+	PicturePath           = "/picture"
+	ProfilePath           = "/profile"
+	ReviewsPath           = "/reviews"
+	ReplyPath             = "/reply"
+	HidePath              = "/hide"
+	WebhooksV1            = "/v1/webhooks"
+	DeliveriesPath        = "/deliveries"
+	RedeliverPath         = "/redeliver"
+	CommentsPath          = "/comments"
+	RepliesPath           = "/replies"
+	UpvotePath            = "/upvote"
+	AnswerPath            = "/answer"
+	LockPath              = "/lock"
+	NotesPath             = "/notes"
+	ExportPath            = "/export"
+	BookmarkPath          = "/bookmark"
+	BookmarksPath         = "/bookmarks"
+	StreakPath            = "/streak"
+	XpPath                = "/xp"
+	AchievementsPath      = "/achievements"
+	DataExportPath        = "/data-export"
+	SchedulePath          = "/schedule"
+	ScheduledV1           = "/v1/scheduled-releases"
+	EditorialPath         = "/editorial"
+	ReviewersPath         = "/reviewers"
+	ResolvePath           = "/resolve"
+	EditorialCommentsPath = "/editorial-comments"
+	EditorialReviewPath   = "/editorial-review"
+	VersionsPath          = "/versions"
+	VersionPath           = "/version"
+	OrganizationsV1       = "/v1/organizations"
+	InvitationsPath       = "/invitations"
+	AcceptPath            = "/accept"
+	MembersPath           = "/members"
+	CohortsPath           = "/cohorts"
+	LicensesPath          = "/licenses"
+	EnrollmentPath        = "/enrollment"
+	CheckoutPath          = "/checkout"
+	PricePath             = "/price"
+	PreviewPath           = "/preview"
+	MembersOnlyPath       = "/members-only"
+	AnalyticsPath         = "/analytics"
+	TimelinePath          = "/timeline"
+	FunnelPath            = "/funnel"
+	EventsPath            = "/events"
+	DownloadPath          = "/download"
+	PaymentsV1            = "/v1/payments"
+	WebhookPath           = "/webhook"
+	CouponsV1             = "/v1/coupons"
 	// DiscoverV1 TODO: add discovery endpoints
 	DiscoverV1 = "/v1/discover"
 )
@@ -36,7 +36,7 @@ func (c *Cache) AddBlacklist(ctx context.Context, opts AddBlacklistOptions) erro
 	key := blackListPrefix + ":" + opts.ID
 	val := []byte(opts.ID)
 	exp := time.Until(opts.Exp)
-	return c.storage.Set(key, val, exp)
+	return c.set(ctx, "AddBlacklist", key, val, exp)
 }
 
 type IsBlacklistedOptions struct {
@@ -48,7 +48,7 @@ func (c *Cache) IsBlacklisted(ctx context.Context, opts IsBlacklistedOptions) (b
 	log := c.buildLogger(opts.RequestID, "IsBlacklisted")
 	log.DebugContext(ctx, "Checking if refresh token is blacklisted")
 	key := blackListPrefix + ":" + opts.ID
-	valByte, err := c.storage.Get(key)
+	valByte, err := c.get(ctx, "IsBlacklisted", key)
 
 	if err != nil {
 		log.ErrorContext(ctx, "Error checking if refresh token is blacklisted", "error", err)
@@ -18,9 +18,14 @@
 package cc
 
 import (
+	"context"
+	"log/slog"
+	"time"
+
 	"github.com/gofiber/storage/redis/v3"
+	"github.com/kiwiscript/kiwiscript_go/providers/telemetry"
 	"github.com/kiwiscript/kiwiscript_go/utils"
-	"log/slog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Cache struct {
@@ -39,6 +44,10 @@ func (c *Cache) ResetCache() error {
 	return c.storage.Reset()
 }
 
+func (c *Cache) Ping(ctx context.Context) error {
+	return c.storage.Conn().Ping(ctx).Err()
+}
+
 func (c *Cache) buildLogger(requestID, function string) *slog.Logger {
 	return utils.BuildLogger(c.log, utils.LoggerOptions{
 		Layer:     utils.ProvidersLogLayer,
@@ -47,3 +56,42 @@ func (c *Cache) buildLogger(requestID, function string) *slog.Logger {
 		RequestID: requestID,
 	})
 }
+
+// get, set and delete wrap the storage calls with a redis span, lookups also
+// record whether they hit or missed the cache.
+func (c *Cache) get(ctx context.Context, operation, key string) ([]byte, error) {
+	_, span := telemetry.StartSpan(ctx, "redis.GET "+operation, trace.SpanKindClient)
+	val, err := c.storage.Get(key)
+	telemetry.EndSpan(span, err)
+
+	switch {
+	case err != nil:
+		telemetry.RecordCacheResult(operation, telemetry.CacheResultError)
+	case val == nil:
+		telemetry.RecordCacheResult(operation, telemetry.CacheResultMiss)
+	default:
+		telemetry.RecordCacheResult(operation, telemetry.CacheResultHit)
+	}
+	return val, err
+}
+
+func (c *Cache) set(ctx context.Context, operation, key string, val []byte, exp time.Duration) error {
+	_, span := telemetry.StartSpan(ctx, "redis.SET "+operation, trace.SpanKindClient)
+	err := c.storage.Set(key, val, exp)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+func (c *Cache) delete(ctx context.Context, operation, key string) error {
+	_, span := telemetry.StartSpan(ctx, "redis.DEL "+operation, trace.SpanKindClient)
+	err := c.storage.Delete(key)
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+func (c *Cache) incr(ctx context.Context, operation, key string) (int64, error) {
+	ctx, span := telemetry.StartSpan(ctx, "redis.INCR "+operation, trace.SpanKindClient)
+	val, err := c.storage.Conn().Incr(ctx, key).Result()
+	telemetry.EndSpan(span, err)
+	return val, err
+}
@@ -46,8 +46,8 @@ func (c *Cache) AddFileURL(ctx context.Context, opts AddFileURLOptions) error {
 	log.DebugContext(ctx, "Adding file URL...")
 	key := creteFileURLKey(opts.UserID, opts.FileID)
 	val := []byte(opts.URL)
-	exp := time.Hour*23 + time.Minute*55
-	return c.storage.Set(key, val, exp)
+	exp := time.Minute * 55
+	return c.set(ctx, "AddFileURL", key, val, exp)
 }
 
 type GetFileURLOptions struct {
@@ -63,7 +63,7 @@ func (c *Cache) GetFileURL(ctx context.Context, opts GetFileURLOptions) (string,
 	)
 	log.DebugContext(ctx, "Getting file URL...")
 	key := creteFileURLKey(opts.UserID, opts.FileID)
-	valByte, err := c.storage.Get(key)
+	valByte, err := c.get(ctx, "GetFileURL", key)
 
 	if err != nil {
 		log.ErrorContext(ctx, "Error getting file URL", "error", err)
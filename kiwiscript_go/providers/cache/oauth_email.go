@@ -34,7 +34,9 @@ type AddOAuthEmailOptions struct {
 func (c *Cache) AddOAuthEmail(ctx context.Context, opts AddOAuthEmailOptions) error {
 	log := c.buildLogger(opts.RequestID, "AddOAuthEmail").With("code", opts.Code)
 	log.DebugContext(ctx, "Adding OAuth email...")
-	return c.storage.Set(
+	return c.set(
+		ctx,
+		"AddOAuthEmail",
 		oauthEmailPrefix+":"+opts.Code,
 		[]byte(opts.Email),
 		time.Duration(opts.DurationSeconds)*time.Second,
@@ -50,7 +52,7 @@ func (c *Cache) GetOAuthEmail(ctx context.Context, opts GetOAuthEmailOptions) (s
 	log := c.buildLogger(opts.RequestID, "GetOAuthEmail").With("code", opts.Code)
 	log.DebugContext(ctx, "Getting OAuth email...")
 
-	valByte, err := c.storage.Get(oauthEmailPrefix + ":" + opts.Code)
+	valByte, err := c.get(ctx, "GetOAuthEmail", oauthEmailPrefix+":"+opts.Code)
 	if err != nil {
 		log.ErrorContext(ctx, "Error getting OAuth email", "error", err)
 		return "", err
@@ -39,7 +39,9 @@ func (c *Cache) AddOAuthState(ctx context.Context, opts AddOAuthStateOptions) er
 		"provider", opts.Provider,
 	)
 	log.DebugContext(ctx, "Adding OAuth state...")
-	return c.storage.Set(
+	return c.set(
+		ctx,
+		"AddOAuthState",
 		oauthStatePrefix+":"+opts.State,
 		[]byte(opts.Provider),
 		time.Duration(oauthStateSeconds)*time.Second,
@@ -58,7 +60,7 @@ func (c *Cache) VerifyOAuthState(ctx context.Context, opts VerifyOAuthStateOptio
 		"provider", opts.Provider,
 	)
 	log.DebugContext(ctx, "Verifying OAuth state...")
-	valByte, err := c.storage.Get(oauthStatePrefix + ":" + opts.State)
+	valByte, err := c.get(ctx, "VerifyOAuthState", oauthStatePrefix+":"+opts.State)
 
 	if err != nil {
 		log.ErrorContext(ctx, "Error verifying OAuth state", "error", err)
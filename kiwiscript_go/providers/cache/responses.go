@@ -0,0 +1,121 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package cc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	catalogVersionPrefix string = "catalog_version"
+	responsePrefix       string = "response"
+)
+
+func createCatalogVersionKey(scope string) string {
+	return fmt.Sprintf("%s:%s", catalogVersionPrefix, scope)
+}
+
+func createResponseKey(key string) string {
+	return fmt.Sprintf("%s:%s", responsePrefix, key)
+}
+
+type GetCatalogVersionOptions struct {
+	RequestID string
+	Scope     string
+}
+
+func (c *Cache) GetCatalogVersion(ctx context.Context, opts GetCatalogVersionOptions) (int64, error) {
+	log := c.buildLogger(opts.RequestID, "GetCatalogVersion").With(
+		"scope", opts.Scope,
+	)
+	log.DebugContext(ctx, "Getting catalog version...")
+	valByte, err := c.get(ctx, "GetCatalogVersion", createCatalogVersionKey(opts.Scope))
+
+	if err != nil {
+		log.ErrorContext(ctx, "Error getting catalog version", "error", err)
+		return 0, err
+	}
+	if valByte == nil {
+		log.DebugContext(ctx, "Catalog version not found")
+		return 0, nil
+	}
+
+	return strconv.ParseInt(string(valByte), 10, 64)
+}
+
+type BumpCatalogVersionsOptions struct {
+	RequestID string
+	Scopes    []string
+}
+
+// BumpCatalogVersions moves every scope to a new version, cached responses
+// and ETags of the previous version stop matching straight away.
+func (c *Cache) BumpCatalogVersions(ctx context.Context, opts BumpCatalogVersionsOptions) error {
+	log := c.buildLogger(opts.RequestID, "BumpCatalogVersions").With(
+		"scopes", opts.Scopes,
+	)
+	log.DebugContext(ctx, "Bumping catalog versions...")
+
+	for _, scope := range opts.Scopes {
+		if _, err := c.incr(ctx, "BumpCatalogVersions", createCatalogVersionKey(scope)); err != nil {
+			log.ErrorContext(ctx, "Error bumping catalog version", "scope", scope, "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+type GetResponseOptions struct {
+	RequestID string
+	Key       string
+}
+
+func (c *Cache) GetResponse(ctx context.Context, opts GetResponseOptions) ([]byte, error) {
+	log := c.buildLogger(opts.RequestID, "GetResponse").With(
+		"key", opts.Key,
+	)
+	log.DebugContext(ctx, "Getting response...")
+	valByte, err := c.get(ctx, "GetResponse", createResponseKey(opts.Key))
+
+	if err != nil {
+		log.ErrorContext(ctx, "Error getting response", "error", err)
+		return nil, err
+	}
+
+	return valByte, nil
+}
+
+type AddResponseOptions struct {
+	RequestID string
+	Key       string
+	Body      []byte
+	TTL       time.Duration
+}
+
+func (c *Cache) AddResponse(ctx context.Context, opts AddResponseOptions) error {
+	log := c.buildLogger(opts.RequestID, "AddResponse").With(
+		"key", opts.Key,
+		"ttl", opts.TTL,
+	)
+	log.DebugContext(ctx, "Adding response...")
+	return c.set(ctx, "AddResponse", createResponseKey(opts.Key), opts.Body, opts.TTL)
+}
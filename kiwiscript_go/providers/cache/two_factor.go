@@ -72,7 +72,7 @@ func (c *Cache) AddTwoFactorCode(ctx context.Context, opts AddTwoFactorCodeOptio
 	key := fmt.Sprintf("%s:%d", twoFactorPrefix, opts.UserID)
 	val := []byte(hashedCode)
 	exp := time.Duration(twoFactorSeconds) * time.Second
-	if err := c.storage.Set(key, val, exp); err != nil {
+	if err := c.set(ctx, "AddTwoFactorCode", key, val, exp); err != nil {
 		log.ErrorContext(ctx, "Error setting two factor code", "error", err)
 		return "", err
 	}
@@ -91,7 +91,7 @@ func (c *Cache) VerifyTwoFactorCode(ctx context.Context, opts VerifyTwoFactorCod
 	log.DebugContext(ctx, "Verifying two factor code...")
 	key := fmt.Sprintf("%s:%d", twoFactorPrefix, opts.UserID)
 
-	valByte, err := c.storage.Get(key)
+	valByte, err := c.get(ctx, "VerifyTwoFactorCode", key)
 	if err != nil {
 		log.ErrorContext(ctx, "Error verifying two factor code", "error", err)
 		return false, err
@@ -106,7 +106,7 @@ func (c *Cache) VerifyTwoFactorCode(ctx context.Context, opts VerifyTwoFactorCod
 		log.DebugContext(ctx, "Two factor code is invalid")
 		return false, nil
 	}
-	if err := c.storage.Delete(key); err != nil {
+	if err := c.delete(ctx, "VerifyTwoFactorCode", key); err != nil {
 		log.ErrorContext(ctx, "Error deleting two factor code", "error", err)
 		return true, err
 	}
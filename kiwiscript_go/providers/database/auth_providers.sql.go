@@ -86,3 +86,35 @@ func (q *Queries) FindAuthProviderByEmailAndProvider(ctx context.Context, arg Fi
 	)
 	return i, err
 }
+
+const findAuthProvidersByEmail = `-- name: FindAuthProvidersByEmail :many
+SELECT id, email, provider, created_at, updated_at FROM "auth_providers"
+WHERE "email" = $1
+ORDER BY "id" ASC
+`
+
+func (q *Queries) FindAuthProvidersByEmail(ctx context.Context, email string) ([]AuthProvider, error) {
+	rows, err := q.db.Query(ctx, findAuthProvidersByEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuthProvider{}
+	for rows.Next() {
+		var i AuthProvider
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Provider,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
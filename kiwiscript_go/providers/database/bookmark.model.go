@@ -0,0 +1,82 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+type SeriesBookmarkModel struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	SeriesTitle  string
+	CreatedAt    string
+}
+
+func (b *SeriesBookmark) ToSeriesBookmarkModel(seriesTitle string) *SeriesBookmarkModel {
+	return &SeriesBookmarkModel{
+		ID:           b.ID,
+		LanguageSlug: b.LanguageSlug,
+		SeriesSlug:   b.SeriesSlug,
+		SeriesTitle:  seriesTitle,
+		CreatedAt:    b.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (b *FindPaginatedSeriesBookmarksWithSeriesByUserIDRow) ToSeriesBookmarkModel() *SeriesBookmarkModel {
+	return &SeriesBookmarkModel{
+		ID:           b.ID,
+		LanguageSlug: b.LanguageSlug,
+		SeriesSlug:   b.SeriesSlug,
+		SeriesTitle:  b.SeriesTitle,
+		CreatedAt:    b.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type LessonBookmarkModel struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	LessonTitle  string
+	CreatedAt    string
+}
+
+func (b *LessonBookmark) ToLessonBookmarkModel(lessonTitle string) *LessonBookmarkModel {
+	return &LessonBookmarkModel{
+		ID:           b.ID,
+		LanguageSlug: b.LanguageSlug,
+		SeriesSlug:   b.SeriesSlug,
+		SectionID:    b.SectionID,
+		LessonID:     b.LessonID,
+		LessonTitle:  lessonTitle,
+		CreatedAt:    b.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (b *FindPaginatedLessonBookmarksWithLessonByUserIDRow) ToLessonBookmarkModel() *LessonBookmarkModel {
+	return &LessonBookmarkModel{
+		ID:           b.ID,
+		LanguageSlug: b.LanguageSlug,
+		SeriesSlug:   b.SeriesSlug,
+		SectionID:    b.SectionID,
+		LessonID:     b.LessonID,
+		LessonTitle:  b.LessonTitle,
+		CreatedAt:    b.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
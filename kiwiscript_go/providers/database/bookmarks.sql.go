@@ -0,0 +1,327 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: bookmarks.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countLessonBookmarksByUserID = `-- name: CountLessonBookmarksByUserID :one
+SELECT COUNT("id") FROM "lesson_bookmarks"
+WHERE "user_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountLessonBookmarksByUserID(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countLessonBookmarksByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSeriesBookmarksByUserID = `-- name: CountSeriesBookmarksByUserID :one
+SELECT COUNT("id") FROM "series_bookmarks"
+WHERE "user_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountSeriesBookmarksByUserID(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeriesBookmarksByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createLessonBookmark = `-- name: CreateLessonBookmark :one
+INSERT INTO "lesson_bookmarks" (
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "lesson_id",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING id, language_slug, series_slug, section_id, lesson_id, user_id, created_at
+`
+
+type CreateLessonBookmarkParams struct {
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	UserID       int32
+}
+
+func (q *Queries) CreateLessonBookmark(ctx context.Context, arg CreateLessonBookmarkParams) (LessonBookmark, error) {
+	row := q.db.QueryRow(ctx, createLessonBookmark,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.LessonID,
+		arg.UserID,
+	)
+	var i LessonBookmark
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createSeriesBookmark = `-- name: CreateSeriesBookmark :one
+
+INSERT INTO "series_bookmarks" (
+    "language_slug",
+    "series_slug",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3
+) RETURNING id, language_slug, series_slug, user_id, created_at
+`
+
+type CreateSeriesBookmarkParams struct {
+	LanguageSlug string
+	SeriesSlug   string
+	UserID       int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateSeriesBookmark(ctx context.Context, arg CreateSeriesBookmarkParams) (SeriesBookmark, error) {
+	row := q.db.QueryRow(ctx, createSeriesBookmark, arg.LanguageSlug, arg.SeriesSlug, arg.UserID)
+	var i SeriesBookmark
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteLessonBookmarkByID = `-- name: DeleteLessonBookmarkByID :exec
+DELETE FROM "lesson_bookmarks"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteLessonBookmarkByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteLessonBookmarkByID, id)
+	return err
+}
+
+const deleteSeriesBookmarkByID = `-- name: DeleteSeriesBookmarkByID :exec
+DELETE FROM "series_bookmarks"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteSeriesBookmarkByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteSeriesBookmarkByID, id)
+	return err
+}
+
+const findLessonBookmarkByUserIDAndLessonID = `-- name: FindLessonBookmarkByUserIDAndLessonID :one
+SELECT id, language_slug, series_slug, section_id, lesson_id, user_id, created_at FROM "lesson_bookmarks"
+WHERE "user_id" = $1 AND "lesson_id" = $2
+LIMIT 1
+`
+
+type FindLessonBookmarkByUserIDAndLessonIDParams struct {
+	UserID   int32
+	LessonID int32
+}
+
+func (q *Queries) FindLessonBookmarkByUserIDAndLessonID(ctx context.Context, arg FindLessonBookmarkByUserIDAndLessonIDParams) (LessonBookmark, error) {
+	row := q.db.QueryRow(ctx, findLessonBookmarkByUserIDAndLessonID, arg.UserID, arg.LessonID)
+	var i LessonBookmark
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findPaginatedLessonBookmarksWithLessonByUserID = `-- name: FindPaginatedLessonBookmarksWithLessonByUserID :many
+SELECT
+    lesson_bookmarks.id, lesson_bookmarks.language_slug, lesson_bookmarks.series_slug, lesson_bookmarks.section_id, lesson_bookmarks.lesson_id, lesson_bookmarks.user_id, lesson_bookmarks.created_at,
+    "lessons"."title" AS "lesson_title"
+FROM "lesson_bookmarks"
+INNER JOIN "lessons" ON "lesson_bookmarks"."lesson_id" = "lessons"."id"
+WHERE "lesson_bookmarks"."user_id" = $1
+ORDER BY "lesson_bookmarks"."id" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedLessonBookmarksWithLessonByUserIDParams struct {
+	UserID int32
+	Limit  int32
+	Offset int32
+}
+
+type FindPaginatedLessonBookmarksWithLessonByUserIDRow struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	UserID       int32
+	CreatedAt    pgtype.Timestamp
+	LessonTitle  string
+}
+
+func (q *Queries) FindPaginatedLessonBookmarksWithLessonByUserID(ctx context.Context, arg FindPaginatedLessonBookmarksWithLessonByUserIDParams) ([]FindPaginatedLessonBookmarksWithLessonByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedLessonBookmarksWithLessonByUserID, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedLessonBookmarksWithLessonByUserIDRow{}
+	for rows.Next() {
+		var i FindPaginatedLessonBookmarksWithLessonByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.LessonTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedSeriesBookmarksWithSeriesByUserID = `-- name: FindPaginatedSeriesBookmarksWithSeriesByUserID :many
+SELECT
+    series_bookmarks.id, series_bookmarks.language_slug, series_bookmarks.series_slug, series_bookmarks.user_id, series_bookmarks.created_at,
+    "series"."title" AS "series_title"
+FROM "series_bookmarks"
+INNER JOIN "series" ON "series_bookmarks"."series_slug" = "series"."slug"
+WHERE "series_bookmarks"."user_id" = $1
+ORDER BY "series_bookmarks"."id" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedSeriesBookmarksWithSeriesByUserIDParams struct {
+	UserID int32
+	Limit  int32
+	Offset int32
+}
+
+type FindPaginatedSeriesBookmarksWithSeriesByUserIDRow struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	UserID       int32
+	CreatedAt    pgtype.Timestamp
+	SeriesTitle  string
+}
+
+func (q *Queries) FindPaginatedSeriesBookmarksWithSeriesByUserID(ctx context.Context, arg FindPaginatedSeriesBookmarksWithSeriesByUserIDParams) ([]FindPaginatedSeriesBookmarksWithSeriesByUserIDRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedSeriesBookmarksWithSeriesByUserID, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedSeriesBookmarksWithSeriesByUserIDRow{}
+	for rows.Next() {
+		var i FindPaginatedSeriesBookmarksWithSeriesByUserIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.SeriesTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSeriesBookmarkByUserIDAndSeriesSlug = `-- name: FindSeriesBookmarkByUserIDAndSeriesSlug :one
+SELECT id, language_slug, series_slug, user_id, created_at FROM "series_bookmarks"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1
+`
+
+type FindSeriesBookmarkByUserIDAndSeriesSlugParams struct {
+	UserID     int32
+	SeriesSlug string
+}
+
+func (q *Queries) FindSeriesBookmarkByUserIDAndSeriesSlug(ctx context.Context, arg FindSeriesBookmarkByUserIDAndSeriesSlugParams) (SeriesBookmark, error) {
+	row := q.db.QueryRow(ctx, findSeriesBookmarkByUserIDAndSeriesSlug, arg.UserID, arg.SeriesSlug)
+	var i SeriesBookmark
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateLessonBookmarksSectionID = `-- name: UpdateLessonBookmarksSectionID :exec
+UPDATE "lesson_bookmarks" SET
+  "section_id" = $1
+WHERE "lesson_id" = ANY($2::int[])
+`
+
+type UpdateLessonBookmarksSectionIDParams struct {
+	SectionID int32
+	LessonIds []int32
+}
+
+func (q *Queries) UpdateLessonBookmarksSectionID(ctx context.Context, arg UpdateLessonBookmarksSectionIDParams) error {
+	_, err := q.db.Exec(ctx, updateLessonBookmarksSectionID, arg.SectionID, arg.LessonIds)
+	return err
+}
@@ -36,6 +36,7 @@ INSERT INTO "certificates" (
     "lessons",
     "watch_time_seconds",
     "read_time_seconds",
+    "series_version",
     "completed_at"
 ) VALUES (
     $1,
@@ -46,8 +47,9 @@ INSERT INTO "certificates" (
     $6,
     $7,
     $8,
+    $9,
     now()
-) RETURNING id, user_id, series_title, lessons, watch_time_seconds, read_time_seconds, language_slug, series_slug, completed_at, created_at, updated_at
+) RETURNING id, user_id, series_title, lessons, watch_time_seconds, read_time_seconds, language_slug, series_slug, completed_at, created_at, updated_at, series_version
 `
 
 type CreateCertificateParams struct {
@@ -59,6 +61,7 @@ type CreateCertificateParams struct {
 	Lessons          int16
 	WatchTimeSeconds int32
 	ReadTimeSeconds  int32
+	SeriesVersion    pgtype.Int2
 }
 
 func (q *Queries) CreateCertificate(ctx context.Context, arg CreateCertificateParams) (Certificate, error) {
@@ -71,6 +74,7 @@ func (q *Queries) CreateCertificate(ctx context.Context, arg CreateCertificatePa
 		arg.Lessons,
 		arg.WatchTimeSeconds,
 		arg.ReadTimeSeconds,
+		arg.SeriesVersion,
 	)
 	var i Certificate
 	err := row.Scan(
@@ -85,13 +89,53 @@ func (q *Queries) CreateCertificate(ctx context.Context, arg CreateCertificatePa
 		&i.CompletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesVersion,
 	)
 	return i, err
 }
 
+const findAllCertificatesByUserID = `-- name: FindAllCertificatesByUserID :many
+SELECT id, user_id, series_title, lessons, watch_time_seconds, read_time_seconds, language_slug, series_slug, completed_at, created_at, updated_at, series_version FROM "certificates"
+WHERE "user_id" = $1
+ORDER BY "created_at" ASC
+`
+
+func (q *Queries) FindAllCertificatesByUserID(ctx context.Context, userID int32) ([]Certificate, error) {
+	rows, err := q.db.Query(ctx, findAllCertificatesByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Certificate{}
+	for rows.Next() {
+		var i Certificate
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.SeriesTitle,
+			&i.Lessons,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SeriesVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findCertificateByIDWithUserAndLanguage = `-- name: FindCertificateByIDWithUserAndLanguage :one
 SELECT
-    certificates.id, certificates.user_id, certificates.series_title, certificates.lessons, certificates.watch_time_seconds, certificates.read_time_seconds, certificates.language_slug, certificates.series_slug, certificates.completed_at, certificates.created_at, certificates.updated_at,
+    certificates.id, certificates.user_id, certificates.series_title, certificates.lessons, certificates.watch_time_seconds, certificates.read_time_seconds, certificates.language_slug, certificates.series_slug, certificates.completed_at, certificates.created_at, certificates.updated_at, certificates.series_version,
     "languages"."id" AS "language_id",
     "languages"."name" AS "language_name",
     "users"."first_name" AS "author_first_name",
@@ -115,6 +159,7 @@ type FindCertificateByIDWithUserAndLanguageRow struct {
 	CompletedAt      pgtype.Timestamp
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	SeriesVersion    pgtype.Int2
 	LanguageID       int32
 	LanguageName     string
 	AuthorFirstName  string
@@ -136,6 +181,7 @@ func (q *Queries) FindCertificateByIDWithUserAndLanguage(ctx context.Context, id
 		&i.CompletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesVersion,
 		&i.LanguageID,
 		&i.LanguageName,
 		&i.AuthorFirstName,
@@ -146,7 +192,7 @@ func (q *Queries) FindCertificateByIDWithUserAndLanguage(ctx context.Context, id
 
 const findCertificateByUserIDAndSeriesSlug = `-- name: FindCertificateByUserIDAndSeriesSlug :one
 
-SELECT id, user_id, series_title, lessons, watch_time_seconds, read_time_seconds, language_slug, series_slug, completed_at, created_at, updated_at FROM "certificates"
+SELECT id, user_id, series_title, lessons, watch_time_seconds, read_time_seconds, language_slug, series_slug, completed_at, created_at, updated_at, series_version FROM "certificates"
 WHERE "user_id" = $1 AND "series_slug" = $2
 LIMIT 1
 `
@@ -187,13 +233,14 @@ func (q *Queries) FindCertificateByUserIDAndSeriesSlug(ctx context.Context, arg
 		&i.CompletedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesVersion,
 	)
 	return i, err
 }
 
 const findPaginatedCertificatesByUserID = `-- name: FindPaginatedCertificatesByUserID :many
 SELECT
-    certificates.id, certificates.user_id, certificates.series_title, certificates.lessons, certificates.watch_time_seconds, certificates.read_time_seconds, certificates.language_slug, certificates.series_slug, certificates.completed_at, certificates.created_at, certificates.updated_at,
+    certificates.id, certificates.user_id, certificates.series_title, certificates.lessons, certificates.watch_time_seconds, certificates.read_time_seconds, certificates.language_slug, certificates.series_slug, certificates.completed_at, certificates.created_at, certificates.updated_at, certificates.series_version,
     "languages"."id" AS "language_id",
     "languages"."name" AS "language_name"
 FROM "certificates"
@@ -221,6 +268,7 @@ type FindPaginatedCertificatesByUserIDRow struct {
 	CompletedAt      pgtype.Timestamp
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	SeriesVersion    pgtype.Int2
 	LanguageID       int32
 	LanguageName     string
 }
@@ -246,6 +294,7 @@ func (q *Queries) FindPaginatedCertificatesByUserID(ctx context.Context, arg Fin
 			&i.CompletedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SeriesVersion,
 			&i.LanguageID,
 			&i.LanguageName,
 		); err != nil {
@@ -0,0 +1,253 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: coupons.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countCoupons = `-- name: CountCoupons :one
+SELECT COUNT("id") FROM "coupons"
+LIMIT 1
+`
+
+func (q *Queries) CountCoupons(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countCoupons)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createCoupon = `-- name: CreateCoupon :one
+
+
+INSERT INTO "coupons" (
+  "code",
+  "series_slug",
+  "percent_off",
+  "max_redemptions",
+  "expires_at",
+  "created_by_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+) RETURNING id, code, series_slug, percent_off, max_redemptions, redemptions_count, expires_at, is_active, created_by_id, created_at, updated_at
+`
+
+type CreateCouponParams struct {
+	Code           string
+	SeriesSlug     pgtype.Text
+	PercentOff     int16
+	MaxRedemptions int32
+	ExpiresAt      pgtype.Timestamp
+	CreatedByID    int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateCoupon(ctx context.Context, arg CreateCouponParams) (Coupon, error) {
+	row := q.db.QueryRow(ctx, createCoupon,
+		arg.Code,
+		arg.SeriesSlug,
+		arg.PercentOff,
+		arg.MaxRedemptions,
+		arg.ExpiresAt,
+		arg.CreatedByID,
+	)
+	var i Coupon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.SeriesSlug,
+		&i.PercentOff,
+		&i.MaxRedemptions,
+		&i.RedemptionsCount,
+		&i.ExpiresAt,
+		&i.IsActive,
+		&i.CreatedByID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findCouponByCode = `-- name: FindCouponByCode :one
+SELECT id, code, series_slug, percent_off, max_redemptions, redemptions_count, expires_at, is_active, created_by_id, created_at, updated_at FROM "coupons"
+WHERE "code" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindCouponByCode(ctx context.Context, code string) (Coupon, error) {
+	row := q.db.QueryRow(ctx, findCouponByCode, code)
+	var i Coupon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.SeriesSlug,
+		&i.PercentOff,
+		&i.MaxRedemptions,
+		&i.RedemptionsCount,
+		&i.ExpiresAt,
+		&i.IsActive,
+		&i.CreatedByID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findCouponByID = `-- name: FindCouponByID :one
+SELECT id, code, series_slug, percent_off, max_redemptions, redemptions_count, expires_at, is_active, created_by_id, created_at, updated_at FROM "coupons"
+WHERE "id" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindCouponByID(ctx context.Context, id int32) (Coupon, error) {
+	row := q.db.QueryRow(ctx, findCouponByID, id)
+	var i Coupon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.SeriesSlug,
+		&i.PercentOff,
+		&i.MaxRedemptions,
+		&i.RedemptionsCount,
+		&i.ExpiresAt,
+		&i.IsActive,
+		&i.CreatedByID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findPaginatedCoupons = `-- name: FindPaginatedCoupons :many
+SELECT id, code, series_slug, percent_off, max_redemptions, redemptions_count, expires_at, is_active, created_by_id, created_at, updated_at FROM "coupons"
+ORDER BY "id" DESC
+LIMIT $1 OFFSET $2
+`
+
+type FindPaginatedCouponsParams struct {
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) FindPaginatedCoupons(ctx context.Context, arg FindPaginatedCouponsParams) ([]Coupon, error) {
+	rows, err := q.db.Query(ctx, findPaginatedCoupons, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Coupon{}
+	for rows.Next() {
+		var i Coupon
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.SeriesSlug,
+			&i.PercentOff,
+			&i.MaxRedemptions,
+			&i.RedemptionsCount,
+			&i.ExpiresAt,
+			&i.IsActive,
+			&i.CreatedByID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementCouponRedemptionsCount = `-- name: IncrementCouponRedemptionsCount :execrows
+UPDATE "coupons" SET
+  "redemptions_count" = "redemptions_count" + 1,
+  "updated_at" = now()
+WHERE
+  "id" = $1 AND
+  ("max_redemptions" = 0 OR "redemptions_count" < "max_redemptions")
+`
+
+func (q *Queries) IncrementCouponRedemptionsCount(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, incrementCouponRedemptionsCount, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reassignCouponsCreator = `-- name: ReassignCouponsCreator :exec
+UPDATE "coupons" SET
+  "created_by_id" = $1
+WHERE "created_by_id" = $2
+`
+
+type ReassignCouponsCreatorParams struct {
+	NewCreatorID int32
+	OldCreatorID int32
+}
+
+func (q *Queries) ReassignCouponsCreator(ctx context.Context, arg ReassignCouponsCreatorParams) error {
+	_, err := q.db.Exec(ctx, reassignCouponsCreator, arg.NewCreatorID, arg.OldCreatorID)
+	return err
+}
+
+const updateCouponIsActive = `-- name: UpdateCouponIsActive :one
+UPDATE "coupons" SET
+  "is_active" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, code, series_slug, percent_off, max_redemptions, redemptions_count, expires_at, is_active, created_by_id, created_at, updated_at
+`
+
+type UpdateCouponIsActiveParams struct {
+	IsActive bool
+	ID       int32
+}
+
+func (q *Queries) UpdateCouponIsActive(ctx context.Context, arg UpdateCouponIsActiveParams) (Coupon, error) {
+	row := q.db.QueryRow(ctx, updateCouponIsActive, arg.IsActive, arg.ID)
+	var i Coupon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.SeriesSlug,
+		&i.PercentOff,
+		&i.MaxRedemptions,
+		&i.RedemptionsCount,
+		&i.ExpiresAt,
+		&i.IsActive,
+		&i.CreatedByID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
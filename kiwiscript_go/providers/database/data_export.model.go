@@ -0,0 +1,215 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// The data export structs below define the JSON documents handed to users
+// when they export their account data, they must never include secrets such
+// as the password hash.
+
+func formatDataExportTimestamp(ts pgtype.Timestamp) string {
+	if !ts.Valid {
+		return ""
+	}
+	return ts.Time.Format(time.RFC3339)
+}
+
+type UserDataExportUser struct {
+	ID          int32  `json:"id"`
+	FirstName   string `json:"firstName"`
+	LastName    string `json:"lastName"`
+	Location    string `json:"location"`
+	Email       string `json:"email"`
+	IsAdmin     bool   `json:"isAdmin"`
+	IsStaff     bool   `json:"isStaff"`
+	IsConfirmed bool   `json:"isConfirmed"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+func (u *User) ToUserDataExport() UserDataExportUser {
+	return UserDataExportUser{
+		ID:          u.ID,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		Location:    u.Location,
+		Email:       u.Email,
+		IsAdmin:     u.IsAdmin,
+		IsStaff:     u.IsStaff,
+		IsConfirmed: u.IsConfirmed,
+		CreatedAt:   formatDataExportTimestamp(u.CreatedAt),
+		UpdatedAt:   formatDataExportTimestamp(u.UpdatedAt),
+	}
+}
+
+type UserDataExportProfile struct {
+	Bio       string `json:"bio"`
+	Github    string `json:"github"`
+	Linkedin  string `json:"linkedin"`
+	Website   string `json:"website"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+func (p *UserProfile) ToUserDataExport() UserDataExportProfile {
+	return UserDataExportProfile{
+		Bio:       p.Bio,
+		Github:    p.Github,
+		Linkedin:  p.Linkedin,
+		Website:   p.Website,
+		CreatedAt: formatDataExportTimestamp(p.CreatedAt),
+		UpdatedAt: formatDataExportTimestamp(p.UpdatedAt),
+	}
+}
+
+type UserDataExportPicture struct {
+	ID        string `json:"id"`
+	Ext       string `json:"ext"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func (p *UserPicture) ToUserDataExport() UserDataExportPicture {
+	return UserDataExportPicture{
+		ID:        p.ID.String(),
+		Ext:       p.Ext,
+		CreatedAt: formatDataExportTimestamp(p.CreatedAt),
+	}
+}
+
+type UserDataExportAuthProvider struct {
+	Provider  string `json:"provider"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func (p *AuthProvider) ToUserDataExport() UserDataExportAuthProvider {
+	return UserDataExportAuthProvider{
+		Provider:  p.Provider,
+		CreatedAt: formatDataExportTimestamp(p.CreatedAt),
+	}
+}
+
+type UserDataExportLanguageProgress struct {
+	LanguageSlug    string `json:"languageSlug"`
+	CompletedSeries int16  `json:"completedSeries"`
+	ViewedAt        string `json:"viewedAt"`
+	CreatedAt       string `json:"createdAt"`
+}
+
+func (p *LanguageProgress) ToUserDataExport() UserDataExportLanguageProgress {
+	return UserDataExportLanguageProgress{
+		LanguageSlug:    p.LanguageSlug,
+		CompletedSeries: p.CompletedSeries,
+		ViewedAt:        formatDataExportTimestamp(p.ViewedAt),
+		CreatedAt:       formatDataExportTimestamp(p.CreatedAt),
+	}
+}
+
+type UserDataExportSeriesProgress struct {
+	LanguageSlug      string `json:"languageSlug"`
+	SeriesSlug        string `json:"seriesSlug"`
+	CompletedSections int16  `json:"completedSections"`
+	CompletedLessons  int16  `json:"completedLessons"`
+	CompletedAt       string `json:"completedAt,omitempty"`
+	ViewedAt          string `json:"viewedAt"`
+	CreatedAt         string `json:"createdAt"`
+}
+
+func (p *SeriesProgress) ToUserDataExport() UserDataExportSeriesProgress {
+	return UserDataExportSeriesProgress{
+		LanguageSlug:      p.LanguageSlug,
+		SeriesSlug:        p.SeriesSlug,
+		CompletedSections: p.CompletedSections,
+		CompletedLessons:  p.CompletedLessons,
+		CompletedAt:       formatDataExportTimestamp(p.CompletedAt),
+		ViewedAt:          formatDataExportTimestamp(p.ViewedAt),
+		CreatedAt:         formatDataExportTimestamp(p.CreatedAt),
+	}
+}
+
+type UserDataExportSectionProgress struct {
+	LanguageSlug     string `json:"languageSlug"`
+	SeriesSlug       string `json:"seriesSlug"`
+	SectionID        int32  `json:"sectionId"`
+	CompletedLessons int16  `json:"completedLessons"`
+	CompletedAt      string `json:"completedAt,omitempty"`
+	ViewedAt         string `json:"viewedAt"`
+	CreatedAt        string `json:"createdAt"`
+}
+
+func (p *SectionProgress) ToUserDataExport() UserDataExportSectionProgress {
+	return UserDataExportSectionProgress{
+		LanguageSlug:     p.LanguageSlug,
+		SeriesSlug:       p.SeriesSlug,
+		SectionID:        p.SectionID,
+		CompletedLessons: p.CompletedLessons,
+		CompletedAt:      formatDataExportTimestamp(p.CompletedAt),
+		ViewedAt:         formatDataExportTimestamp(p.ViewedAt),
+		CreatedAt:        formatDataExportTimestamp(p.CreatedAt),
+	}
+}
+
+type UserDataExportLessonProgress struct {
+	LanguageSlug string `json:"languageSlug"`
+	SeriesSlug   string `json:"seriesSlug"`
+	SectionID    int32  `json:"sectionId"`
+	LessonID     int32  `json:"lessonId"`
+	CompletedAt  string `json:"completedAt,omitempty"`
+	ViewedAt     string `json:"viewedAt"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+func (p *LessonProgress) ToUserDataExport() UserDataExportLessonProgress {
+	return UserDataExportLessonProgress{
+		LanguageSlug: p.LanguageSlug,
+		SeriesSlug:   p.SeriesSlug,
+		SectionID:    p.SectionID,
+		LessonID:     p.LessonID,
+		CompletedAt:  formatDataExportTimestamp(p.CompletedAt),
+		ViewedAt:     formatDataExportTimestamp(p.ViewedAt),
+		CreatedAt:    formatDataExportTimestamp(p.CreatedAt),
+	}
+}
+
+type UserDataExportCertificate struct {
+	ID               string `json:"id"`
+	LanguageSlug     string `json:"languageSlug"`
+	SeriesSlug       string `json:"seriesSlug"`
+	SeriesTitle      string `json:"seriesTitle"`
+	Lessons          int16  `json:"lessons"`
+	WatchTimeSeconds int32  `json:"watchTimeSeconds"`
+	ReadTimeSeconds  int32  `json:"readTimeSeconds"`
+	CompletedAt      string `json:"completedAt"`
+}
+
+func (c *Certificate) ToUserDataExport() UserDataExportCertificate {
+	return UserDataExportCertificate{
+		ID:               c.ID.String(),
+		LanguageSlug:     c.LanguageSlug,
+		SeriesSlug:       c.SeriesSlug,
+		SeriesTitle:      c.SeriesTitle,
+		Lessons:          c.Lessons,
+		WatchTimeSeconds: c.WatchTimeSeconds,
+		ReadTimeSeconds:  c.ReadTimeSeconds,
+		CompletedAt:      formatDataExportTimestamp(c.CompletedAt),
+	}
+}
@@ -68,6 +68,35 @@ func (database *Database) FinalizeTx(ctx context.Context, txn pgx.Tx, err error,
 	}
 }
 
+// WithAdvisoryLock runs fn only when the session level advisory lock identified
+// by key could be taken, the lock lives on a dedicated connection so it is
+// held for as long as fn runs and other instances skip the work meanwhile.
+func (database *Database) WithAdvisoryLock(ctx context.Context, key int64, fn func(ctx context.Context)) (bool, error) {
+	conn, err := database.connPool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Release()
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		return false, err
+	}
+	if !acquired {
+		return false, nil
+	}
+	defer func() {
+		// The context might already be cancelled, if the unlock still fails the
+		// connection is closed as that releases every lock of its session.
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			_ = conn.Conn().Close(context.Background())
+		}
+	}()
+
+	fn(ctx)
+	return true, nil
+}
+
 func (database *Database) RawQuery(ctx context.Context, sql string, args []interface{}) (pgx.Rows, error) {
 	return database.connPool.Query(ctx, sql, args...)
 }
@@ -75,3 +104,7 @@ func (database *Database) RawQuery(ctx context.Context, sql string, args []inter
 func (database *Database) RawQueryRow(ctx context.Context, sql string, args []interface{}) pgx.Row {
 	return database.connPool.QueryRow(ctx, sql, args...)
 }
+
+func (database *Database) Ping(ctx context.Context) error {
+	return database.connPool.Ping(ctx)
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+type EditorialUser struct {
+	ID        int32
+	FirstName string
+	LastName  string
+}
+
+type SeriesEditorialModel struct {
+	LanguageSlug   string
+	SeriesSlug     string
+	Status         string
+	RequiresReview bool
+	IsPublished    bool
+	Reviewers      []SeriesEditorialReviewerModel
+	ChangeRequests []SeriesChangeRequestModel
+}
+
+type SeriesEditorialReviewerModel struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	User         EditorialUser
+	CreatedAt    string
+}
+
+func (r *SeriesEditorialReviewer) ToSeriesEditorialReviewerModel(user *User) *SeriesEditorialReviewerModel {
+	return &SeriesEditorialReviewerModel{
+		ID:           r.ID,
+		LanguageSlug: r.LanguageSlug,
+		SeriesSlug:   r.SeriesSlug,
+		User: EditorialUser{
+			ID:        user.ID,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		},
+		CreatedAt: r.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (r *FindSeriesEditorialReviewersWithUserBySeriesSlugRow) ToSeriesEditorialReviewerModel() *SeriesEditorialReviewerModel {
+	return &SeriesEditorialReviewerModel{
+		ID:           r.ID,
+		LanguageSlug: r.LanguageSlug,
+		SeriesSlug:   r.SeriesSlug,
+		User: EditorialUser{
+			ID:        r.UserID,
+			FirstName: r.UserFirstName,
+			LastName:  r.UserLastName,
+		},
+		CreatedAt: r.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type SeriesChangeRequestModel struct {
+	ID           int32
+	Comment      string
+	LanguageSlug string
+	SeriesSlug   string
+	Reviewer     EditorialUser
+	ResolvedAt   string
+	CreatedAt    string
+}
+
+func (r *FindSeriesChangeRequestsWithReviewerBySeriesSlugRow) ToSeriesChangeRequestModel() *SeriesChangeRequestModel {
+	var resolvedAt string
+	if r.ResolvedAt.Valid {
+		resolvedAt = r.ResolvedAt.Time.Format(time.RFC3339)
+	}
+
+	return &SeriesChangeRequestModel{
+		ID:           r.ID,
+		Comment:      r.Comment,
+		LanguageSlug: r.LanguageSlug,
+		SeriesSlug:   r.SeriesSlug,
+		Reviewer: EditorialUser{
+			ID:        r.ReviewerID,
+			FirstName: r.ReviewerFirstName,
+			LastName:  r.ReviewerLastName,
+		},
+		ResolvedAt: resolvedAt,
+		CreatedAt:  r.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type LessonEditorialCommentModel struct {
+	ID           int32
+	Body         string
+	IsResolved   bool
+	LanguageSlug string
+	SeriesSlug   string
+	LessonID     int32
+	Author       EditorialUser
+	CreatedAt    string
+	UpdatedAt    string
+}
+
+func (c *LessonEditorialComment) ToLessonEditorialCommentModel(author *User) *LessonEditorialCommentModel {
+	return &LessonEditorialCommentModel{
+		ID:           c.ID,
+		Body:         c.Body,
+		IsResolved:   c.IsResolved,
+		LanguageSlug: c.LanguageSlug,
+		SeriesSlug:   c.SeriesSlug,
+		LessonID:     c.LessonID,
+		Author: EditorialUser{
+			ID:        author.ID,
+			FirstName: author.FirstName,
+			LastName:  author.LastName,
+		},
+		CreatedAt: c.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: c.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (r *FindLessonEditorialCommentsWithAuthorByLessonIDRow) ToLessonEditorialCommentModel() *LessonEditorialCommentModel {
+	return &LessonEditorialCommentModel{
+		ID:           r.ID,
+		Body:         r.Body,
+		IsResolved:   r.IsResolved,
+		LanguageSlug: r.LanguageSlug,
+		SeriesSlug:   r.SeriesSlug,
+		LessonID:     r.LessonID,
+		Author: EditorialUser{
+			ID:        r.AuthorID,
+			FirstName: r.AuthorFirstName,
+			LastName:  r.AuthorLastName,
+		},
+		CreatedAt: r.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: r.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
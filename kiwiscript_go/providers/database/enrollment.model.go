@@ -0,0 +1,103 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+const (
+	EnrollmentSourceFree         string = "free"
+	EnrollmentSourcePaid         string = "paid"
+	EnrollmentSourceOrganization string = "organization"
+)
+
+const (
+	PaymentStatusPending   string = "pending"
+	PaymentStatusCompleted string = "completed"
+	PaymentStatusExpired   string = "expired"
+)
+
+type EnrollmentModel struct {
+	ID             int32
+	UserID         int32
+	LanguageSlug   string
+	SeriesSlug     string
+	Source         string
+	OrganizationID int32
+	CreatedAt      string
+}
+
+func (e *Enrollment) ToEnrollmentModel() *EnrollmentModel {
+	return &EnrollmentModel{
+		ID:             e.ID,
+		UserID:         e.UserID,
+		LanguageSlug:   e.LanguageSlug,
+		SeriesSlug:     e.SeriesSlug,
+		Source:         e.Source,
+		OrganizationID: e.OrganizationID.Int32,
+		CreatedAt:      e.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type CouponModel struct {
+	ID               int32
+	Code             string
+	SeriesSlug       string
+	PercentOff       int16
+	MaxRedemptions   int32
+	RedemptionsCount int32
+	ExpiresAt        string
+	IsActive         bool
+	CreatedAt        string
+	UpdatedAt        string
+}
+
+func (c *Coupon) ToCouponModel() *CouponModel {
+	var expiresAt string
+	if c.ExpiresAt.Valid {
+		expiresAt = c.ExpiresAt.Time.Format(time.RFC3339)
+	}
+
+	return &CouponModel{
+		ID:               c.ID,
+		Code:             c.Code,
+		SeriesSlug:       c.SeriesSlug.String,
+		PercentOff:       c.PercentOff,
+		MaxRedemptions:   c.MaxRedemptions,
+		RedemptionsCount: c.RedemptionsCount,
+		ExpiresAt:        expiresAt,
+		IsActive:         c.IsActive,
+		CreatedAt:        c.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:        c.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// IsRedeemable reports whether the coupon can still be applied to the series
+// at the given time.
+func (c *Coupon) IsRedeemable(seriesSlug string, now time.Time) bool {
+	if !c.IsActive {
+		return false
+	}
+	if c.SeriesSlug.Valid && c.SeriesSlug.String != seriesSlug {
+		return false
+	}
+	if c.ExpiresAt.Valid && !c.ExpiresAt.Time.After(now) {
+		return false
+	}
+
+	return c.MaxRedemptions == 0 || c.RedemptionsCount < c.MaxRedemptions
+}
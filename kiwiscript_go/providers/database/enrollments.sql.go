@@ -0,0 +1,210 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: enrollments.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createEnrollment = `-- name: CreateEnrollment :one
+
+
+INSERT INTO "enrollments" (
+  "user_id",
+  "language_slug",
+  "series_slug",
+  "source",
+  "organization_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+) RETURNING id, user_id, language_slug, series_slug, source, payment_id, organization_id, created_at
+`
+
+type CreateEnrollmentParams struct {
+	UserID         int32
+	LanguageSlug   string
+	SeriesSlug     string
+	Source         string
+	OrganizationID pgtype.Int4
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateEnrollment(ctx context.Context, arg CreateEnrollmentParams) (Enrollment, error) {
+	row := q.db.QueryRow(ctx, createEnrollment,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.Source,
+		arg.OrganizationID,
+	)
+	var i Enrollment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Source,
+		&i.PaymentID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findEnrollmentByUserIDAndSeriesSlug = `-- name: FindEnrollmentByUserIDAndSeriesSlug :one
+SELECT id, user_id, language_slug, series_slug, source, payment_id, organization_id, created_at FROM "enrollments"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1
+`
+
+type FindEnrollmentByUserIDAndSeriesSlugParams struct {
+	UserID     int32
+	SeriesSlug string
+}
+
+func (q *Queries) FindEnrollmentByUserIDAndSeriesSlug(ctx context.Context, arg FindEnrollmentByUserIDAndSeriesSlugParams) (Enrollment, error) {
+	row := q.db.QueryRow(ctx, findEnrollmentByUserIDAndSeriesSlug, arg.UserID, arg.SeriesSlug)
+	var i Enrollment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Source,
+		&i.PaymentID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationLicenseByUserIDAndSeriesSlug = `-- name: FindOrganizationLicenseByUserIDAndSeriesSlug :one
+SELECT "cohorts"."organization_id" FROM "cohort_series"
+INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_series"."cohort_id"
+INNER JOIN "cohort_members" ON "cohort_members"."cohort_id" = "cohort_series"."cohort_id"
+INNER JOIN "organization_licenses" ON (
+  "organization_licenses"."organization_id" = "cohorts"."organization_id" AND
+  "organization_licenses"."series_slug" = "cohort_series"."series_slug"
+)
+WHERE "cohort_members"."user_id" = $1 AND "cohort_series"."series_slug" = $2
+ORDER BY "cohort_series"."id" ASC
+LIMIT 1
+`
+
+type FindOrganizationLicenseByUserIDAndSeriesSlugParams struct {
+	UserID     int32
+	SeriesSlug string
+}
+
+func (q *Queries) FindOrganizationLicenseByUserIDAndSeriesSlug(ctx context.Context, arg FindOrganizationLicenseByUserIDAndSeriesSlugParams) (int32, error) {
+	row := q.db.QueryRow(ctx, findOrganizationLicenseByUserIDAndSeriesSlug, arg.UserID, arg.SeriesSlug)
+	var organization_id int32
+	err := row.Scan(&organization_id)
+	return organization_id, err
+}
+
+const hasSeriesAccess = `-- name: HasSeriesAccess :one
+SELECT (
+  EXISTS (
+    SELECT 1 FROM "enrollments"
+    WHERE
+      "enrollments"."user_id" = $1 AND
+      "enrollments"."series_slug" = $2 AND
+      "enrollments"."source" <> 'organization'
+  ) OR EXISTS (
+    SELECT 1 FROM "cohort_series"
+    INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_series"."cohort_id"
+    INNER JOIN "cohort_members" ON "cohort_members"."cohort_id" = "cohort_series"."cohort_id"
+    INNER JOIN "organization_licenses" ON (
+      "organization_licenses"."organization_id" = "cohorts"."organization_id" AND
+      "organization_licenses"."series_slug" = "cohort_series"."series_slug"
+    )
+    WHERE "cohort_members"."user_id" = $1 AND "cohort_series"."series_slug" = $2
+  )
+)::boolean AS "has_access"
+`
+
+type HasSeriesAccessParams struct {
+	UserID     int32
+	SeriesSlug string
+}
+
+func (q *Queries) HasSeriesAccess(ctx context.Context, arg HasSeriesAccessParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasSeriesAccess, arg.UserID, arg.SeriesSlug)
+	var has_access bool
+	err := row.Scan(&has_access)
+	return has_access, err
+}
+
+const upsertPaidEnrollment = `-- name: UpsertPaidEnrollment :one
+INSERT INTO "enrollments" (
+  "user_id",
+  "language_slug",
+  "series_slug",
+  "source",
+  "payment_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  'paid',
+  $4
+)
+ON CONFLICT ("user_id", "series_slug") DO UPDATE SET
+  "source" = 'paid',
+  "payment_id" = EXCLUDED."payment_id",
+  "organization_id" = NULL
+RETURNING id, user_id, language_slug, series_slug, source, payment_id, organization_id, created_at
+`
+
+type UpsertPaidEnrollmentParams struct {
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	PaymentID    pgtype.Int4
+}
+
+func (q *Queries) UpsertPaidEnrollment(ctx context.Context, arg UpsertPaidEnrollmentParams) (Enrollment, error) {
+	row := q.db.QueryRow(ctx, upsertPaidEnrollment,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.PaymentID,
+	)
+	var i Enrollment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Source,
+		&i.PaymentID,
+		&i.OrganizationID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
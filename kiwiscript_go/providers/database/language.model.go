@@ -26,6 +26,7 @@ type LanguageModel struct {
 	Icon            string
 	CompletedSeries int16
 	TotalSeries     int16
+	RequiresReview  bool
 	ViewedAt        string
 }
 
@@ -45,6 +46,7 @@ func (l *Language) ToLanguageModel() *LanguageModel {
 		Icon:            l.Icon,
 		CompletedSeries: 0,
 		TotalSeries:     l.SeriesCount,
+		RequiresReview:  l.RequiresEditorialReview,
 		ViewedAt:        "",
 	}
 }
@@ -62,6 +64,7 @@ func (l *Language) ToLanguageModelWithProgress(progress *LanguageProgress) *Lang
 		Icon:            l.Icon,
 		CompletedSeries: progress.CompletedSeries,
 		TotalSeries:     l.SeriesCount,
+		RequiresReview:  l.RequiresEditorialReview,
 		ViewedAt:        viewedAt,
 	}
 }
@@ -79,6 +82,7 @@ func (l *FindPaginatedLanguagesWithLanguageProgressRow) ToLanguageModel() *Langu
 		Icon:            l.Icon,
 		CompletedSeries: l.LanguageProgressCompletedSeries.Int16,
 		TotalSeries:     l.SeriesCount,
+		RequiresReview:  l.RequiresEditorialReview,
 		ViewedAt:        viewedAt,
 	}
 }
@@ -96,6 +100,7 @@ func (l *FindFilteredPaginatedLanguagesWithLanguageProgressRow) ToLanguageModel(
 		Icon:            l.Icon,
 		CompletedSeries: l.LanguageProgressCompletedSeries.Int16,
 		TotalSeries:     l.SeriesCount,
+		RequiresReview:  l.RequiresEditorialReview,
 		ViewedAt:        viewedAt,
 	}
 }
@@ -113,6 +118,7 @@ func (l *FindLanguageBySlugWithLanguageProgressRow) ToLanguageModel() *LanguageM
 		Icon:            l.Icon,
 		CompletedSeries: l.CompletedSeries.Int16,
 		TotalSeries:     l.SeriesCount,
+		RequiresReview:  l.RequiresEditorialReview,
 		ViewedAt:        viewedAt,
 	}
 }
@@ -125,6 +131,7 @@ func (l *FindPaginatedLanguagesWithInnerProgressRow) ToLanguageModel() *Language
 		Icon:            l.Icon,
 		CompletedSeries: l.LanguageProgressCompletedSeries,
 		TotalSeries:     l.SeriesCount,
+		RequiresReview:  l.RequiresEditorialReview,
 		ViewedAt:        l.LanguageProgressViewedAt.Time.Format(time.RFC3339),
 	}
 }
@@ -101,6 +101,40 @@ func (q *Queries) DeleteLanguageProgressByID(ctx context.Context, id int32) erro
 	return err
 }
 
+const findAllLanguageProgressByUserID = `-- name: FindAllLanguageProgressByUserID :many
+SELECT id, user_id, language_slug, completed_series, viewed_at, created_at, updated_at FROM "language_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC
+`
+
+func (q *Queries) FindAllLanguageProgressByUserID(ctx context.Context, userID int32) ([]LanguageProgress, error) {
+	rows, err := q.db.Query(ctx, findAllLanguageProgressByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LanguageProgress{}
+	for rows.Next() {
+		var i LanguageProgress
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LanguageSlug,
+			&i.CompletedSeries,
+			&i.ViewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findLanguageProgressBySlugAndUserID = `-- name: FindLanguageProgressBySlugAndUserID :one
 SELECT id, user_id, language_slug, completed_series, viewed_at, created_at, updated_at FROM "language_progress"
 WHERE "language_slug" = $1 AND "user_id" = $2 LIMIT 1
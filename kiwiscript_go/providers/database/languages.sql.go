@@ -62,7 +62,7 @@ INSERT INTO "languages" (
   $2,
   $3,
   $4
-) RETURNING id, name, slug, icon, series_count, author_id, created_at, updated_at
+) RETURNING id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review
 `
 
 type CreateLanguageParams struct {
@@ -105,6 +105,7 @@ func (q *Queries) CreateLanguage(ctx context.Context, arg CreateLanguageParams)
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RequiresEditorialReview,
 	)
 	return i, err
 }
@@ -140,7 +141,7 @@ func (q *Queries) DeleteLanguageById(ctx context.Context, id int32) error {
 }
 
 const findAllLanguages = `-- name: FindAllLanguages :many
-SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at FROM "languages"
+SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review FROM "languages"
 ORDER BY "slug" ASC
 `
 
@@ -162,6 +163,7 @@ func (q *Queries) FindAllLanguages(ctx context.Context) ([]Language, error) {
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RequiresEditorialReview,
 		); err != nil {
 			return nil, err
 		}
@@ -174,7 +176,7 @@ func (q *Queries) FindAllLanguages(ctx context.Context) ([]Language, error) {
 }
 
 const findFilteredPaginatedLanguages = `-- name: FindFilteredPaginatedLanguages :many
-SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at FROM "languages"
+SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review FROM "languages"
 WHERE "name" ILIKE $1
 ORDER BY "slug" ASC
 LIMIT $2 OFFSET $3
@@ -204,6 +206,7 @@ func (q *Queries) FindFilteredPaginatedLanguages(ctx context.Context, arg FindFi
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RequiresEditorialReview,
 		); err != nil {
 			return nil, err
 		}
@@ -217,7 +220,7 @@ func (q *Queries) FindFilteredPaginatedLanguages(ctx context.Context, arg FindFi
 
 const findFilteredPaginatedLanguagesWithLanguageProgress = `-- name: FindFilteredPaginatedLanguagesWithLanguageProgress :many
 SELECT
-    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at,
+    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at, languages.requires_editorial_review,
     "language_progress"."completed_series" AS "language_progress_completed_series",
     "language_progress"."viewed_at" AS "language_progress_viewed_at"
 FROM "languages"
@@ -246,6 +249,7 @@ type FindFilteredPaginatedLanguagesWithLanguageProgressRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	RequiresEditorialReview         bool
 	LanguageProgressCompletedSeries pgtype.Int2
 	LanguageProgressViewedAt        pgtype.Timestamp
 }
@@ -273,6 +277,7 @@ func (q *Queries) FindFilteredPaginatedLanguagesWithLanguageProgress(ctx context
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RequiresEditorialReview,
 			&i.LanguageProgressCompletedSeries,
 			&i.LanguageProgressViewedAt,
 		); err != nil {
@@ -287,7 +292,7 @@ func (q *Queries) FindFilteredPaginatedLanguagesWithLanguageProgress(ctx context
 }
 
 const findLanguageById = `-- name: FindLanguageById :one
-SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at FROM "languages"
+SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review FROM "languages"
 WHERE "id" = $1 LIMIT 1
 `
 
@@ -303,12 +308,13 @@ func (q *Queries) FindLanguageById(ctx context.Context, id int32) (Language, err
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RequiresEditorialReview,
 	)
 	return i, err
 }
 
 const findLanguageBySlug = `-- name: FindLanguageBySlug :one
-SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at FROM "languages"
+SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review FROM "languages"
 WHERE "slug" = $1 LIMIT 1
 `
 
@@ -324,13 +330,14 @@ func (q *Queries) FindLanguageBySlug(ctx context.Context, slug string) (Language
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RequiresEditorialReview,
 	)
 	return i, err
 }
 
 const findLanguageBySlugWithLanguageProgress = `-- name: FindLanguageBySlugWithLanguageProgress :one
 SELECT
-    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at,
+    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at, languages.requires_editorial_review,
     "language_progress"."completed_series" AS "completed_series",
     "language_progress"."viewed_at" AS "viewed_at"
 FROM "languages"
@@ -348,16 +355,17 @@ type FindLanguageBySlugWithLanguageProgressParams struct {
 }
 
 type FindLanguageBySlugWithLanguageProgressRow struct {
-	ID              int32
-	Name            string
-	Slug            string
-	Icon            string
-	SeriesCount     int16
-	AuthorID        int32
-	CreatedAt       pgtype.Timestamp
-	UpdatedAt       pgtype.Timestamp
-	CompletedSeries pgtype.Int2
-	ViewedAt        pgtype.Timestamp
+	ID                      int32
+	Name                    string
+	Slug                    string
+	Icon                    string
+	SeriesCount             int16
+	AuthorID                int32
+	CreatedAt               pgtype.Timestamp
+	UpdatedAt               pgtype.Timestamp
+	RequiresEditorialReview bool
+	CompletedSeries         pgtype.Int2
+	ViewedAt                pgtype.Timestamp
 }
 
 func (q *Queries) FindLanguageBySlugWithLanguageProgress(ctx context.Context, arg FindLanguageBySlugWithLanguageProgressParams) (FindLanguageBySlugWithLanguageProgressRow, error) {
@@ -372,6 +380,7 @@ func (q *Queries) FindLanguageBySlugWithLanguageProgress(ctx context.Context, ar
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RequiresEditorialReview,
 		&i.CompletedSeries,
 		&i.ViewedAt,
 	)
@@ -379,7 +388,7 @@ func (q *Queries) FindLanguageBySlugWithLanguageProgress(ctx context.Context, ar
 }
 
 const findPaginatedLanguages = `-- name: FindPaginatedLanguages :many
-SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at FROM "languages"
+SELECT id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review FROM "languages"
 ORDER BY "slug" ASC
 LIMIT $1 OFFSET $2
 `
@@ -407,6 +416,7 @@ func (q *Queries) FindPaginatedLanguages(ctx context.Context, arg FindPaginatedL
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RequiresEditorialReview,
 		); err != nil {
 			return nil, err
 		}
@@ -420,7 +430,7 @@ func (q *Queries) FindPaginatedLanguages(ctx context.Context, arg FindPaginatedL
 
 const findPaginatedLanguagesWithInnerProgress = `-- name: FindPaginatedLanguagesWithInnerProgress :many
 SELECT
-    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at,
+    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at, languages.requires_editorial_review,
     "language_progress"."completed_series" AS "language_progress_completed_series",
     "language_progress"."viewed_at" AS "language_progress_viewed_at"
 FROM "languages"
@@ -447,6 +457,7 @@ type FindPaginatedLanguagesWithInnerProgressRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	RequiresEditorialReview         bool
 	LanguageProgressCompletedSeries int16
 	LanguageProgressViewedAt        pgtype.Timestamp
 }
@@ -469,6 +480,7 @@ func (q *Queries) FindPaginatedLanguagesWithInnerProgress(ctx context.Context, a
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RequiresEditorialReview,
 			&i.LanguageProgressCompletedSeries,
 			&i.LanguageProgressViewedAt,
 		); err != nil {
@@ -484,7 +496,7 @@ func (q *Queries) FindPaginatedLanguagesWithInnerProgress(ctx context.Context, a
 
 const findPaginatedLanguagesWithLanguageProgress = `-- name: FindPaginatedLanguagesWithLanguageProgress :many
 SELECT
-    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at,
+    languages.id, languages.name, languages.slug, languages.icon, languages.series_count, languages.author_id, languages.created_at, languages.updated_at, languages.requires_editorial_review,
     "language_progress"."completed_series" AS "language_progress_completed_series",
     "language_progress"."viewed_at" AS "language_progress_viewed_at"
 FROM "languages"
@@ -511,6 +523,7 @@ type FindPaginatedLanguagesWithLanguageProgressRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	RequiresEditorialReview         bool
 	LanguageProgressCompletedSeries pgtype.Int2
 	LanguageProgressViewedAt        pgtype.Timestamp
 }
@@ -533,6 +546,7 @@ func (q *Queries) FindPaginatedLanguagesWithLanguageProgress(ctx context.Context
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RequiresEditorialReview,
 			&i.LanguageProgressCompletedSeries,
 			&i.LanguageProgressViewedAt,
 		); err != nil {
@@ -557,13 +571,29 @@ func (q *Queries) IncrementLanguageSeriesCount(ctx context.Context, slug string)
 	return err
 }
 
+const reassignLanguagesAuthor = `-- name: ReassignLanguagesAuthor :exec
+UPDATE "languages" SET
+  "author_id" = $1
+WHERE "author_id" = $2
+`
+
+type ReassignLanguagesAuthorParams struct {
+	NewAuthorID int32
+	OldAuthorID int32
+}
+
+func (q *Queries) ReassignLanguagesAuthor(ctx context.Context, arg ReassignLanguagesAuthorParams) error {
+	_, err := q.db.Exec(ctx, reassignLanguagesAuthor, arg.NewAuthorID, arg.OldAuthorID)
+	return err
+}
+
 const updateLanguage = `-- name: UpdateLanguage :one
 UPDATE "languages" SET
   "name" = $1,
   "icon" = $2,
   "slug" = $3
 WHERE "id" = $4
-RETURNING id, name, slug, icon, series_count, author_id, created_at, updated_at
+RETURNING id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review
 `
 
 type UpdateLanguageParams struct {
@@ -590,6 +620,37 @@ func (q *Queries) UpdateLanguage(ctx context.Context, arg UpdateLanguageParams)
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RequiresEditorialReview,
+	)
+	return i, err
+}
+
+const updateLanguageRequiresEditorialReview = `-- name: UpdateLanguageRequiresEditorialReview :one
+UPDATE "languages" SET
+  "requires_editorial_review" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, name, slug, icon, series_count, author_id, created_at, updated_at, requires_editorial_review
+`
+
+type UpdateLanguageRequiresEditorialReviewParams struct {
+	RequiresEditorialReview bool
+	ID                      int32
+}
+
+func (q *Queries) UpdateLanguageRequiresEditorialReview(ctx context.Context, arg UpdateLanguageRequiresEditorialReviewParams) (Language, error) {
+	row := q.db.QueryRow(ctx, updateLanguageRequiresEditorialReview, arg.RequiresEditorialReview, arg.ID)
+	var i Language
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Slug,
+		&i.Icon,
+		&i.SeriesCount,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RequiresEditorialReview,
 	)
 	return i, err
 }
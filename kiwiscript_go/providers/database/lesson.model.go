@@ -30,7 +30,9 @@ type LessonModel struct {
 	SectionID        int32
 	ViewedAt         string
 	IsPublished      bool
+	IsPreview        bool
 	IsCompleted      bool
+	TimeOnTask       int32
 }
 
 type ToLessonModel interface {
@@ -52,6 +54,7 @@ func (l *Lesson) ToLessonModel() *LessonModel {
 		SeriesSlug:       l.SeriesSlug,
 		SectionID:        l.SectionID,
 		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
 		IsCompleted:      false,
 		ViewedAt:         "",
 	}
@@ -74,7 +77,9 @@ func (l *Lesson) ToLessonModelWithProgress(progress *LessonProgress) *LessonMode
 		SectionID:        l.SectionID,
 		ViewedAt:         viewedAt,
 		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
 		IsCompleted:      progress.CompletedAt.Valid,
+		TimeOnTask:       progress.TimeOnTaskSeconds,
 	}
 }
 
@@ -94,8 +99,56 @@ func (l *FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgressRow) ToLess
 		SeriesSlug:       l.SeriesSlug,
 		SectionID:        l.SectionID,
 		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
 		IsCompleted:      l.LessonProgressCompletedAt.Valid,
 		ViewedAt:         viewedAt,
+		TimeOnTask:       l.LessonProgressTimeOnTaskSeconds.Int32,
+	}
+}
+
+func (l *FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorRow) ToLessonModel() *LessonModel {
+	var viewedAt string
+	if l.LessonProgressViewedAt.Valid {
+		viewedAt = l.LessonProgressViewedAt.Time.Format(time.RFC3339)
+	}
+
+	return &LessonModel{
+		ID:               l.ID,
+		Title:            l.Title,
+		Position:         l.Position,
+		WatchTimeSeconds: l.WatchTimeSeconds,
+		ReadTimeSeconds:  l.ReadTimeSeconds,
+		LanguageSlug:     l.LanguageSlug,
+		SeriesSlug:       l.SeriesSlug,
+		SectionID:        l.SectionID,
+		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
+		IsCompleted:      l.LessonProgressCompletedAt.Valid,
+		ViewedAt:         viewedAt,
+		TimeOnTask:       l.LessonProgressTimeOnTaskSeconds.Int32,
+	}
+}
+
+func (l *FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorRow) ToLessonModel() *LessonModel {
+	var viewedAt string
+	if l.LessonProgressViewedAt.Valid {
+		viewedAt = l.LessonProgressViewedAt.Time.Format(time.RFC3339)
+	}
+
+	return &LessonModel{
+		ID:               l.ID,
+		Title:            l.Title,
+		Position:         l.Position,
+		WatchTimeSeconds: l.WatchTimeSeconds,
+		ReadTimeSeconds:  l.ReadTimeSeconds,
+		LanguageSlug:     l.LanguageSlug,
+		SeriesSlug:       l.SeriesSlug,
+		SectionID:        l.SectionID,
+		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
+		IsCompleted:      l.LessonProgressCompletedAt.Valid,
+		ViewedAt:         viewedAt,
+		TimeOnTask:       l.LessonProgressTimeOnTaskSeconds.Int32,
 	}
 }
 
@@ -115,8 +168,10 @@ func (l *FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideoRow) ToLesso
 		SeriesSlug:       l.SeriesSlug,
 		SectionID:        l.SectionID,
 		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
 		IsCompleted:      l.LessonProgressCompletedAt.Valid,
 		ViewedAt:         viewedAt,
+		TimeOnTask:       l.LessonProgressTimeOnTaskSeconds.Int32,
 	}
 }
 
@@ -131,6 +186,7 @@ func (l *FindLessonBySlugsAndIDsWithArticleAndVideoRow) ToLessonModel() *LessonM
 		SeriesSlug:       l.SeriesSlug,
 		SectionID:        l.SectionID,
 		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
 		IsCompleted:      false,
 	}
 }
@@ -146,7 +202,9 @@ func (l *FindCurrentLessonRow) ToLessonModel() *LessonModel {
 		SeriesSlug:       l.SeriesSlug,
 		SectionID:        l.SectionID,
 		IsPublished:      l.IsPublished,
+		IsPreview:        l.IsPreview,
 		IsCompleted:      l.LessonProgressCompletedAt.Valid,
 		ViewedAt:         l.LessonProgressViewedAt.Time.Format(time.RFC3339),
+		TimeOnTask:       l.LessonProgressTimeOnTaskSeconds,
 	}
 }
@@ -21,7 +21,7 @@ INSERT INTO "lesson_articles" (
     $2,
     $3,
     $4
-) RETURNING id, lesson_id, author_id, content, read_time_seconds, created_at, updated_at
+) RETURNING id, lesson_id, author_id, content, read_time_seconds, created_at, updated_at, version
 `
 
 type CreateLessonArticleParams struct {
@@ -63,6 +63,7 @@ func (q *Queries) CreateLessonArticle(ctx context.Context, arg CreateLessonArtic
 		&i.ReadTimeSeconds,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -77,8 +78,26 @@ func (q *Queries) DeleteLessonArticle(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteLessonArticleByIDAndVersion = `-- name: DeleteLessonArticleByIDAndVersion :execrows
+DELETE FROM "lesson_articles"
+WHERE "id" = $1 AND "version" = $2
+`
+
+type DeleteLessonArticleByIDAndVersionParams struct {
+	ID      int32
+	Version int32
+}
+
+func (q *Queries) DeleteLessonArticleByIDAndVersion(ctx context.Context, arg DeleteLessonArticleByIDAndVersionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLessonArticleByIDAndVersion, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getLessonArticleByLessonID = `-- name: GetLessonArticleByLessonID :one
-SELECT id, lesson_id, author_id, content, read_time_seconds, created_at, updated_at FROM "lesson_articles"
+SELECT id, lesson_id, author_id, content, read_time_seconds, created_at, updated_at, version FROM "lesson_articles"
 WHERE "lesson_id" = $1
 LIMIT 1
 `
@@ -94,27 +113,51 @@ func (q *Queries) GetLessonArticleByLessonID(ctx context.Context, lessonID int32
 		&i.ReadTimeSeconds,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
+const reassignLessonArticlesAuthor = `-- name: ReassignLessonArticlesAuthor :exec
+UPDATE "lesson_articles" SET
+  "author_id" = $1
+WHERE "author_id" = $2
+`
+
+type ReassignLessonArticlesAuthorParams struct {
+	NewAuthorID int32
+	OldAuthorID int32
+}
+
+func (q *Queries) ReassignLessonArticlesAuthor(ctx context.Context, arg ReassignLessonArticlesAuthorParams) error {
+	_, err := q.db.Exec(ctx, reassignLessonArticlesAuthor, arg.NewAuthorID, arg.OldAuthorID)
+	return err
+}
+
 const updateLessonArticle = `-- name: UpdateLessonArticle :one
 UPDATE "lesson_articles" SET
   "content" = $1,
   "read_time_seconds" = $2,
+  "version" = "version" + 1,
   "updated_at" = NOW()
-WHERE "id" = $3
-RETURNING id, lesson_id, author_id, content, read_time_seconds, created_at, updated_at
+WHERE "id" = $3 AND "version" = $4
+RETURNING id, lesson_id, author_id, content, read_time_seconds, created_at, updated_at, version
 `
 
 type UpdateLessonArticleParams struct {
 	Content         string
 	ReadTimeSeconds int32
 	ID              int32
+	Version         int32
 }
 
 func (q *Queries) UpdateLessonArticle(ctx context.Context, arg UpdateLessonArticleParams) (LessonArticle, error) {
-	row := q.db.QueryRow(ctx, updateLessonArticle, arg.Content, arg.ReadTimeSeconds, arg.ID)
+	row := q.db.QueryRow(ctx, updateLessonArticle,
+		arg.Content,
+		arg.ReadTimeSeconds,
+		arg.ID,
+		arg.Version,
+	)
 	var i LessonArticle
 	err := row.Scan(
 		&i.ID,
@@ -124,6 +167,7 @@ func (q *Queries) UpdateLessonArticle(ctx context.Context, arg UpdateLessonArtic
 		&i.ReadTimeSeconds,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -0,0 +1,89 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+type LessonCommentAuthor struct {
+	ID        int32
+	FirstName string
+	LastName  string
+}
+
+type LessonCommentModel struct {
+	ID           int32
+	Body         string
+	ParentID     int32
+	RepliesCount int32
+	UpvotesCount int32
+	IsAnswer     bool
+	IsAnswered   bool
+	IsHidden     bool
+	IsLocked     bool
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	Author       LessonCommentAuthor
+	CreatedAt    string
+	UpdatedAt    string
+}
+
+func (r *FindLessonCommentByIDWithAuthorRow) ToLessonCommentModel() *LessonCommentModel {
+	return &LessonCommentModel{
+		ID:           r.ID,
+		Body:         r.Body,
+		ParentID:     r.ParentID.Int32,
+		RepliesCount: r.RepliesCount,
+		UpvotesCount: r.UpvotesCount,
+		IsAnswer:     r.IsAnswer,
+		IsAnswered:   r.IsAnswered,
+		IsHidden:     r.IsHidden,
+		IsLocked:     r.IsLocked,
+		LanguageSlug: r.LanguageSlug,
+		SeriesSlug:   r.SeriesSlug,
+		SectionID:    r.SectionID,
+		LessonID:     r.LessonID,
+		Author: LessonCommentAuthor{
+			ID:        r.AuthorID,
+			FirstName: r.AuthorFirstName,
+			LastName:  r.AuthorLastName,
+		},
+		CreatedAt: r.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: r.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// The paginated rows share the same columns as FindLessonCommentByIDWithAuthorRow,
+// so they can be converted directly.
+
+func (r *FindPaginatedLessonCommentsWithAuthorRow) ToLessonCommentModel() *LessonCommentModel {
+	return (*FindLessonCommentByIDWithAuthorRow)(r).ToLessonCommentModel()
+}
+
+func (r *FindAllPaginatedLessonCommentsWithAuthorRow) ToLessonCommentModel() *LessonCommentModel {
+	return (*FindLessonCommentByIDWithAuthorRow)(r).ToLessonCommentModel()
+}
+
+func (r *FindPaginatedLessonCommentRepliesWithAuthorRow) ToLessonCommentModel() *LessonCommentModel {
+	return (*FindLessonCommentByIDWithAuthorRow)(r).ToLessonCommentModel()
+}
+
+func (r *FindAllPaginatedLessonCommentRepliesWithAuthorRow) ToLessonCommentModel() *LessonCommentModel {
+	return (*FindLessonCommentByIDWithAuthorRow)(r).ToLessonCommentModel()
+}
@@ -0,0 +1,83 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: lesson_comment_upvotes.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createLessonCommentUpvote = `-- name: CreateLessonCommentUpvote :exec
+
+INSERT INTO "lesson_comment_upvotes" (
+    "comment_id",
+    "user_id"
+) VALUES (
+    $1,
+    $2
+)
+`
+
+type CreateLessonCommentUpvoteParams struct {
+	CommentID int32
+	UserID    int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateLessonCommentUpvote(ctx context.Context, arg CreateLessonCommentUpvoteParams) error {
+	_, err := q.db.Exec(ctx, createLessonCommentUpvote, arg.CommentID, arg.UserID)
+	return err
+}
+
+const deleteLessonCommentUpvoteByID = `-- name: DeleteLessonCommentUpvoteByID :execrows
+DELETE FROM "lesson_comment_upvotes"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteLessonCommentUpvoteByID(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLessonCommentUpvoteByID, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findLessonCommentUpvoteByCommentIDAndUserID = `-- name: FindLessonCommentUpvoteByCommentIDAndUserID :one
+SELECT id, comment_id, user_id, created_at FROM "lesson_comment_upvotes"
+WHERE "comment_id" = $1 AND "user_id" = $2
+LIMIT 1
+`
+
+type FindLessonCommentUpvoteByCommentIDAndUserIDParams struct {
+	CommentID int32
+	UserID    int32
+}
+
+func (q *Queries) FindLessonCommentUpvoteByCommentIDAndUserID(ctx context.Context, arg FindLessonCommentUpvoteByCommentIDAndUserIDParams) (LessonCommentUpvote, error) {
+	row := q.db.QueryRow(ctx, findLessonCommentUpvoteByCommentIDAndUserID, arg.CommentID, arg.UserID)
+	var i LessonCommentUpvote
+	err := row.Scan(
+		&i.ID,
+		&i.CommentID,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
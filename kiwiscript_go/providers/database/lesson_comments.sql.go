@@ -0,0 +1,790 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: lesson_comments.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countAllLessonCommentRepliesByParentID = `-- name: CountAllLessonCommentRepliesByParentID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "parent_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountAllLessonCommentRepliesByParentID(ctx context.Context, parentID pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countAllLessonCommentRepliesByParentID, parentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countAllLessonCommentsByLessonID = `-- name: CountAllLessonCommentsByLessonID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "lesson_id" = $1 AND "parent_id" IS NULL
+LIMIT 1
+`
+
+func (q *Queries) CountAllLessonCommentsByLessonID(ctx context.Context, lessonID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countAllLessonCommentsByLessonID, lessonID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countLessonCommentRepliesByParentID = `-- name: CountLessonCommentRepliesByParentID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "parent_id" = $1 AND "is_hidden" = false
+LIMIT 1
+`
+
+func (q *Queries) CountLessonCommentRepliesByParentID(ctx context.Context, parentID pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countLessonCommentRepliesByParentID, parentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countLessonCommentsByLessonID = `-- name: CountLessonCommentsByLessonID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "lesson_id" = $1 AND "parent_id" IS NULL AND "is_hidden" = false
+LIMIT 1
+`
+
+func (q *Queries) CountLessonCommentsByLessonID(ctx context.Context, lessonID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countLessonCommentsByLessonID, lessonID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createLessonComment = `-- name: CreateLessonComment :one
+
+INSERT INTO "lesson_comments" (
+    "body",
+    "parent_id",
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "lesson_id",
+    "author_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+) RETURNING id, body, parent_id, replies_count, upvotes_count, is_answer, is_answered, is_hidden, is_locked, language_slug, series_slug, section_id, lesson_id, author_id, created_at, updated_at
+`
+
+type CreateLessonCommentParams struct {
+	Body         string
+	ParentID     pgtype.Int4
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	AuthorID     int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateLessonComment(ctx context.Context, arg CreateLessonCommentParams) (LessonComment, error) {
+	row := q.db.QueryRow(ctx, createLessonComment,
+		arg.Body,
+		arg.ParentID,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.LessonID,
+		arg.AuthorID,
+	)
+	var i LessonComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.ParentID,
+		&i.RepliesCount,
+		&i.UpvotesCount,
+		&i.IsAnswer,
+		&i.IsAnswered,
+		&i.IsHidden,
+		&i.IsLocked,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const decrementLessonCommentRepliesCount = `-- name: DecrementLessonCommentRepliesCount :exec
+UPDATE "lesson_comments" SET
+    "replies_count" = "replies_count" - 1
+WHERE "id" = $1
+`
+
+func (q *Queries) DecrementLessonCommentRepliesCount(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, decrementLessonCommentRepliesCount, id)
+	return err
+}
+
+const decrementLessonCommentUpvotesCount = `-- name: DecrementLessonCommentUpvotesCount :exec
+UPDATE "lesson_comments" SET
+    "upvotes_count" = "upvotes_count" - 1
+WHERE "id" = $1
+`
+
+func (q *Queries) DecrementLessonCommentUpvotesCount(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, decrementLessonCommentUpvotesCount, id)
+	return err
+}
+
+const deleteLessonCommentByID = `-- name: DeleteLessonCommentByID :execrows
+DELETE FROM "lesson_comments"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteLessonCommentByID(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLessonCommentByID, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findAllPaginatedLessonCommentRepliesWithAuthor = `-- name: FindAllPaginatedLessonCommentRepliesWithAuthor :many
+SELECT
+    lesson_comments.id, lesson_comments.body, lesson_comments.parent_id, lesson_comments.replies_count, lesson_comments.upvotes_count, lesson_comments.is_answer, lesson_comments.is_answered, lesson_comments.is_hidden, lesson_comments.is_locked, lesson_comments.language_slug, lesson_comments.series_slug, lesson_comments.section_id, lesson_comments.lesson_id, lesson_comments.author_id, lesson_comments.created_at, lesson_comments.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE "lesson_comments"."parent_id" = $1
+ORDER BY "lesson_comments"."is_answer" DESC, "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" ASC
+LIMIT $2 OFFSET $3
+`
+
+type FindAllPaginatedLessonCommentRepliesWithAuthorParams struct {
+	ParentID pgtype.Int4
+	Limit    int32
+	Offset   int32
+}
+
+type FindAllPaginatedLessonCommentRepliesWithAuthorRow struct {
+	ID              int32
+	Body            string
+	ParentID        pgtype.Int4
+	RepliesCount    int32
+	UpvotesCount    int32
+	IsAnswer        bool
+	IsAnswered      bool
+	IsHidden        bool
+	IsLocked        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	SectionID       int32
+	LessonID        int32
+	AuthorID        int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindAllPaginatedLessonCommentRepliesWithAuthor(ctx context.Context, arg FindAllPaginatedLessonCommentRepliesWithAuthorParams) ([]FindAllPaginatedLessonCommentRepliesWithAuthorRow, error) {
+	rows, err := q.db.Query(ctx, findAllPaginatedLessonCommentRepliesWithAuthor, arg.ParentID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindAllPaginatedLessonCommentRepliesWithAuthorRow{}
+	for rows.Next() {
+		var i FindAllPaginatedLessonCommentRepliesWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.ParentID,
+			&i.RepliesCount,
+			&i.UpvotesCount,
+			&i.IsAnswer,
+			&i.IsAnswered,
+			&i.IsHidden,
+			&i.IsLocked,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findAllPaginatedLessonCommentsWithAuthor = `-- name: FindAllPaginatedLessonCommentsWithAuthor :many
+SELECT
+    lesson_comments.id, lesson_comments.body, lesson_comments.parent_id, lesson_comments.replies_count, lesson_comments.upvotes_count, lesson_comments.is_answer, lesson_comments.is_answered, lesson_comments.is_hidden, lesson_comments.is_locked, lesson_comments.language_slug, lesson_comments.series_slug, lesson_comments.section_id, lesson_comments.lesson_id, lesson_comments.author_id, lesson_comments.created_at, lesson_comments.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE
+    "lesson_comments"."lesson_id" = $1 AND
+    "lesson_comments"."parent_id" IS NULL
+ORDER BY "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindAllPaginatedLessonCommentsWithAuthorParams struct {
+	LessonID int32
+	Limit    int32
+	Offset   int32
+}
+
+type FindAllPaginatedLessonCommentsWithAuthorRow struct {
+	ID              int32
+	Body            string
+	ParentID        pgtype.Int4
+	RepliesCount    int32
+	UpvotesCount    int32
+	IsAnswer        bool
+	IsAnswered      bool
+	IsHidden        bool
+	IsLocked        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	SectionID       int32
+	LessonID        int32
+	AuthorID        int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindAllPaginatedLessonCommentsWithAuthor(ctx context.Context, arg FindAllPaginatedLessonCommentsWithAuthorParams) ([]FindAllPaginatedLessonCommentsWithAuthorRow, error) {
+	rows, err := q.db.Query(ctx, findAllPaginatedLessonCommentsWithAuthor, arg.LessonID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindAllPaginatedLessonCommentsWithAuthorRow{}
+	for rows.Next() {
+		var i FindAllPaginatedLessonCommentsWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.ParentID,
+			&i.RepliesCount,
+			&i.UpvotesCount,
+			&i.IsAnswer,
+			&i.IsAnswered,
+			&i.IsHidden,
+			&i.IsLocked,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLessonCommentByIDAndLessonID = `-- name: FindLessonCommentByIDAndLessonID :one
+SELECT id, body, parent_id, replies_count, upvotes_count, is_answer, is_answered, is_hidden, is_locked, language_slug, series_slug, section_id, lesson_id, author_id, created_at, updated_at FROM "lesson_comments"
+WHERE "id" = $1 AND "lesson_id" = $2
+LIMIT 1
+`
+
+type FindLessonCommentByIDAndLessonIDParams struct {
+	ID       int32
+	LessonID int32
+}
+
+func (q *Queries) FindLessonCommentByIDAndLessonID(ctx context.Context, arg FindLessonCommentByIDAndLessonIDParams) (LessonComment, error) {
+	row := q.db.QueryRow(ctx, findLessonCommentByIDAndLessonID, arg.ID, arg.LessonID)
+	var i LessonComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.ParentID,
+		&i.RepliesCount,
+		&i.UpvotesCount,
+		&i.IsAnswer,
+		&i.IsAnswered,
+		&i.IsHidden,
+		&i.IsLocked,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findLessonCommentByIDWithAuthor = `-- name: FindLessonCommentByIDWithAuthor :one
+SELECT
+    lesson_comments.id, lesson_comments.body, lesson_comments.parent_id, lesson_comments.replies_count, lesson_comments.upvotes_count, lesson_comments.is_answer, lesson_comments.is_answered, lesson_comments.is_hidden, lesson_comments.is_locked, lesson_comments.language_slug, lesson_comments.series_slug, lesson_comments.section_id, lesson_comments.lesson_id, lesson_comments.author_id, lesson_comments.created_at, lesson_comments.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE "lesson_comments"."id" = $1
+LIMIT 1
+`
+
+type FindLessonCommentByIDWithAuthorRow struct {
+	ID              int32
+	Body            string
+	ParentID        pgtype.Int4
+	RepliesCount    int32
+	UpvotesCount    int32
+	IsAnswer        bool
+	IsAnswered      bool
+	IsHidden        bool
+	IsLocked        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	SectionID       int32
+	LessonID        int32
+	AuthorID        int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindLessonCommentByIDWithAuthor(ctx context.Context, id int32) (FindLessonCommentByIDWithAuthorRow, error) {
+	row := q.db.QueryRow(ctx, findLessonCommentByIDWithAuthor, id)
+	var i FindLessonCommentByIDWithAuthorRow
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.ParentID,
+		&i.RepliesCount,
+		&i.UpvotesCount,
+		&i.IsAnswer,
+		&i.IsAnswered,
+		&i.IsHidden,
+		&i.IsLocked,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AuthorFirstName,
+		&i.AuthorLastName,
+	)
+	return i, err
+}
+
+const findPaginatedLessonCommentRepliesWithAuthor = `-- name: FindPaginatedLessonCommentRepliesWithAuthor :many
+SELECT
+    lesson_comments.id, lesson_comments.body, lesson_comments.parent_id, lesson_comments.replies_count, lesson_comments.upvotes_count, lesson_comments.is_answer, lesson_comments.is_answered, lesson_comments.is_hidden, lesson_comments.is_locked, lesson_comments.language_slug, lesson_comments.series_slug, lesson_comments.section_id, lesson_comments.lesson_id, lesson_comments.author_id, lesson_comments.created_at, lesson_comments.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE
+    "lesson_comments"."parent_id" = $1 AND
+    "lesson_comments"."is_hidden" = false
+ORDER BY "lesson_comments"."is_answer" DESC, "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" ASC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedLessonCommentRepliesWithAuthorParams struct {
+	ParentID pgtype.Int4
+	Limit    int32
+	Offset   int32
+}
+
+type FindPaginatedLessonCommentRepliesWithAuthorRow struct {
+	ID              int32
+	Body            string
+	ParentID        pgtype.Int4
+	RepliesCount    int32
+	UpvotesCount    int32
+	IsAnswer        bool
+	IsAnswered      bool
+	IsHidden        bool
+	IsLocked        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	SectionID       int32
+	LessonID        int32
+	AuthorID        int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindPaginatedLessonCommentRepliesWithAuthor(ctx context.Context, arg FindPaginatedLessonCommentRepliesWithAuthorParams) ([]FindPaginatedLessonCommentRepliesWithAuthorRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedLessonCommentRepliesWithAuthor, arg.ParentID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedLessonCommentRepliesWithAuthorRow{}
+	for rows.Next() {
+		var i FindPaginatedLessonCommentRepliesWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.ParentID,
+			&i.RepliesCount,
+			&i.UpvotesCount,
+			&i.IsAnswer,
+			&i.IsAnswered,
+			&i.IsHidden,
+			&i.IsLocked,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedLessonCommentsWithAuthor = `-- name: FindPaginatedLessonCommentsWithAuthor :many
+SELECT
+    lesson_comments.id, lesson_comments.body, lesson_comments.parent_id, lesson_comments.replies_count, lesson_comments.upvotes_count, lesson_comments.is_answer, lesson_comments.is_answered, lesson_comments.is_hidden, lesson_comments.is_locked, lesson_comments.language_slug, lesson_comments.series_slug, lesson_comments.section_id, lesson_comments.lesson_id, lesson_comments.author_id, lesson_comments.created_at, lesson_comments.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE
+    "lesson_comments"."lesson_id" = $1 AND
+    "lesson_comments"."parent_id" IS NULL AND
+    "lesson_comments"."is_hidden" = false
+ORDER BY "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedLessonCommentsWithAuthorParams struct {
+	LessonID int32
+	Limit    int32
+	Offset   int32
+}
+
+type FindPaginatedLessonCommentsWithAuthorRow struct {
+	ID              int32
+	Body            string
+	ParentID        pgtype.Int4
+	RepliesCount    int32
+	UpvotesCount    int32
+	IsAnswer        bool
+	IsAnswered      bool
+	IsHidden        bool
+	IsLocked        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	SectionID       int32
+	LessonID        int32
+	AuthorID        int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindPaginatedLessonCommentsWithAuthor(ctx context.Context, arg FindPaginatedLessonCommentsWithAuthorParams) ([]FindPaginatedLessonCommentsWithAuthorRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedLessonCommentsWithAuthor, arg.LessonID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedLessonCommentsWithAuthorRow{}
+	for rows.Next() {
+		var i FindPaginatedLessonCommentsWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.ParentID,
+			&i.RepliesCount,
+			&i.UpvotesCount,
+			&i.IsAnswer,
+			&i.IsAnswered,
+			&i.IsHidden,
+			&i.IsLocked,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementLessonCommentRepliesCount = `-- name: IncrementLessonCommentRepliesCount :exec
+UPDATE "lesson_comments" SET
+    "replies_count" = "replies_count" + 1
+WHERE "id" = $1
+`
+
+func (q *Queries) IncrementLessonCommentRepliesCount(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, incrementLessonCommentRepliesCount, id)
+	return err
+}
+
+const incrementLessonCommentUpvotesCount = `-- name: IncrementLessonCommentUpvotesCount :exec
+UPDATE "lesson_comments" SET
+    "upvotes_count" = "upvotes_count" + 1
+WHERE "id" = $1
+`
+
+func (q *Queries) IncrementLessonCommentUpvotesCount(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, incrementLessonCommentUpvotesCount, id)
+	return err
+}
+
+const refreshLessonCommentIsAnswered = `-- name: RefreshLessonCommentIsAnswered :exec
+UPDATE "lesson_comments" SET
+    "is_answered" = EXISTS (
+        SELECT 1 FROM "lesson_comments" AS "replies"
+        WHERE "replies"."parent_id" = "lesson_comments"."id" AND "replies"."is_answer" = true
+    )
+WHERE "lesson_comments"."id" = $1
+`
+
+func (q *Queries) RefreshLessonCommentIsAnswered(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, refreshLessonCommentIsAnswered, id)
+	return err
+}
+
+const updateLessonCommentBody = `-- name: UpdateLessonCommentBody :one
+UPDATE "lesson_comments" SET
+    "body" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, body, parent_id, replies_count, upvotes_count, is_answer, is_answered, is_hidden, is_locked, language_slug, series_slug, section_id, lesson_id, author_id, created_at, updated_at
+`
+
+type UpdateLessonCommentBodyParams struct {
+	Body string
+	ID   int32
+}
+
+func (q *Queries) UpdateLessonCommentBody(ctx context.Context, arg UpdateLessonCommentBodyParams) (LessonComment, error) {
+	row := q.db.QueryRow(ctx, updateLessonCommentBody, arg.Body, arg.ID)
+	var i LessonComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.ParentID,
+		&i.RepliesCount,
+		&i.UpvotesCount,
+		&i.IsAnswer,
+		&i.IsAnswered,
+		&i.IsHidden,
+		&i.IsLocked,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateLessonCommentIsAnswer = `-- name: UpdateLessonCommentIsAnswer :one
+UPDATE "lesson_comments" SET
+    "is_answer" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, body, parent_id, replies_count, upvotes_count, is_answer, is_answered, is_hidden, is_locked, language_slug, series_slug, section_id, lesson_id, author_id, created_at, updated_at
+`
+
+type UpdateLessonCommentIsAnswerParams struct {
+	IsAnswer bool
+	ID       int32
+}
+
+func (q *Queries) UpdateLessonCommentIsAnswer(ctx context.Context, arg UpdateLessonCommentIsAnswerParams) (LessonComment, error) {
+	row := q.db.QueryRow(ctx, updateLessonCommentIsAnswer, arg.IsAnswer, arg.ID)
+	var i LessonComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.ParentID,
+		&i.RepliesCount,
+		&i.UpvotesCount,
+		&i.IsAnswer,
+		&i.IsAnswered,
+		&i.IsHidden,
+		&i.IsLocked,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateLessonCommentIsHidden = `-- name: UpdateLessonCommentIsHidden :one
+UPDATE "lesson_comments" SET
+    "is_hidden" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, body, parent_id, replies_count, upvotes_count, is_answer, is_answered, is_hidden, is_locked, language_slug, series_slug, section_id, lesson_id, author_id, created_at, updated_at
+`
+
+type UpdateLessonCommentIsHiddenParams struct {
+	IsHidden bool
+	ID       int32
+}
+
+func (q *Queries) UpdateLessonCommentIsHidden(ctx context.Context, arg UpdateLessonCommentIsHiddenParams) (LessonComment, error) {
+	row := q.db.QueryRow(ctx, updateLessonCommentIsHidden, arg.IsHidden, arg.ID)
+	var i LessonComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.ParentID,
+		&i.RepliesCount,
+		&i.UpvotesCount,
+		&i.IsAnswer,
+		&i.IsAnswered,
+		&i.IsHidden,
+		&i.IsLocked,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateLessonCommentIsLocked = `-- name: UpdateLessonCommentIsLocked :one
+UPDATE "lesson_comments" SET
+    "is_locked" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, body, parent_id, replies_count, upvotes_count, is_answer, is_answered, is_hidden, is_locked, language_slug, series_slug, section_id, lesson_id, author_id, created_at, updated_at
+`
+
+type UpdateLessonCommentIsLockedParams struct {
+	IsLocked bool
+	ID       int32
+}
+
+func (q *Queries) UpdateLessonCommentIsLocked(ctx context.Context, arg UpdateLessonCommentIsLockedParams) (LessonComment, error) {
+	row := q.db.QueryRow(ctx, updateLessonCommentIsLocked, arg.IsLocked, arg.ID)
+	var i LessonComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.ParentID,
+		&i.RepliesCount,
+		&i.UpvotesCount,
+		&i.IsAnswer,
+		&i.IsAnswered,
+		&i.IsHidden,
+		&i.IsLocked,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateLessonCommentsSectionID = `-- name: UpdateLessonCommentsSectionID :exec
+UPDATE "lesson_comments" SET
+  "section_id" = $1
+WHERE "lesson_id" = ANY($2::int[])
+`
+
+type UpdateLessonCommentsSectionIDParams struct {
+	SectionID int32
+	LessonIds []int32
+}
+
+func (q *Queries) UpdateLessonCommentsSectionID(ctx context.Context, arg UpdateLessonCommentsSectionIDParams) error {
+	_, err := q.db.Exec(ctx, updateLessonCommentsSectionID, arg.SectionID, arg.LessonIds)
+	return err
+}
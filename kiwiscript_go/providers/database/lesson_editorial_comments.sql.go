@@ -0,0 +1,192 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: lesson_editorial_comments.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createLessonEditorialComment = `-- name: CreateLessonEditorialComment :one
+
+
+INSERT INTO "lesson_editorial_comments" (
+  "body",
+  "language_slug",
+  "series_slug",
+  "lesson_id",
+  "author_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+) RETURNING id, body, is_resolved, language_slug, series_slug, lesson_id, author_id, created_at, updated_at
+`
+
+type CreateLessonEditorialCommentParams struct {
+	Body         string
+	LanguageSlug string
+	SeriesSlug   string
+	LessonID     int32
+	AuthorID     int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateLessonEditorialComment(ctx context.Context, arg CreateLessonEditorialCommentParams) (LessonEditorialComment, error) {
+	row := q.db.QueryRow(ctx, createLessonEditorialComment,
+		arg.Body,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.LessonID,
+		arg.AuthorID,
+	)
+	var i LessonEditorialComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.IsResolved,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findLessonEditorialCommentByLessonIDAndID = `-- name: FindLessonEditorialCommentByLessonIDAndID :one
+SELECT id, body, is_resolved, language_slug, series_slug, lesson_id, author_id, created_at, updated_at FROM "lesson_editorial_comments"
+WHERE "lesson_id" = $1 AND "id" = $2
+LIMIT 1
+`
+
+type FindLessonEditorialCommentByLessonIDAndIDParams struct {
+	LessonID int32
+	ID       int32
+}
+
+func (q *Queries) FindLessonEditorialCommentByLessonIDAndID(ctx context.Context, arg FindLessonEditorialCommentByLessonIDAndIDParams) (LessonEditorialComment, error) {
+	row := q.db.QueryRow(ctx, findLessonEditorialCommentByLessonIDAndID, arg.LessonID, arg.ID)
+	var i LessonEditorialComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.IsResolved,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findLessonEditorialCommentsWithAuthorByLessonID = `-- name: FindLessonEditorialCommentsWithAuthorByLessonID :many
+SELECT
+  lesson_editorial_comments.id, lesson_editorial_comments.body, lesson_editorial_comments.is_resolved, lesson_editorial_comments.language_slug, lesson_editorial_comments.series_slug, lesson_editorial_comments.lesson_id, lesson_editorial_comments.author_id, lesson_editorial_comments.created_at, lesson_editorial_comments.updated_at,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name"
+FROM "lesson_editorial_comments"
+INNER JOIN "users" ON "lesson_editorial_comments"."author_id" = "users"."id"
+WHERE "lesson_editorial_comments"."lesson_id" = $1
+ORDER BY "lesson_editorial_comments"."id" ASC
+`
+
+type FindLessonEditorialCommentsWithAuthorByLessonIDRow struct {
+	ID              int32
+	Body            string
+	IsResolved      bool
+	LanguageSlug    string
+	SeriesSlug      string
+	LessonID        int32
+	AuthorID        int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindLessonEditorialCommentsWithAuthorByLessonID(ctx context.Context, lessonID int32) ([]FindLessonEditorialCommentsWithAuthorByLessonIDRow, error) {
+	rows, err := q.db.Query(ctx, findLessonEditorialCommentsWithAuthorByLessonID, lessonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindLessonEditorialCommentsWithAuthorByLessonIDRow{}
+	for rows.Next() {
+		var i FindLessonEditorialCommentsWithAuthorByLessonIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.IsResolved,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.LessonID,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateLessonEditorialCommentIsResolved = `-- name: UpdateLessonEditorialCommentIsResolved :one
+UPDATE "lesson_editorial_comments" SET
+  "is_resolved" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, body, is_resolved, language_slug, series_slug, lesson_id, author_id, created_at, updated_at
+`
+
+type UpdateLessonEditorialCommentIsResolvedParams struct {
+	IsResolved bool
+	ID         int32
+}
+
+func (q *Queries) UpdateLessonEditorialCommentIsResolved(ctx context.Context, arg UpdateLessonEditorialCommentIsResolvedParams) (LessonEditorialComment, error) {
+	row := q.db.QueryRow(ctx, updateLessonEditorialCommentIsResolved, arg.IsResolved, arg.ID)
+	var i LessonEditorialComment
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.IsResolved,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.LessonID,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
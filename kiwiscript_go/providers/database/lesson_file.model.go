@@ -20,23 +20,23 @@ package db
 import "github.com/google/uuid"
 
 type LessonFileModel struct {
-	ID       uuid.UUID
-	Ext      string
-	Name     string
-	URL      string
-	LessonID int32
+	ID            uuid.UUID
+	Ext           string
+	Name          string
+	LessonID      int32
+	DownloadCount int32
 }
 
 type ToLessonFileModel interface {
-	ToLessonFileModel(url string) *LessonFileModel
+	ToLessonFileModel() *LessonFileModel
 }
 
-func (lf *LessonFile) ToLessonFileModel(url string) *LessonFileModel {
+func (lf *LessonFile) ToLessonFileModel() *LessonFileModel {
 	return &LessonFileModel{
-		ID:       lf.ID,
-		Ext:      lf.Ext,
-		Name:     lf.Name,
-		URL:      url,
-		LessonID: lf.LessonID,
+		ID:            lf.ID,
+		Ext:           lf.Ext,
+		Name:          lf.Name,
+		LessonID:      lf.LessonID,
+		DownloadCount: lf.DownloadCount,
 	}
 }
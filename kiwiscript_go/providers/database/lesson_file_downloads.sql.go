@@ -0,0 +1,65 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: lesson_file_downloads.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const upsertLessonFileDownload = `-- name: UpsertLessonFileDownload :one
+
+
+INSERT INTO "lesson_file_downloads" (
+  "file_id",
+  "user_id"
+) VALUES (
+  $1,
+  $2
+)
+ON CONFLICT ("file_id", "user_id") DO UPDATE SET
+  "downloads" = "lesson_file_downloads"."downloads" + 1,
+  "last_downloaded_at" = now(),
+  "updated_at" = now()
+RETURNING id, file_id, user_id, downloads, last_downloaded_at, created_at, updated_at
+`
+
+type UpsertLessonFileDownloadParams struct {
+	FileID uuid.UUID
+	UserID int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) UpsertLessonFileDownload(ctx context.Context, arg UpsertLessonFileDownloadParams) (LessonFileDownload, error) {
+	row := q.db.QueryRow(ctx, upsertLessonFileDownload, arg.FileID, arg.UserID)
+	var i LessonFileDownload
+	err := row.Scan(
+		&i.ID,
+		&i.FileID,
+		&i.UserID,
+		&i.Downloads,
+		&i.LastDownloadedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -25,7 +25,7 @@ INSERT INTO "lesson_files" (
     $3,
     $4,
     $5
-) RETURNING id, lesson_id, author_id, ext, name, created_at, updated_at
+) RETURNING id, lesson_id, author_id, ext, name, created_at, updated_at, download_count
 `
 
 type CreateLessonFileParams struct {
@@ -69,6 +69,7 @@ func (q *Queries) CreateLessonFile(ctx context.Context, arg CreateLessonFilePara
 		&i.Name,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DownloadCount,
 	)
 	return i, err
 }
@@ -83,8 +84,18 @@ func (q *Queries) DeleteLessonFile(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteLessonFilesByAuthorID = `-- name: DeleteLessonFilesByAuthorID :exec
+DELETE FROM "lesson_files"
+WHERE "author_id" = $1
+`
+
+func (q *Queries) DeleteLessonFilesByAuthorID(ctx context.Context, authorID int32) error {
+	_, err := q.db.Exec(ctx, deleteLessonFilesByAuthorID, authorID)
+	return err
+}
+
 const findLessonFileByIDAndLessonID = `-- name: FindLessonFileByIDAndLessonID :one
-SELECT id, lesson_id, author_id, ext, name, created_at, updated_at FROM "lesson_files"
+SELECT id, lesson_id, author_id, ext, name, created_at, updated_at, download_count FROM "lesson_files"
 WHERE "id" = $1 AND "lesson_id" = $2
 LIMIT 1
 `
@@ -105,12 +116,13 @@ func (q *Queries) FindLessonFileByIDAndLessonID(ctx context.Context, arg FindLes
 		&i.Name,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DownloadCount,
 	)
 	return i, err
 }
 
 const findLessonFilesByLessonID = `-- name: FindLessonFilesByLessonID :many
-SELECT id, lesson_id, author_id, ext, name, created_at, updated_at FROM "lesson_files"
+SELECT id, lesson_id, author_id, ext, name, created_at, updated_at, download_count FROM "lesson_files"
 WHERE "lesson_id" = $1
 ORDER BY "created_at" ASC
 `
@@ -132,6 +144,7 @@ func (q *Queries) FindLessonFilesByLessonID(ctx context.Context, lessonID int32)
 			&i.Name,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DownloadCount,
 		); err != nil {
 			return nil, err
 		}
@@ -143,11 +156,25 @@ func (q *Queries) FindLessonFilesByLessonID(ctx context.Context, lessonID int32)
 	return items, nil
 }
 
+const incrementLessonFileDownloadCount = `-- name: IncrementLessonFileDownloadCount :one
+UPDATE "lesson_files" SET
+    "download_count" = "download_count" + 1
+WHERE "id" = $1
+RETURNING "download_count"
+`
+
+func (q *Queries) IncrementLessonFileDownloadCount(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, incrementLessonFileDownloadCount, id)
+	var download_count int32
+	err := row.Scan(&download_count)
+	return download_count, err
+}
+
 const updateLessonFile = `-- name: UpdateLessonFile :one
 UPDATE "lesson_files" SET
     "name" = $1
 WHERE "id" = $2
-RETURNING id, lesson_id, author_id, ext, name, created_at, updated_at
+RETURNING id, lesson_id, author_id, ext, name, created_at, updated_at, download_count
 `
 
 type UpdateLessonFileParams struct {
@@ -166,6 +193,7 @@ func (q *Queries) UpdateLessonFile(ctx context.Context, arg UpdateLessonFilePara
 		&i.Name,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DownloadCount,
 	)
 	return i, err
 }
@@ -0,0 +1,71 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+type LessonNoteModel struct {
+	ID            int32
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionID     int32
+	LessonID      int32
+	SectionTitle  string
+	LessonTitle   string
+	CreatedAt     string
+	UpdatedAt     string
+}
+
+func (n *LessonNote) ToLessonNoteModel() *LessonNoteModel {
+	return &LessonNoteModel{
+		ID:            n.ID,
+		Body:          n.Body,
+		AnchorHeading: n.AnchorHeading,
+		AnchorSeconds: n.AnchorSeconds,
+		LanguageSlug:  n.LanguageSlug,
+		SeriesSlug:    n.SeriesSlug,
+		SectionID:     n.SectionID,
+		LessonID:      n.LessonID,
+		CreatedAt:     n.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:     n.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (n *FindAllLessonNotesWithLessonByUserIDAndSeriesSlugRow) ToLessonNoteModel() *LessonNoteModel {
+	return &LessonNoteModel{
+		ID:            n.ID,
+		Body:          n.Body,
+		AnchorHeading: n.AnchorHeading,
+		AnchorSeconds: n.AnchorSeconds,
+		LanguageSlug:  n.LanguageSlug,
+		SeriesSlug:    n.SeriesSlug,
+		SectionID:     n.SectionID,
+		LessonID:      n.LessonID,
+		SectionTitle:  n.SectionTitle,
+		LessonTitle:   n.LessonTitle,
+		CreatedAt:     n.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:     n.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (n *FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugRow) ToLessonNoteModel() *LessonNoteModel {
+	return (*FindAllLessonNotesWithLessonByUserIDAndSeriesSlugRow)(n).ToLessonNoteModel()
+}
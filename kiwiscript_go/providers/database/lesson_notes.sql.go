@@ -0,0 +1,424 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: lesson_notes.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countLessonNotesByUserIDAndLessonID = `-- name: CountLessonNotesByUserIDAndLessonID :one
+SELECT COUNT("id") FROM "lesson_notes"
+WHERE "user_id" = $1 AND "lesson_id" = $2
+LIMIT 1
+`
+
+type CountLessonNotesByUserIDAndLessonIDParams struct {
+	UserID   int32
+	LessonID int32
+}
+
+func (q *Queries) CountLessonNotesByUserIDAndLessonID(ctx context.Context, arg CountLessonNotesByUserIDAndLessonIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLessonNotesByUserIDAndLessonID, arg.UserID, arg.LessonID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countLessonNotesByUserIDAndSeriesSlug = `-- name: CountLessonNotesByUserIDAndSeriesSlug :one
+SELECT COUNT("id") FROM "lesson_notes"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1
+`
+
+type CountLessonNotesByUserIDAndSeriesSlugParams struct {
+	UserID     int32
+	SeriesSlug string
+}
+
+func (q *Queries) CountLessonNotesByUserIDAndSeriesSlug(ctx context.Context, arg CountLessonNotesByUserIDAndSeriesSlugParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countLessonNotesByUserIDAndSeriesSlug, arg.UserID, arg.SeriesSlug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createLessonNote = `-- name: CreateLessonNote :one
+
+INSERT INTO "lesson_notes" (
+    "body",
+    "anchor_heading",
+    "anchor_seconds",
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "lesson_id",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+) RETURNING id, body, anchor_heading, anchor_seconds, language_slug, series_slug, section_id, lesson_id, user_id, created_at, updated_at
+`
+
+type CreateLessonNoteParams struct {
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionID     int32
+	LessonID      int32
+	UserID        int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateLessonNote(ctx context.Context, arg CreateLessonNoteParams) (LessonNote, error) {
+	row := q.db.QueryRow(ctx, createLessonNote,
+		arg.Body,
+		arg.AnchorHeading,
+		arg.AnchorSeconds,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.LessonID,
+		arg.UserID,
+	)
+	var i LessonNote
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.AnchorHeading,
+		&i.AnchorSeconds,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteLessonNoteByID = `-- name: DeleteLessonNoteByID :exec
+DELETE FROM "lesson_notes"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteLessonNoteByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteLessonNoteByID, id)
+	return err
+}
+
+const findAllLessonNotesWithLessonByUserIDAndSeriesSlug = `-- name: FindAllLessonNotesWithLessonByUserIDAndSeriesSlug :many
+SELECT
+    lesson_notes.id, lesson_notes.body, lesson_notes.anchor_heading, lesson_notes.anchor_seconds, lesson_notes.language_slug, lesson_notes.series_slug, lesson_notes.section_id, lesson_notes.lesson_id, lesson_notes.user_id, lesson_notes.created_at, lesson_notes.updated_at,
+    "sections"."title" AS "section_title",
+    "lessons"."title" AS "lesson_title"
+FROM "lesson_notes"
+INNER JOIN "lessons" ON "lesson_notes"."lesson_id" = "lessons"."id"
+INNER JOIN "sections" ON "lesson_notes"."section_id" = "sections"."id"
+WHERE "lesson_notes"."user_id" = $1 AND "lesson_notes"."series_slug" = $2
+ORDER BY
+    "sections"."position" ASC,
+    "lessons"."position" ASC,
+    "lesson_notes"."anchor_seconds" ASC,
+    "lesson_notes"."id" ASC
+`
+
+type FindAllLessonNotesWithLessonByUserIDAndSeriesSlugParams struct {
+	UserID     int32
+	SeriesSlug string
+}
+
+type FindAllLessonNotesWithLessonByUserIDAndSeriesSlugRow struct {
+	ID            int32
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionID     int32
+	LessonID      int32
+	UserID        int32
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	SectionTitle  string
+	LessonTitle   string
+}
+
+func (q *Queries) FindAllLessonNotesWithLessonByUserIDAndSeriesSlug(ctx context.Context, arg FindAllLessonNotesWithLessonByUserIDAndSeriesSlugParams) ([]FindAllLessonNotesWithLessonByUserIDAndSeriesSlugRow, error) {
+	rows, err := q.db.Query(ctx, findAllLessonNotesWithLessonByUserIDAndSeriesSlug, arg.UserID, arg.SeriesSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindAllLessonNotesWithLessonByUserIDAndSeriesSlugRow{}
+	for rows.Next() {
+		var i FindAllLessonNotesWithLessonByUserIDAndSeriesSlugRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.AnchorHeading,
+			&i.AnchorSeconds,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SectionTitle,
+			&i.LessonTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLessonNoteByIDAndUserID = `-- name: FindLessonNoteByIDAndUserID :one
+SELECT id, body, anchor_heading, anchor_seconds, language_slug, series_slug, section_id, lesson_id, user_id, created_at, updated_at FROM "lesson_notes"
+WHERE "id" = $1 AND "user_id" = $2
+LIMIT 1
+`
+
+type FindLessonNoteByIDAndUserIDParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) FindLessonNoteByIDAndUserID(ctx context.Context, arg FindLessonNoteByIDAndUserIDParams) (LessonNote, error) {
+	row := q.db.QueryRow(ctx, findLessonNoteByIDAndUserID, arg.ID, arg.UserID)
+	var i LessonNote
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.AnchorHeading,
+		&i.AnchorSeconds,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findPaginatedLessonNotesByUserIDAndLessonID = `-- name: FindPaginatedLessonNotesByUserIDAndLessonID :many
+SELECT id, body, anchor_heading, anchor_seconds, language_slug, series_slug, section_id, lesson_id, user_id, created_at, updated_at FROM "lesson_notes"
+WHERE "user_id" = $1 AND "lesson_id" = $2
+ORDER BY "anchor_seconds" ASC, "id" ASC
+LIMIT $3 OFFSET $4
+`
+
+type FindPaginatedLessonNotesByUserIDAndLessonIDParams struct {
+	UserID   int32
+	LessonID int32
+	Limit    int32
+	Offset   int32
+}
+
+func (q *Queries) FindPaginatedLessonNotesByUserIDAndLessonID(ctx context.Context, arg FindPaginatedLessonNotesByUserIDAndLessonIDParams) ([]LessonNote, error) {
+	rows, err := q.db.Query(ctx, findPaginatedLessonNotesByUserIDAndLessonID,
+		arg.UserID,
+		arg.LessonID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LessonNote{}
+	for rows.Next() {
+		var i LessonNote
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.AnchorHeading,
+			&i.AnchorSeconds,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedLessonNotesWithLessonByUserIDAndSeriesSlug = `-- name: FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlug :many
+SELECT
+    lesson_notes.id, lesson_notes.body, lesson_notes.anchor_heading, lesson_notes.anchor_seconds, lesson_notes.language_slug, lesson_notes.series_slug, lesson_notes.section_id, lesson_notes.lesson_id, lesson_notes.user_id, lesson_notes.created_at, lesson_notes.updated_at,
+    "sections"."title" AS "section_title",
+    "lessons"."title" AS "lesson_title"
+FROM "lesson_notes"
+INNER JOIN "lessons" ON "lesson_notes"."lesson_id" = "lessons"."id"
+INNER JOIN "sections" ON "lesson_notes"."section_id" = "sections"."id"
+WHERE "lesson_notes"."user_id" = $1 AND "lesson_notes"."series_slug" = $2
+ORDER BY
+    "sections"."position" ASC,
+    "lessons"."position" ASC,
+    "lesson_notes"."anchor_seconds" ASC,
+    "lesson_notes"."id" ASC
+LIMIT $3 OFFSET $4
+`
+
+type FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugParams struct {
+	UserID     int32
+	SeriesSlug string
+	Limit      int32
+	Offset     int32
+}
+
+type FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugRow struct {
+	ID            int32
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionID     int32
+	LessonID      int32
+	UserID        int32
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	SectionTitle  string
+	LessonTitle   string
+}
+
+func (q *Queries) FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlug(ctx context.Context, arg FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugParams) ([]FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedLessonNotesWithLessonByUserIDAndSeriesSlug,
+		arg.UserID,
+		arg.SeriesSlug,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugRow{}
+	for rows.Next() {
+		var i FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.AnchorHeading,
+			&i.AnchorSeconds,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SectionTitle,
+			&i.LessonTitle,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateLessonNote = `-- name: UpdateLessonNote :one
+UPDATE "lesson_notes" SET
+    "body" = $1,
+    "anchor_heading" = $2,
+    "anchor_seconds" = $3,
+    "updated_at" = now()
+WHERE "id" = $4
+RETURNING id, body, anchor_heading, anchor_seconds, language_slug, series_slug, section_id, lesson_id, user_id, created_at, updated_at
+`
+
+type UpdateLessonNoteParams struct {
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+	ID            int32
+}
+
+func (q *Queries) UpdateLessonNote(ctx context.Context, arg UpdateLessonNoteParams) (LessonNote, error) {
+	row := q.db.QueryRow(ctx, updateLessonNote,
+		arg.Body,
+		arg.AnchorHeading,
+		arg.AnchorSeconds,
+		arg.ID,
+	)
+	var i LessonNote
+	err := row.Scan(
+		&i.ID,
+		&i.Body,
+		&i.AnchorHeading,
+		&i.AnchorSeconds,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LessonID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateLessonNotesSectionID = `-- name: UpdateLessonNotesSectionID :exec
+UPDATE "lesson_notes" SET
+  "section_id" = $1
+WHERE "lesson_id" = ANY($2::int[])
+`
+
+type UpdateLessonNotesSectionIDParams struct {
+	SectionID int32
+	LessonIds []int32
+}
+
+func (q *Queries) UpdateLessonNotesSectionID(ctx context.Context, arg UpdateLessonNotesSectionIDParams) error {
+	_, err := q.db.Exec(ctx, updateLessonNotesSectionID, arg.SectionID, arg.LessonIds)
+	return err
+}
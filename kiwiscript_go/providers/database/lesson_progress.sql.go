@@ -13,7 +13,7 @@ const completeLessonProgress = `-- name: CompleteLessonProgress :one
 UPDATE "lesson_progress"
 SET "completed_at" = now()
 WHERE "id" = $1
-RETURNING id, user_id, language_slug, series_slug, section_id, lesson_id, language_progress_id, series_progress_id, section_progress_id, completed_at, viewed_at, created_at, updated_at
+RETURNING id, user_id, language_slug, series_slug, section_id, lesson_id, language_progress_id, series_progress_id, section_progress_id, completed_at, viewed_at, created_at, updated_at, time_on_task_seconds
 `
 
 func (q *Queries) CompleteLessonProgress(ctx context.Context, id int32) (LessonProgress, error) {
@@ -33,6 +33,7 @@ func (q *Queries) CompleteLessonProgress(ctx context.Context, id int32) (LessonP
 		&i.ViewedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeOnTaskSeconds,
 	)
 	return i, err
 }
@@ -70,7 +71,7 @@ INSERT INTO "lesson_progress" (
     $6,
     $7,
     $8
-) RETURNING id, user_id, language_slug, series_slug, section_id, lesson_id, language_progress_id, series_progress_id, section_progress_id, completed_at, viewed_at, created_at, updated_at
+) RETURNING id, user_id, language_slug, series_slug, section_id, lesson_id, language_progress_id, series_progress_id, section_progress_id, completed_at, viewed_at, created_at, updated_at, time_on_task_seconds
 `
 
 type CreateLessonProgressParams struct {
@@ -126,6 +127,7 @@ func (q *Queries) CreateLessonProgress(ctx context.Context, arg CreateLessonProg
 		&i.ViewedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeOnTaskSeconds,
 	)
 	return i, err
 }
@@ -140,8 +142,49 @@ func (q *Queries) DeleteLessonProgress(ctx context.Context, id int32) error {
 	return err
 }
 
+const findAllLessonProgressByUserID = `-- name: FindAllLessonProgressByUserID :many
+SELECT id, user_id, language_slug, series_slug, section_id, lesson_id, language_progress_id, series_progress_id, section_progress_id, completed_at, viewed_at, created_at, updated_at, time_on_task_seconds FROM "lesson_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC
+`
+
+func (q *Queries) FindAllLessonProgressByUserID(ctx context.Context, userID int32) ([]LessonProgress, error) {
+	rows, err := q.db.Query(ctx, findAllLessonProgressByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []LessonProgress{}
+	for rows.Next() {
+		var i LessonProgress
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LessonID,
+			&i.LanguageProgressID,
+			&i.SeriesProgressID,
+			&i.SectionProgressID,
+			&i.CompletedAt,
+			&i.ViewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TimeOnTaskSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findLessonProgressBySlugsIDsAndUserID = `-- name: FindLessonProgressBySlugsIDsAndUserID :one
-SELECT id, user_id, language_slug, series_slug, section_id, lesson_id, language_progress_id, series_progress_id, section_progress_id, completed_at, viewed_at, created_at, updated_at FROM "lesson_progress"
+SELECT id, user_id, language_slug, series_slug, section_id, lesson_id, language_progress_id, series_progress_id, section_progress_id, completed_at, viewed_at, created_at, updated_at, time_on_task_seconds FROM "lesson_progress"
 WHERE
     "language_slug" = $1 AND
     "series_slug" = $2 AND
@@ -182,6 +225,7 @@ func (q *Queries) FindLessonProgressBySlugsIDsAndUserID(ctx context.Context, arg
 		&i.ViewedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeOnTaskSeconds,
 	)
 	return i, err
 }
@@ -197,6 +241,25 @@ func (q *Queries) RemovedLessonProgressCompletedAt(ctx context.Context, id int32
 	return err
 }
 
+const updateLessonProgressSection = `-- name: UpdateLessonProgressSection :exec
+UPDATE "lesson_progress" SET
+  "section_id" = $1,
+  "section_progress_id" = $2,
+  "updated_at" = NOW()
+WHERE "id" = $3
+`
+
+type UpdateLessonProgressSectionParams struct {
+	SectionID         int32
+	SectionProgressID int32
+	ID                int32
+}
+
+func (q *Queries) UpdateLessonProgressSection(ctx context.Context, arg UpdateLessonProgressSectionParams) error {
+	_, err := q.db.Exec(ctx, updateLessonProgressSection, arg.SectionID, arg.SectionProgressID, arg.ID)
+	return err
+}
+
 const updateLessonProgressViewedAt = `-- name: UpdateLessonProgressViewedAt :exec
 UPDATE "lesson_progress"
 SET "viewed_at" = now()
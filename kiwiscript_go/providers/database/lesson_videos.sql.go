@@ -98,6 +98,22 @@ func (q *Queries) GetLessonVideoByLessonID(ctx context.Context, lessonID int32)
 	return i, err
 }
 
+const reassignLessonVideosAuthor = `-- name: ReassignLessonVideosAuthor :exec
+UPDATE "lesson_videos" SET
+  "author_id" = $1
+WHERE "author_id" = $2
+`
+
+type ReassignLessonVideosAuthorParams struct {
+	NewAuthorID int32
+	OldAuthorID int32
+}
+
+func (q *Queries) ReassignLessonVideosAuthor(ctx context.Context, arg ReassignLessonVideosAuthorParams) error {
+	_, err := q.db.Exec(ctx, reassignLessonVideosAuthor, arg.NewAuthorID, arg.OldAuthorID)
+	return err
+}
+
 const updateLessonVideo = `-- name: UpdateLessonVideo :one
 UPDATE "lesson_videos" SET
   "url" = $1,
@@ -11,6 +11,48 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearLessonPublishAt = `-- name: ClearLessonPublishAt :exec
+UPDATE "lessons" SET
+  "publish_at" = NULL
+WHERE "id" = $1 AND "publish_at" <= now()
+`
+
+func (q *Queries) ClearLessonPublishAt(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, clearLessonPublishAt, id)
+	return err
+}
+
+const clearLessonUnpublishAt = `-- name: ClearLessonUnpublishAt :exec
+UPDATE "lessons" SET
+  "unpublish_at" = NULL
+WHERE "id" = $1 AND "unpublish_at" <= now()
+`
+
+func (q *Queries) ClearLessonUnpublishAt(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, clearLessonUnpublishAt, id)
+	return err
+}
+
+const compactLessonPositions = `-- name: CompactLessonPositions :exec
+UPDATE "lessons" SET
+  "position" = "ordered"."position"
+FROM (
+  SELECT
+    "section_lessons"."id",
+    (row_number() OVER (
+      ORDER BY "section_lessons"."position" ASC, "section_lessons"."id" ASC
+    ))::smallint AS "position"
+  FROM "lessons" AS "section_lessons"
+  WHERE "section_lessons"."section_id" = $1
+) AS "ordered"
+WHERE "lessons"."id" = "ordered"."id"
+`
+
+func (q *Queries) CompactLessonPositions(ctx context.Context, sectionID int32) error {
+	_, err := q.db.Exec(ctx, compactLessonPositions, sectionID)
+	return err
+}
+
 const countLessonsBySectionID = `-- name: CountLessonsBySectionID :one
 SELECT COUNT("id") FROM "lessons"
 WHERE "section_id" = $1
@@ -56,7 +98,7 @@ INSERT INTO "lessons" (
     SELECT COUNT("id") + 1 FROM "lessons"
     WHERE "section_id" = $3
   )
-) RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at
+) RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
 `
 
 type CreateLessonParams struct {
@@ -105,6 +147,10 @@ func (q *Queries) CreateLesson(ctx context.Context, arg CreateLessonParams) (Les
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 	)
 	return i, err
 }
@@ -139,11 +185,30 @@ func (q *Queries) DeleteLessonByID(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteLessonByIDAndVersion = `-- name: DeleteLessonByIDAndVersion :execrows
+DELETE FROM "lessons"
+WHERE "id" = $1 AND "version" = $2
+`
+
+type DeleteLessonByIDAndVersionParams struct {
+	ID      int32
+	Version int32
+}
+
+func (q *Queries) DeleteLessonByIDAndVersion(ctx context.Context, arg DeleteLessonByIDAndVersionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLessonByIDAndVersion, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const findCurrentLesson = `-- name: FindCurrentLesson :one
 SELECT
-    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at,
+    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at, lessons.version, lessons.publish_at, lessons.unpublish_at, lessons.is_preview,
     "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
     "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds",
     "lesson_articles"."id" AS "lesson_acticle_id",
     "lesson_articles"."content" AS "lesson_article_content",
     "lesson_videos"."id" AS "lesson_video_id",
@@ -172,24 +237,29 @@ type FindCurrentLessonParams struct {
 }
 
 type FindCurrentLessonRow struct {
-	ID                        int32
-	Title                     string
-	Position                  int16
-	IsPublished               bool
-	WatchTimeSeconds          int32
-	ReadTimeSeconds           int32
-	AuthorID                  int32
-	LanguageSlug              string
-	SeriesSlug                string
-	SectionID                 int32
-	CreatedAt                 pgtype.Timestamp
-	UpdatedAt                 pgtype.Timestamp
-	LessonProgressCompletedAt pgtype.Timestamp
-	LessonProgressViewedAt    pgtype.Timestamp
-	LessonActicleID           pgtype.Int4
-	LessonArticleContent      pgtype.Text
-	LessonVideoID             pgtype.Int4
-	LessonVideoUrl            pgtype.Text
+	ID                              int32
+	Title                           string
+	Position                        int16
+	IsPublished                     bool
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	AuthorID                        int32
+	LanguageSlug                    string
+	SeriesSlug                      string
+	SectionID                       int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	IsPreview                       bool
+	LessonProgressCompletedAt       pgtype.Timestamp
+	LessonProgressViewedAt          pgtype.Timestamp
+	LessonProgressTimeOnTaskSeconds int32
+	LessonActicleID                 pgtype.Int4
+	LessonArticleContent            pgtype.Text
+	LessonVideoID                   pgtype.Int4
+	LessonVideoUrl                  pgtype.Text
 }
 
 func (q *Queries) FindCurrentLesson(ctx context.Context, arg FindCurrentLessonParams) (FindCurrentLessonRow, error) {
@@ -213,8 +283,13 @@ func (q *Queries) FindCurrentLesson(ctx context.Context, arg FindCurrentLessonPa
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 		&i.LessonProgressCompletedAt,
 		&i.LessonProgressViewedAt,
+		&i.LessonProgressTimeOnTaskSeconds,
 		&i.LessonActicleID,
 		&i.LessonArticleContent,
 		&i.LessonVideoID,
@@ -224,7 +299,7 @@ func (q *Queries) FindCurrentLesson(ctx context.Context, arg FindCurrentLessonPa
 }
 
 const findLessonBySlugsAndIDs = `-- name: FindLessonBySlugsAndIDs :one
-SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at FROM "lessons"
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
 WHERE
   "language_slug" = $1 AND
   "series_slug" = $2 AND
@@ -261,13 +336,17 @@ func (q *Queries) FindLessonBySlugsAndIDs(ctx context.Context, arg FindLessonByS
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 	)
 	return i, err
 }
 
 const findLessonBySlugsAndIDsWithArticleAndVideo = `-- name: FindLessonBySlugsAndIDsWithArticleAndVideo :one
 SELECT
-    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at,
+    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at, lessons.version, lessons.publish_at, lessons.unpublish_at, lessons.is_preview,
     "lesson_articles"."id" AS "lesson_acticle_id",
     "lesson_articles"."content" AS "lesson_article_content",
     "lesson_videos"."id" AS "lesson_video_id",
@@ -303,6 +382,10 @@ type FindLessonBySlugsAndIDsWithArticleAndVideoRow struct {
 	SectionID            int32
 	CreatedAt            pgtype.Timestamp
 	UpdatedAt            pgtype.Timestamp
+	Version              int32
+	PublishAt            pgtype.Timestamp
+	UnpublishAt          pgtype.Timestamp
+	IsPreview            bool
 	LessonActicleID      pgtype.Int4
 	LessonArticleContent pgtype.Text
 	LessonVideoID        pgtype.Int4
@@ -330,6 +413,10 @@ func (q *Queries) FindLessonBySlugsAndIDsWithArticleAndVideo(ctx context.Context
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 		&i.LessonActicleID,
 		&i.LessonArticleContent,
 		&i.LessonVideoID,
@@ -339,7 +426,7 @@ func (q *Queries) FindLessonBySlugsAndIDsWithArticleAndVideo(ctx context.Context
 }
 
 const findLessonsBySectionID = `-- name: FindLessonsBySectionID :many
-SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at FROM "lessons"
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
 WHERE "section_id" = $1
 ORDER BY "position" ASC
 `
@@ -366,6 +453,277 @@ func (q *Queries) FindLessonsBySectionID(ctx context.Context, sectionID int32) (
 			&i.SectionID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLessonsBySectionIDForUpdate = `-- name: FindLessonsBySectionIDForUpdate :many
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
+WHERE "section_id" = $1
+ORDER BY "position" ASC
+FOR UPDATE
+`
+
+func (q *Queries) FindLessonsBySectionIDForUpdate(ctx context.Context, sectionID int32) ([]Lesson, error) {
+	rows, err := q.db.Query(ctx, findLessonsBySectionIDForUpdate, sectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lesson{}
+	for rows.Next() {
+		var i Lesson
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLessonsBySeriesSlugAndIDsForUpdate = `-- name: FindLessonsBySeriesSlugAndIDsForUpdate :many
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
+WHERE
+  "series_slug" = $1 AND
+  "id" = ANY($2::int[])
+ORDER BY "id" ASC
+FOR UPDATE
+`
+
+type FindLessonsBySeriesSlugAndIDsForUpdateParams struct {
+	SeriesSlug string
+	Ids        []int32
+}
+
+func (q *Queries) FindLessonsBySeriesSlugAndIDsForUpdate(ctx context.Context, arg FindLessonsBySeriesSlugAndIDsForUpdateParams) ([]Lesson, error) {
+	rows, err := q.db.Query(ctx, findLessonsBySeriesSlugAndIDsForUpdate, arg.SeriesSlug, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lesson{}
+	for rows.Next() {
+		var i Lesson
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLessonsBySlugsAndSectionIDAfterCursor = `-- name: FindLessonsBySlugsAndSectionIDAfterCursor :many
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
+WHERE
+    "language_slug" = $1 AND
+    "series_slug" = $2 AND
+    "section_id" = $3 AND
+    ("position", "id") > ($4::smallint, $5::int)
+ORDER BY "position" ASC, "id" ASC
+LIMIT $6
+`
+
+type FindLessonsBySlugsAndSectionIDAfterCursorParams struct {
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Position     int16
+	ID           int32
+	Limit        int32
+}
+
+func (q *Queries) FindLessonsBySlugsAndSectionIDAfterCursor(ctx context.Context, arg FindLessonsBySlugsAndSectionIDAfterCursorParams) ([]Lesson, error) {
+	rows, err := q.db.Query(ctx, findLessonsBySlugsAndSectionIDAfterCursor,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.Position,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lesson{}
+	for rows.Next() {
+		var i Lesson
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLessonsBySlugsAndSectionIDBeforeCursor = `-- name: FindLessonsBySlugsAndSectionIDBeforeCursor :many
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
+WHERE
+    "language_slug" = $1 AND
+    "series_slug" = $2 AND
+    "section_id" = $3 AND
+    ("position", "id") < ($4::smallint, $5::int)
+ORDER BY "position" DESC, "id" DESC
+LIMIT $6
+`
+
+type FindLessonsBySlugsAndSectionIDBeforeCursorParams struct {
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Position     int16
+	ID           int32
+	Limit        int32
+}
+
+func (q *Queries) FindLessonsBySlugsAndSectionIDBeforeCursor(ctx context.Context, arg FindLessonsBySlugsAndSectionIDBeforeCursorParams) ([]Lesson, error) {
+	rows, err := q.db.Query(ctx, findLessonsBySlugsAndSectionIDBeforeCursor,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.Position,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lesson{}
+	for rows.Next() {
+		var i Lesson
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLessonsDueForScheduling = `-- name: FindLessonsDueForScheduling :many
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
+WHERE "publish_at" <= now() OR "unpublish_at" <= now()
+ORDER BY "id" ASC
+LIMIT $1
+`
+
+func (q *Queries) FindLessonsDueForScheduling(ctx context.Context, limit int32) ([]Lesson, error) {
+	rows, err := q.db.Query(ctx, findLessonsDueForScheduling, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lesson{}
+	for rows.Next() {
+		var i Lesson
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
 		); err != nil {
 			return nil, err
 		}
@@ -378,7 +736,7 @@ func (q *Queries) FindLessonsBySectionID(ctx context.Context, sectionID int32) (
 }
 
 const findPaginatedLessonsBySlugsAndSectionID = `-- name: FindPaginatedLessonsBySlugsAndSectionID :many
-SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at FROM "lessons"
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
 WHERE
   "language_slug" = $1 AND
   "series_slug" = $2 AND
@@ -423,6 +781,10 @@ func (q *Queries) FindPaginatedLessonsBySlugsAndSectionID(ctx context.Context, a
 			&i.SectionID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
 		); err != nil {
 			return nil, err
 		}
@@ -435,7 +797,7 @@ func (q *Queries) FindPaginatedLessonsBySlugsAndSectionID(ctx context.Context, a
 }
 
 const findPaginatedPublishedLessonsBySlugsAndSectionID = `-- name: FindPaginatedPublishedLessonsBySlugsAndSectionID :many
-SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at FROM "lessons"
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
 WHERE
     "language_slug" = $1 AND
     "series_slug" = $2 AND
@@ -481,6 +843,10 @@ func (q *Queries) FindPaginatedPublishedLessonsBySlugsAndSectionID(ctx context.C
 			&i.SectionID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
 		); err != nil {
 			return nil, err
 		}
@@ -494,9 +860,10 @@ func (q *Queries) FindPaginatedPublishedLessonsBySlugsAndSectionID(ctx context.C
 
 const findPaginatedPublishedLessonsBySlugsAndSectionIDWithProgress = `-- name: FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgress :many
 SELECT
-    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at,
+    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at, lessons.version, lessons.publish_at, lessons.unpublish_at, lessons.is_preview,
     "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
-    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at"
+    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds"
 FROM "lessons"
 LEFT JOIN "lesson_progress" ON (
     "lessons"."id" = "lesson_progress"."lesson_id" AND
@@ -521,20 +888,25 @@ type FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgressParams struct {
 }
 
 type FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgressRow struct {
-	ID                        int32
-	Title                     string
-	Position                  int16
-	IsPublished               bool
-	WatchTimeSeconds          int32
-	ReadTimeSeconds           int32
-	AuthorID                  int32
-	LanguageSlug              string
-	SeriesSlug                string
-	SectionID                 int32
-	CreatedAt                 pgtype.Timestamp
-	UpdatedAt                 pgtype.Timestamp
-	LessonProgressCompletedAt pgtype.Timestamp
-	LessonProgressViewedAt    pgtype.Timestamp
+	ID                              int32
+	Title                           string
+	Position                        int16
+	IsPublished                     bool
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	AuthorID                        int32
+	LanguageSlug                    string
+	SeriesSlug                      string
+	SectionID                       int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	IsPreview                       bool
+	LessonProgressCompletedAt       pgtype.Timestamp
+	LessonProgressViewedAt          pgtype.Timestamp
+	LessonProgressTimeOnTaskSeconds pgtype.Int4
 }
 
 func (q *Queries) FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgress(ctx context.Context, arg FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgressParams) ([]FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgressRow, error) {
@@ -566,8 +938,13 @@ func (q *Queries) FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgress(c
 			&i.SectionID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
 			&i.LessonProgressCompletedAt,
 			&i.LessonProgressViewedAt,
+			&i.LessonProgressTimeOnTaskSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -581,9 +958,10 @@ func (q *Queries) FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgress(c
 
 const findPublishedLessonBySlugsAndIDsWithProgressArticleAndVideo = `-- name: FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideo :one
 SELECT
-    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at,
+    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at, lessons.version, lessons.publish_at, lessons.unpublish_at, lessons.is_preview,
     "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
     "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds",
     "lesson_articles"."id" AS "lesson_acticle_id",
     "lesson_articles"."content" AS "lesson_article_content",
     "lesson_videos"."id" AS "lesson_video_id",
@@ -613,24 +991,29 @@ type FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideoParams struct {
 }
 
 type FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideoRow struct {
-	ID                        int32
-	Title                     string
-	Position                  int16
-	IsPublished               bool
-	WatchTimeSeconds          int32
-	ReadTimeSeconds           int32
-	AuthorID                  int32
-	LanguageSlug              string
-	SeriesSlug                string
-	SectionID                 int32
-	CreatedAt                 pgtype.Timestamp
-	UpdatedAt                 pgtype.Timestamp
-	LessonProgressCompletedAt pgtype.Timestamp
-	LessonProgressViewedAt    pgtype.Timestamp
-	LessonActicleID           pgtype.Int4
-	LessonArticleContent      pgtype.Text
-	LessonVideoID             pgtype.Int4
-	LessonVideoUrl            pgtype.Text
+	ID                              int32
+	Title                           string
+	Position                        int16
+	IsPublished                     bool
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	AuthorID                        int32
+	LanguageSlug                    string
+	SeriesSlug                      string
+	SectionID                       int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	IsPreview                       bool
+	LessonProgressCompletedAt       pgtype.Timestamp
+	LessonProgressViewedAt          pgtype.Timestamp
+	LessonProgressTimeOnTaskSeconds pgtype.Int4
+	LessonActicleID                 pgtype.Int4
+	LessonArticleContent            pgtype.Text
+	LessonVideoID                   pgtype.Int4
+	LessonVideoUrl                  pgtype.Text
 }
 
 func (q *Queries) FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideo(ctx context.Context, arg FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideoParams) (FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideoRow, error) {
@@ -655,8 +1038,13 @@ func (q *Queries) FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideo(ct
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 		&i.LessonProgressCompletedAt,
 		&i.LessonProgressViewedAt,
+		&i.LessonProgressTimeOnTaskSeconds,
 		&i.LessonActicleID,
 		&i.LessonArticleContent,
 		&i.LessonVideoID,
@@ -665,40 +1053,412 @@ func (q *Queries) FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideo(ct
 	return i, err
 }
 
-const incrementLessonPosition = `-- name: IncrementLessonPosition :exec
-UPDATE "lessons" SET
-  "position" = "position" + 1
+const findPublishedLessonsBySlugsAndSectionIDAfterCursor = `-- name: FindPublishedLessonsBySlugsAndSectionIDAfterCursor :many
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
 WHERE
-  "section_id" = $1 AND
-  "position" < $2 AND
-  "position" >= $3
+    "language_slug" = $1 AND
+    "series_slug" = $2 AND
+    "section_id" = $3 AND
+    "is_published" = true AND
+    ("position", "id") > ($4::smallint, $5::int)
+ORDER BY "position" ASC, "id" ASC
+LIMIT $6
 `
 
-type IncrementLessonPositionParams struct {
-	SectionID  int32
-	Position   int16
-	Position_2 int16
-}
-
-func (q *Queries) IncrementLessonPosition(ctx context.Context, arg IncrementLessonPositionParams) error {
-	_, err := q.db.Exec(ctx, incrementLessonPosition, arg.SectionID, arg.Position, arg.Position_2)
-	return err
+type FindPublishedLessonsBySlugsAndSectionIDAfterCursorParams struct {
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Position     int16
+	ID           int32
+	Limit        int32
 }
 
-const updateLesson = `-- name: UpdateLesson :one
+func (q *Queries) FindPublishedLessonsBySlugsAndSectionIDAfterCursor(ctx context.Context, arg FindPublishedLessonsBySlugsAndSectionIDAfterCursorParams) ([]Lesson, error) {
+	rows, err := q.db.Query(ctx, findPublishedLessonsBySlugsAndSectionIDAfterCursor,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.Position,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lesson{}
+	for rows.Next() {
+		var i Lesson
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPublishedLessonsBySlugsAndSectionIDBeforeCursor = `-- name: FindPublishedLessonsBySlugsAndSectionIDBeforeCursor :many
+SELECT id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview FROM "lessons"
+WHERE
+    "language_slug" = $1 AND
+    "series_slug" = $2 AND
+    "section_id" = $3 AND
+    "is_published" = true AND
+    ("position", "id") < ($4::smallint, $5::int)
+ORDER BY "position" DESC, "id" DESC
+LIMIT $6
+`
+
+type FindPublishedLessonsBySlugsAndSectionIDBeforeCursorParams struct {
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Position     int16
+	ID           int32
+	Limit        int32
+}
+
+func (q *Queries) FindPublishedLessonsBySlugsAndSectionIDBeforeCursor(ctx context.Context, arg FindPublishedLessonsBySlugsAndSectionIDBeforeCursorParams) ([]Lesson, error) {
+	rows, err := q.db.Query(ctx, findPublishedLessonsBySlugsAndSectionIDBeforeCursor,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.Position,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lesson{}
+	for rows.Next() {
+		var i Lesson
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursor = `-- name: FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursor :many
+SELECT
+    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at, lessons.version, lessons.publish_at, lessons.unpublish_at, lessons.is_preview,
+    "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
+    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds"
+FROM "lessons"
+LEFT JOIN "lesson_progress" ON (
+    "lessons"."id" = "lesson_progress"."lesson_id" AND
+    "lesson_progress"."user_id" = $1
+)
+WHERE
+    "lessons"."language_slug" = $2 AND
+    "lessons"."series_slug" = $3 AND
+    "lessons"."section_id" = $4 AND
+    "lessons"."is_published" = true AND
+    ("lessons"."position", "lessons"."id") > ($5::smallint, $6::int)
+ORDER BY "lessons"."position" ASC, "lessons"."id" ASC
+LIMIT $7
+`
+
+type FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorParams struct {
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Position     int16
+	ID           int32
+	Limit        int32
+}
+
+type FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorRow struct {
+	ID                              int32
+	Title                           string
+	Position                        int16
+	IsPublished                     bool
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	AuthorID                        int32
+	LanguageSlug                    string
+	SeriesSlug                      string
+	SectionID                       int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	IsPreview                       bool
+	LessonProgressCompletedAt       pgtype.Timestamp
+	LessonProgressViewedAt          pgtype.Timestamp
+	LessonProgressTimeOnTaskSeconds pgtype.Int4
+}
+
+func (q *Queries) FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursor(ctx context.Context, arg FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorParams) ([]FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorRow, error) {
+	rows, err := q.db.Query(ctx, findPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursor,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.Position,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorRow{}
+	for rows.Next() {
+		var i FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+			&i.LessonProgressCompletedAt,
+			&i.LessonProgressViewedAt,
+			&i.LessonProgressTimeOnTaskSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursor = `-- name: FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursor :many
+SELECT
+    lessons.id, lessons.title, lessons.position, lessons.is_published, lessons.watch_time_seconds, lessons.read_time_seconds, lessons.author_id, lessons.language_slug, lessons.series_slug, lessons.section_id, lessons.created_at, lessons.updated_at, lessons.version, lessons.publish_at, lessons.unpublish_at, lessons.is_preview,
+    "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
+    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds"
+FROM "lessons"
+LEFT JOIN "lesson_progress" ON (
+    "lessons"."id" = "lesson_progress"."lesson_id" AND
+    "lesson_progress"."user_id" = $1
+)
+WHERE
+    "lessons"."language_slug" = $2 AND
+    "lessons"."series_slug" = $3 AND
+    "lessons"."section_id" = $4 AND
+    "lessons"."is_published" = true AND
+    ("lessons"."position", "lessons"."id") < ($5::smallint, $6::int)
+ORDER BY "lessons"."position" DESC, "lessons"."id" DESC
+LIMIT $7
+`
+
+type FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorParams struct {
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Position     int16
+	ID           int32
+	Limit        int32
+}
+
+type FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorRow struct {
+	ID                              int32
+	Title                           string
+	Position                        int16
+	IsPublished                     bool
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	AuthorID                        int32
+	LanguageSlug                    string
+	SeriesSlug                      string
+	SectionID                       int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	IsPreview                       bool
+	LessonProgressCompletedAt       pgtype.Timestamp
+	LessonProgressViewedAt          pgtype.Timestamp
+	LessonProgressTimeOnTaskSeconds pgtype.Int4
+}
+
+func (q *Queries) FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursor(ctx context.Context, arg FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorParams) ([]FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorRow, error) {
+	rows, err := q.db.Query(ctx, findPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursor,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.SectionID,
+		arg.Position,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorRow{}
+	for rows.Next() {
+		var i FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Position,
+			&i.IsPublished,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.IsPreview,
+			&i.LessonProgressCompletedAt,
+			&i.LessonProgressViewedAt,
+			&i.LessonProgressTimeOnTaskSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementLessonPosition = `-- name: IncrementLessonPosition :exec
 UPDATE "lessons" SET
-  "title" = $1
-WHERE "id" = $2
-RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at
+  "position" = "position" + 1
+WHERE
+  "section_id" = $1 AND
+  "position" < $2 AND
+  "position" >= $3
+`
+
+type IncrementLessonPositionParams struct {
+	SectionID  int32
+	Position   int16
+	Position_2 int16
+}
+
+func (q *Queries) IncrementLessonPosition(ctx context.Context, arg IncrementLessonPositionParams) error {
+	_, err := q.db.Exec(ctx, incrementLessonPosition, arg.SectionID, arg.Position, arg.Position_2)
+	return err
+}
+
+const reassignLessonsAuthor = `-- name: ReassignLessonsAuthor :exec
+UPDATE "lessons" SET
+  "author_id" = $1
+WHERE "author_id" = $2
+`
+
+type ReassignLessonsAuthorParams struct {
+	NewAuthorID int32
+	OldAuthorID int32
+}
+
+func (q *Queries) ReassignLessonsAuthor(ctx context.Context, arg ReassignLessonsAuthorParams) error {
+	_, err := q.db.Exec(ctx, reassignLessonsAuthor, arg.NewAuthorID, arg.OldAuthorID)
+	return err
+}
+
+const reorderLessons = `-- name: ReorderLessons :exec
+UPDATE "lessons" SET
+  "section_id" = $1,
+  "position" = array_position($2::int[], "id")::smallint,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE
+  "series_slug" = $3 AND
+  "id" = ANY($2::int[])
+`
+
+type ReorderLessonsParams struct {
+	SectionID  int32
+	Ids        []int32
+	SeriesSlug string
+}
+
+func (q *Queries) ReorderLessons(ctx context.Context, arg ReorderLessonsParams) error {
+	_, err := q.db.Exec(ctx, reorderLessons, arg.SectionID, arg.Ids, arg.SeriesSlug)
+	return err
+}
+
+const updateLesson = `-- name: UpdateLesson :one
+UPDATE "lessons" SET
+  "title" = $1,
+  "version" = "version" + 1
+WHERE "id" = $2 AND "version" = $3
+RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
 `
 
 type UpdateLessonParams struct {
-	Title string
-	ID    int32
+	Title   string
+	ID      int32
+	Version int32
 }
 
 func (q *Queries) UpdateLesson(ctx context.Context, arg UpdateLessonParams) (Lesson, error) {
-	row := q.db.QueryRow(ctx, updateLesson, arg.Title, arg.ID)
+	row := q.db.QueryRow(ctx, updateLesson, arg.Title, arg.ID, arg.Version)
 	var i Lesson
 	err := row.Scan(
 		&i.ID,
@@ -713,15 +1473,57 @@ func (q *Queries) UpdateLesson(ctx context.Context, arg UpdateLessonParams) (Les
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
+	)
+	return i, err
+}
+
+const updateLessonIsPreview = `-- name: UpdateLessonIsPreview :one
+UPDATE "lessons" SET
+  "is_preview" = $1,
+  "version" = "version" + 1
+WHERE "id" = $2
+RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
+`
+
+type UpdateLessonIsPreviewParams struct {
+	IsPreview bool
+	ID        int32
+}
+
+func (q *Queries) UpdateLessonIsPreview(ctx context.Context, arg UpdateLessonIsPreviewParams) (Lesson, error) {
+	row := q.db.QueryRow(ctx, updateLessonIsPreview, arg.IsPreview, arg.ID)
+	var i Lesson
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Position,
+		&i.IsPublished,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.AuthorID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 	)
 	return i, err
 }
 
 const updateLessonIsPublished = `-- name: UpdateLessonIsPublished :one
 UPDATE "lessons" SET
-  "is_published" = $1
+  "is_published" = $1,
+  "version" = "version" + 1
 WHERE "id" = $2
-RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at
+RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
 `
 
 type UpdateLessonIsPublishedParams struct {
@@ -745,6 +1547,48 @@ func (q *Queries) UpdateLessonIsPublished(ctx context.Context, arg UpdateLessonI
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
+	)
+	return i, err
+}
+
+const updateLessonIsPublishedByIDAndVersion = `-- name: UpdateLessonIsPublishedByIDAndVersion :one
+UPDATE "lessons" SET
+  "is_published" = $1,
+  "version" = "version" + 1
+WHERE "id" = $2 AND "version" = $3
+RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
+`
+
+type UpdateLessonIsPublishedByIDAndVersionParams struct {
+	IsPublished bool
+	ID          int32
+	Version     int32
+}
+
+func (q *Queries) UpdateLessonIsPublishedByIDAndVersion(ctx context.Context, arg UpdateLessonIsPublishedByIDAndVersionParams) (Lesson, error) {
+	row := q.db.QueryRow(ctx, updateLessonIsPublishedByIDAndVersion, arg.IsPublished, arg.ID, arg.Version)
+	var i Lesson
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Position,
+		&i.IsPublished,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.AuthorID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 	)
 	return i, err
 }
@@ -753,7 +1597,7 @@ const updateLessonPosition = `-- name: UpdateLessonPosition :one
 UPDATE "lessons" SET
   "position" = $1
 WHERE "id" = $2
-RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at
+RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
 `
 
 type UpdateLessonPositionParams struct {
@@ -777,6 +1621,10 @@ func (q *Queries) UpdateLessonPosition(ctx context.Context, arg UpdateLessonPosi
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 	)
 	return i, err
 }
@@ -797,6 +1645,52 @@ func (q *Queries) UpdateLessonReadTimeSeconds(ctx context.Context, arg UpdateLes
 	return err
 }
 
+const updateLessonSchedule = `-- name: UpdateLessonSchedule :one
+UPDATE "lessons" SET
+  "publish_at" = $1,
+  "unpublish_at" = $2,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE "id" = $3 AND "version" = $4
+RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
+`
+
+type UpdateLessonScheduleParams struct {
+	PublishAt   pgtype.Timestamp
+	UnpublishAt pgtype.Timestamp
+	ID          int32
+	Version     int32
+}
+
+func (q *Queries) UpdateLessonSchedule(ctx context.Context, arg UpdateLessonScheduleParams) (Lesson, error) {
+	row := q.db.QueryRow(ctx, updateLessonSchedule,
+		arg.PublishAt,
+		arg.UnpublishAt,
+		arg.ID,
+		arg.Version,
+	)
+	var i Lesson
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Position,
+		&i.IsPublished,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.AuthorID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
+	)
+	return i, err
+}
+
 const updateLessonWatchTimeSeconds = `-- name: UpdateLessonWatchTimeSeconds :exec
 UPDATE "lessons" SET
   "watch_time_seconds" = $1
@@ -816,19 +1710,26 @@ func (q *Queries) UpdateLessonWatchTimeSeconds(ctx context.Context, arg UpdateLe
 const updateLessonWithPosition = `-- name: UpdateLessonWithPosition :one
 UPDATE "lessons" SET
   "title" = $1,
-  "position" = $2
-WHERE "id" = $3
-RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at
+  "position" = $2,
+  "version" = "version" + 1
+WHERE "id" = $3 AND "version" = $4
+RETURNING id, title, position, is_published, watch_time_seconds, read_time_seconds, author_id, language_slug, series_slug, section_id, created_at, updated_at, version, publish_at, unpublish_at, is_preview
 `
 
 type UpdateLessonWithPositionParams struct {
 	Title    string
 	Position int16
 	ID       int32
+	Version  int32
 }
 
 func (q *Queries) UpdateLessonWithPosition(ctx context.Context, arg UpdateLessonWithPositionParams) (Lesson, error) {
-	row := q.db.QueryRow(ctx, updateLessonWithPosition, arg.Title, arg.Position, arg.ID)
+	row := q.db.QueryRow(ctx, updateLessonWithPosition,
+		arg.Title,
+		arg.Position,
+		arg.ID,
+		arg.Version,
+	)
 	var i Lesson
 	err := row.Scan(
 		&i.ID,
@@ -843,6 +1744,10 @@ func (q *Queries) UpdateLessonWithPosition(ctx context.Context, arg UpdateLesson
 		&i.SectionID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.IsPreview,
 	)
 	return i, err
 }
@@ -0,0 +1,56 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+CREATE TABLE "webhooks" (
+  "id" serial PRIMARY KEY,
+  "url" varchar(250) NOT NULL,
+  "secret" varchar(100) NOT NULL,
+  "events" varchar(50)[] NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "author_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries" (
+  "id" uuid PRIMARY KEY,
+  "webhook_id" int NOT NULL,
+  "event" varchar(50) NOT NULL,
+  "payload" text NOT NULL,
+  "status" varchar(10) NOT NULL DEFAULT 'pending',
+  "attempts" smallint NOT NULL DEFAULT 0,
+  "response_status" smallint NOT NULL DEFAULT 0,
+  "response_body" text NOT NULL DEFAULT '',
+  "next_attempt_at" timestamp,
+  "delivered_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "webhooks_author_id_idx" ON "webhooks" ("author_id");
+
+CREATE INDEX "webhooks_is_active_idx" ON "webhooks" ("is_active");
+
+CREATE INDEX "webhook_deliveries_webhook_id_idx" ON "webhook_deliveries" ("webhook_id");
+
+CREATE INDEX "webhook_deliveries_status_next_attempt_at_idx" ON "webhook_deliveries" ("status", "next_attempt_at");
+
+CREATE INDEX "webhook_deliveries_created_at_idx" ON "webhook_deliveries" ("created_at");
+
+ALTER TABLE "webhooks" ADD FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
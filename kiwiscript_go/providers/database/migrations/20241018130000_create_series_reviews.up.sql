@@ -0,0 +1,54 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+ALTER TABLE "series" ADD COLUMN "reviews_count" int NOT NULL DEFAULT 0;
+
+ALTER TABLE "series" ADD COLUMN "rating_total" int NOT NULL DEFAULT 0;
+
+CREATE TABLE "series_reviews" (
+  "id" serial PRIMARY KEY,
+  "rating" smallint NOT NULL,
+  "review" text NOT NULL,
+  "reply" text NOT NULL DEFAULT '',
+  "reply_author_id" int,
+  "replied_at" timestamp,
+  "is_hidden" boolean NOT NULL DEFAULT false,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "user_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now()),
+  CONSTRAINT "series_reviews_rating_check" CHECK ("rating" BETWEEN 1 AND 5)
+);
+
+CREATE INDEX "series_language_slug_rating_idx" ON "series" ("language_slug", ("rating_total"::real / GREATEST("reviews_count", 1)) DESC, "reviews_count" DESC, "id" DESC);
+
+CREATE UNIQUE INDEX "series_reviews_user_id_series_slug_unique_idx" ON "series_reviews" ("user_id", "series_slug");
+
+CREATE INDEX "series_reviews_series_slug_idx" ON "series_reviews" ("series_slug");
+
+CREATE INDEX "series_reviews_series_slug_is_hidden_idx" ON "series_reviews" ("series_slug", "is_hidden");
+
+CREATE INDEX "series_reviews_user_id_idx" ON "series_reviews" ("user_id");
+
+ALTER TABLE "series_reviews" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_reviews" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_reviews" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_reviews" ADD FOREIGN KEY ("reply_author_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,68 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+CREATE TABLE "lesson_comments" (
+  "id" serial PRIMARY KEY,
+  "body" text NOT NULL,
+  "parent_id" int,
+  "replies_count" int NOT NULL DEFAULT 0,
+  "upvotes_count" int NOT NULL DEFAULT 0,
+  "is_answer" boolean NOT NULL DEFAULT false,
+  "is_answered" boolean NOT NULL DEFAULT false,
+  "is_hidden" boolean NOT NULL DEFAULT false,
+  "is_locked" boolean NOT NULL DEFAULT false,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "section_id" int NOT NULL,
+  "lesson_id" int NOT NULL,
+  "author_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "lesson_comment_upvotes" (
+  "id" serial PRIMARY KEY,
+  "comment_id" int NOT NULL,
+  "user_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "lesson_comments_lesson_id_parent_id_idx" ON "lesson_comments" ("lesson_id", "parent_id");
+
+CREATE INDEX "lesson_comments_parent_id_idx" ON "lesson_comments" ("parent_id");
+
+CREATE INDEX "lesson_comments_author_id_idx" ON "lesson_comments" ("author_id");
+
+CREATE UNIQUE INDEX "lesson_comment_upvotes_comment_id_user_id_unique_idx" ON "lesson_comment_upvotes" ("comment_id", "user_id");
+
+CREATE INDEX "lesson_comment_upvotes_user_id_idx" ON "lesson_comment_upvotes" ("user_id");
+
+ALTER TABLE "lesson_comments" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_comments" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_comments" ADD FOREIGN KEY ("section_id") REFERENCES "sections" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_comments" ADD FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_comments" ADD FOREIGN KEY ("parent_id") REFERENCES "lesson_comments" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_comments" ADD FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_comment_upvotes" ADD FOREIGN KEY ("comment_id") REFERENCES "lesson_comments" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_comment_upvotes" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
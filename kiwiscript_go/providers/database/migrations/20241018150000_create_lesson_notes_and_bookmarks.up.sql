@@ -0,0 +1,82 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+CREATE TABLE "lesson_notes" (
+  "id" serial PRIMARY KEY,
+  "body" text NOT NULL,
+  "anchor_heading" varchar(250) NOT NULL DEFAULT '',
+  "anchor_seconds" int NOT NULL DEFAULT 0,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "section_id" int NOT NULL,
+  "lesson_id" int NOT NULL,
+  "user_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "series_bookmarks" (
+  "id" serial PRIMARY KEY,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "user_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "lesson_bookmarks" (
+  "id" serial PRIMARY KEY,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "section_id" int NOT NULL,
+  "lesson_id" int NOT NULL,
+  "user_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "lesson_notes_user_id_lesson_id_idx" ON "lesson_notes" ("user_id", "lesson_id");
+
+CREATE INDEX "lesson_notes_user_id_series_slug_idx" ON "lesson_notes" ("user_id", "series_slug");
+
+CREATE UNIQUE INDEX "series_bookmarks_user_id_series_slug_unique_idx" ON "series_bookmarks" ("user_id", "series_slug");
+
+CREATE UNIQUE INDEX "lesson_bookmarks_user_id_lesson_id_unique_idx" ON "lesson_bookmarks" ("user_id", "lesson_id");
+
+ALTER TABLE "lesson_notes" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_notes" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_notes" ADD FOREIGN KEY ("section_id") REFERENCES "sections" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_notes" ADD FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_notes" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_bookmarks" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_bookmarks" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_bookmarks" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_bookmarks" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_bookmarks" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_bookmarks" ADD FOREIGN KEY ("section_id") REFERENCES "sections" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_bookmarks" ADD FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_bookmarks" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,61 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+CREATE TABLE "user_learning_stats" (
+  "id" serial PRIMARY KEY,
+  "user_id" int NOT NULL,
+  "timezone" varchar(50) NOT NULL DEFAULT 'UTC',
+  "current_streak" int NOT NULL DEFAULT 0,
+  "longest_streak" int NOT NULL DEFAULT 0,
+  "last_activity_date" date,
+  "xp_total" int NOT NULL DEFAULT 0,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "xp_events" (
+  "id" serial PRIMARY KEY,
+  "user_id" int NOT NULL,
+  "source" varchar(10) NOT NULL,
+  "reference" varchar(100) NOT NULL,
+  "points" int NOT NULL,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "user_achievements" (
+  "id" serial PRIMARY KEY,
+  "user_id" int NOT NULL,
+  "achievement_slug" varchar(50) NOT NULL,
+  "unlocked_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "user_learning_stats_user_id_unique_idx" ON "user_learning_stats" ("user_id");
+
+CREATE INDEX "xp_events_user_id_idx" ON "xp_events" ("user_id");
+
+CREATE UNIQUE INDEX "xp_events_user_id_source_reference_unique_idx" ON "xp_events" ("user_id", "source", "reference");
+
+CREATE UNIQUE INDEX "user_achievements_user_id_achievement_slug_unique_idx" ON "user_achievements" ("user_id", "achievement_slug");
+
+ALTER TABLE "user_learning_stats" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "xp_events" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "user_achievements" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
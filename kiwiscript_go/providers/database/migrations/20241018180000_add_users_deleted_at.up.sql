@@ -0,0 +1,27 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+ALTER TABLE "users" ADD COLUMN "deleted_at" timestamp;
+
+CREATE INDEX "users_deleted_at_idx" ON "users" ("deleted_at");
+
+-- Users whose stored objects still have to be deleted after their purge committed
+CREATE TABLE "purged_user_objects" (
+    "user_id" integer PRIMARY KEY,
+    "created_at" timestamp NOT NULL DEFAULT (now())
+);
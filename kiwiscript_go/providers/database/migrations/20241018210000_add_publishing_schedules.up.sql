@@ -0,0 +1,33 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+ALTER TABLE "series" ADD COLUMN "publish_at" timestamp;
+ALTER TABLE "series" ADD COLUMN "unpublish_at" timestamp;
+
+ALTER TABLE "sections" ADD COLUMN "publish_at" timestamp;
+ALTER TABLE "sections" ADD COLUMN "unpublish_at" timestamp;
+
+ALTER TABLE "lessons" ADD COLUMN "publish_at" timestamp;
+ALTER TABLE "lessons" ADD COLUMN "unpublish_at" timestamp;
+
+CREATE INDEX "series_publish_at_idx" ON "series" ("publish_at") WHERE "publish_at" IS NOT NULL;
+CREATE INDEX "series_unpublish_at_idx" ON "series" ("unpublish_at") WHERE "unpublish_at" IS NOT NULL;
+CREATE INDEX "sections_publish_at_idx" ON "sections" ("publish_at") WHERE "publish_at" IS NOT NULL;
+CREATE INDEX "sections_unpublish_at_idx" ON "sections" ("unpublish_at") WHERE "unpublish_at" IS NOT NULL;
+CREATE INDEX "lessons_publish_at_idx" ON "lessons" ("publish_at") WHERE "publish_at" IS NOT NULL;
+CREATE INDEX "lessons_unpublish_at_idx" ON "lessons" ("unpublish_at") WHERE "unpublish_at" IS NOT NULL;
@@ -0,0 +1,83 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+ALTER TABLE "languages" ADD COLUMN "requires_editorial_review" boolean NOT NULL DEFAULT false;
+
+ALTER TABLE "series" ADD COLUMN "editorial_status" varchar(20) NOT NULL DEFAULT 'draft';
+
+CREATE TABLE "series_editorial_reviewers" (
+  "id" serial PRIMARY KEY,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "user_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "series_change_requests" (
+  "id" serial PRIMARY KEY,
+  "comment" text NOT NULL,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "reviewer_id" int NOT NULL,
+  "resolved_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "lesson_editorial_comments" (
+  "id" serial PRIMARY KEY,
+  "body" text NOT NULL,
+  "is_resolved" boolean NOT NULL DEFAULT false,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "lesson_id" int NOT NULL,
+  "author_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "series_editorial_reviewers_series_slug_user_id_unique_idx" ON "series_editorial_reviewers" ("series_slug", "user_id");
+
+CREATE INDEX "series_editorial_reviewers_user_id_idx" ON "series_editorial_reviewers" ("user_id");
+
+CREATE INDEX "series_change_requests_series_slug_resolved_at_idx" ON "series_change_requests" ("series_slug", "resolved_at");
+
+CREATE INDEX "series_change_requests_reviewer_id_idx" ON "series_change_requests" ("reviewer_id");
+
+CREATE INDEX "lesson_editorial_comments_lesson_id_idx" ON "lesson_editorial_comments" ("lesson_id");
+
+CREATE INDEX "lesson_editorial_comments_author_id_idx" ON "lesson_editorial_comments" ("author_id");
+
+ALTER TABLE "series_editorial_reviewers" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_editorial_reviewers" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_editorial_reviewers" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_change_requests" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_change_requests" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_change_requests" ADD FOREIGN KEY ("reviewer_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_editorial_comments" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_editorial_comments" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_editorial_comments" ADD FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_editorial_comments" ADD FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
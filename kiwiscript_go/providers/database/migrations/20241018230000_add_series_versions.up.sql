@@ -0,0 +1,66 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+CREATE TABLE "series_versions" (
+  "id" serial PRIMARY KEY,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "version" smallint NOT NULL,
+  "title" varchar(100) NOT NULL,
+  "sections_count" smallint NOT NULL,
+  "lessons_count" smallint NOT NULL,
+  "watch_time_seconds" int NOT NULL,
+  "read_time_seconds" int NOT NULL,
+  "author_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "series_version_lessons" (
+  "id" serial PRIMARY KEY,
+  "series_version_id" int NOT NULL,
+  "section_id" int NOT NULL,
+  "lesson_id" int NOT NULL,
+  "section_position" smallint NOT NULL,
+  "position" smallint NOT NULL
+);
+
+ALTER TABLE "series_progress" ADD COLUMN "series_version_id" int;
+
+ALTER TABLE "certificates" ADD COLUMN "series_version" smallint;
+
+CREATE UNIQUE INDEX "series_versions_series_slug_version_unique_idx" ON "series_versions" ("series_slug", "version");
+
+CREATE INDEX "series_versions_language_slug_idx" ON "series_versions" ("language_slug");
+
+CREATE INDEX "series_versions_author_id_idx" ON "series_versions" ("author_id");
+
+CREATE UNIQUE INDEX "series_version_lessons_series_version_id_lesson_id_unique_idx" ON "series_version_lessons" ("series_version_id", "lesson_id");
+
+CREATE INDEX "series_version_lessons_series_version_id_section_id_idx" ON "series_version_lessons" ("series_version_id", "section_id");
+
+CREATE INDEX "series_progress_series_version_id_idx" ON "series_progress" ("series_version_id");
+
+ALTER TABLE "series_versions" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_versions" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_versions" ADD FOREIGN KEY ("author_id") REFERENCES "users" ("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+ALTER TABLE "series_version_lessons" ADD FOREIGN KEY ("series_version_id") REFERENCES "series_versions" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_progress" ADD FOREIGN KEY ("series_version_id") REFERENCES "series_versions" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -0,0 +1,43 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+CREATE TABLE "language_slug_history" (
+  "id" serial PRIMARY KEY,
+  "language_id" int NOT NULL,
+  "old_slug" varchar(50) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "series_slug_history" (
+  "id" serial PRIMARY KEY,
+  "series_id" int NOT NULL,
+  "old_slug" varchar(100) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "language_slug_history_old_slug_unique_idx" ON "language_slug_history" ("old_slug");
+
+CREATE INDEX "language_slug_history_language_id_idx" ON "language_slug_history" ("language_id");
+
+CREATE UNIQUE INDEX "series_slug_history_old_slug_unique_idx" ON "series_slug_history" ("old_slug");
+
+CREATE INDEX "series_slug_history_series_id_idx" ON "series_slug_history" ("series_id");
+
+ALTER TABLE "language_slug_history" ADD FOREIGN KEY ("language_id") REFERENCES "languages" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "series_slug_history" ADD FOREIGN KEY ("series_id") REFERENCES "series" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,113 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+CREATE TABLE "organizations" (
+  "id" serial PRIMARY KEY,
+  "name" varchar(100) NOT NULL,
+  "owner_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "organization_members" (
+  "id" serial PRIMARY KEY,
+  "organization_id" int NOT NULL,
+  "user_id" int NOT NULL,
+  "role" varchar(10) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "organization_invitations" (
+  "id" serial PRIMARY KEY,
+  "organization_id" int NOT NULL,
+  "email" varchar(250) NOT NULL,
+  "role" varchar(10) NOT NULL,
+  "token" uuid NOT NULL,
+  "invited_by_id" int,
+  "expires_at" timestamp NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "cohorts" (
+  "id" serial PRIMARY KEY,
+  "organization_id" int NOT NULL,
+  "name" varchar(100) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "cohort_members" (
+  "id" serial PRIMARY KEY,
+  "cohort_id" int NOT NULL,
+  "user_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "cohort_series" (
+  "id" serial PRIMARY KEY,
+  "cohort_id" int NOT NULL,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "due_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "organizations_owner_id_idx" ON "organizations" ("owner_id");
+
+CREATE UNIQUE INDEX "organization_members_organization_id_user_id_unique_idx" ON "organization_members" ("organization_id", "user_id");
+
+CREATE INDEX "organization_members_user_id_idx" ON "organization_members" ("user_id");
+
+CREATE UNIQUE INDEX "organization_invitations_organization_id_email_unique_idx" ON "organization_invitations" ("organization_id", "email");
+
+CREATE UNIQUE INDEX "organization_invitations_token_unique_idx" ON "organization_invitations" ("token");
+
+CREATE UNIQUE INDEX "cohorts_organization_id_name_unique_idx" ON "cohorts" ("organization_id", "name");
+
+CREATE UNIQUE INDEX "cohort_members_cohort_id_user_id_unique_idx" ON "cohort_members" ("cohort_id", "user_id");
+
+CREATE INDEX "cohort_members_user_id_idx" ON "cohort_members" ("user_id");
+
+CREATE UNIQUE INDEX "cohort_series_cohort_id_series_slug_unique_idx" ON "cohort_series" ("cohort_id", "series_slug");
+
+CREATE INDEX "cohort_series_language_slug_idx" ON "cohort_series" ("language_slug");
+
+CREATE INDEX "cohort_series_series_slug_idx" ON "cohort_series" ("series_slug");
+
+ALTER TABLE "organizations" ADD FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+ALTER TABLE "organization_members" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "organization_members" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "organization_invitations" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "organization_invitations" ADD FOREIGN KEY ("invited_by_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE "cohorts" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "cohort_members" ADD FOREIGN KEY ("cohort_id") REFERENCES "cohorts" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "cohort_members" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "cohort_series" ADD FOREIGN KEY ("cohort_id") REFERENCES "cohorts" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "cohort_series" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "cohort_series" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
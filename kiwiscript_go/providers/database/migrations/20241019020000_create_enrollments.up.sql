@@ -0,0 +1,98 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+ALTER TABLE "series" ADD COLUMN "price" int NOT NULL DEFAULT 0;
+
+ALTER TABLE "series" ADD COLUMN "currency" varchar(3) NOT NULL DEFAULT 'usd';
+
+ALTER TABLE "lessons" ADD COLUMN "is_preview" boolean NOT NULL DEFAULT false;
+
+CREATE TABLE "coupons" (
+  "id" serial PRIMARY KEY,
+  "code" varchar(50) NOT NULL,
+  "series_slug" varchar(100),
+  "percent_off" smallint NOT NULL,
+  "max_redemptions" int NOT NULL DEFAULT 0,
+  "redemptions_count" int NOT NULL DEFAULT 0,
+  "expires_at" timestamp,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "created_by_id" int NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now()),
+  CONSTRAINT "coupons_percent_off_check" CHECK ("percent_off" BETWEEN 1 AND 100)
+);
+
+CREATE TABLE "payments" (
+  "id" serial PRIMARY KEY,
+  "user_id" int,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "provider" varchar(20) NOT NULL,
+  "provider_session_id" varchar(250) NOT NULL,
+  "amount" int NOT NULL,
+  "currency" varchar(3) NOT NULL,
+  "coupon_id" int,
+  "status" varchar(10) NOT NULL DEFAULT 'pending',
+  "completed_at" timestamp,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "enrollments" (
+  "id" serial PRIMARY KEY,
+  "user_id" int NOT NULL,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "source" varchar(20) NOT NULL,
+  "payment_id" int,
+  "organization_id" int,
+  "created_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "coupons_code_unique_idx" ON "coupons" ("code");
+
+CREATE INDEX "coupons_series_slug_idx" ON "coupons" ("series_slug");
+
+CREATE UNIQUE INDEX "payments_provider_session_id_unique_idx" ON "payments" ("provider_session_id");
+
+CREATE INDEX "payments_user_id_series_slug_idx" ON "payments" ("user_id", "series_slug");
+
+CREATE UNIQUE INDEX "enrollments_user_id_series_slug_unique_idx" ON "enrollments" ("user_id", "series_slug");
+
+CREATE INDEX "enrollments_series_slug_idx" ON "enrollments" ("series_slug");
+
+ALTER TABLE "coupons" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "coupons" ADD FOREIGN KEY ("created_by_id") REFERENCES "users" ("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+
+ALTER TABLE "payments" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE "payments" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "payments" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "payments" ADD FOREIGN KEY ("coupon_id") REFERENCES "coupons" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE "enrollments" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "enrollments" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "enrollments" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "enrollments" ADD FOREIGN KEY ("payment_id") REFERENCES "payments" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE "enrollments" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,27 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+DROP INDEX IF EXISTS "series_progress_language_slug_series_slug_completed_at_idx";
+
+DROP MATERIALIZED VIEW IF EXISTS "lesson_daily_stats";
+
+DROP MATERIALIZED VIEW IF EXISTS "section_daily_stats";
+
+DROP MATERIALIZED VIEW IF EXISTS "series_daily_stats";
+
+DROP TABLE IF EXISTS "analytics_refreshes";
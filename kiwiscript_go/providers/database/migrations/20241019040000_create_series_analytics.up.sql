@@ -0,0 +1,116 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+CREATE TABLE "analytics_refreshes" (
+  "name" varchar(50) PRIMARY KEY,
+  "refreshed_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE MATERIALIZED VIEW "series_daily_stats" AS
+SELECT
+  "stats"."language_slug",
+  "stats"."series_slug",
+  "stats"."day",
+  SUM("stats"."learners")::int AS "learners",
+  SUM("stats"."enrollments")::int AS "enrollments",
+  SUM("stats"."completions")::int AS "completions",
+  SUM("stats"."certificates")::int AS "certificates"
+FROM (
+  SELECT
+    "language_slug",
+    "series_slug",
+    "created_at"::date AS "day",
+    1 AS "learners",
+    0 AS "enrollments",
+    0 AS "completions",
+    0 AS "certificates"
+  FROM "series_progress"
+  UNION ALL
+  SELECT "language_slug", "series_slug", "completed_at"::date, 0, 0, 1, 0
+  FROM "series_progress"
+  WHERE "completed_at" IS NOT NULL
+  UNION ALL
+  SELECT "language_slug", "series_slug", "created_at"::date, 0, 1, 0, 0
+  FROM "enrollments"
+  UNION ALL
+  SELECT "language_slug", "series_slug", "created_at"::date, 0, 0, 0, 1
+  FROM "certificates"
+) AS "stats"
+GROUP BY "stats"."language_slug", "stats"."series_slug", "stats"."day";
+
+CREATE MATERIALIZED VIEW "section_daily_stats" AS
+SELECT
+  "stats"."language_slug",
+  "stats"."series_slug",
+  "stats"."section_id",
+  "stats"."day",
+  SUM("stats"."learners")::int AS "learners",
+  SUM("stats"."completions")::int AS "completions"
+FROM (
+  SELECT
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "created_at"::date AS "day",
+    1 AS "learners",
+    0 AS "completions"
+  FROM "section_progress"
+  UNION ALL
+  SELECT "language_slug", "series_slug", "section_id", "completed_at"::date, 0, 1
+  FROM "section_progress"
+  WHERE "completed_at" IS NOT NULL
+) AS "stats"
+GROUP BY "stats"."language_slug", "stats"."series_slug", "stats"."section_id", "stats"."day";
+
+CREATE MATERIALIZED VIEW "lesson_daily_stats" AS
+SELECT
+  "stats"."language_slug",
+  "stats"."series_slug",
+  "stats"."section_id",
+  "stats"."lesson_id",
+  "stats"."day",
+  SUM("stats"."learners")::int AS "learners",
+  SUM("stats"."completions")::int AS "completions"
+FROM (
+  SELECT
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "lesson_id",
+    "created_at"::date AS "day",
+    1 AS "learners",
+    0 AS "completions"
+  FROM "lesson_progress"
+  UNION ALL
+  SELECT "language_slug", "series_slug", "section_id", "lesson_id", "completed_at"::date, 0, 1
+  FROM "lesson_progress"
+  WHERE "completed_at" IS NOT NULL
+) AS "stats"
+GROUP BY "stats"."language_slug", "stats"."series_slug", "stats"."section_id", "stats"."lesson_id", "stats"."day";
+
+CREATE UNIQUE INDEX "series_daily_stats_language_slug_series_slug_day_unique_idx" ON "series_daily_stats" ("language_slug", "series_slug", "day");
+
+CREATE UNIQUE INDEX "section_daily_stats_section_id_day_unique_idx" ON "section_daily_stats" ("section_id", "day");
+
+CREATE INDEX "section_daily_stats_language_slug_series_slug_day_idx" ON "section_daily_stats" ("language_slug", "series_slug", "day");
+
+CREATE UNIQUE INDEX "lesson_daily_stats_lesson_id_day_unique_idx" ON "lesson_daily_stats" ("lesson_id", "day");
+
+CREATE INDEX "lesson_daily_stats_language_slug_series_slug_day_idx" ON "lesson_daily_stats" ("language_slug", "series_slug", "day");
+
+CREATE INDEX "series_progress_language_slug_series_slug_completed_at_idx" ON "series_progress" ("language_slug", "series_slug", "completed_at");
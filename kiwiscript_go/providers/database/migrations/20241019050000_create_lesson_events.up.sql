@@ -0,0 +1,105 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+CREATE TABLE "lesson_events" (
+  "id" bigserial,
+  "client_event_id" uuid NOT NULL,
+  "user_id" int NOT NULL,
+  "lesson_id" int NOT NULL,
+  "event_type" varchar(20) NOT NULL,
+  "scroll_depth" smallint NOT NULL DEFAULT 0,
+  "video_position_seconds" int NOT NULL DEFAULT 0,
+  "file_id" uuid,
+  "occurred_at" timestamp NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("id", "occurred_at")
+) PARTITION BY RANGE ("occurred_at");
+
+CREATE TABLE "lesson_engagement_daily" (
+  "id" serial PRIMARY KEY,
+  "user_id" int NOT NULL,
+  "lesson_id" int NOT NULL,
+  "day" date NOT NULL,
+  "time_on_task_seconds" int NOT NULL DEFAULT 0,
+  "opens" int NOT NULL DEFAULT 0,
+  "max_scroll_depth" smallint NOT NULL DEFAULT 0,
+  "video_plays" int NOT NULL DEFAULT 0,
+  "video_seeks" int NOT NULL DEFAULT 0,
+  "file_downloads" int NOT NULL DEFAULT 0,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "lesson_progress" ADD COLUMN "time_on_task_seconds" int NOT NULL DEFAULT 0;
+
+CREATE UNIQUE INDEX "lesson_events_user_id_client_event_id_occurred_at_unique_idx" ON "lesson_events" ("user_id", "client_event_id", "occurred_at");
+
+CREATE INDEX "lesson_events_user_id_lesson_id_occurred_at_idx" ON "lesson_events" ("user_id", "lesson_id", "occurred_at");
+
+CREATE UNIQUE INDEX "lesson_engagement_daily_user_id_lesson_id_day_unique_idx" ON "lesson_engagement_daily" ("user_id", "lesson_id", "day");
+
+CREATE INDEX "lesson_engagement_daily_lesson_id_day_idx" ON "lesson_engagement_daily" ("lesson_id", "day");
+
+ALTER TABLE "lesson_events" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_events" ADD FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_events" ADD FOREIGN KEY ("file_id") REFERENCES "lesson_files" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_engagement_daily" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_engagement_daily" ADD FOREIGN KEY ("lesson_id") REFERENCES "lessons" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- Partitions are named lesson_events_YYYY_MM so they sort chronologically
+CREATE FUNCTION "create_lesson_events_partition"("month" date) RETURNS void AS $$
+DECLARE
+  "starts_at" date := date_trunc('month', "month")::date;
+BEGIN
+  EXECUTE format(
+    'CREATE TABLE IF NOT EXISTS %I PARTITION OF "lesson_events" FOR VALUES FROM (%L) TO (%L)',
+    'lesson_events_' || to_char("starts_at", 'YYYY_MM'),
+    "starts_at",
+    ("starts_at" + interval '1 month')::date
+  );
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION "drop_lesson_events_partitions"("before" date) RETURNS int AS $$
+DECLARE
+  "partition_name" text;
+  "dropped" int := 0;
+BEGIN
+  FOR "partition_name" IN
+    SELECT "child"."relname"
+    FROM "pg_inherits"
+    INNER JOIN "pg_class" AS "parent" ON "parent"."oid" = "pg_inherits"."inhparent"
+    INNER JOIN "pg_class" AS "child" ON "child"."oid" = "pg_inherits"."inhrelid"
+    WHERE
+      "parent"."relname" = 'lesson_events' AND
+      "child"."relname" < 'lesson_events_' || to_char(date_trunc('month', "before"), 'YYYY_MM')
+  LOOP
+    EXECUTE format('DROP TABLE IF EXISTS %I', "partition_name");
+    "dropped" := "dropped" + 1;
+  END LOOP;
+
+  RETURN "dropped";
+END;
+$$ LANGUAGE plpgsql;
+
+SELECT "create_lesson_events_partition"((date_trunc('month', now()) + "offset" * interval '1 month')::date)
+FROM generate_series(-1, 1) AS "offset";
@@ -0,0 +1,37 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+ALTER TABLE "lesson_files" ADD COLUMN "download_count" int NOT NULL DEFAULT 0;
+
+CREATE TABLE "lesson_file_downloads" (
+  "id" serial PRIMARY KEY,
+  "file_id" uuid NOT NULL,
+  "user_id" int NOT NULL,
+  "downloads" int NOT NULL DEFAULT 1,
+  "last_downloaded_at" timestamp NOT NULL DEFAULT (now()),
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX "lesson_file_downloads_file_id_user_id_unique_idx" ON "lesson_file_downloads" ("file_id", "user_id");
+
+CREATE INDEX "lesson_file_downloads_user_id_idx" ON "lesson_file_downloads" ("user_id");
+
+ALTER TABLE "lesson_file_downloads" ADD FOREIGN KEY ("file_id") REFERENCES "lesson_files" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "lesson_file_downloads" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
@@ -0,0 +1,40 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+CREATE TABLE "organization_licenses" (
+  "id" serial PRIMARY KEY,
+  "organization_id" int NOT NULL,
+  "language_slug" varchar(50) NOT NULL,
+  "series_slug" varchar(100) NOT NULL,
+  "seats" int NOT NULL,
+  "granted_by_id" int,
+  "created_at" timestamp NOT NULL DEFAULT (now()),
+  "updated_at" timestamp NOT NULL DEFAULT (now()),
+  CONSTRAINT "organization_licenses_seats_check" CHECK ("seats" > 0)
+);
+
+CREATE UNIQUE INDEX "organization_licenses_organization_id_series_slug_unique_idx" ON "organization_licenses" ("organization_id", "series_slug");
+
+CREATE INDEX "organization_licenses_series_slug_idx" ON "organization_licenses" ("series_slug");
+
+ALTER TABLE "organization_licenses" ADD FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "organization_licenses" ADD FOREIGN KEY ("language_slug") REFERENCES "languages" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "organization_licenses" ADD FOREIGN KEY ("series_slug") REFERENCES "series" ("slug") ON DELETE CASCADE ON UPDATE CASCADE;
+
+ALTER TABLE "organization_licenses" ADD FOREIGN KEY ("granted_by_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;
@@ -9,6 +9,11 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AnalyticsRefresh struct {
+	Name        string
+	RefreshedAt pgtype.Timestamp
+}
+
 type AuthProvider struct {
 	ID        int32
 	Email     string
@@ -29,17 +34,69 @@ type Certificate struct {
 	CompletedAt      pgtype.Timestamp
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	SeriesVersion    pgtype.Int2
+}
+
+type Cohort struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type CohortMember struct {
+	ID        int32
+	CohortID  int32
+	UserID    int32
+	CreatedAt pgtype.Timestamp
+}
+
+type CohortSeries struct {
+	ID           int32
+	CohortID     int32
+	LanguageSlug string
+	SeriesSlug   string
+	DueAt        pgtype.Timestamp
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
+type Coupon struct {
+	ID               int32
+	Code             string
+	SeriesSlug       pgtype.Text
+	PercentOff       int16
+	MaxRedemptions   int32
+	RedemptionsCount int32
+	ExpiresAt        pgtype.Timestamp
+	IsActive         bool
+	CreatedByID      int32
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+}
+
+type Enrollment struct {
+	ID             int32
+	UserID         int32
+	LanguageSlug   string
+	SeriesSlug     string
+	Source         string
+	PaymentID      pgtype.Int4
+	OrganizationID pgtype.Int4
+	CreatedAt      pgtype.Timestamp
 }
 
 type Language struct {
-	ID          int32
-	Name        string
-	Slug        string
-	Icon        string
-	SeriesCount int16
-	AuthorID    int32
-	CreatedAt   pgtype.Timestamp
-	UpdatedAt   pgtype.Timestamp
+	ID                      int32
+	Name                    string
+	Slug                    string
+	Icon                    string
+	SeriesCount             int16
+	AuthorID                int32
+	CreatedAt               pgtype.Timestamp
+	UpdatedAt               pgtype.Timestamp
+	RequiresEditorialReview bool
 }
 
 type LanguageProgress struct {
@@ -52,6 +109,13 @@ type LanguageProgress struct {
 	UpdatedAt       pgtype.Timestamp
 }
 
+type LanguageSlugHistory struct {
+	ID         int32
+	LanguageID int32
+	OldSlug    string
+	CreatedAt  pgtype.Timestamp
+}
+
 type Lesson struct {
 	ID               int32
 	Title            string
@@ -65,6 +129,10 @@ type Lesson struct {
 	SectionID        int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	Version          int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	IsPreview        bool
 }
 
 type LessonArticle struct {
@@ -75,16 +143,128 @@ type LessonArticle struct {
 	ReadTimeSeconds int32
 	CreatedAt       pgtype.Timestamp
 	UpdatedAt       pgtype.Timestamp
+	Version         int32
 }
 
-type LessonFile struct {
-	ID        uuid.UUID
-	LessonID  int32
-	AuthorID  int32
-	Ext       string
-	Name      string
+type LessonBookmark struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	UserID       int32
+	CreatedAt    pgtype.Timestamp
+}
+
+type LessonComment struct {
+	ID           int32
+	Body         string
+	ParentID     pgtype.Int4
+	RepliesCount int32
+	UpvotesCount int32
+	IsAnswer     bool
+	IsAnswered   bool
+	IsHidden     bool
+	IsLocked     bool
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	AuthorID     int32
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
+type LessonCommentUpvote struct {
+	ID        int32
+	CommentID int32
+	UserID    int32
 	CreatedAt pgtype.Timestamp
-	UpdatedAt pgtype.Timestamp
+}
+
+type LessonDailyStat struct {
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	Day          pgtype.Date
+	Learners     int32
+	Completions  int32
+}
+
+type LessonEditorialComment struct {
+	ID           int32
+	Body         string
+	IsResolved   bool
+	LanguageSlug string
+	SeriesSlug   string
+	LessonID     int32
+	AuthorID     int32
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
+type LessonEngagementDaily struct {
+	ID                int32
+	UserID            int32
+	LessonID          int32
+	Day               pgtype.Date
+	TimeOnTaskSeconds int32
+	Opens             int32
+	MaxScrollDepth    int16
+	VideoPlays        int32
+	VideoSeeks        int32
+	FileDownloads     int32
+	CreatedAt         pgtype.Timestamp
+	UpdatedAt         pgtype.Timestamp
+}
+
+type LessonEvent struct {
+	ID                   int64
+	ClientEventID        uuid.UUID
+	UserID               int32
+	LessonID             int32
+	EventType            string
+	ScrollDepth          int16
+	VideoPositionSeconds int32
+	FileID               pgtype.UUID
+	OccurredAt           pgtype.Timestamp
+	CreatedAt            pgtype.Timestamp
+}
+
+type LessonFile struct {
+	ID            uuid.UUID
+	LessonID      int32
+	AuthorID      int32
+	Ext           string
+	Name          string
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+	DownloadCount int32
+}
+
+type LessonFileDownload struct {
+	ID               int32
+	FileID           uuid.UUID
+	UserID           int32
+	Downloads        int32
+	LastDownloadedAt pgtype.Timestamp
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+}
+
+type LessonNote struct {
+	ID            int32
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionID     int32
+	LessonID      int32
+	UserID        int32
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
 }
 
 type LessonProgress struct {
@@ -101,6 +281,7 @@ type LessonProgress struct {
 	ViewedAt           pgtype.Timestamp
 	CreatedAt          pgtype.Timestamp
 	UpdatedAt          pgtype.Timestamp
+	TimeOnTaskSeconds  int32
 }
 
 type LessonVideo struct {
@@ -113,6 +294,64 @@ type LessonVideo struct {
 	UpdatedAt        pgtype.Timestamp
 }
 
+type Organization struct {
+	ID        int32
+	Name      string
+	OwnerID   int32
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type OrganizationInvitation struct {
+	ID             int32
+	OrganizationID int32
+	Email          string
+	Role           string
+	Token          uuid.UUID
+	InvitedByID    pgtype.Int4
+	ExpiresAt      pgtype.Timestamp
+	CreatedAt      pgtype.Timestamp
+}
+
+type OrganizationLicense struct {
+	ID             int32
+	OrganizationID int32
+	LanguageSlug   string
+	SeriesSlug     string
+	Seats          int32
+	GrantedByID    pgtype.Int4
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type OrganizationMember struct {
+	ID             int32
+	OrganizationID int32
+	UserID         int32
+	Role           string
+	CreatedAt      pgtype.Timestamp
+}
+
+type Payment struct {
+	ID                int32
+	UserID            pgtype.Int4
+	LanguageSlug      string
+	SeriesSlug        string
+	Provider          string
+	ProviderSessionID string
+	Amount            int32
+	Currency          string
+	CouponID          pgtype.Int4
+	Status            string
+	CompletedAt       pgtype.Timestamp
+	CreatedAt         pgtype.Timestamp
+}
+
+type PurgedUserObject struct {
+	UserID    int32
+	CreatedAt pgtype.Timestamp
+}
+
 type Section struct {
 	ID               int32
 	Title            string
@@ -127,6 +366,18 @@ type Section struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	Version          int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+}
+
+type SectionDailyStat struct {
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Day          pgtype.Date
+	Learners     int32
+	Completions  int32
 }
 
 type SectionProgress struct {
@@ -158,6 +409,50 @@ type Series struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
+}
+
+type SeriesBookmark struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	UserID       int32
+	CreatedAt    pgtype.Timestamp
+}
+
+type SeriesChangeRequest struct {
+	ID           int32
+	Comment      string
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewerID   int32
+	ResolvedAt   pgtype.Timestamp
+	CreatedAt    pgtype.Timestamp
+}
+
+type SeriesDailyStat struct {
+	LanguageSlug string
+	SeriesSlug   string
+	Day          pgtype.Date
+	Learners     int32
+	Enrollments  int32
+	Completions  int32
+	Certificates int32
+}
+
+type SeriesEditorialReviewer struct {
+	ID           int32
+	LanguageSlug string
+	SeriesSlug   string
+	UserID       int32
+	CreatedAt    pgtype.Timestamp
 }
 
 type SeriesPicture struct {
@@ -182,6 +477,52 @@ type SeriesProgress struct {
 	ViewedAt           pgtype.Timestamp
 	CreatedAt          pgtype.Timestamp
 	UpdatedAt          pgtype.Timestamp
+	SeriesVersionID    pgtype.Int4
+}
+
+type SeriesReview struct {
+	ID            int32
+	Rating        int16
+	Review        string
+	Reply         string
+	ReplyAuthorID pgtype.Int4
+	RepliedAt     pgtype.Timestamp
+	IsHidden      bool
+	LanguageSlug  string
+	SeriesSlug    string
+	UserID        int32
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+}
+
+type SeriesSlugHistory struct {
+	ID        int32
+	SeriesID  int32
+	OldSlug   string
+	CreatedAt pgtype.Timestamp
+}
+
+type SeriesVersion struct {
+	ID               int32
+	LanguageSlug     string
+	SeriesSlug       string
+	Version          int16
+	Title            string
+	SectionsCount    int16
+	LessonsCount     int16
+	WatchTimeSeconds int32
+	ReadTimeSeconds  int32
+	AuthorID         int32
+	CreatedAt        pgtype.Timestamp
+}
+
+type SeriesVersionLesson struct {
+	ID              int32
+	SeriesVersionID int32
+	SectionID       int32
+	LessonID        int32
+	SectionPosition int16
+	Position        int16
 }
 
 type User struct {
@@ -197,6 +538,35 @@ type User struct {
 	Password    pgtype.Text
 	CreatedAt   pgtype.Timestamp
 	UpdatedAt   pgtype.Timestamp
+	DeletedAt   pgtype.Timestamp
+}
+
+type UserAchievement struct {
+	ID              int32
+	UserID          int32
+	AchievementSlug string
+	UnlockedAt      pgtype.Timestamp
+}
+
+type UserDataExport struct {
+	ID        uuid.UUID
+	UserID    int32
+	Status    string
+	ExpiresAt pgtype.Timestamp
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type UserLearningStat struct {
+	ID               int32
+	UserID           int32
+	Timezone         string
+	CurrentStreak    int32
+	LongestStreak    int32
+	LastActivityDate pgtype.Date
+	XpTotal          int32
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
 }
 
 type UserPicture struct {
@@ -217,3 +587,40 @@ type UserProfile struct {
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
 }
+
+type Webhook struct {
+	ID        int32
+	Url       string
+	Secret    string
+	Events    []string
+	IsActive  bool
+	AuthorID  int32
+	CreatedAt pgtype.Timestamp
+	UpdatedAt pgtype.Timestamp
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	WebhookID      int32
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int16
+	ResponseStatus int16
+	ResponseBody   string
+	NextAttemptAt  pgtype.Timestamp
+	DeliveredAt    pgtype.Timestamp
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+type XpEvent struct {
+	ID           int32
+	UserID       int32
+	Source       string
+	Reference    string
+	Points       int32
+	LanguageSlug string
+	SeriesSlug   string
+	CreatedAt    pgtype.Timestamp
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+const (
+	OrganizationRoleAdmin  string = "admin"
+	OrganizationRoleMember string = "member"
+)
+
+type OrganizationModel struct {
+	ID        int32
+	Name      string
+	OwnerID   int32
+	CreatedAt string
+	UpdatedAt string
+}
+
+func (o *Organization) ToOrganizationModel() *OrganizationModel {
+	return &OrganizationModel{
+		ID:        o.ID,
+		Name:      o.Name,
+		OwnerID:   o.OwnerID,
+		CreatedAt: o.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: o.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type OrganizationMemberModel struct {
+	OrganizationID int32
+	UserID         int32
+	FirstName      string
+	LastName       string
+	Email          string
+	Role           string
+	CreatedAt      string
+}
+
+func (m *FindPaginatedOrganizationMembersWithUserRow) ToOrganizationMemberModel() *OrganizationMemberModel {
+	return &OrganizationMemberModel{
+		OrganizationID: m.OrganizationID,
+		UserID:         m.UserID,
+		FirstName:      m.UserFirstName,
+		LastName:       m.UserLastName,
+		Email:          m.UserEmail,
+		Role:           m.Role,
+		CreatedAt:      m.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type OrganizationInvitationModel struct {
+	OrganizationID int32
+	Email          string
+	Role           string
+	ExpiresAt      string
+	CreatedAt      string
+}
+
+func (i *OrganizationInvitation) ToOrganizationInvitationModel() *OrganizationInvitationModel {
+	return &OrganizationInvitationModel{
+		OrganizationID: i.OrganizationID,
+		Email:          i.Email,
+		Role:           i.Role,
+		ExpiresAt:      i.ExpiresAt.Time.Format(time.RFC3339),
+		CreatedAt:      i.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type CohortModel struct {
+	ID             int32
+	OrganizationID int32
+	Name           string
+	CreatedAt      string
+	UpdatedAt      string
+}
+
+func (c *Cohort) ToCohortModel() *CohortModel {
+	return &CohortModel{
+		ID:             c.ID,
+		OrganizationID: c.OrganizationID,
+		Name:           c.Name,
+		CreatedAt:      c.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:      c.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type CohortSeriesModel struct {
+	CohortID     int32
+	LanguageSlug string
+	SeriesSlug   string
+	DueAt        string
+	CreatedAt    string
+	UpdatedAt    string
+}
+
+func (s *CohortSeries) ToCohortSeriesModel() *CohortSeriesModel {
+	var dueAt string
+	if s.DueAt.Valid {
+		dueAt = s.DueAt.Time.Format(time.RFC3339)
+	}
+
+	return &CohortSeriesModel{
+		CohortID:     s.CohortID,
+		LanguageSlug: s.LanguageSlug,
+		SeriesSlug:   s.SeriesSlug,
+		DueAt:        dueAt,
+		CreatedAt:    s.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:    s.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// CohortProgressModel is the progress of a cohort member in one of the series
+// assigned to the cohort, members that never started the series have zeroed
+// counters.
+type CohortProgressModel struct {
+	UserID            int32
+	FirstName         string
+	LastName          string
+	Email             string
+	LanguageSlug      string
+	SeriesSlug        string
+	SeriesTitle       string
+	DueAt             string
+	IsOverdue         bool
+	StartedSections   int16
+	CompletedSections int16
+	TotalSections     int16
+	CompletedLessons  int16
+	TotalLessons      int16
+	CompletedAt       string
+	CertificateID     string
+}
+
+func (r *FindCohortProgressRow) ToCohortProgressModel(now time.Time) *CohortProgressModel {
+	var dueAt, completedAt string
+	if r.DueAt.Valid {
+		dueAt = r.DueAt.Time.Format(time.RFC3339)
+	}
+	if r.CompletedAt.Valid {
+		completedAt = r.CompletedAt.Time.Format(time.RFC3339)
+	}
+
+	return &CohortProgressModel{
+		UserID:            r.UserID,
+		FirstName:         r.UserFirstName,
+		LastName:          r.UserLastName,
+		Email:             r.UserEmail,
+		LanguageSlug:      r.LanguageSlug,
+		SeriesSlug:        r.SeriesSlug,
+		SeriesTitle:       r.SeriesTitle,
+		DueAt:             dueAt,
+		IsOverdue:         r.DueAt.Valid && !r.CompletedAt.Valid && r.DueAt.Time.Before(now),
+		StartedSections:   r.StartedSections,
+		CompletedSections: r.CompletedSections,
+		TotalSections:     r.SectionsCount,
+		CompletedLessons:  r.CompletedLessons,
+		TotalLessons:      r.LessonsCount,
+		CompletedAt:       completedAt,
+		CertificateID:     r.CertificateID,
+	}
+}
+
+type OrganizationLicenseModel struct {
+	OrganizationID int32
+	LanguageSlug   string
+	SeriesSlug     string
+	Seats          int32
+	CreatedAt      string
+	UpdatedAt      string
+}
+
+func (l *OrganizationLicense) ToOrganizationLicenseModel() *OrganizationLicenseModel {
+	return &OrganizationLicenseModel{
+		OrganizationID: l.OrganizationID,
+		LanguageSlug:   l.LanguageSlug,
+		SeriesSlug:     l.SeriesSlug,
+		Seats:          l.Seats,
+		CreatedAt:      l.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:      l.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
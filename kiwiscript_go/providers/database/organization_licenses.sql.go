@@ -0,0 +1,183 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: organization_licenses.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countOrganizationLicenseSeatsWithCohort = `-- name: CountOrganizationLicenseSeatsWithCohort :one
+SELECT COUNT(DISTINCT "cohort_members"."user_id") FROM "cohort_members"
+INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_members"."cohort_id"
+WHERE
+  "cohort_members"."cohort_id" = $1 OR (
+    "cohorts"."organization_id" = $2 AND
+    EXISTS (
+      SELECT 1 FROM "cohort_series"
+      WHERE
+        "cohort_series"."cohort_id" = "cohort_members"."cohort_id" AND
+        "cohort_series"."series_slug" = $3
+    )
+  )
+`
+
+type CountOrganizationLicenseSeatsWithCohortParams struct {
+	CohortID       int32
+	OrganizationID int32
+	SeriesSlug     string
+}
+
+// CountOrganizationLicenseSeatsWithCohort counts the distinct users that hold
+// a seat of the series through the organization cohorts once the given cohort
+// is assigned the series.
+func (q *Queries) CountOrganizationLicenseSeatsWithCohort(ctx context.Context, arg CountOrganizationLicenseSeatsWithCohortParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationLicenseSeatsWithCohort, arg.CohortID, arg.OrganizationID, arg.SeriesSlug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteOrganizationLicense = `-- name: DeleteOrganizationLicense :exec
+DELETE FROM "organization_licenses"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteOrganizationLicense(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteOrganizationLicense, id)
+	return err
+}
+
+const findFullOrganizationLicenseSeriesSlugByCohortID = `-- name: FindFullOrganizationLicenseSeriesSlugByCohortID :one
+SELECT "organization_licenses"."series_slug" FROM "cohort_series"
+INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_series"."cohort_id"
+INNER JOIN "organization_licenses" ON (
+  "organization_licenses"."organization_id" = "cohorts"."organization_id" AND
+  "organization_licenses"."series_slug" = "cohort_series"."series_slug"
+)
+WHERE
+  "cohort_series"."cohort_id" = $1 AND
+  "organization_licenses"."seats" <= (
+    SELECT COUNT(DISTINCT "cohort_members"."user_id") FROM "cohort_members"
+    INNER JOIN "cohorts" AS "seat_cohorts" ON "seat_cohorts"."id" = "cohort_members"."cohort_id"
+    INNER JOIN "cohort_series" AS "seat_series" ON "seat_series"."cohort_id" = "cohort_members"."cohort_id"
+    WHERE
+      "seat_cohorts"."organization_id" = "organization_licenses"."organization_id" AND
+      "seat_series"."series_slug" = "organization_licenses"."series_slug" AND
+      "cohort_members"."user_id" <> $2
+  )
+LIMIT 1
+`
+
+type FindFullOrganizationLicenseSeriesSlugByCohortIDParams struct {
+	CohortID int32
+	UserID   int32
+}
+
+// FindFullOrganizationLicenseSeriesSlugByCohortID returns a licensed series
+// of the cohort without a free seat for the user.
+func (q *Queries) FindFullOrganizationLicenseSeriesSlugByCohortID(ctx context.Context, arg FindFullOrganizationLicenseSeriesSlugByCohortIDParams) (string, error) {
+	row := q.db.QueryRow(ctx, findFullOrganizationLicenseSeriesSlugByCohortID, arg.CohortID, arg.UserID)
+	var series_slug string
+	err := row.Scan(&series_slug)
+	return series_slug, err
+}
+
+const findOrganizationLicenseByOrganizationIDAndSeriesSlug = `-- name: FindOrganizationLicenseByOrganizationIDAndSeriesSlug :one
+SELECT id, organization_id, language_slug, series_slug, seats, granted_by_id, created_at, updated_at FROM "organization_licenses"
+WHERE "organization_id" = $1 AND "series_slug" = $2
+LIMIT 1
+`
+
+type FindOrganizationLicenseByOrganizationIDAndSeriesSlugParams struct {
+	OrganizationID int32
+	SeriesSlug     string
+}
+
+func (q *Queries) FindOrganizationLicenseByOrganizationIDAndSeriesSlug(ctx context.Context, arg FindOrganizationLicenseByOrganizationIDAndSeriesSlugParams) (OrganizationLicense, error) {
+	row := q.db.QueryRow(ctx, findOrganizationLicenseByOrganizationIDAndSeriesSlug, arg.OrganizationID, arg.SeriesSlug)
+	var i OrganizationLicense
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Seats,
+		&i.GrantedByID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertOrganizationLicense = `-- name: UpsertOrganizationLicense :one
+
+INSERT INTO "organization_licenses" (
+  "organization_id",
+  "language_slug",
+  "series_slug",
+  "seats",
+  "granted_by_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+ON CONFLICT ("organization_id", "series_slug") DO UPDATE SET
+  "seats" = EXCLUDED."seats",
+  "granted_by_id" = EXCLUDED."granted_by_id",
+  "updated_at" = now()
+RETURNING id, organization_id, language_slug, series_slug, seats, granted_by_id, created_at, updated_at
+`
+
+type UpsertOrganizationLicenseParams struct {
+	OrganizationID int32
+	LanguageSlug   string
+	SeriesSlug     string
+	Seats          int32
+	GrantedByID    pgtype.Int4
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) UpsertOrganizationLicense(ctx context.Context, arg UpsertOrganizationLicenseParams) (OrganizationLicense, error) {
+	row := q.db.QueryRow(ctx, upsertOrganizationLicense,
+		arg.OrganizationID,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.Seats,
+		arg.GrantedByID,
+	)
+	var i OrganizationLicense
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Seats,
+		&i.GrantedByID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
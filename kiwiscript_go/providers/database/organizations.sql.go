@@ -0,0 +1,472 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: organizations.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countOrganizationMembers = `-- name: CountOrganizationMembers :one
+SELECT COUNT("id") FROM "organization_members"
+WHERE "organization_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountOrganizationMembers(ctx context.Context, organizationID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationMembers, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOrganizationsByUserID = `-- name: CountOrganizationsByUserID :one
+SELECT COUNT("organization_members"."id") FROM "organization_members"
+WHERE "organization_members"."user_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountOrganizationsByUserID(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationsByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOrganization = `-- name: CreateOrganization :one
+
+
+INSERT INTO "organizations" (
+  "name",
+  "owner_id"
+) VALUES (
+  $1,
+  $2
+) RETURNING id, name, owner_id, created_at, updated_at
+`
+
+type CreateOrganizationParams struct {
+	Name    string
+	OwnerID int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error) {
+	row := q.db.QueryRow(ctx, createOrganization, arg.Name, arg.OwnerID)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createOrganizationInvitation = `-- name: CreateOrganizationInvitation :one
+INSERT INTO "organization_invitations" (
+  "organization_id",
+  "email",
+  "role",
+  "token",
+  "invited_by_id",
+  "expires_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+ON CONFLICT ("organization_id", "email") DO UPDATE SET
+  "role" = EXCLUDED."role",
+  "token" = EXCLUDED."token",
+  "invited_by_id" = EXCLUDED."invited_by_id",
+  "expires_at" = EXCLUDED."expires_at",
+  "created_at" = now()
+RETURNING id, organization_id, email, role, token, invited_by_id, expires_at, created_at
+`
+
+type CreateOrganizationInvitationParams struct {
+	OrganizationID int32
+	Email          string
+	Role           string
+	Token          uuid.UUID
+	InvitedByID    pgtype.Int4
+	ExpiresAt      pgtype.Timestamp
+}
+
+func (q *Queries) CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitation, error) {
+	row := q.db.QueryRow(ctx, createOrganizationInvitation,
+		arg.OrganizationID,
+		arg.Email,
+		arg.Role,
+		arg.Token,
+		arg.InvitedByID,
+		arg.ExpiresAt,
+	)
+	var i OrganizationInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.Token,
+		&i.InvitedByID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createOrganizationMember = `-- name: CreateOrganizationMember :one
+INSERT INTO "organization_members" (
+  "organization_id",
+  "user_id",
+  "role"
+) VALUES (
+  $1,
+  $2,
+  $3
+) RETURNING id, organization_id, user_id, role, created_at
+`
+
+type CreateOrganizationMemberParams struct {
+	OrganizationID int32
+	UserID         int32
+	Role           string
+}
+
+func (q *Queries) CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) (OrganizationMember, error) {
+	row := q.db.QueryRow(ctx, createOrganizationMember, arg.OrganizationID, arg.UserID, arg.Role)
+	var i OrganizationMember
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteAllOrganizations = `-- name: DeleteAllOrganizations :exec
+DELETE FROM "organizations"
+`
+
+func (q *Queries) DeleteAllOrganizations(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteAllOrganizations)
+	return err
+}
+
+const deleteOrganizationByID = `-- name: DeleteOrganizationByID :exec
+DELETE FROM "organizations"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteOrganizationByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteOrganizationByID, id)
+	return err
+}
+
+const deleteOrganizationInvitation = `-- name: DeleteOrganizationInvitation :exec
+DELETE FROM "organization_invitations"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteOrganizationInvitation(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteOrganizationInvitation, id)
+	return err
+}
+
+const deleteOrganizationMember = `-- name: DeleteOrganizationMember :exec
+DELETE FROM "organization_members"
+WHERE "organization_id" = $1 AND "user_id" = $2
+`
+
+type DeleteOrganizationMemberParams struct {
+	OrganizationID int32
+	UserID         int32
+}
+
+func (q *Queries) DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) error {
+	_, err := q.db.Exec(ctx, deleteOrganizationMember, arg.OrganizationID, arg.UserID)
+	return err
+}
+
+const findOrganizationByID = `-- name: FindOrganizationByID :one
+SELECT id, name, owner_id, created_at, updated_at FROM "organizations"
+WHERE "id" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindOrganizationByID(ctx context.Context, id int32) (Organization, error) {
+	row := q.db.QueryRow(ctx, findOrganizationByID, id)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OwnerID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationInvitationByToken = `-- name: FindOrganizationInvitationByToken :one
+SELECT id, organization_id, email, role, token, invited_by_id, expires_at, created_at FROM "organization_invitations"
+WHERE "token" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindOrganizationInvitationByToken(ctx context.Context, token uuid.UUID) (OrganizationInvitation, error) {
+	row := q.db.QueryRow(ctx, findOrganizationInvitationByToken, token)
+	var i OrganizationInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.Token,
+		&i.InvitedByID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationMember = `-- name: FindOrganizationMember :one
+SELECT id, organization_id, user_id, role, created_at FROM "organization_members"
+WHERE "organization_id" = $1 AND "user_id" = $2
+LIMIT 1
+`
+
+type FindOrganizationMemberParams struct {
+	OrganizationID int32
+	UserID         int32
+}
+
+func (q *Queries) FindOrganizationMember(ctx context.Context, arg FindOrganizationMemberParams) (OrganizationMember, error) {
+	row := q.db.QueryRow(ctx, findOrganizationMember, arg.OrganizationID, arg.UserID)
+	var i OrganizationMember
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationSuccessorMember = `-- name: FindOrganizationSuccessorMember :one
+SELECT id, organization_id, user_id, role, created_at FROM "organization_members"
+WHERE "organization_id" = $1 AND "user_id" <> $2
+ORDER BY ("role" = 'admin') DESC, "created_at" ASC, "id" ASC
+LIMIT 1
+`
+
+type FindOrganizationSuccessorMemberParams struct {
+	OrganizationID int32
+	UserID         int32
+}
+
+func (q *Queries) FindOrganizationSuccessorMember(ctx context.Context, arg FindOrganizationSuccessorMemberParams) (OrganizationMember, error) {
+	row := q.db.QueryRow(ctx, findOrganizationSuccessorMember, arg.OrganizationID, arg.UserID)
+	var i OrganizationMember
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationsByOwnerIDForUpdate = `-- name: FindOrganizationsByOwnerIDForUpdate :many
+SELECT id, name, owner_id, created_at, updated_at FROM "organizations"
+WHERE "owner_id" = $1
+ORDER BY "id" ASC
+FOR UPDATE
+`
+
+func (q *Queries) FindOrganizationsByOwnerIDForUpdate(ctx context.Context, ownerID int32) ([]Organization, error) {
+	rows, err := q.db.Query(ctx, findOrganizationsByOwnerIDForUpdate, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Organization{}
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OwnerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedOrganizationMembersWithUser = `-- name: FindPaginatedOrganizationMembersWithUser :many
+SELECT
+  organization_members.id, organization_members.organization_id, organization_members.user_id, organization_members.role, organization_members.created_at,
+  "users"."first_name" AS "user_first_name",
+  "users"."last_name" AS "user_last_name",
+  "users"."email" AS "user_email"
+FROM "organization_members"
+INNER JOIN "users" ON "users"."id" = "organization_members"."user_id"
+WHERE "organization_members"."organization_id" = $1
+ORDER BY "users"."last_name" ASC, "users"."first_name" ASC, "users"."id" ASC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedOrganizationMembersWithUserParams struct {
+	OrganizationID int32
+	Limit          int32
+	Offset         int32
+}
+
+type FindPaginatedOrganizationMembersWithUserRow struct {
+	ID             int32
+	OrganizationID int32
+	UserID         int32
+	Role           string
+	CreatedAt      pgtype.Timestamp
+	UserFirstName  string
+	UserLastName   string
+	UserEmail      string
+}
+
+func (q *Queries) FindPaginatedOrganizationMembersWithUser(ctx context.Context, arg FindPaginatedOrganizationMembersWithUserParams) ([]FindPaginatedOrganizationMembersWithUserRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedOrganizationMembersWithUser, arg.OrganizationID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedOrganizationMembersWithUserRow{}
+	for rows.Next() {
+		var i FindPaginatedOrganizationMembersWithUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UserFirstName,
+			&i.UserLastName,
+			&i.UserEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedOrganizationsByUserID = `-- name: FindPaginatedOrganizationsByUserID :many
+SELECT organizations.id, organizations.name, organizations.owner_id, organizations.created_at, organizations.updated_at FROM "organizations"
+INNER JOIN "organization_members" ON "organization_members"."organization_id" = "organizations"."id"
+WHERE "organization_members"."user_id" = $1
+ORDER BY "organizations"."name" ASC, "organizations"."id" ASC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedOrganizationsByUserIDParams struct {
+	UserID int32
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) FindPaginatedOrganizationsByUserID(ctx context.Context, arg FindPaginatedOrganizationsByUserIDParams) ([]Organization, error) {
+	rows, err := q.db.Query(ctx, findPaginatedOrganizationsByUserID, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Organization{}
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OwnerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateOrganizationMemberRole = `-- name: UpdateOrganizationMemberRole :exec
+UPDATE "organization_members" SET
+  "role" = $1
+WHERE "id" = $2
+`
+
+type UpdateOrganizationMemberRoleParams struct {
+	Role string
+	ID   int32
+}
+
+func (q *Queries) UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) error {
+	_, err := q.db.Exec(ctx, updateOrganizationMemberRole, arg.Role, arg.ID)
+	return err
+}
+
+const updateOrganizationOwner = `-- name: UpdateOrganizationOwner :exec
+UPDATE "organizations" SET
+  "owner_id" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+`
+
+type UpdateOrganizationOwnerParams struct {
+	OwnerID int32
+	ID      int32
+}
+
+func (q *Queries) UpdateOrganizationOwner(ctx context.Context, arg UpdateOrganizationOwnerParams) error {
+	_, err := q.db.Exec(ctx, updateOrganizationOwner, arg.OwnerID, arg.ID)
+	return err
+}
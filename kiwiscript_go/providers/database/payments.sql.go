@@ -0,0 +1,152 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: payments.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const completePayment = `-- name: CompletePayment :execrows
+UPDATE "payments" SET
+  "status" = 'completed',
+  "completed_at" = now()
+WHERE "id" = $1 AND "status" = 'pending'
+`
+
+func (q *Queries) CompletePayment(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, completePayment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createPayment = `-- name: CreatePayment :one
+
+
+INSERT INTO "payments" (
+  "user_id",
+  "language_slug",
+  "series_slug",
+  "provider",
+  "provider_session_id",
+  "amount",
+  "currency",
+  "coupon_id",
+  "status",
+  "completed_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10
+) RETURNING id, user_id, language_slug, series_slug, provider, provider_session_id, amount, currency, coupon_id, status, completed_at, created_at
+`
+
+type CreatePaymentParams struct {
+	UserID            pgtype.Int4
+	LanguageSlug      string
+	SeriesSlug        string
+	Provider          string
+	ProviderSessionID string
+	Amount            int32
+	Currency          string
+	CouponID          pgtype.Int4
+	Status            string
+	CompletedAt       pgtype.Timestamp
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error) {
+	row := q.db.QueryRow(ctx, createPayment,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.Provider,
+		arg.ProviderSessionID,
+		arg.Amount,
+		arg.Currency,
+		arg.CouponID,
+		arg.Status,
+		arg.CompletedAt,
+	)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Provider,
+		&i.ProviderSessionID,
+		&i.Amount,
+		&i.Currency,
+		&i.CouponID,
+		&i.Status,
+		&i.CompletedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const expirePayment = `-- name: ExpirePayment :exec
+UPDATE "payments" SET
+  "status" = 'expired'
+WHERE "id" = $1 AND "status" = 'pending'
+`
+
+func (q *Queries) ExpirePayment(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, expirePayment, id)
+	return err
+}
+
+const findPaymentByProviderSessionID = `-- name: FindPaymentByProviderSessionID :one
+SELECT id, user_id, language_slug, series_slug, provider, provider_session_id, amount, currency, coupon_id, status, completed_at, created_at FROM "payments"
+WHERE "provider_session_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindPaymentByProviderSessionID(ctx context.Context, providerSessionID string) (Payment, error) {
+	row := q.db.QueryRow(ctx, findPaymentByProviderSessionID, providerSessionID)
+	var i Payment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Provider,
+		&i.ProviderSessionID,
+		&i.Amount,
+		&i.Currency,
+		&i.CouponID,
+		&i.Status,
+		&i.CompletedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
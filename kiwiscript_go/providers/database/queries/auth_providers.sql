@@ -33,4 +33,8 @@ LIMIT 1;
 
 -- name: DeleteProviderByEmailAndNotProvider :exec
 DELETE FROM "auth_providers"
-WHERE "email" = $1 AND "provider" <> $2;
\ No newline at end of file
+WHERE "email" = $1 AND "provider" <> $2;
+-- name: FindAuthProvidersByEmail :many
+SELECT * FROM "auth_providers"
+WHERE "email" = $1
+ORDER BY "id" ASC;
@@ -0,0 +1,95 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+-- name: CreateSeriesBookmark :one
+INSERT INTO "series_bookmarks" (
+    "language_slug",
+    "series_slug",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3
+) RETURNING *;
+
+-- name: FindSeriesBookmarkByUserIDAndSeriesSlug :one
+SELECT * FROM "series_bookmarks"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1;
+
+-- name: DeleteSeriesBookmarkByID :exec
+DELETE FROM "series_bookmarks"
+WHERE "id" = $1;
+
+-- name: CountSeriesBookmarksByUserID :one
+SELECT COUNT("id") FROM "series_bookmarks"
+WHERE "user_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedSeriesBookmarksWithSeriesByUserID :many
+SELECT
+    "series_bookmarks".*,
+    "series"."title" AS "series_title"
+FROM "series_bookmarks"
+INNER JOIN "series" ON "series_bookmarks"."series_slug" = "series"."slug"
+WHERE "series_bookmarks"."user_id" = $1
+ORDER BY "series_bookmarks"."id" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CreateLessonBookmark :one
+INSERT INTO "lesson_bookmarks" (
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "lesson_id",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING *;
+
+-- name: FindLessonBookmarkByUserIDAndLessonID :one
+SELECT * FROM "lesson_bookmarks"
+WHERE "user_id" = $1 AND "lesson_id" = $2
+LIMIT 1;
+
+-- name: DeleteLessonBookmarkByID :exec
+DELETE FROM "lesson_bookmarks"
+WHERE "id" = $1;
+
+-- name: CountLessonBookmarksByUserID :one
+SELECT COUNT("id") FROM "lesson_bookmarks"
+WHERE "user_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedLessonBookmarksWithLessonByUserID :many
+SELECT
+    "lesson_bookmarks".*,
+    "lessons"."title" AS "lesson_title"
+FROM "lesson_bookmarks"
+INNER JOIN "lessons" ON "lesson_bookmarks"."lesson_id" = "lessons"."id"
+WHERE "lesson_bookmarks"."user_id" = $1
+ORDER BY "lesson_bookmarks"."id" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: UpdateLessonBookmarksSectionID :exec
+UPDATE "lesson_bookmarks" SET
+  "section_id" = sqlc.arg('section_id')
+WHERE "lesson_id" = ANY(sqlc.arg('lesson_ids')::int[]);
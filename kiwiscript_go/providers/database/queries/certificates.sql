@@ -30,6 +30,7 @@ INSERT INTO "certificates" (
     "lessons",
     "watch_time_seconds",
     "read_time_seconds",
+    "series_version",
     "completed_at"
 ) VALUES (
     $1,
@@ -40,6 +41,7 @@ INSERT INTO "certificates" (
     $6,
     $7,
     $8,
+    $9,
     now()
 ) RETURNING *;
 
@@ -71,4 +73,8 @@ FROM "certificates"
 INNER JOIN "languages" ON "certificates"."language_slug" = "languages"."slug"
 INNER JOIN "users" ON "certificates"."user_id" = "users"."id"
 WHERE "certificates"."id" = $1
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+-- name: FindAllCertificatesByUserID :many
+SELECT * FROM "certificates"
+WHERE "user_id" = $1
+ORDER BY "created_at" ASC;
@@ -0,0 +1,130 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateCohort :one
+INSERT INTO "cohorts" (
+  "organization_id",
+  "name"
+) VALUES (
+  $1,
+  $2
+) RETURNING *;
+
+-- name: FindCohortByOrganizationIDAndID :one
+SELECT * FROM "cohorts"
+WHERE "organization_id" = $1 AND "id" = $2
+LIMIT 1;
+
+-- name: CountCohortsByOrganizationID :one
+SELECT COUNT("id") FROM "cohorts"
+WHERE "organization_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedCohortsByOrganizationID :many
+SELECT * FROM "cohorts"
+WHERE "organization_id" = $1
+ORDER BY "name" ASC, "id" ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CreateCohortMember :exec
+INSERT INTO "cohort_members" (
+  "cohort_id",
+  "user_id"
+) VALUES (
+  $1,
+  $2
+);
+
+-- name: DeleteCohortMember :exec
+DELETE FROM "cohort_members"
+WHERE "cohort_id" = $1 AND "user_id" = $2;
+
+-- name: DeleteCohortMembersByOrganizationIDAndUserID :exec
+DELETE FROM "cohort_members"
+USING "cohorts"
+WHERE
+  "cohorts"."id" = "cohort_members"."cohort_id" AND
+  "cohorts"."organization_id" = $1 AND
+  "cohort_members"."user_id" = $2;
+
+-- name: UpsertCohortSeries :one
+INSERT INTO "cohort_series" (
+  "cohort_id",
+  "language_slug",
+  "series_slug",
+  "due_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4
+)
+ON CONFLICT ("cohort_id", "series_slug") DO UPDATE SET
+  "due_at" = EXCLUDED."due_at",
+  "updated_at" = now()
+RETURNING *;
+
+-- name: FindCohortSeriesByCohortIDAndSeriesSlug :one
+SELECT * FROM "cohort_series"
+WHERE "cohort_id" = $1 AND "series_slug" = $2
+LIMIT 1;
+
+-- name: DeleteCohortSeries :exec
+DELETE FROM "cohort_series"
+WHERE "id" = $1;
+
+-- name: FindCohortProgress :many
+SELECT
+  "users"."id" AS "user_id",
+  "users"."first_name" AS "user_first_name",
+  "users"."last_name" AS "user_last_name",
+  "users"."email" AS "user_email",
+  "cohort_series"."language_slug",
+  "cohort_series"."series_slug",
+  "cohort_series"."due_at",
+  "series"."title" AS "series_title",
+  "series"."sections_count",
+  "series"."lessons_count",
+  COALESCE("series_progress"."completed_sections", 0)::smallint AS "completed_sections",
+  COALESCE("series_progress"."completed_lessons", 0)::smallint AS "completed_lessons",
+  (
+    SELECT COUNT("section_progress"."id")
+    FROM "section_progress"
+    WHERE "section_progress"."series_progress_id" = "series_progress"."id"
+  )::smallint AS "started_sections",
+  "series_progress"."completed_at",
+  COALESCE("certificates"."id"::text, '')::text AS "certificate_id"
+FROM "cohort_members"
+INNER JOIN "users" ON "users"."id" = "cohort_members"."user_id"
+INNER JOIN "cohort_series" ON "cohort_series"."cohort_id" = "cohort_members"."cohort_id"
+INNER JOIN "series" ON "series"."slug" = "cohort_series"."series_slug"
+LEFT JOIN "series_progress" ON (
+  "series_progress"."user_id" = "users"."id" AND
+  "series_progress"."series_slug" = "cohort_series"."series_slug"
+)
+LEFT JOIN "certificates" ON (
+  "certificates"."user_id" = "users"."id" AND
+  "certificates"."series_slug" = "cohort_series"."series_slug"
+)
+WHERE "cohort_members"."cohort_id" = $1 AND "users"."deleted_at" IS NULL
+ORDER BY
+  "users"."last_name" ASC,
+  "users"."first_name" ASC,
+  "users"."id" ASC,
+  "cohort_series"."due_at" ASC NULLS LAST,
+  "cohort_series"."series_slug" ASC;
@@ -0,0 +1,73 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateCoupon :one
+INSERT INTO "coupons" (
+  "code",
+  "series_slug",
+  "percent_off",
+  "max_redemptions",
+  "expires_at",
+  "created_by_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+) RETURNING *;
+
+-- name: FindCouponByID :one
+SELECT * FROM "coupons"
+WHERE "id" = $1
+LIMIT 1;
+
+-- name: FindCouponByCode :one
+SELECT * FROM "coupons"
+WHERE "code" = $1
+LIMIT 1;
+
+-- name: CountCoupons :one
+SELECT COUNT("id") FROM "coupons"
+LIMIT 1;
+
+-- name: FindPaginatedCoupons :many
+SELECT * FROM "coupons"
+ORDER BY "id" DESC
+LIMIT $1 OFFSET $2;
+
+-- name: UpdateCouponIsActive :one
+UPDATE "coupons" SET
+  "is_active" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: IncrementCouponRedemptionsCount :execrows
+UPDATE "coupons" SET
+  "redemptions_count" = "redemptions_count" + 1,
+  "updated_at" = now()
+WHERE
+  "id" = $1 AND
+  ("max_redemptions" = 0 OR "redemptions_count" < "max_redemptions");
+
+-- name: ReassignCouponsCreator :exec
+UPDATE "coupons" SET
+  "created_by_id" = sqlc.arg('new_creator_id')
+WHERE "created_by_id" = sqlc.arg('old_creator_id');
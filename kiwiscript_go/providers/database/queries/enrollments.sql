@@ -0,0 +1,89 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateEnrollment :one
+INSERT INTO "enrollments" (
+  "user_id",
+  "language_slug",
+  "series_slug",
+  "source",
+  "organization_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+) RETURNING *;
+
+-- name: UpsertPaidEnrollment :one
+INSERT INTO "enrollments" (
+  "user_id",
+  "language_slug",
+  "series_slug",
+  "source",
+  "payment_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  'paid',
+  $4
+)
+ON CONFLICT ("user_id", "series_slug") DO UPDATE SET
+  "source" = 'paid',
+  "payment_id" = EXCLUDED."payment_id",
+  "organization_id" = NULL
+RETURNING *;
+
+-- name: FindEnrollmentByUserIDAndSeriesSlug :one
+SELECT * FROM "enrollments"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1;
+
+-- name: FindOrganizationLicenseByUserIDAndSeriesSlug :one
+SELECT "cohorts"."organization_id" FROM "cohort_series"
+INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_series"."cohort_id"
+INNER JOIN "cohort_members" ON "cohort_members"."cohort_id" = "cohort_series"."cohort_id"
+INNER JOIN "organization_licenses" ON (
+  "organization_licenses"."organization_id" = "cohorts"."organization_id" AND
+  "organization_licenses"."series_slug" = "cohort_series"."series_slug"
+)
+WHERE "cohort_members"."user_id" = $1 AND "cohort_series"."series_slug" = $2
+ORDER BY "cohort_series"."id" ASC
+LIMIT 1;
+
+-- name: HasSeriesAccess :one
+SELECT (
+  EXISTS (
+    SELECT 1 FROM "enrollments"
+    WHERE
+      "enrollments"."user_id" = $1 AND
+      "enrollments"."series_slug" = $2 AND
+      "enrollments"."source" <> 'organization'
+  ) OR EXISTS (
+    SELECT 1 FROM "cohort_series"
+    INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_series"."cohort_id"
+    INNER JOIN "cohort_members" ON "cohort_members"."cohort_id" = "cohort_series"."cohort_id"
+    INNER JOIN "organization_licenses" ON (
+      "organization_licenses"."organization_id" = "cohorts"."organization_id" AND
+      "organization_licenses"."series_slug" = "cohort_series"."series_slug"
+    )
+    WHERE "cohort_members"."user_id" = $1 AND "cohort_series"."series_slug" = $2
+  )
+)::boolean AS "has_access";
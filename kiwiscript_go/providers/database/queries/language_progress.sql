@@ -51,3 +51,8 @@ WHERE "id" = $1;
 SELECT COUNT("id") FROM "language_progress"
 WHERE "language_slug" = $1
 LIMIT 1;
+
+-- name: FindAllLanguageProgressByUserID :many
+SELECT * FROM "language_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC;
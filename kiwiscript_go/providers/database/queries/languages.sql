@@ -143,3 +143,15 @@ WHERE "slug" = $1;
 UPDATE "languages" SET
   "series_count" = "series_count" - 1
 WHERE "slug" = $1;
+
+-- name: ReassignLanguagesAuthor :exec
+UPDATE "languages" SET
+  "author_id" = sqlc.arg('new_author_id')
+WHERE "author_id" = sqlc.arg('old_author_id');
+
+-- name: UpdateLanguageRequiresEditorialReview :one
+UPDATE "languages" SET
+  "requires_editorial_review" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
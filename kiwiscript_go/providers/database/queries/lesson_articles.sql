@@ -32,15 +32,25 @@ INSERT INTO "lesson_articles" (
 UPDATE "lesson_articles" SET
   "content" = $1,
   "read_time_seconds" = $2,
+  "version" = "version" + 1,
   "updated_at" = NOW()
-WHERE "id" = $3
+WHERE "id" = $3 AND "version" = $4
 RETURNING *;
 
 -- name: DeleteLessonArticle :exec
 DELETE FROM "lesson_articles"
 WHERE "id" = $1;
 
+-- name: DeleteLessonArticleByIDAndVersion :execrows
+DELETE FROM "lesson_articles"
+WHERE "id" = $1 AND "version" = $2;
+
 -- name: GetLessonArticleByLessonID :one
 SELECT * FROM "lesson_articles"
 WHERE "lesson_id" = $1
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+
+-- name: ReassignLessonArticlesAuthor :exec
+UPDATE "lesson_articles" SET
+  "author_id" = sqlc.arg('new_author_id')
+WHERE "author_id" = sqlc.arg('old_author_id');
@@ -0,0 +1,186 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+-- name: CreateLessonComment :one
+INSERT INTO "lesson_comments" (
+    "body",
+    "parent_id",
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "lesson_id",
+    "author_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7
+) RETURNING *;
+
+-- name: FindLessonCommentByIDAndLessonID :one
+SELECT * FROM "lesson_comments"
+WHERE "id" = $1 AND "lesson_id" = $2
+LIMIT 1;
+
+-- name: FindLessonCommentByIDWithAuthor :one
+SELECT
+    "lesson_comments".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE "lesson_comments"."id" = $1
+LIMIT 1;
+
+-- name: UpdateLessonCommentBody :one
+UPDATE "lesson_comments" SET
+    "body" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: UpdateLessonCommentIsHidden :one
+UPDATE "lesson_comments" SET
+    "is_hidden" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: UpdateLessonCommentIsLocked :one
+UPDATE "lesson_comments" SET
+    "is_locked" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: UpdateLessonCommentIsAnswer :one
+UPDATE "lesson_comments" SET
+    "is_answer" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: RefreshLessonCommentIsAnswered :exec
+UPDATE "lesson_comments" SET
+    "is_answered" = EXISTS (
+        SELECT 1 FROM "lesson_comments" AS "replies"
+        WHERE "replies"."parent_id" = "lesson_comments"."id" AND "replies"."is_answer" = true
+    )
+WHERE "lesson_comments"."id" = $1;
+
+-- name: IncrementLessonCommentRepliesCount :exec
+UPDATE "lesson_comments" SET
+    "replies_count" = "replies_count" + 1
+WHERE "id" = $1;
+
+-- name: DecrementLessonCommentRepliesCount :exec
+UPDATE "lesson_comments" SET
+    "replies_count" = "replies_count" - 1
+WHERE "id" = $1;
+
+-- name: IncrementLessonCommentUpvotesCount :exec
+UPDATE "lesson_comments" SET
+    "upvotes_count" = "upvotes_count" + 1
+WHERE "id" = $1;
+
+-- name: DecrementLessonCommentUpvotesCount :exec
+UPDATE "lesson_comments" SET
+    "upvotes_count" = "upvotes_count" - 1
+WHERE "id" = $1;
+
+-- name: DeleteLessonCommentByID :execrows
+DELETE FROM "lesson_comments"
+WHERE "id" = $1;
+
+-- name: CountLessonCommentsByLessonID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "lesson_id" = $1 AND "parent_id" IS NULL AND "is_hidden" = false
+LIMIT 1;
+
+-- name: CountAllLessonCommentsByLessonID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "lesson_id" = $1 AND "parent_id" IS NULL
+LIMIT 1;
+
+-- name: FindPaginatedLessonCommentsWithAuthor :many
+SELECT
+    "lesson_comments".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE
+    "lesson_comments"."lesson_id" = $1 AND
+    "lesson_comments"."parent_id" IS NULL AND
+    "lesson_comments"."is_hidden" = false
+ORDER BY "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: FindAllPaginatedLessonCommentsWithAuthor :many
+SELECT
+    "lesson_comments".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE
+    "lesson_comments"."lesson_id" = $1 AND
+    "lesson_comments"."parent_id" IS NULL
+ORDER BY "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountLessonCommentRepliesByParentID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "parent_id" = $1 AND "is_hidden" = false
+LIMIT 1;
+
+-- name: CountAllLessonCommentRepliesByParentID :one
+SELECT COUNT("id") FROM "lesson_comments"
+WHERE "parent_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedLessonCommentRepliesWithAuthor :many
+SELECT
+    "lesson_comments".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE
+    "lesson_comments"."parent_id" = $1 AND
+    "lesson_comments"."is_hidden" = false
+ORDER BY "lesson_comments"."is_answer" DESC, "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" ASC
+LIMIT $2 OFFSET $3;
+
+-- name: FindAllPaginatedLessonCommentRepliesWithAuthor :many
+SELECT
+    "lesson_comments".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "lesson_comments"
+INNER JOIN "users" ON "lesson_comments"."author_id" = "users"."id"
+WHERE "lesson_comments"."parent_id" = $1
+ORDER BY "lesson_comments"."is_answer" DESC, "lesson_comments"."upvotes_count" DESC, "lesson_comments"."id" ASC
+LIMIT $2 OFFSET $3;
+
+-- name: UpdateLessonCommentsSectionID :exec
+UPDATE "lesson_comments" SET
+  "section_id" = sqlc.arg('section_id')
+WHERE "lesson_id" = ANY(sqlc.arg('lesson_ids')::int[]);
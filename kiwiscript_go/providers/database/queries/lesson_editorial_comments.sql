@@ -0,0 +1,54 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateLessonEditorialComment :one
+INSERT INTO "lesson_editorial_comments" (
+  "body",
+  "language_slug",
+  "series_slug",
+  "lesson_id",
+  "author_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+) RETURNING *;
+
+-- name: FindLessonEditorialCommentByLessonIDAndID :one
+SELECT * FROM "lesson_editorial_comments"
+WHERE "lesson_id" = $1 AND "id" = $2
+LIMIT 1;
+
+-- name: FindLessonEditorialCommentsWithAuthorByLessonID :many
+SELECT
+  "lesson_editorial_comments".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name"
+FROM "lesson_editorial_comments"
+INNER JOIN "users" ON "lesson_editorial_comments"."author_id" = "users"."id"
+WHERE "lesson_editorial_comments"."lesson_id" = $1
+ORDER BY "lesson_editorial_comments"."id" ASC;
+
+-- name: UpdateLessonEditorialCommentIsResolved :one
+UPDATE "lesson_editorial_comments" SET
+  "is_resolved" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
@@ -0,0 +1,119 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateLessonEvent :execrows
+INSERT INTO "lesson_events" (
+  "client_event_id",
+  "user_id",
+  "lesson_id",
+  "event_type",
+  "scroll_depth",
+  "video_position_seconds",
+  "file_id",
+  "occurred_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8
+)
+ON CONFLICT DO NOTHING;
+
+-- name: CreateLessonEventsPartition :exec
+SELECT "create_lesson_events_partition"(sqlc.arg('month')::date);
+
+-- name: DropLessonEventsPartitionsBefore :one
+SELECT "drop_lesson_events_partitions"(sqlc.arg('before')::date)::int AS "dropped";
+
+-- name: UpdateLessonProgressViewedAtFromEvents :exec
+UPDATE "lesson_progress" SET
+  "viewed_at" = GREATEST("viewed_at", sqlc.arg('viewed_at')::timestamp)
+WHERE "id" = sqlc.arg('id');
+
+-- Time on task is the gap between an event and the next one of the same
+-- learner on the same lesson, capped so idle tabs do not count, a playing
+-- video is trusted for longer than a page that is only being read.
+-- name: UpsertLessonEngagementDailyFrom :execrows
+WITH "timed_events" AS (
+  SELECT
+    "user_id",
+    "lesson_id",
+    "event_type",
+    "scroll_depth",
+    "occurred_at",
+    LEAST(
+      COALESCE(EXTRACT(EPOCH FROM LEAD("occurred_at") OVER (
+        PARTITION BY "user_id", "lesson_id" ORDER BY "occurred_at", "id"
+      ) - "occurred_at"), 0),
+      CASE WHEN "event_type" = 'video_play' THEN 1800 ELSE 300 END
+    ) AS "seconds"
+  FROM "lesson_events"
+  WHERE "occurred_at" >= sqlc.arg('from_day')::date
+)
+INSERT INTO "lesson_engagement_daily" (
+  "user_id",
+  "lesson_id",
+  "day",
+  "time_on_task_seconds",
+  "opens",
+  "max_scroll_depth",
+  "video_plays",
+  "video_seeks",
+  "file_downloads"
+)
+SELECT
+  "timed_events"."user_id",
+  "timed_events"."lesson_id",
+  "timed_events"."occurred_at"::date,
+  SUM("timed_events"."seconds")::int,
+  COUNT(*) FILTER (WHERE "timed_events"."event_type" = 'lesson_open')::int,
+  MAX("timed_events"."scroll_depth")::smallint,
+  COUNT(*) FILTER (WHERE "timed_events"."event_type" = 'video_play')::int,
+  COUNT(*) FILTER (WHERE "timed_events"."event_type" = 'video_seek')::int,
+  COUNT(*) FILTER (WHERE "timed_events"."event_type" = 'file_download')::int
+FROM "timed_events"
+GROUP BY "timed_events"."user_id", "timed_events"."lesson_id", "timed_events"."occurred_at"::date
+ON CONFLICT ("user_id", "lesson_id", "day") DO UPDATE SET
+  "time_on_task_seconds" = EXCLUDED."time_on_task_seconds",
+  "opens" = EXCLUDED."opens",
+  "max_scroll_depth" = EXCLUDED."max_scroll_depth",
+  "video_plays" = EXCLUDED."video_plays",
+  "video_seeks" = EXCLUDED."video_seeks",
+  "file_downloads" = EXCLUDED."file_downloads",
+  "updated_at" = now();
+
+-- name: UpdateLessonProgressTimeOnTaskFrom :execrows
+UPDATE "lesson_progress" SET
+  "time_on_task_seconds" = "totals"."seconds"
+FROM (
+  SELECT
+    "user_id",
+    "lesson_id",
+    SUM("time_on_task_seconds")::int AS "seconds"
+  FROM "lesson_engagement_daily"
+  GROUP BY "user_id", "lesson_id"
+  HAVING MAX("day") >= sqlc.arg('from_day')::date
+) AS "totals"
+WHERE
+  "lesson_progress"."user_id" = "totals"."user_id" AND
+  "lesson_progress"."lesson_id" = "totals"."lesson_id" AND
+  "lesson_progress"."time_on_task_seconds" <> "totals"."seconds";
@@ -48,4 +48,13 @@ LIMIT 1;
 UPDATE "lesson_files" SET
     "name" = $1
 WHERE "id" = $2
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+-- name: DeleteLessonFilesByAuthorID :exec
+DELETE FROM "lesson_files"
+WHERE "author_id" = $1;
+
+-- name: IncrementLessonFileDownloadCount :one
+UPDATE "lesson_files" SET
+    "download_count" = "download_count" + 1
+WHERE "id" = $1
+RETURNING "download_count";
@@ -0,0 +1,107 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+-- name: CreateLessonNote :one
+INSERT INTO "lesson_notes" (
+    "body",
+    "anchor_heading",
+    "anchor_seconds",
+    "language_slug",
+    "series_slug",
+    "section_id",
+    "lesson_id",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+) RETURNING *;
+
+-- name: FindLessonNoteByIDAndUserID :one
+SELECT * FROM "lesson_notes"
+WHERE "id" = $1 AND "user_id" = $2
+LIMIT 1;
+
+-- name: UpdateLessonNote :one
+UPDATE "lesson_notes" SET
+    "body" = $1,
+    "anchor_heading" = $2,
+    "anchor_seconds" = $3,
+    "updated_at" = now()
+WHERE "id" = $4
+RETURNING *;
+
+-- name: DeleteLessonNoteByID :exec
+DELETE FROM "lesson_notes"
+WHERE "id" = $1;
+
+-- name: CountLessonNotesByUserIDAndLessonID :one
+SELECT COUNT("id") FROM "lesson_notes"
+WHERE "user_id" = $1 AND "lesson_id" = $2
+LIMIT 1;
+
+-- name: FindPaginatedLessonNotesByUserIDAndLessonID :many
+SELECT * FROM "lesson_notes"
+WHERE "user_id" = $1 AND "lesson_id" = $2
+ORDER BY "anchor_seconds" ASC, "id" ASC
+LIMIT $3 OFFSET $4;
+
+-- name: CountLessonNotesByUserIDAndSeriesSlug :one
+SELECT COUNT("id") FROM "lesson_notes"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1;
+
+-- name: FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlug :many
+SELECT
+    "lesson_notes".*,
+    "sections"."title" AS "section_title",
+    "lessons"."title" AS "lesson_title"
+FROM "lesson_notes"
+INNER JOIN "lessons" ON "lesson_notes"."lesson_id" = "lessons"."id"
+INNER JOIN "sections" ON "lesson_notes"."section_id" = "sections"."id"
+WHERE "lesson_notes"."user_id" = $1 AND "lesson_notes"."series_slug" = $2
+ORDER BY
+    "sections"."position" ASC,
+    "lessons"."position" ASC,
+    "lesson_notes"."anchor_seconds" ASC,
+    "lesson_notes"."id" ASC
+LIMIT $3 OFFSET $4;
+
+-- name: FindAllLessonNotesWithLessonByUserIDAndSeriesSlug :many
+SELECT
+    "lesson_notes".*,
+    "sections"."title" AS "section_title",
+    "lessons"."title" AS "lesson_title"
+FROM "lesson_notes"
+INNER JOIN "lessons" ON "lesson_notes"."lesson_id" = "lessons"."id"
+INNER JOIN "sections" ON "lesson_notes"."section_id" = "sections"."id"
+WHERE "lesson_notes"."user_id" = $1 AND "lesson_notes"."series_slug" = $2
+ORDER BY
+    "sections"."position" ASC,
+    "lessons"."position" ASC,
+    "lesson_notes"."anchor_seconds" ASC,
+    "lesson_notes"."id" ASC;
+
+-- name: UpdateLessonNotesSectionID :exec
+UPDATE "lesson_notes" SET
+  "section_id" = sqlc.arg('section_id')
+WHERE "lesson_id" = ANY(sqlc.arg('lesson_ids')::int[]);
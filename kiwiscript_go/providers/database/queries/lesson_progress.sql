@@ -63,6 +63,13 @@ UPDATE "lesson_progress"
 SET "completed_at" = NULL
 WHERE "id" = $1;
 
+-- name: UpdateLessonProgressSection :exec
+UPDATE "lesson_progress" SET
+  "section_id" = $1,
+  "section_progress_id" = $2,
+  "updated_at" = NOW()
+WHERE "id" = $3;
+
 -- name: DeleteLessonProgress :exec
 DELETE FROM "lesson_progress"
 WHERE "id" = $1;
@@ -71,3 +78,8 @@ WHERE "id" = $1;
 SELECT COUNT("id") FROM "lesson_progress"
 WHERE "lesson_id" = $1
 LIMIT 1;
+
+-- name: FindAllLessonProgressByUserID :many
+SELECT * FROM "lesson_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC;
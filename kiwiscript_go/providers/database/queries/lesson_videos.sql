@@ -43,4 +43,8 @@ WHERE "id" = $1;
 -- name: GetLessonVideoByLessonID :one
 SELECT * FROM "lesson_videos"
 WHERE "lesson_id" = $1
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+-- name: ReassignLessonVideosAuthor :exec
+UPDATE "lesson_videos" SET
+  "author_id" = sqlc.arg('new_author_id')
+WHERE "author_id" = sqlc.arg('old_author_id');
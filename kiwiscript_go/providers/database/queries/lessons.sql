@@ -37,23 +37,33 @@ INSERT INTO "lessons" (
 
 -- name: UpdateLesson :one
 UPDATE "lessons" SET
-  "title" = $1
-WHERE "id" = $2
+  "title" = $1,
+  "version" = "version" + 1
+WHERE "id" = $2 AND "version" = $3
 RETURNING *;
 
 -- name: UpdateLessonWithPosition :one
 UPDATE "lessons" SET
   "title" = $1,
-  "position" = $2
-WHERE "id" = $3
+  "position" = $2,
+  "version" = "version" + 1
+WHERE "id" = $3 AND "version" = $4
 RETURNING *;
 
 -- name: UpdateLessonIsPublished :one
 UPDATE "lessons" SET
-  "is_published" = $1
+  "is_published" = $1,
+  "version" = "version" + 1
 WHERE "id" = $2
 RETURNING *;
 
+-- name: UpdateLessonIsPublishedByIDAndVersion :one
+UPDATE "lessons" SET
+  "is_published" = $1,
+  "version" = "version" + 1
+WHERE "id" = $2 AND "version" = $3
+RETURNING *;
+
 -- name: UpdateLessonPosition :one
 UPDATE "lessons" SET
   "position" = $1
@@ -98,7 +108,8 @@ LIMIT $4 OFFSET $5;
 SELECT
     "lessons".*,
     "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
-    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at"
+    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds"
 FROM "lessons"
 LEFT JOIN "lesson_progress" ON (
     "lessons"."id" = "lesson_progress"."lesson_id" AND
@@ -123,11 +134,94 @@ ORDER BY "position" ASC
 LIMIT $4 OFFSET $5;
 
 
+-- name: FindLessonsBySlugsAndSectionIDAfterCursor :many
+SELECT * FROM "lessons"
+WHERE
+    "language_slug" = sqlc.arg('language_slug') AND
+    "series_slug" = sqlc.arg('series_slug') AND
+    "section_id" = sqlc.arg('section_id') AND
+    ("position", "id") > (sqlc.arg('position')::smallint, sqlc.arg('id')::int)
+ORDER BY "position" ASC, "id" ASC
+LIMIT sqlc.arg('limit');
+
+-- name: FindLessonsBySlugsAndSectionIDBeforeCursor :many
+SELECT * FROM "lessons"
+WHERE
+    "language_slug" = sqlc.arg('language_slug') AND
+    "series_slug" = sqlc.arg('series_slug') AND
+    "section_id" = sqlc.arg('section_id') AND
+    ("position", "id") < (sqlc.arg('position')::smallint, sqlc.arg('id')::int)
+ORDER BY "position" DESC, "id" DESC
+LIMIT sqlc.arg('limit');
+
+-- name: FindPublishedLessonsBySlugsAndSectionIDAfterCursor :many
+SELECT * FROM "lessons"
+WHERE
+    "language_slug" = sqlc.arg('language_slug') AND
+    "series_slug" = sqlc.arg('series_slug') AND
+    "section_id" = sqlc.arg('section_id') AND
+    "is_published" = true AND
+    ("position", "id") > (sqlc.arg('position')::smallint, sqlc.arg('id')::int)
+ORDER BY "position" ASC, "id" ASC
+LIMIT sqlc.arg('limit');
+
+-- name: FindPublishedLessonsBySlugsAndSectionIDBeforeCursor :many
+SELECT * FROM "lessons"
+WHERE
+    "language_slug" = sqlc.arg('language_slug') AND
+    "series_slug" = sqlc.arg('series_slug') AND
+    "section_id" = sqlc.arg('section_id') AND
+    "is_published" = true AND
+    ("position", "id") < (sqlc.arg('position')::smallint, sqlc.arg('id')::int)
+ORDER BY "position" DESC, "id" DESC
+LIMIT sqlc.arg('limit');
+
+-- name: FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursor :many
+SELECT
+    "lessons".*,
+    "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
+    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds"
+FROM "lessons"
+LEFT JOIN "lesson_progress" ON (
+    "lessons"."id" = "lesson_progress"."lesson_id" AND
+    "lesson_progress"."user_id" = sqlc.arg('user_id')
+)
+WHERE
+    "lessons"."language_slug" = sqlc.arg('language_slug') AND
+    "lessons"."series_slug" = sqlc.arg('series_slug') AND
+    "lessons"."section_id" = sqlc.arg('section_id') AND
+    "lessons"."is_published" = true AND
+    ("lessons"."position", "lessons"."id") > (sqlc.arg('position')::smallint, sqlc.arg('id')::int)
+ORDER BY "lessons"."position" ASC, "lessons"."id" ASC
+LIMIT sqlc.arg('limit');
+
+-- name: FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursor :many
+SELECT
+    "lessons".*,
+    "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
+    "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds"
+FROM "lessons"
+LEFT JOIN "lesson_progress" ON (
+    "lessons"."id" = "lesson_progress"."lesson_id" AND
+    "lesson_progress"."user_id" = sqlc.arg('user_id')
+)
+WHERE
+    "lessons"."language_slug" = sqlc.arg('language_slug') AND
+    "lessons"."series_slug" = sqlc.arg('series_slug') AND
+    "lessons"."section_id" = sqlc.arg('section_id') AND
+    "lessons"."is_published" = true AND
+    ("lessons"."position", "lessons"."id") < (sqlc.arg('position')::smallint, sqlc.arg('id')::int)
+ORDER BY "lessons"."position" DESC, "lessons"."id" DESC
+LIMIT sqlc.arg('limit');
+
 -- name: FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideo :one
 SELECT
     "lessons".*,
     "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
     "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds",
     "lesson_articles"."id" AS "lesson_acticle_id",
     "lesson_articles"."content" AS "lesson_article_content",
     "lesson_videos"."id" AS "lesson_video_id",
@@ -169,6 +263,12 @@ SELECT * FROM "lessons"
 WHERE "section_id" = $1
 ORDER BY "position" ASC;
 
+-- name: FindLessonsBySectionIDForUpdate :many
+SELECT * FROM "lessons"
+WHERE "section_id" = $1
+ORDER BY "position" ASC
+FOR UPDATE;
+
 -- name: UpdateLessonReadTimeSeconds :exec
 UPDATE "lessons" SET
   "read_time_seconds" = $2
@@ -193,11 +293,16 @@ LIMIT 1;
 DELETE FROM "lessons"
 WHERE "id" = $1;
 
+-- name: DeleteLessonByIDAndVersion :execrows
+DELETE FROM "lessons"
+WHERE "id" = $1 AND "version" = $2;
+
 -- name: FindCurrentLesson :one
 SELECT
     "lessons".*,
     "lesson_progress"."completed_at" AS "lesson_progress_completed_at",
     "lesson_progress"."viewed_at" AS "lesson_progress_viewed_at",
+    "lesson_progress"."time_on_task_seconds" AS "lesson_progress_time_on_task_seconds",
     "lesson_articles"."id" AS "lesson_acticle_id",
     "lesson_articles"."content" AS "lesson_article_content",
     "lesson_videos"."id" AS "lesson_video_id",
@@ -216,3 +321,72 @@ WHERE
     "lessons"."is_published" = true
 ORDER BY "lesson_progress"."viewed_at" DESC
 LIMIT 1;
+
+-- name: ReassignLessonsAuthor :exec
+UPDATE "lessons" SET
+  "author_id" = sqlc.arg('new_author_id')
+WHERE "author_id" = sqlc.arg('old_author_id');
+
+-- name: FindLessonsBySeriesSlugAndIDsForUpdate :many
+SELECT * FROM "lessons"
+WHERE
+  "series_slug" = sqlc.arg('series_slug') AND
+  "id" = ANY(sqlc.arg('ids')::int[])
+ORDER BY "id" ASC
+FOR UPDATE;
+
+-- name: ReorderLessons :exec
+UPDATE "lessons" SET
+  "section_id" = sqlc.arg('section_id'),
+  "position" = array_position(sqlc.arg('ids')::int[], "id")::smallint,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE
+  "series_slug" = sqlc.arg('series_slug') AND
+  "id" = ANY(sqlc.arg('ids')::int[]);
+
+-- name: CompactLessonPositions :exec
+UPDATE "lessons" SET
+  "position" = "ordered"."position"
+FROM (
+  SELECT
+    "section_lessons"."id",
+    (row_number() OVER (
+      ORDER BY "section_lessons"."position" ASC, "section_lessons"."id" ASC
+    ))::smallint AS "position"
+  FROM "lessons" AS "section_lessons"
+  WHERE "section_lessons"."section_id" = $1
+) AS "ordered"
+WHERE "lessons"."id" = "ordered"."id";
+
+-- name: UpdateLessonSchedule :one
+UPDATE "lessons" SET
+  "publish_at" = sqlc.arg('publish_at'),
+  "unpublish_at" = sqlc.arg('unpublish_at'),
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE "id" = sqlc.arg('id') AND "version" = sqlc.arg('version')
+RETURNING *;
+
+-- name: FindLessonsDueForScheduling :many
+SELECT * FROM "lessons"
+WHERE "publish_at" <= now() OR "unpublish_at" <= now()
+ORDER BY "id" ASC
+LIMIT $1;
+
+-- name: ClearLessonPublishAt :exec
+UPDATE "lessons" SET
+  "publish_at" = NULL
+WHERE "id" = $1 AND "publish_at" <= now();
+
+-- name: ClearLessonUnpublishAt :exec
+UPDATE "lessons" SET
+  "unpublish_at" = NULL
+WHERE "id" = $1 AND "unpublish_at" <= now();
+
+-- name: UpdateLessonIsPreview :one
+UPDATE "lessons" SET
+  "is_preview" = $1,
+  "version" = "version" + 1
+WHERE "id" = $2
+RETURNING *;
@@ -0,0 +1,84 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+-- name: UpsertOrganizationLicense :one
+INSERT INTO "organization_licenses" (
+  "organization_id",
+  "language_slug",
+  "series_slug",
+  "seats",
+  "granted_by_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+ON CONFLICT ("organization_id", "series_slug") DO UPDATE SET
+  "seats" = EXCLUDED."seats",
+  "granted_by_id" = EXCLUDED."granted_by_id",
+  "updated_at" = now()
+RETURNING *;
+
+-- name: FindOrganizationLicenseByOrganizationIDAndSeriesSlug :one
+SELECT * FROM "organization_licenses"
+WHERE "organization_id" = $1 AND "series_slug" = $2
+LIMIT 1;
+
+-- name: DeleteOrganizationLicense :exec
+DELETE FROM "organization_licenses"
+WHERE "id" = $1;
+
+-- CountOrganizationLicenseSeatsWithCohort counts the distinct users that hold
+-- a seat of the series through the organization cohorts once the given cohort
+-- is assigned the series.
+-- name: CountOrganizationLicenseSeatsWithCohort :one
+SELECT COUNT(DISTINCT "cohort_members"."user_id") FROM "cohort_members"
+INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_members"."cohort_id"
+WHERE
+  "cohort_members"."cohort_id" = sqlc.arg('cohort_id') OR (
+    "cohorts"."organization_id" = sqlc.arg('organization_id') AND
+    EXISTS (
+      SELECT 1 FROM "cohort_series"
+      WHERE
+        "cohort_series"."cohort_id" = "cohort_members"."cohort_id" AND
+        "cohort_series"."series_slug" = sqlc.arg('series_slug')
+    )
+  );
+
+-- FindFullOrganizationLicenseSeriesSlugByCohortID returns a licensed series
+-- of the cohort without a free seat for the user.
+-- name: FindFullOrganizationLicenseSeriesSlugByCohortID :one
+SELECT "organization_licenses"."series_slug" FROM "cohort_series"
+INNER JOIN "cohorts" ON "cohorts"."id" = "cohort_series"."cohort_id"
+INNER JOIN "organization_licenses" ON (
+  "organization_licenses"."organization_id" = "cohorts"."organization_id" AND
+  "organization_licenses"."series_slug" = "cohort_series"."series_slug"
+)
+WHERE
+  "cohort_series"."cohort_id" = sqlc.arg('cohort_id') AND
+  "organization_licenses"."seats" <= (
+    SELECT COUNT(DISTINCT "cohort_members"."user_id") FROM "cohort_members"
+    INNER JOIN "cohorts" AS "seat_cohorts" ON "seat_cohorts"."id" = "cohort_members"."cohort_id"
+    INNER JOIN "cohort_series" AS "seat_series" ON "seat_series"."cohort_id" = "cohort_members"."cohort_id"
+    WHERE
+      "seat_cohorts"."organization_id" = "organization_licenses"."organization_id" AND
+      "seat_series"."series_slug" = "organization_licenses"."series_slug" AND
+      "cohort_members"."user_id" <> sqlc.arg('user_id')
+  )
+LIMIT 1;
@@ -0,0 +1,143 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateOrganization :one
+INSERT INTO "organizations" (
+  "name",
+  "owner_id"
+) VALUES (
+  $1,
+  $2
+) RETURNING *;
+
+-- name: DeleteAllOrganizations :exec
+DELETE FROM "organizations";
+
+-- name: FindOrganizationByID :one
+SELECT * FROM "organizations"
+WHERE "id" = $1
+LIMIT 1;
+
+-- name: CountOrganizationsByUserID :one
+SELECT COUNT("organization_members"."id") FROM "organization_members"
+WHERE "organization_members"."user_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedOrganizationsByUserID :many
+SELECT "organizations".* FROM "organizations"
+INNER JOIN "organization_members" ON "organization_members"."organization_id" = "organizations"."id"
+WHERE "organization_members"."user_id" = $1
+ORDER BY "organizations"."name" ASC, "organizations"."id" ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CreateOrganizationMember :one
+INSERT INTO "organization_members" (
+  "organization_id",
+  "user_id",
+  "role"
+) VALUES (
+  $1,
+  $2,
+  $3
+) RETURNING *;
+
+-- name: FindOrganizationMember :one
+SELECT * FROM "organization_members"
+WHERE "organization_id" = $1 AND "user_id" = $2
+LIMIT 1;
+
+-- name: CountOrganizationMembers :one
+SELECT COUNT("id") FROM "organization_members"
+WHERE "organization_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedOrganizationMembersWithUser :many
+SELECT
+  "organization_members".*,
+  "users"."first_name" AS "user_first_name",
+  "users"."last_name" AS "user_last_name",
+  "users"."email" AS "user_email"
+FROM "organization_members"
+INNER JOIN "users" ON "users"."id" = "organization_members"."user_id"
+WHERE "organization_members"."organization_id" = $1
+ORDER BY "users"."last_name" ASC, "users"."first_name" ASC, "users"."id" ASC
+LIMIT $2 OFFSET $3;
+
+-- name: DeleteOrganizationMember :exec
+DELETE FROM "organization_members"
+WHERE "organization_id" = $1 AND "user_id" = $2;
+
+-- name: CreateOrganizationInvitation :one
+INSERT INTO "organization_invitations" (
+  "organization_id",
+  "email",
+  "role",
+  "token",
+  "invited_by_id",
+  "expires_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+ON CONFLICT ("organization_id", "email") DO UPDATE SET
+  "role" = EXCLUDED."role",
+  "token" = EXCLUDED."token",
+  "invited_by_id" = EXCLUDED."invited_by_id",
+  "expires_at" = EXCLUDED."expires_at",
+  "created_at" = now()
+RETURNING *;
+
+-- name: FindOrganizationInvitationByToken :one
+SELECT * FROM "organization_invitations"
+WHERE "token" = $1
+LIMIT 1;
+
+-- name: DeleteOrganizationInvitation :exec
+DELETE FROM "organization_invitations"
+WHERE "id" = $1;
+
+-- name: FindOrganizationsByOwnerIDForUpdate :many
+SELECT * FROM "organizations"
+WHERE "owner_id" = $1
+ORDER BY "id" ASC
+FOR UPDATE;
+
+-- name: FindOrganizationSuccessorMember :one
+SELECT * FROM "organization_members"
+WHERE "organization_id" = $1 AND "user_id" <> $2
+ORDER BY ("role" = 'admin') DESC, "created_at" ASC, "id" ASC
+LIMIT 1;
+
+-- name: UpdateOrganizationOwner :exec
+UPDATE "organizations" SET
+  "owner_id" = $1,
+  "updated_at" = now()
+WHERE "id" = $2;
+
+-- name: UpdateOrganizationMemberRole :exec
+UPDATE "organization_members" SET
+  "role" = $1
+WHERE "id" = $2;
+
+-- name: DeleteOrganizationByID :exec
+DELETE FROM "organizations"
+WHERE "id" = $1;
@@ -0,0 +1,58 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreatePayment :one
+INSERT INTO "payments" (
+  "user_id",
+  "language_slug",
+  "series_slug",
+  "provider",
+  "provider_session_id",
+  "amount",
+  "currency",
+  "coupon_id",
+  "status",
+  "completed_at"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10
+) RETURNING *;
+
+-- name: FindPaymentByProviderSessionID :one
+SELECT * FROM "payments"
+WHERE "provider_session_id" = $1
+LIMIT 1;
+
+-- name: CompletePayment :execrows
+UPDATE "payments" SET
+  "status" = 'completed',
+  "completed_at" = now()
+WHERE "id" = $1 AND "status" = 'pending';
+
+-- name: ExpirePayment :exec
+UPDATE "payments" SET
+  "status" = 'expired'
+WHERE "id" = $1 AND "status" = 'pending';
@@ -73,6 +73,21 @@ WHERE
     "section_progress"."id" = $1
 RETURNING "section_progress".*;
 
+-- name: IncrementSectionProgressCompletedLessonsInSeriesVersion :one
+UPDATE "section_progress"
+SET
+    "completed_lessons" = "completed_lessons" + 1,
+    "completed_at" = CASE
+        WHEN (
+            sqlc.arg(lessons_count)::smallint = "completed_lessons" + 1 AND
+            "completed_at" IS NULL
+        )
+            THEN (NOW())
+            ELSE "completed_at"
+    END
+WHERE "id" = sqlc.arg(id)
+RETURNING *;
+
 -- name: DecrementSectionProgressCompletedLessons :exec
 UPDATE "section_progress"
 SET
@@ -86,4 +101,8 @@ WHERE
 -- name: CountSectionProgress :one
 SELECT COUNT("id") FROM "section_progress"
 WHERE "section_id" = $1
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+-- name: FindAllSectionProgressByUserID :many
+SELECT * FROM "section_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC;
@@ -38,8 +38,9 @@ INSERT INTO "sections" (
 -- name: UpdateSection :one
 UPDATE "sections" SET
   "title" = $1,
-  "description" = $2
-WHERE "id" = $3
+  "description" = $2,
+  "version" = "version" + 1
+WHERE "id" = $3 AND "version" = $4
 RETURNING *;
 
 -- name: UpdateSectionWithPosition :one
@@ -47,17 +48,27 @@ UPDATE "sections" SET
   "title" = $1,
   "description" = $2,
   "position" = $3,
+  "version" = "version" + 1,
   "updated_at" = now()
-WHERE "id" = $4
+WHERE "id" = $4 AND "version" = $5
 RETURNING *;
 
 -- name: UpdateSectionIsPublished :one
 UPDATE "sections" SET
   "is_published" = $1,
+  "version" = "version" + 1,
   "updated_at" = now()
 WHERE "id" = $2
 RETURNING *;
 
+-- name: UpdateSectionIsPublishedByIDAndVersion :one
+UPDATE "sections" SET
+  "is_published" = $1,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE "id" = $2 AND "version" = $3
+RETURNING *;
+
 -- name: IncrementSectionPosition :exec
 UPDATE "sections" SET
   "position" = "position" + 1
@@ -179,6 +190,10 @@ WHERE "series_slug" = $1 AND "is_published" = true LIMIT 1;
 DELETE FROM "sections"
 WHERE "id" = $1;
 
+-- name: DeleteSectionByIDAndVersion :execrows
+DELETE FROM "sections"
+WHERE "id" = $1 AND "version" = $2;
+
 -- name: FindCurrentSection :one
 SELECT
     "sections".*,
@@ -196,3 +211,53 @@ WHERE
     "sections"."is_published" = true
 ORDER BY "section_progress"."viewed_at" DESC
 LIMIT 1;
+
+-- name: ReassignSectionsAuthor :exec
+UPDATE "sections" SET
+  "author_id" = sqlc.arg('new_author_id')
+WHERE "author_id" = sqlc.arg('old_author_id');
+
+-- name: FindSectionsBySeriesSlug :many
+SELECT * FROM "sections"
+WHERE "series_slug" = $1
+ORDER BY "position" ASC;
+
+-- name: FindSectionsBySeriesSlugForUpdate :many
+SELECT * FROM "sections"
+WHERE "series_slug" = $1
+ORDER BY "position" ASC
+FOR UPDATE;
+
+-- name: ReorderSections :exec
+UPDATE "sections" SET
+  "position" = array_position(sqlc.arg('ids')::int[], "id")::smallint,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE
+  "series_slug" = sqlc.arg('series_slug') AND
+  "id" = ANY(sqlc.arg('ids')::int[]);
+
+-- name: UpdateSectionSchedule :one
+UPDATE "sections" SET
+  "publish_at" = sqlc.arg('publish_at'),
+  "unpublish_at" = sqlc.arg('unpublish_at'),
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE "id" = sqlc.arg('id') AND "version" = sqlc.arg('version')
+RETURNING *;
+
+-- name: FindSectionsDueForScheduling :many
+SELECT * FROM "sections"
+WHERE "publish_at" <= now() OR "unpublish_at" <= now()
+ORDER BY "id" ASC
+LIMIT $1;
+
+-- name: ClearSectionPublishAt :exec
+UPDATE "sections" SET
+  "publish_at" = NULL
+WHERE "id" = $1 AND "publish_at" <= now();
+
+-- name: ClearSectionUnpublishAt :exec
+UPDATE "sections" SET
+  "unpublish_at" = NULL
+WHERE "id" = $1 AND "unpublish_at" <= now();
@@ -46,6 +46,13 @@ UPDATE "series" SET
 WHERE "id" = $2
 RETURNING *;
 
+-- name: ToggleSeriesIsPublished :one
+UPDATE "series" SET
+  "is_published" = NOT "is_published",
+  "updated_at" = now()
+WHERE "id" = $1 AND "is_published" = $2
+RETURNING *;
+
 -- name: FindPublishedSeriesBySlugsWithAuthor :one
 SELECT
   "series".*,
@@ -391,6 +398,58 @@ WHERE
 ORDER BY "series_progress"."viewed_at" DESC
 LIMIT $3 OFFSET $4;
 
+-- name: FindPublishedSeriesWithAuthorAndInnerProgressAfterCursor :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+INNER JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = sqlc.arg('user_id')
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."language_slug" = sqlc.arg('language_slug') AND
+  "series"."is_published" = true AND
+  ("series_progress"."viewed_at", "series_progress"."id") < (sqlc.arg('viewed_at')::timestamp, sqlc.arg('id')::int)
+ORDER BY "series_progress"."viewed_at" DESC, "series_progress"."id" DESC
+LIMIT sqlc.arg('limit');
+
+-- name: FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursor :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+INNER JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = sqlc.arg('user_id')
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."language_slug" = sqlc.arg('language_slug') AND
+  "series"."is_published" = true AND
+  ("series_progress"."viewed_at", "series_progress"."id") > (sqlc.arg('viewed_at')::timestamp, sqlc.arg('id')::int)
+ORDER BY "series_progress"."viewed_at" ASC, "series_progress"."id" ASC
+LIMIT sqlc.arg('limit');
+
 -- name: CountPublishedSeriesWithInnerProgress :one
 SELECT COUNT("series"."id") AS "count" FROM "series"
 INNER JOIN "series_progress" ON (
@@ -600,7 +659,223 @@ WHERE
 ORDER BY "series"."slug" ASC
 LIMIT $1 OFFSET $2;
 
+-- name: FindPaginatedSeriesWithAuthorSortByRating :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE "series"."language_slug" = $1
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: FindFilteredSeriesWithAuthorSortByRating :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+    "series"."language_slug" = $1 AND
+    (
+        "series"."title" ILIKE $2 OR
+        "users"."first_name" ILIKE $2 OR
+        "users"."last_name" ILIKE $2
+    )
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $3 OFFSET $4;
+
+-- name: FindPaginatedPublishedSeriesWithAuthorSortByRating :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+    "series"."language_slug" = $1 AND
+    "series"."is_published" = true
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: FindFilteredPublishedSeriesWithAuthorSortByRating :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+    "series"."language_slug" = $1 AND
+    "series"."is_published" = true AND
+    (
+        "series"."title" ILIKE $2 OR
+        "users"."first_name" ILIKE $2 OR
+        "users"."last_name" ILIKE $2
+    )
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $3 OFFSET $4;
+
+-- name: FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRating :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."language_slug" = $2 AND
+  "series"."is_published" = true
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $3 OFFSET $4;
+
+-- name: FindFilteredPublishedSeriesWithAuthorAndProgressSortByRating :many
+SELECT
+  "series".*,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."language_slug" = $3 AND
+  "series"."is_published" = true AND
+  (
+    "series"."title" ILIKE $2 OR
+    "users"."first_name" ILIKE $2 OR
+    "users"."last_name" ILIKE $2
+  )
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $4 OFFSET $5;
+
+-- name: AddSeriesReview :exec
+UPDATE "series" SET
+  "reviews_count" = "reviews_count" + 1,
+  "rating_total" = "rating_total" + $2
+WHERE "slug" = $1;
+
+-- name: RemoveSeriesReview :exec
+UPDATE "series" SET
+  "reviews_count" = "reviews_count" - 1,
+  "rating_total" = "rating_total" - $2
+WHERE "slug" = $1;
+
+-- name: AddSeriesRatingTotal :exec
+UPDATE "series" SET
+  "rating_total" = "rating_total" + $2
+WHERE "slug" = $1;
+
 -- name: DeleteAllLanguageSeries :exec
 DELETE FROM "series"
 WHERE "language_slug" = $1;
 
+
+-- name: FindPublishedSeriesByAuthorID :many
+SELECT * FROM "series"
+WHERE "author_id" = $1 AND "is_published" = true;
+
+-- name: ReassignSeriesAuthor :exec
+UPDATE "series" SET
+  "author_id" = sqlc.arg('new_author_id')
+WHERE "author_id" = sqlc.arg('old_author_id');
+
+-- name: UpdateSeriesSchedule :one
+UPDATE "series" SET
+  "publish_at" = sqlc.arg('publish_at'),
+  "unpublish_at" = sqlc.arg('unpublish_at'),
+  "updated_at" = now()
+WHERE "id" = sqlc.arg('id')
+RETURNING *;
+
+-- name: FindSeriesDueForScheduling :many
+SELECT * FROM "series"
+WHERE "publish_at" <= now() OR "unpublish_at" <= now()
+ORDER BY "id" ASC
+LIMIT $1;
+
+-- name: ClearSeriesPublishAt :exec
+UPDATE "series" SET
+  "publish_at" = NULL
+WHERE "id" = $1 AND "publish_at" <= now();
+
+-- name: ClearSeriesUnpublishAt :exec
+UPDATE "series" SET
+  "unpublish_at" = NULL
+WHERE "id" = $1 AND "unpublish_at" <= now();
+
+-- name: UpdateSeriesEditorialStatus :one
+UPDATE "series" SET
+  "editorial_status" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: UpdateSeriesPrice :one
+UPDATE "series" SET
+  "price" = $1,
+  "currency" = $2,
+  "updated_at" = now()
+WHERE "id" = $3
+RETURNING *;
+
+-- name: UpdateSeriesIsMembersOnly :one
+UPDATE "series" SET
+  "is_members_only" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
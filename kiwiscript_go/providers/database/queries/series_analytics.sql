@@ -0,0 +1,128 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: RefreshSeriesDailyStats :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY "series_daily_stats";
+
+-- name: RefreshSectionDailyStats :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY "section_daily_stats";
+
+-- name: RefreshLessonDailyStats :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY "lesson_daily_stats";
+
+-- name: UpsertAnalyticsRefresh :exec
+INSERT INTO "analytics_refreshes" ("name") VALUES ($1)
+ON CONFLICT ("name") DO UPDATE SET "refreshed_at" = now();
+
+-- name: FindAnalyticsRefreshByName :one
+SELECT * FROM "analytics_refreshes"
+WHERE "name" = $1
+LIMIT 1;
+
+-- name: FindSeriesDailyStatsBySlugs :many
+SELECT * FROM "series_daily_stats"
+WHERE
+  "language_slug" = $1 AND
+  "series_slug" = $2 AND
+  "day" BETWEEN sqlc.arg('from_day')::date AND sqlc.arg('to_day')::date
+ORDER BY "day" ASC;
+
+-- name: SumSeriesDailyStatsBySlugs :one
+SELECT
+  COALESCE(SUM("learners"), 0)::int AS "learners",
+  COALESCE(SUM("enrollments"), 0)::int AS "enrollments",
+  COALESCE(SUM("completions"), 0)::int AS "completions",
+  COALESCE(SUM("certificates"), 0)::int AS "certificates"
+FROM "series_daily_stats"
+WHERE
+  "language_slug" = $1 AND
+  "series_slug" = $2 AND
+  "day" BETWEEN sqlc.arg('from_day')::date AND sqlc.arg('to_day')::date;
+
+-- name: FindSeriesMedianCompletionSeconds :one
+SELECT COALESCE(
+  percentile_cont(0.5) WITHIN GROUP (
+    ORDER BY EXTRACT(EPOCH FROM ("completed_at" - "created_at"))
+  ),
+  0
+)::int AS "median_seconds"
+FROM "series_progress"
+WHERE
+  "language_slug" = $1 AND
+  "series_slug" = $2 AND
+  "completed_at" >= sqlc.arg('from_day')::date AND
+  "completed_at" < sqlc.arg('to_day')::date + 1;
+
+-- name: FindSectionFunnelStatsBySlugs :many
+SELECT
+  "sections"."id",
+  "sections"."title",
+  "sections"."position",
+  COALESCE(SUM("section_daily_stats"."learners"), 0)::int AS "learners",
+  COALESCE(SUM("section_daily_stats"."completions"), 0)::int AS "completions"
+FROM "sections"
+LEFT JOIN "section_daily_stats" ON (
+  "section_daily_stats"."section_id" = "sections"."id" AND
+  "section_daily_stats"."day" BETWEEN sqlc.arg('from_day')::date AND sqlc.arg('to_day')::date
+)
+WHERE
+  "sections"."language_slug" = $1 AND
+  "sections"."series_slug" = $2 AND
+  "sections"."is_published" = true
+GROUP BY "sections"."id"
+ORDER BY "sections"."position" ASC;
+
+-- name: FindLessonFunnelStatsBySlugs :many
+WITH "engagement" AS (
+  SELECT
+    "lesson_engagement_daily"."lesson_id",
+    SUM("lesson_engagement_daily"."opens")::int AS "opens",
+    COUNT(DISTINCT "lesson_engagement_daily"."user_id")::int AS "engaged_learners",
+    SUM("lesson_engagement_daily"."time_on_task_seconds")::int AS "time_on_task_seconds"
+  FROM "lesson_engagement_daily"
+  INNER JOIN "lessons" ON "lessons"."id" = "lesson_engagement_daily"."lesson_id"
+  WHERE
+    "lessons"."language_slug" = $1 AND
+    "lessons"."series_slug" = $2 AND
+    "lesson_engagement_daily"."day" BETWEEN sqlc.arg('from_day')::date AND sqlc.arg('to_day')::date
+  GROUP BY "lesson_engagement_daily"."lesson_id"
+)
+SELECT
+  "lessons"."id",
+  "lessons"."section_id",
+  "lessons"."title",
+  "lessons"."position",
+  COALESCE(SUM("lesson_daily_stats"."learners"), 0)::int AS "learners",
+  COALESCE(SUM("lesson_daily_stats"."completions"), 0)::int AS "completions",
+  COALESCE(MAX("engagement"."opens"), 0)::int AS "opens",
+  COALESCE(MAX("engagement"."engaged_learners"), 0)::int AS "engaged_learners",
+  COALESCE(MAX("engagement"."time_on_task_seconds"), 0)::int AS "time_on_task_seconds"
+FROM "lessons"
+INNER JOIN "sections" ON "sections"."id" = "lessons"."section_id"
+LEFT JOIN "lesson_daily_stats" ON (
+  "lesson_daily_stats"."lesson_id" = "lessons"."id" AND
+  "lesson_daily_stats"."day" BETWEEN sqlc.arg('from_day')::date AND sqlc.arg('to_day')::date
+)
+LEFT JOIN "engagement" ON "engagement"."lesson_id" = "lessons"."id"
+WHERE
+  "lessons"."language_slug" = $1 AND
+  "lessons"."series_slug" = $2 AND
+  "lessons"."is_published" = true AND
+  "sections"."is_published" = true
+GROUP BY "lessons"."id", "sections"."position"
+ORDER BY "sections"."position" ASC, "lessons"."position" ASC;
@@ -0,0 +1,79 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateSeriesEditorialReviewer :one
+INSERT INTO "series_editorial_reviewers" (
+  "language_slug",
+  "series_slug",
+  "user_id"
+) VALUES (
+  $1,
+  $2,
+  $3
+) RETURNING *;
+
+-- name: FindSeriesEditorialReviewerBySeriesSlugAndUserID :one
+SELECT * FROM "series_editorial_reviewers"
+WHERE "series_slug" = $1 AND "user_id" = $2
+LIMIT 1;
+
+-- name: FindSeriesEditorialReviewersWithUserBySeriesSlug :many
+SELECT
+  "series_editorial_reviewers".*,
+  "users"."first_name" AS "user_first_name",
+  "users"."last_name" AS "user_last_name"
+FROM "series_editorial_reviewers"
+INNER JOIN "users" ON "series_editorial_reviewers"."user_id" = "users"."id"
+WHERE "series_editorial_reviewers"."series_slug" = $1
+ORDER BY "series_editorial_reviewers"."id" ASC;
+
+-- name: CountSeriesEditorialReviewersBySeriesSlug :one
+SELECT COUNT("id") FROM "series_editorial_reviewers"
+WHERE "series_slug" = $1;
+
+-- name: DeleteSeriesEditorialReviewerByID :exec
+DELETE FROM "series_editorial_reviewers"
+WHERE "id" = $1;
+
+-- name: CreateSeriesChangeRequest :one
+INSERT INTO "series_change_requests" (
+  "comment",
+  "language_slug",
+  "series_slug",
+  "reviewer_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4
+) RETURNING *;
+
+-- name: FindSeriesChangeRequestsWithReviewerBySeriesSlug :many
+SELECT
+  "series_change_requests".*,
+  "users"."first_name" AS "reviewer_first_name",
+  "users"."last_name" AS "reviewer_last_name"
+FROM "series_change_requests"
+INNER JOIN "users" ON "series_change_requests"."reviewer_id" = "users"."id"
+WHERE "series_change_requests"."series_slug" = $1
+ORDER BY "series_change_requests"."id" DESC;
+
+-- name: ResolveSeriesChangeRequests :exec
+UPDATE "series_change_requests" SET
+  "resolved_at" = now()
+WHERE "series_slug" = $1 AND "resolved_at" IS NULL;
@@ -35,4 +35,7 @@ WHERE "id" = $1;
 -- name: FindSeriesPictureBySeriesID :one
 SELECT * FROM "series_pictures"
 WHERE "series_id" = $1
-LIMIT 1;
\ No newline at end of file
+LIMIT 1;
+-- name: DeleteSeriesPicturesByAuthorID :exec
+DELETE FROM "series_pictures"
+WHERE "author_id" = $1;
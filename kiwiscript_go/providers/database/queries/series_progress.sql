@@ -20,12 +20,14 @@ INSERT INTO "series_progress" (
   "language_slug",
   "series_slug",
   "language_progress_id",
-  "user_id"
+  "user_id",
+  "series_version_id"
 ) VALUES (
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5
 ) RETURNING *;
 
 -- name: UpdateSeriesProgressViewedAt :exec
@@ -41,6 +43,11 @@ WHERE
     "user_id" = $3
 LIMIT 1;
 
+-- name: FindSeriesProgressByID :one
+SELECT * FROM "series_progress"
+WHERE "id" = $1
+LIMIT 1;
+
 -- name: FindSeriesProgressByLanguageProgressID :many
 SELECT * FROM "series_progress"
 WHERE "language_progress_id" = $1
@@ -65,6 +72,50 @@ FROM "series"
 WHERE "series_progress"."id" = $1
 RETURNING "series_progress".*;
 
+-- name: IncrementSeriesProgressCompletedSectionsInSeriesVersion :one
+UPDATE "series_progress" SET
+    "completed_sections" = "completed_sections" + 1,
+    "completed_lessons" = "completed_lessons" + 1,
+    "completed_at" = CASE
+        WHEN (
+            "completed_sections" + 1 >= sqlc.arg(sections_count)::smallint AND
+            "completed_at" IS NULL
+        ) THEN (NOW())
+        ELSE "completed_at"
+    END
+WHERE "id" = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateSeriesProgressSeriesVersion :one
+UPDATE "series_progress" SET
+    "series_version_id" = sqlc.arg(series_version_id),
+    "completed_lessons" = (
+        SELECT COALESCE(SUM("section_progress"."completed_lessons"), 0)::smallint
+        FROM "section_progress"
+        WHERE "section_progress"."series_progress_id" = "series_progress"."id"
+    ),
+    "completed_sections" = (
+        SELECT COUNT("section_progress"."id")::smallint
+        FROM "section_progress"
+        WHERE
+            "section_progress"."series_progress_id" = "series_progress"."id" AND
+            "section_progress"."completed_at" IS NOT NULL
+    ),
+    "completed_at" = CASE
+        WHEN (
+            SELECT COUNT("section_progress"."id")
+            FROM "section_progress"
+            WHERE
+                "section_progress"."series_progress_id" = "series_progress"."id" AND
+                "section_progress"."completed_at" IS NOT NULL
+        ) >= sqlc.arg(sections_count)::smallint
+            THEN COALESCE("series_progress"."completed_at", NOW())
+        ELSE NULL
+    END,
+    "updated_at" = NOW()
+WHERE "series_progress"."id" = sqlc.arg(id)
+RETURNING *;
+
 -- name: DecrementSeriesProgressCompletedSections :exec
 UPDATE "series_progress" SET
     "completed_sections" = "completed_sections" - 1,
@@ -91,3 +142,8 @@ WHERE "id" = $1;
 SELECT COUNT("id") FROM "series_progress"
 WHERE "series_slug" = $1
 LIMIT 1;
+
+-- name: FindAllSeriesProgressByUserID :many
+SELECT * FROM "series_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC;
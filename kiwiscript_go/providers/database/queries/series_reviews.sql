@@ -0,0 +1,119 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+-- name: CreateSeriesReview :one
+INSERT INTO "series_reviews" (
+    "rating",
+    "review",
+    "language_slug",
+    "series_slug",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING *;
+
+-- name: FindSeriesReviewByIDAndSeriesSlug :one
+SELECT * FROM "series_reviews"
+WHERE "id" = $1 AND "series_slug" = $2
+LIMIT 1;
+
+-- name: FindSeriesReviewByIDForUpdate :one
+SELECT * FROM "series_reviews"
+WHERE "id" = $1
+LIMIT 1
+FOR UPDATE;
+
+-- name: FindSeriesReviewByUserIDAndSeriesSlug :one
+SELECT * FROM "series_reviews"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1;
+
+-- name: FindSeriesReviewByIDWithAuthor :one
+SELECT
+    "series_reviews".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "series_reviews"
+INNER JOIN "users" ON "series_reviews"."user_id" = "users"."id"
+WHERE "series_reviews"."id" = $1
+LIMIT 1;
+
+-- name: UpdateSeriesReview :one
+UPDATE "series_reviews" SET
+    "rating" = $1,
+    "review" = $2,
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING *;
+
+-- name: UpdateSeriesReviewReply :one
+UPDATE "series_reviews" SET
+    "reply" = $1,
+    "reply_author_id" = $2,
+    "replied_at" = now(),
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING *;
+
+-- name: UpdateSeriesReviewIsHidden :one
+UPDATE "series_reviews" SET
+    "is_hidden" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: DeleteSeriesReviewByID :exec
+DELETE FROM "series_reviews"
+WHERE "id" = $1;
+
+-- name: CountSeriesReviewsBySeriesSlug :one
+SELECT COUNT("id") FROM "series_reviews"
+WHERE "series_slug" = $1 AND "is_hidden" = false
+LIMIT 1;
+
+-- name: CountAllSeriesReviewsBySeriesSlug :one
+SELECT COUNT("id") FROM "series_reviews"
+WHERE "series_slug" = $1
+LIMIT 1;
+
+-- name: FindPaginatedSeriesReviewsWithAuthor :many
+SELECT
+    "series_reviews".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "series_reviews"
+INNER JOIN "users" ON "series_reviews"."user_id" = "users"."id"
+WHERE
+    "series_reviews"."series_slug" = $1 AND
+    "series_reviews"."is_hidden" = false
+ORDER BY "series_reviews"."created_at" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: FindAllPaginatedSeriesReviewsWithAuthor :many
+SELECT
+    "series_reviews".*,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "series_reviews"
+INNER JOIN "users" ON "series_reviews"."user_id" = "users"."id"
+WHERE "series_reviews"."series_slug" = $1
+ORDER BY "series_reviews"."created_at" DESC
+LIMIT $2 OFFSET $3;
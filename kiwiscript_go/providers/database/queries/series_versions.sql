@@ -0,0 +1,186 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateSeriesVersion :one
+INSERT INTO "series_versions" (
+  "language_slug",
+  "series_slug",
+  "version",
+  "title",
+  "sections_count",
+  "lessons_count",
+  "watch_time_seconds",
+  "read_time_seconds",
+  "author_id"
+)
+SELECT
+  "series"."language_slug",
+  "series"."slug",
+  (
+    SELECT COALESCE(MAX("previous"."version"), 0) + 1
+    FROM "series_versions" AS "previous"
+    WHERE "previous"."series_slug" = "series"."slug"
+  )::smallint,
+  "series"."title",
+  (
+    SELECT COUNT(DISTINCT "lessons"."section_id")
+    FROM "lessons"
+    INNER JOIN "sections" ON "sections"."id" = "lessons"."section_id"
+    WHERE
+      "lessons"."series_slug" = "series"."slug" AND
+      "lessons"."is_published" = true AND
+      "sections"."is_published" = true
+  )::smallint,
+  (
+    SELECT COUNT("lessons"."id")
+    FROM "lessons"
+    INNER JOIN "sections" ON "sections"."id" = "lessons"."section_id"
+    WHERE
+      "lessons"."series_slug" = "series"."slug" AND
+      "lessons"."is_published" = true AND
+      "sections"."is_published" = true
+  )::smallint,
+  "series"."watch_time_seconds",
+  "series"."read_time_seconds",
+  sqlc.arg(author_id)
+FROM "series"
+WHERE "series"."id" = sqlc.arg(series_id)
+RETURNING *;
+
+-- name: CreateSeriesVersionLessons :exec
+INSERT INTO "series_version_lessons" (
+  "series_version_id",
+  "section_id",
+  "lesson_id",
+  "section_position",
+  "position"
+)
+SELECT
+  sqlc.arg(series_version_id),
+  "lessons"."section_id",
+  "lessons"."id",
+  "sections"."position",
+  "lessons"."position"
+FROM "lessons"
+INNER JOIN "sections" ON "sections"."id" = "lessons"."section_id"
+WHERE
+  "lessons"."series_slug" = sqlc.arg(series_slug) AND
+  "lessons"."is_published" = true AND
+  "sections"."is_published" = true;
+
+-- name: FindLatestSeriesVersionBySeriesSlug :one
+SELECT * FROM "series_versions"
+WHERE "series_slug" = $1
+ORDER BY "version" DESC
+LIMIT 1;
+
+-- name: FindSeriesVersionByID :one
+SELECT * FROM "series_versions"
+WHERE "id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedSeriesVersionsBySeriesSlug :many
+SELECT * FROM "series_versions"
+WHERE "series_slug" = $1
+ORDER BY "version" DESC
+OFFSET $2 LIMIT $3;
+
+-- name: CountSeriesVersionsBySeriesSlug :one
+SELECT COUNT("id") FROM "series_versions"
+WHERE "series_slug" = $1
+LIMIT 1;
+
+-- name: FindSeriesVersionLessonByLessonID :one
+SELECT * FROM "series_version_lessons"
+WHERE
+  "series_version_id" = $1 AND
+  "lesson_id" = $2
+LIMIT 1;
+
+-- name: CountSeriesVersionLessonsBySectionID :one
+SELECT COUNT("id") FROM "series_version_lessons"
+WHERE
+  "series_version_id" = $1 AND
+  "section_id" = $2
+LIMIT 1;
+
+-- name: FindLessonProgressMovedInSeriesVersion :many
+SELECT
+  "lesson_progress"."id",
+  "series_version_lessons"."section_id"
+FROM "lesson_progress"
+INNER JOIN "series_version_lessons" ON (
+  "series_version_lessons"."lesson_id" = "lesson_progress"."lesson_id" AND
+  "series_version_lessons"."series_version_id" = sqlc.arg(series_version_id)
+)
+WHERE
+  "lesson_progress"."series_progress_id" = sqlc.arg(series_progress_id) AND
+  "series_version_lessons"."section_id" <> "lesson_progress"."section_id";
+
+-- name: DeleteLessonProgressNotInSeriesVersion :exec
+DELETE FROM "lesson_progress"
+WHERE
+  "lesson_progress"."series_progress_id" = sqlc.arg(series_progress_id) AND
+  NOT EXISTS (
+    SELECT 1 FROM "series_version_lessons"
+    WHERE
+      "series_version_lessons"."series_version_id" = sqlc.arg(series_version_id) AND
+      "series_version_lessons"."lesson_id" = "lesson_progress"."lesson_id"
+  );
+
+-- name: DeleteSectionProgressNotInSeriesVersion :exec
+DELETE FROM "section_progress"
+WHERE
+  "section_progress"."series_progress_id" = sqlc.arg(series_progress_id) AND
+  NOT EXISTS (
+    SELECT 1 FROM "series_version_lessons"
+    WHERE
+      "series_version_lessons"."series_version_id" = sqlc.arg(series_version_id) AND
+      "series_version_lessons"."section_id" = "section_progress"."section_id"
+  );
+
+-- name: RecountSectionProgressInSeriesVersion :exec
+UPDATE "section_progress" SET
+  "completed_lessons" = "totals"."completed_lessons",
+  "completed_at" = CASE
+    WHEN "totals"."completed_lessons" = "totals"."lessons_count"
+      THEN COALESCE("section_progress"."completed_at", NOW())
+    ELSE NULL
+  END,
+  "updated_at" = NOW()
+FROM (
+  SELECT
+    "series_version_lessons"."section_id",
+    COUNT("series_version_lessons"."id")::smallint AS "lessons_count",
+    COUNT("lesson_progress"."completed_at")::smallint AS "completed_lessons"
+  FROM "series_version_lessons"
+  LEFT JOIN "lesson_progress" ON (
+    "lesson_progress"."lesson_id" = "series_version_lessons"."lesson_id" AND
+    "lesson_progress"."series_progress_id" = sqlc.arg(series_progress_id)
+  )
+  WHERE "series_version_lessons"."series_version_id" = sqlc.arg(series_version_id)
+  GROUP BY "series_version_lessons"."section_id"
+) AS "totals"
+WHERE
+  "section_progress"."series_progress_id" = sqlc.arg(series_progress_id) AND
+  "section_progress"."section_id" = "totals"."section_id";
+
+-- name: ReassignSeriesVersionsAuthor :exec
+UPDATE "series_versions" SET
+  "author_id" = sqlc.arg('new_author_id')
+WHERE "author_id" = sqlc.arg('old_author_id');
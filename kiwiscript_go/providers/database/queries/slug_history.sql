@@ -0,0 +1,75 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateLanguageSlugHistory :exec
+INSERT INTO "language_slug_history" (
+  "language_id",
+  "old_slug"
+) VALUES (
+  $1,
+  $2
+)
+ON CONFLICT ("old_slug") DO UPDATE SET
+  "language_id" = EXCLUDED."language_id",
+  "created_at" = now();
+
+-- name: CreateSeriesSlugHistory :exec
+INSERT INTO "series_slug_history" (
+  "series_id",
+  "old_slug"
+) VALUES (
+  $1,
+  $2
+)
+ON CONFLICT ("old_slug") DO UPDATE SET
+  "series_id" = EXCLUDED."series_id",
+  "created_at" = now();
+
+-- name: FindLanguageSlugByOldSlug :one
+SELECT "languages"."slug" FROM "language_slug_history"
+INNER JOIN "languages" ON "languages"."id" = "language_slug_history"."language_id"
+WHERE
+  "language_slug_history"."old_slug" = $1 AND
+  NOT EXISTS (
+    SELECT 1 FROM "languages" AS "live_languages"
+    WHERE "live_languages"."slug" = $1
+  )
+LIMIT 1;
+
+-- name: FindSeriesSlugsByOldSlugs :one
+SELECT
+  "series"."language_slug",
+  "series"."slug"
+FROM "series_slug_history"
+INNER JOIN "series" ON "series"."id" = "series_slug_history"."series_id"
+WHERE
+  "series_slug_history"."old_slug" = sqlc.arg(series_slug) AND
+  NOT EXISTS (
+    SELECT 1 FROM "series" AS "live_series"
+    WHERE "live_series"."slug" = sqlc.arg(series_slug)
+  ) AND (
+    "series"."language_slug" = sqlc.arg(language_slug) OR
+    EXISTS (
+      SELECT 1 FROM "language_slug_history"
+      INNER JOIN "languages" ON "languages"."id" = "language_slug_history"."language_id"
+      WHERE
+        "language_slug_history"."old_slug" = sqlc.arg(language_slug) AND
+        "languages"."slug" = "series"."language_slug"
+    )
+  )
+LIMIT 1;
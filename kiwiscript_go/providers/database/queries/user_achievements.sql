@@ -0,0 +1,31 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateUserAchievement :execrows
+INSERT INTO "user_achievements" (
+    "user_id",
+    "achievement_slug"
+) VALUES (
+    $1,
+    $2
+) ON CONFLICT ("user_id", "achievement_slug") DO NOTHING;
+
+-- name: FindUserAchievementsByUserID :many
+SELECT * FROM "user_achievements"
+WHERE "user_id" = $1
+ORDER BY "unlocked_at" ASC;
@@ -0,0 +1,70 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateUserDataExport :one
+INSERT INTO "user_data_exports" (
+    "id",
+    "user_id"
+) VALUES (
+    $1,
+    $2
+) RETURNING *;
+
+-- name: FindLatestUserDataExportByUserID :one
+SELECT * FROM "user_data_exports"
+WHERE "user_id" = $1
+ORDER BY "created_at" DESC
+LIMIT 1;
+
+-- name: ClaimPendingUserDataExports :many
+UPDATE "user_data_exports" SET
+    "status" = 'processing',
+    "updated_at" = now()
+WHERE "id" IN (
+    SELECT "id" FROM "user_data_exports"
+    WHERE "status" = 'pending'
+    ORDER BY "created_at" ASC
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteUserDataExport :exec
+UPDATE "user_data_exports" SET
+    "status" = 'completed',
+    "expires_at" = $1,
+    "updated_at" = now()
+WHERE "id" = $2;
+
+-- name: FailUserDataExport :exec
+UPDATE "user_data_exports" SET
+    "status" = 'failed',
+    "updated_at" = now()
+WHERE "id" = $1;
+
+-- name: FindExpiredUserDataExports :many
+SELECT * FROM "user_data_exports"
+WHERE "status" = 'completed' AND "expires_at" <= now()
+ORDER BY "expires_at" ASC
+LIMIT $1;
+
+-- name: ExpireUserDataExport :exec
+UPDATE "user_data_exports" SET
+    "status" = 'expired',
+    "updated_at" = now()
+WHERE "id" = $1;
@@ -0,0 +1,52 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateUserLearningStats :one
+INSERT INTO "user_learning_stats" (
+    "user_id"
+) VALUES (
+    $1
+) RETURNING *;
+
+-- name: FindUserLearningStatsByUserID :one
+SELECT * FROM "user_learning_stats"
+WHERE "user_id" = $1
+LIMIT 1;
+
+-- name: UpdateUserLearningStatsTimezone :one
+UPDATE "user_learning_stats" SET
+    "timezone" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING *;
+
+-- name: UpdateUserLearningStatsStreak :one
+UPDATE "user_learning_stats" SET
+    "current_streak" = $1,
+    "longest_streak" = GREATEST("user_learning_stats"."longest_streak", $1),
+    "last_activity_date" = $2,
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING *;
+
+-- name: AddUserLearningStatsXp :one
+UPDATE "user_learning_stats" SET
+    "xp_total" = "user_learning_stats"."xp_total" + sqlc.arg('points')::int,
+    "updated_at" = now()
+WHERE "id" = sqlc.arg('id')
+RETURNING *;
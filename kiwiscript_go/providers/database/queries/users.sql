@@ -111,3 +111,64 @@ WHERE "id" = $2;
 
 -- name: DeleteAllUsers :exec
 DELETE FROM "users";
+
+-- name: SoftDeleteUserById :exec
+UPDATE "users" SET
+  "deleted_at" = now(),
+  "version" = "version" + 1
+WHERE "id" = $1;
+
+-- name: RestoreUserById :one
+UPDATE "users" SET
+  "deleted_at" = NULL
+WHERE "id" = $1
+RETURNING *;
+
+-- name: FindUsersDueForPurge :many
+SELECT * FROM "users"
+WHERE "deleted_at" IS NOT NULL AND "deleted_at" <= $1
+ORDER BY "deleted_at" ASC
+LIMIT $2;
+
+-- Locks the row so a concurrent restore waits for the purge to either finish or roll back
+-- name: FindUserDueForPurgeByIDForUpdate :one
+SELECT * FROM "users"
+WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "deleted_at" <= $2
+LIMIT 1
+FOR UPDATE;
+
+-- name: DeleteUserDueForPurgeByID :execrows
+DELETE FROM "users"
+WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "deleted_at" <= $2;
+
+-- name: CreatePurgedUserObjects :exec
+INSERT INTO "purged_user_objects" ("user_id")
+VALUES ($1)
+ON CONFLICT ("user_id") DO NOTHING;
+
+-- name: FindPurgedUserObjects :many
+SELECT "user_id" FROM "purged_user_objects"
+ORDER BY "created_at" ASC
+LIMIT $1;
+
+-- name: DeletePurgedUserObjects :exec
+DELETE FROM "purged_user_objects"
+WHERE "user_id" = $1;
+
+-- name: FindSuccessorAdminUser :one
+SELECT * FROM "users"
+WHERE "is_admin" = true AND "deleted_at" IS NULL AND "id" <> $1
+ORDER BY "id" ASC
+LIMIT 1;
+
+-- name: UserHasAuthoredContent :one
+SELECT (
+  EXISTS (SELECT 1 FROM "languages" WHERE "languages"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "series" WHERE "series"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "series_versions" WHERE "series_versions"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "sections" WHERE "sections"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "lessons" WHERE "lessons"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "lesson_articles" WHERE "lesson_articles"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "lesson_videos" WHERE "lesson_videos"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "coupons" WHERE "coupons"."created_by_id" = $1)
+)::boolean AS "has_content";
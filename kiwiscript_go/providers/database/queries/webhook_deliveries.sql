@@ -0,0 +1,113 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+-- Redeliveries are sent inline, the delivery is only due for the worker once
+-- its lease expires without the send having recorded an outcome
+-- name: CreateWebhookDelivery :one
+INSERT INTO "webhook_deliveries" (
+    "id",
+    "webhook_id",
+    "event",
+    "payload",
+    "next_attempt_at"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    now() + interval '5 minutes'
+) RETURNING *;
+
+-- Event deliveries are written in the transaction that produced the event and
+-- sent by the worker, so they are due straight away
+-- name: CreateDueWebhookDelivery :exec
+INSERT INTO "webhook_deliveries" (
+    "id",
+    "webhook_id",
+    "event",
+    "payload",
+    "next_attempt_at"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    now()
+);
+
+-- name: FindWebhookDeliveryByIDAndWebhookID :one
+SELECT * FROM "webhook_deliveries"
+WHERE "id" = $1 AND "webhook_id" = $2
+LIMIT 1;
+
+-- name: CountWebhookDeliveriesByWebhookID :one
+SELECT COUNT("id") FROM "webhook_deliveries"
+WHERE "webhook_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedWebhookDeliveriesByWebhookID :many
+SELECT * FROM "webhook_deliveries"
+WHERE "webhook_id" = $1
+ORDER BY "created_at" DESC
+LIMIT $2 OFFSET $3;
+
+-- Claimed deliveries are leased so concurrent workers skip them while they are being sent
+-- name: ClaimDueWebhookDeliveriesWithWebhook :many
+UPDATE "webhook_deliveries" SET
+    "next_attempt_at" = now() + interval '5 minutes',
+    "updated_at" = now()
+FROM "webhooks"
+WHERE
+    "webhooks"."id" = "webhook_deliveries"."webhook_id" AND
+    "webhook_deliveries"."id" IN (
+        SELECT "due"."id" FROM "webhook_deliveries" AS "due"
+        INNER JOIN "webhooks" AS "due_webhooks" ON "due_webhooks"."id" = "due"."webhook_id"
+        WHERE
+            "due"."status" = 'pending' AND
+            "due"."next_attempt_at" <= now() AND
+            "due_webhooks"."is_active" = true
+        ORDER BY "due"."next_attempt_at" ASC
+        LIMIT $1
+        FOR UPDATE OF "due" SKIP LOCKED
+    )
+RETURNING
+    "webhook_deliveries".*,
+    "webhooks"."url" AS "webhook_url",
+    "webhooks"."secret" AS "webhook_secret";
+
+-- name: UpdateWebhookDeliverySucceeded :one
+UPDATE "webhook_deliveries" SET
+    "status" = 'success',
+    "attempts" = "attempts" + 1,
+    "response_status" = $1,
+    "response_body" = $2,
+    "next_attempt_at" = NULL,
+    "delivered_at" = now(),
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING *;
+
+-- name: UpdateWebhookDeliveryFailed :one
+UPDATE "webhook_deliveries" SET
+    "status" = $1,
+    "attempts" = "attempts" + 1,
+    "response_status" = $2,
+    "response_body" = $3,
+    "next_attempt_at" = $4,
+    "updated_at" = now()
+WHERE "id" = $5
+RETURNING *;
@@ -0,0 +1,62 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+-- name: CreateWebhook :one
+INSERT INTO "webhooks" (
+    "url",
+    "secret",
+    "events",
+    "author_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING *;
+
+-- name: FindWebhookByID :one
+SELECT * FROM "webhooks"
+WHERE "id" = $1
+LIMIT 1;
+
+-- name: CountWebhooks :one
+SELECT COUNT("id") FROM "webhooks"
+LIMIT 1;
+
+-- name: FindPaginatedWebhooks :many
+SELECT * FROM "webhooks"
+ORDER BY "id" ASC
+LIMIT $1 OFFSET $2;
+
+-- name: FindActiveWebhooksByEvent :many
+SELECT * FROM "webhooks"
+WHERE "is_active" = true AND sqlc.arg('event')::varchar = ANY("events")
+ORDER BY "id" ASC;
+
+-- name: UpdateWebhook :one
+UPDATE "webhooks" SET
+    "url" = $1,
+    "secret" = $2,
+    "events" = $3,
+    "is_active" = $4,
+    "updated_at" = now()
+WHERE "id" = $5
+RETURNING *;
+
+-- name: DeleteWebhookByID :exec
+DELETE FROM "webhooks"
+WHERE "id" = $1;
@@ -0,0 +1,53 @@
+-- Copyright (C) 2024 Afonso Barracha
+-- 
+-- This file is part of KiwiScript.
+-- 
+-- KiwiScript is free software: you can redistribute it and/or modify
+-- it under the terms of the GNU General Public License as published by
+-- the Free Software Foundation, either version 3 of the License, or
+-- (at your option) any later version.
+-- 
+-- KiwiScript is distributed in the hope that it will be useful,
+-- but WITHOUT ANY WARRANTY; without even the implied warranty of
+-- MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+-- GNU General Public License for more details.
+-- 
+-- You should have received a copy of the GNU General Public License
+-- along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+
+-- name: CreateXpEvent :execrows
+INSERT INTO "xp_events" (
+    "user_id",
+    "source",
+    "reference",
+    "points",
+    "language_slug",
+    "series_slug"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) ON CONFLICT ("user_id", "source", "reference") DO NOTHING;
+
+-- name: CountXpEventsByUserID :one
+SELECT COUNT("id") FROM "xp_events"
+WHERE "user_id" = $1
+LIMIT 1;
+
+-- name: FindPaginatedXpEventsByUserID :many
+SELECT * FROM "xp_events"
+WHERE "user_id" = $1
+ORDER BY "id" DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountXpEventsBySourceAndUserID :many
+SELECT
+    "source",
+    COUNT("id") AS "count"
+FROM "xp_events"
+WHERE "user_id" = $1
+GROUP BY "source";
@@ -0,0 +1,85 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	ScheduledReleaseKindSeries  string = "series"
+	ScheduledReleaseKindSection string = "section"
+	ScheduledReleaseKindLesson  string = "lesson"
+)
+
+type ScheduleModel struct {
+	PublishAt   string
+	UnpublishAt string
+}
+
+func formatScheduleTimestamp(ts pgtype.Timestamp) string {
+	if !ts.Valid {
+		return ""
+	}
+	return ts.Time.Format(time.RFC3339)
+}
+
+func newScheduleModel(publishAt, unpublishAt pgtype.Timestamp) *ScheduleModel {
+	return &ScheduleModel{
+		PublishAt:   formatScheduleTimestamp(publishAt),
+		UnpublishAt: formatScheduleTimestamp(unpublishAt),
+	}
+}
+
+func (s *Series) ToScheduleModel() *ScheduleModel {
+	return newScheduleModel(s.PublishAt, s.UnpublishAt)
+}
+
+func (s *Section) ToScheduleModel() *ScheduleModel {
+	return newScheduleModel(s.PublishAt, s.UnpublishAt)
+}
+
+func (l *Lesson) ToScheduleModel() *ScheduleModel {
+	return newScheduleModel(l.PublishAt, l.UnpublishAt)
+}
+
+type ScheduledReleaseModel struct {
+	Kind         string
+	Action       string
+	ID           int32
+	Title        string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	ScheduledAt  string
+}
+
+func (r *FindPaginatedScheduledReleasesRow) ToScheduledReleaseModel() *ScheduledReleaseModel {
+	return &ScheduledReleaseModel{
+		Kind:         r.Kind,
+		Action:       r.Action,
+		ID:           r.ID,
+		Title:        r.Title,
+		LanguageSlug: r.LanguageSlug,
+		SeriesSlug:   r.SeriesSlug,
+		SectionID:    r.SectionID,
+		ScheduledAt:  formatScheduleTimestamp(r.ScheduledAt),
+	}
+}
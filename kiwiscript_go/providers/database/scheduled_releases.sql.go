@@ -0,0 +1,131 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: scheduled_releases.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countScheduledReleases = `-- name: CountScheduledReleases :one
+SELECT (
+  (SELECT COUNT("id") FROM "series" WHERE "publish_at" IS NOT NULL) +
+  (SELECT COUNT("id") FROM "series" WHERE "unpublish_at" IS NOT NULL) +
+  (SELECT COUNT("id") FROM "sections" WHERE "publish_at" IS NOT NULL) +
+  (SELECT COUNT("id") FROM "sections" WHERE "unpublish_at" IS NOT NULL) +
+  (SELECT COUNT("id") FROM "lessons" WHERE "publish_at" IS NOT NULL) +
+  (SELECT COUNT("id") FROM "lessons" WHERE "unpublish_at" IS NOT NULL)
+)::bigint AS "count"
+`
+
+func (q *Queries) CountScheduledReleases(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countScheduledReleases)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const findPaginatedScheduledReleases = `-- name: FindPaginatedScheduledReleases :many
+
+
+SELECT kind, action, id, title, language_slug, series_slug, section_id, scheduled_at FROM (
+  SELECT
+    'series'::text AS "kind",
+    'publish'::text AS "action",
+    "id",
+    "title",
+    "language_slug",
+    "slug" AS "series_slug",
+    0::integer AS "section_id",
+    "publish_at"::timestamp AS "scheduled_at"
+  FROM "series"
+  WHERE "publish_at" IS NOT NULL
+  UNION ALL
+  SELECT 'series', 'unpublish', "id", "title", "language_slug", "slug", 0, "unpublish_at"
+  FROM "series"
+  WHERE "unpublish_at" IS NOT NULL
+  UNION ALL
+  SELECT 'section', 'publish', "id", "title", "language_slug", "series_slug", "id", "publish_at"
+  FROM "sections"
+  WHERE "publish_at" IS NOT NULL
+  UNION ALL
+  SELECT 'section', 'unpublish', "id", "title", "language_slug", "series_slug", "id", "unpublish_at"
+  FROM "sections"
+  WHERE "unpublish_at" IS NOT NULL
+  UNION ALL
+  SELECT 'lesson', 'publish', "id", "title", "language_slug", "series_slug", "section_id", "publish_at"
+  FROM "lessons"
+  WHERE "publish_at" IS NOT NULL
+  UNION ALL
+  SELECT 'lesson', 'unpublish', "id", "title", "language_slug", "series_slug", "section_id", "unpublish_at"
+  FROM "lessons"
+  WHERE "unpublish_at" IS NOT NULL
+) AS "releases"
+ORDER BY "scheduled_at" ASC, "kind" ASC, "id" ASC
+LIMIT $1 OFFSET $2
+`
+
+type FindPaginatedScheduledReleasesParams struct {
+	Limit  int32
+	Offset int32
+}
+
+type FindPaginatedScheduledReleasesRow struct {
+	Kind         string
+	Action       string
+	ID           int32
+	Title        string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	ScheduledAt  pgtype.Timestamp
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) FindPaginatedScheduledReleases(ctx context.Context, arg FindPaginatedScheduledReleasesParams) ([]FindPaginatedScheduledReleasesRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedScheduledReleases, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedScheduledReleasesRow{}
+	for rows.Next() {
+		var i FindPaginatedScheduledReleasesRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.Action,
+			&i.ID,
+			&i.Title,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.ScheduledAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -119,6 +119,45 @@ func (q *Queries) DeleteSectionProgress(ctx context.Context, id int32) error {
 	return err
 }
 
+const findAllSectionProgressByUserID = `-- name: FindAllSectionProgressByUserID :many
+SELECT id, user_id, language_slug, series_slug, section_id, language_progress_id, series_progress_id, completed_lessons, completed_at, viewed_at, created_at, updated_at FROM "section_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC
+`
+
+func (q *Queries) FindAllSectionProgressByUserID(ctx context.Context, userID int32) ([]SectionProgress, error) {
+	rows, err := q.db.Query(ctx, findAllSectionProgressByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SectionProgress{}
+	for rows.Next() {
+		var i SectionProgress
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.SectionID,
+			&i.LanguageProgressID,
+			&i.SeriesProgressID,
+			&i.CompletedLessons,
+			&i.CompletedAt,
+			&i.ViewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findSectionProgressByID = `-- name: FindSectionProgressByID :one
 SELECT id, user_id, language_slug, series_slug, section_id, language_progress_id, series_progress_id, completed_lessons, completed_at, viewed_at, created_at, updated_at FROM "section_progress"
 WHERE "id" = $1
@@ -226,6 +265,47 @@ func (q *Queries) IncrementSectionProgressCompletedLessons(ctx context.Context,
 	return i, err
 }
 
+const incrementSectionProgressCompletedLessonsInSeriesVersion = `-- name: IncrementSectionProgressCompletedLessonsInSeriesVersion :one
+UPDATE "section_progress"
+SET
+    "completed_lessons" = "completed_lessons" + 1,
+    "completed_at" = CASE
+        WHEN (
+            $1::smallint = "completed_lessons" + 1 AND
+            "completed_at" IS NULL
+        )
+            THEN (NOW())
+            ELSE "completed_at"
+    END
+WHERE "id" = $2
+RETURNING id, user_id, language_slug, series_slug, section_id, language_progress_id, series_progress_id, completed_lessons, completed_at, viewed_at, created_at, updated_at
+`
+
+type IncrementSectionProgressCompletedLessonsInSeriesVersionParams struct {
+	LessonsCount int16
+	ID           int32
+}
+
+func (q *Queries) IncrementSectionProgressCompletedLessonsInSeriesVersion(ctx context.Context, arg IncrementSectionProgressCompletedLessonsInSeriesVersionParams) (SectionProgress, error) {
+	row := q.db.QueryRow(ctx, incrementSectionProgressCompletedLessonsInSeriesVersion, arg.LessonsCount, arg.ID)
+	var i SectionProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.SectionID,
+		&i.LanguageProgressID,
+		&i.SeriesProgressID,
+		&i.CompletedLessons,
+		&i.CompletedAt,
+		&i.ViewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateSectionProgressViewedAt = `-- name: UpdateSectionProgressViewedAt :exec
 UPDATE "section_progress"
 SET "viewed_at" = NOW()
@@ -45,6 +45,28 @@ func (q *Queries) AddSectionWatchTime(ctx context.Context, arg AddSectionWatchTi
 	return err
 }
 
+const clearSectionPublishAt = `-- name: ClearSectionPublishAt :exec
+UPDATE "sections" SET
+  "publish_at" = NULL
+WHERE "id" = $1 AND "publish_at" <= now()
+`
+
+func (q *Queries) ClearSectionPublishAt(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, clearSectionPublishAt, id)
+	return err
+}
+
+const clearSectionUnpublishAt = `-- name: ClearSectionUnpublishAt :exec
+UPDATE "sections" SET
+  "unpublish_at" = NULL
+WHERE "id" = $1 AND "unpublish_at" <= now()
+`
+
+func (q *Queries) ClearSectionUnpublishAt(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, clearSectionUnpublishAt, id)
+	return err
+}
+
 const countPublishedSectionsBySeriesSlug = `-- name: CountPublishedSectionsBySeriesSlug :one
 SELECT COUNT("id") AS "count" FROM "sections"
 WHERE "series_slug" = $1 AND "is_published" = true LIMIT 1
@@ -88,7 +110,7 @@ INSERT INTO "sections" (
     SELECT COUNT("id") + 1 FROM "sections"
     WHERE "series_slug" = $3::VARCHAR(100)
   )
-) RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at
+) RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at
 `
 
 type CreateSectionParams struct {
@@ -138,6 +160,9 @@ func (q *Queries) CreateSection(ctx context.Context, arg CreateSectionParams) (S
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 	)
 	return i, err
 }
@@ -182,6 +207,24 @@ func (q *Queries) DecrementSectionPosition(ctx context.Context, arg DecrementSec
 	return err
 }
 
+const deleteSectionByIDAndVersion = `-- name: DeleteSectionByIDAndVersion :execrows
+DELETE FROM "sections"
+WHERE "id" = $1 AND "version" = $2
+`
+
+type DeleteSectionByIDAndVersionParams struct {
+	ID      int32
+	Version int32
+}
+
+func (q *Queries) DeleteSectionByIDAndVersion(ctx context.Context, arg DeleteSectionByIDAndVersionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSectionByIDAndVersion, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteSectionById = `-- name: DeleteSectionById :exec
 DELETE FROM "sections"
 WHERE "id" = $1
@@ -194,7 +237,7 @@ func (q *Queries) DeleteSectionById(ctx context.Context, id int32) error {
 
 const findCurrentSection = `-- name: FindCurrentSection :one
 SELECT
-    sections.id, sections.title, sections.language_slug, sections.series_slug, sections.description, sections.position, sections.lessons_count, sections.watch_time_seconds, sections.read_time_seconds, sections.is_published, sections.author_id, sections.created_at, sections.updated_at,
+    sections.id, sections.title, sections.language_slug, sections.series_slug, sections.description, sections.position, sections.lessons_count, sections.watch_time_seconds, sections.read_time_seconds, sections.is_published, sections.author_id, sections.created_at, sections.updated_at, sections.version, sections.publish_at, sections.unpublish_at,
     "section_progress"."completed_lessons" AS "section_progress_completed_lessons",
     "section_progress"."completed_at" AS "section_progress_completed_at",
     "section_progress"."viewed_at" AS "section_progress_viewed_at"
@@ -231,6 +274,9 @@ type FindCurrentSectionRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
 	SectionProgressCompletedLessons int16
 	SectionProgressCompletedAt      pgtype.Timestamp
 	SectionProgressViewedAt         pgtype.Timestamp
@@ -253,6 +299,9 @@ func (q *Queries) FindCurrentSection(ctx context.Context, arg FindCurrentSection
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 		&i.SectionProgressCompletedLessons,
 		&i.SectionProgressCompletedAt,
 		&i.SectionProgressViewedAt,
@@ -261,7 +310,7 @@ func (q *Queries) FindCurrentSection(ctx context.Context, arg FindCurrentSection
 }
 
 const findPaginatedPublishedSectionsBySlugs = `-- name: FindPaginatedPublishedSectionsBySlugs :many
-SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at FROM "sections"
+SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at FROM "sections"
 WHERE
     "language_slug" = $1 AND
     "series_slug" = $2 AND
@@ -305,6 +354,9 @@ func (q *Queries) FindPaginatedPublishedSectionsBySlugs(ctx context.Context, arg
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
 		); err != nil {
 			return nil, err
 		}
@@ -318,7 +370,7 @@ func (q *Queries) FindPaginatedPublishedSectionsBySlugs(ctx context.Context, arg
 
 const findPaginatedPublishedSectionsBySlugsWithProgress = `-- name: FindPaginatedPublishedSectionsBySlugsWithProgress :many
 SELECT
-    sections.id, sections.title, sections.language_slug, sections.series_slug, sections.description, sections.position, sections.lessons_count, sections.watch_time_seconds, sections.read_time_seconds, sections.is_published, sections.author_id, sections.created_at, sections.updated_at,
+    sections.id, sections.title, sections.language_slug, sections.series_slug, sections.description, sections.position, sections.lessons_count, sections.watch_time_seconds, sections.read_time_seconds, sections.is_published, sections.author_id, sections.created_at, sections.updated_at, sections.version, sections.publish_at, sections.unpublish_at,
     "section_progress"."completed_lessons" AS "section_progress_completed_lessons",
     "section_progress"."completed_at" AS "section_progress_completed_at",
     "section_progress"."viewed_at" AS "section_progress_viewed_at"
@@ -357,6 +409,9 @@ type FindPaginatedPublishedSectionsBySlugsWithProgressRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
 	SectionProgressCompletedLessons pgtype.Int2
 	SectionProgressCompletedAt      pgtype.Timestamp
 	SectionProgressViewedAt         pgtype.Timestamp
@@ -391,6 +446,9 @@ func (q *Queries) FindPaginatedPublishedSectionsBySlugsWithProgress(ctx context.
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
 			&i.SectionProgressCompletedLessons,
 			&i.SectionProgressCompletedAt,
 			&i.SectionProgressViewedAt,
@@ -406,7 +464,7 @@ func (q *Queries) FindPaginatedPublishedSectionsBySlugsWithProgress(ctx context.
 }
 
 const findPaginatedSectionsBySlugs = `-- name: FindPaginatedSectionsBySlugs :many
-SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at FROM "sections"
+SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at FROM "sections"
 WHERE
     "sections"."language_slug" = $1 AND
     "sections"."series_slug" = $2
@@ -449,6 +507,9 @@ func (q *Queries) FindPaginatedSectionsBySlugs(ctx context.Context, arg FindPagi
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
 		); err != nil {
 			return nil, err
 		}
@@ -462,7 +523,7 @@ func (q *Queries) FindPaginatedSectionsBySlugs(ctx context.Context, arg FindPagi
 
 const findPublishedSectionBySlugsAndIDWithProgress = `-- name: FindPublishedSectionBySlugsAndIDWithProgress :one
 SELECT
-    sections.id, sections.title, sections.language_slug, sections.series_slug, sections.description, sections.position, sections.lessons_count, sections.watch_time_seconds, sections.read_time_seconds, sections.is_published, sections.author_id, sections.created_at, sections.updated_at,
+    sections.id, sections.title, sections.language_slug, sections.series_slug, sections.description, sections.position, sections.lessons_count, sections.watch_time_seconds, sections.read_time_seconds, sections.is_published, sections.author_id, sections.created_at, sections.updated_at, sections.version, sections.publish_at, sections.unpublish_at,
     "section_progress"."completed_lessons" AS "section_progress_completed_lessons",
     "section_progress"."completed_at" AS "section_progress_completed_at",
     "section_progress"."viewed_at" AS "section_progress_viewed_at"
@@ -500,6 +561,9 @@ type FindPublishedSectionBySlugsAndIDWithProgressRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	Version                         int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
 	SectionProgressCompletedLessons pgtype.Int2
 	SectionProgressCompletedAt      pgtype.Timestamp
 	SectionProgressViewedAt         pgtype.Timestamp
@@ -527,6 +591,9 @@ func (q *Queries) FindPublishedSectionBySlugsAndIDWithProgress(ctx context.Conte
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 		&i.SectionProgressCompletedLessons,
 		&i.SectionProgressCompletedAt,
 		&i.SectionProgressViewedAt,
@@ -535,7 +602,7 @@ func (q *Queries) FindPublishedSectionBySlugsAndIDWithProgress(ctx context.Conte
 }
 
 const findSectionById = `-- name: FindSectionById :one
-SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at FROM "sections"
+SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at FROM "sections"
 WHERE "id" = $1 LIMIT 1
 `
 
@@ -556,12 +623,15 @@ func (q *Queries) FindSectionById(ctx context.Context, id int32) (Section, error
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 	)
 	return i, err
 }
 
 const findSectionBySlugsAndID = `-- name: FindSectionBySlugsAndID :one
-SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at FROM "sections"
+SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at FROM "sections"
 WHERE
     "language_slug" = $1 AND
     "series_slug" = $2 AND
@@ -592,10 +662,144 @@ func (q *Queries) FindSectionBySlugsAndID(ctx context.Context, arg FindSectionBy
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 	)
 	return i, err
 }
 
+const findSectionsBySeriesSlug = `-- name: FindSectionsBySeriesSlug :many
+SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at FROM "sections"
+WHERE "series_slug" = $1
+ORDER BY "position" ASC
+`
+
+func (q *Queries) FindSectionsBySeriesSlug(ctx context.Context, seriesSlug string) ([]Section, error) {
+	rows, err := q.db.Query(ctx, findSectionsBySeriesSlug, seriesSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Section{}
+	for rows.Next() {
+		var i Section
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.Description,
+			&i.Position,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSectionsBySeriesSlugForUpdate = `-- name: FindSectionsBySeriesSlugForUpdate :many
+SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at FROM "sections"
+WHERE "series_slug" = $1
+ORDER BY "position" ASC
+FOR UPDATE
+`
+
+func (q *Queries) FindSectionsBySeriesSlugForUpdate(ctx context.Context, seriesSlug string) ([]Section, error) {
+	rows, err := q.db.Query(ctx, findSectionsBySeriesSlugForUpdate, seriesSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Section{}
+	for rows.Next() {
+		var i Section
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.Description,
+			&i.Position,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSectionsDueForScheduling = `-- name: FindSectionsDueForScheduling :many
+SELECT id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at FROM "sections"
+WHERE "publish_at" <= now() OR "unpublish_at" <= now()
+ORDER BY "id" ASC
+LIMIT $1
+`
+
+func (q *Queries) FindSectionsDueForScheduling(ctx context.Context, limit int32) ([]Section, error) {
+	rows, err := q.db.Query(ctx, findSectionsDueForScheduling, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Section{}
+	for rows.Next() {
+		var i Section
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.Description,
+			&i.Position,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+			&i.PublishAt,
+			&i.UnpublishAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const incrementSectionLessonsCount = `-- name: IncrementSectionLessonsCount :exec
 UPDATE "sections" SET
   "lessons_count" = "lessons_count" + 1,
@@ -636,22 +840,65 @@ func (q *Queries) IncrementSectionPosition(ctx context.Context, arg IncrementSec
 	return err
 }
 
+const reassignSectionsAuthor = `-- name: ReassignSectionsAuthor :exec
+UPDATE "sections" SET
+  "author_id" = $1
+WHERE "author_id" = $2
+`
+
+type ReassignSectionsAuthorParams struct {
+	NewAuthorID int32
+	OldAuthorID int32
+}
+
+func (q *Queries) ReassignSectionsAuthor(ctx context.Context, arg ReassignSectionsAuthorParams) error {
+	_, err := q.db.Exec(ctx, reassignSectionsAuthor, arg.NewAuthorID, arg.OldAuthorID)
+	return err
+}
+
+const reorderSections = `-- name: ReorderSections :exec
+UPDATE "sections" SET
+  "position" = array_position($1::int[], "id")::smallint,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE
+  "series_slug" = $2 AND
+  "id" = ANY($1::int[])
+`
+
+type ReorderSectionsParams struct {
+	Ids        []int32
+	SeriesSlug string
+}
+
+func (q *Queries) ReorderSections(ctx context.Context, arg ReorderSectionsParams) error {
+	_, err := q.db.Exec(ctx, reorderSections, arg.Ids, arg.SeriesSlug)
+	return err
+}
+
 const updateSection = `-- name: UpdateSection :one
 UPDATE "sections" SET
   "title" = $1,
-  "description" = $2
-WHERE "id" = $3
-RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at
+  "description" = $2,
+  "version" = "version" + 1
+WHERE "id" = $3 AND "version" = $4
+RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at
 `
 
 type UpdateSectionParams struct {
 	Title       string
 	Description string
 	ID          int32
+	Version     int32
 }
 
 func (q *Queries) UpdateSection(ctx context.Context, arg UpdateSectionParams) (Section, error) {
-	row := q.db.QueryRow(ctx, updateSection, arg.Title, arg.Description, arg.ID)
+	row := q.db.QueryRow(ctx, updateSection,
+		arg.Title,
+		arg.Description,
+		arg.ID,
+		arg.Version,
+	)
 	var i Section
 	err := row.Scan(
 		&i.ID,
@@ -667,6 +914,9 @@ func (q *Queries) UpdateSection(ctx context.Context, arg UpdateSectionParams) (S
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 	)
 	return i, err
 }
@@ -674,9 +924,10 @@ func (q *Queries) UpdateSection(ctx context.Context, arg UpdateSectionParams) (S
 const updateSectionIsPublished = `-- name: UpdateSectionIsPublished :one
 UPDATE "sections" SET
   "is_published" = $1,
+  "version" = "version" + 1,
   "updated_at" = now()
 WHERE "id" = $2
-RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at
+RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at
 `
 
 type UpdateSectionIsPublishedParams struct {
@@ -701,6 +952,94 @@ func (q *Queries) UpdateSectionIsPublished(ctx context.Context, arg UpdateSectio
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+	)
+	return i, err
+}
+
+const updateSectionIsPublishedByIDAndVersion = `-- name: UpdateSectionIsPublishedByIDAndVersion :one
+UPDATE "sections" SET
+  "is_published" = $1,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE "id" = $2 AND "version" = $3
+RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at
+`
+
+type UpdateSectionIsPublishedByIDAndVersionParams struct {
+	IsPublished bool
+	ID          int32
+	Version     int32
+}
+
+func (q *Queries) UpdateSectionIsPublishedByIDAndVersion(ctx context.Context, arg UpdateSectionIsPublishedByIDAndVersionParams) (Section, error) {
+	row := q.db.QueryRow(ctx, updateSectionIsPublishedByIDAndVersion, arg.IsPublished, arg.ID, arg.Version)
+	var i Section
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Description,
+		&i.Position,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
+	)
+	return i, err
+}
+
+const updateSectionSchedule = `-- name: UpdateSectionSchedule :one
+UPDATE "sections" SET
+  "publish_at" = $1,
+  "unpublish_at" = $2,
+  "version" = "version" + 1,
+  "updated_at" = now()
+WHERE "id" = $3 AND "version" = $4
+RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at
+`
+
+type UpdateSectionScheduleParams struct {
+	PublishAt   pgtype.Timestamp
+	UnpublishAt pgtype.Timestamp
+	ID          int32
+	Version     int32
+}
+
+func (q *Queries) UpdateSectionSchedule(ctx context.Context, arg UpdateSectionScheduleParams) (Section, error) {
+	row := q.db.QueryRow(ctx, updateSectionSchedule,
+		arg.PublishAt,
+		arg.UnpublishAt,
+		arg.ID,
+		arg.Version,
+	)
+	var i Section
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Description,
+		&i.Position,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 	)
 	return i, err
 }
@@ -710,9 +1049,10 @@ UPDATE "sections" SET
   "title" = $1,
   "description" = $2,
   "position" = $3,
+  "version" = "version" + 1,
   "updated_at" = now()
-WHERE "id" = $4
-RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at
+WHERE "id" = $4 AND "version" = $5
+RETURNING id, title, language_slug, series_slug, description, position, lessons_count, watch_time_seconds, read_time_seconds, is_published, author_id, created_at, updated_at, version, publish_at, unpublish_at
 `
 
 type UpdateSectionWithPositionParams struct {
@@ -720,6 +1060,7 @@ type UpdateSectionWithPositionParams struct {
 	Description string
 	Position    int16
 	ID          int32
+	Version     int32
 }
 
 func (q *Queries) UpdateSectionWithPosition(ctx context.Context, arg UpdateSectionWithPositionParams) (Section, error) {
@@ -728,6 +1069,7 @@ func (q *Queries) UpdateSectionWithPosition(ctx context.Context, arg UpdateSecti
 		arg.Description,
 		arg.Position,
 		arg.ID,
+		arg.Version,
 	)
 	var i Section
 	err := row.Scan(
@@ -744,6 +1086,9 @@ func (q *Queries) UpdateSectionWithPosition(ctx context.Context, arg UpdateSecti
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Version,
+		&i.PublishAt,
+		&i.UnpublishAt,
 	)
 	return i, err
 }
@@ -19,6 +19,7 @@ package db
 
 import (
 	"github.com/google/uuid"
+	"math"
 	"time"
 )
 
@@ -48,10 +49,23 @@ type SeriesModel struct {
 	ViewedAt          string
 	CompletedAt       string
 	IsPublished       bool
+	Price             int32
+	Currency          string
+	IsMembersOnly     bool
+	ReviewsCount      int32
+	Rating            float32
 	Author            SeriesAuthor
 	Picture           *SeriesPictureIDAndEXT
 }
 
+func calculateSeriesRating(ratingTotal, reviewsCount int32) float32 {
+	if reviewsCount == 0 {
+		return 0
+	}
+
+	return float32(math.Round(float64(ratingTotal)/float64(reviewsCount)*10) / 10)
+}
+
 type ToSeriesModel interface {
 	ToSeriesModel() *SeriesModel
 }
@@ -78,6 +92,11 @@ func (s *Series) ToSeriesModelWithAuthor(authorID int32, firstName, lastName str
 		CompletedLessons: 0,
 		TotalLessons:     s.LessonsCount,
 		IsPublished:      s.IsPublished,
+		Price:            s.Price,
+		Currency:         s.Currency,
+		IsMembersOnly:    s.IsMembersOnly,
+		ReviewsCount:     s.ReviewsCount,
+		Rating:           calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		Author: SeriesAuthor{
 			ID:        authorID,
 			FirstName: firstName,
@@ -104,6 +123,11 @@ func (s *Series) ToSeriesModelWithAuthorAndPicture(
 		ReadTime:      s.ReadTimeSeconds,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		Author: SeriesAuthor{
 			ID:        authorID,
 			FirstName: firstName,
@@ -134,6 +158,11 @@ func (s *FindSeriesBySlugWithAuthorRow) ToSeriesModel() *SeriesModel {
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -175,6 +204,11 @@ func (s *FindPublishedSeriesBySlugWithAuthorAndProgressRow) ToSeriesModel() *Ser
 		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -206,6 +240,11 @@ func (s *FindPaginatedSeriesWithAuthorSortByIDRow) ToSeriesModel() *SeriesModel
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -235,6 +274,11 @@ func (s *FindPaginatedPublishedSeriesWithAuthorSortByIDRow) ToSeriesModel() *Ser
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -264,6 +308,11 @@ func (s *FindFilteredPublishedSeriesWithAuthorSortByIDRow) ToSeriesModel() *Seri
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -293,6 +342,11 @@ func (s *FindPaginatedSeriesWithAuthorSortBySlugRow) ToSeriesModel() *SeriesMode
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -323,6 +377,11 @@ func (s *FindPaginatedPublishedSeriesWithAuthorSortBySlugRow) ToSeriesModel() *S
 		CompletedLessons: 0,
 		TotalLessons:     s.LessonsCount,
 		IsPublished:      s.IsPublished,
+		Price:            s.Price,
+		Currency:         s.Currency,
+		IsMembersOnly:    s.IsMembersOnly,
+		ReviewsCount:     s.ReviewsCount,
+		Rating:           calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:        s.WatchTimeSeconds,
 		ReadTime:         s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -352,6 +411,11 @@ func (s *FindFilteredPublishedSeriesWithAuthorSortBySlugRow) ToSeriesModel() *Se
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -381,6 +445,11 @@ func (s *FindFilteredSeriesWithAuthorSortBySlugRow) ToSeriesModel() *SeriesModel
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -410,6 +479,11 @@ func (s *FindFilteredSeriesWithAuthorSortByIDRow) ToSeriesModel() *SeriesModel {
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -451,6 +525,11 @@ func (s *FindPaginatedPublishedSeriesWithAuthorAndProgressSortByIDRow) ToSeriesM
 		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -494,6 +573,11 @@ func (s *FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow) ToSerie
 		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -537,6 +621,11 @@ func (s *FindFilteredPublishedSeriesWithAuthorAndProgressSortByIDRow) ToSeriesMo
 		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -580,6 +669,11 @@ func (s *FindFilteredPublishedSeriesWithAuthorAndProgressSortBySlugRow) ToSeries
 		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -623,6 +717,11 @@ func (s *FindPublishedSeriesBySlugsWithAuthorRow) ToSeriesModelWithProgress(prog
 		CompletedLessons:  progress.CompletedLessons,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -654,6 +753,11 @@ func (s *FindPublishedSeriesBySlugsWithAuthorRow) ToSeriesModel() *SeriesModel {
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -690,6 +794,97 @@ func (s *FindPaginatedPublishedSeriesWithAuthorAndInnerProgressRow) ToSeriesMode
 		CompletedLessons:  s.SeriesProgressCompletedLessons,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:         s.WatchTimeSeconds,
+		ReadTime:          s.ReadTimeSeconds,
+		ViewedAt:          s.SeriesProgressViewedAt.Time.Format(time.RFC3339),
+		CompletedAt:       completedAt,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	var completedAt string
+	if s.SeriesProgressCompletedAt.Valid {
+		completedAt = s.SeriesProgressCompletedAt.Time.Format(time.RFC3339)
+	}
+
+	return &SeriesModel{
+		ID:                s.ID,
+		Title:             s.Title,
+		Slug:              s.Slug,
+		LanguageSlug:      s.LanguageSlug,
+		Description:       s.Description,
+		CompletedSections: s.SeriesProgressCompletedSections,
+		TotalSections:     s.SectionsCount,
+		CompletedLessons:  s.SeriesProgressCompletedLessons,
+		TotalLessons:      s.LessonsCount,
+		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:         s.WatchTimeSeconds,
+		ReadTime:          s.ReadTimeSeconds,
+		ViewedAt:          s.SeriesProgressViewedAt.Time.Format(time.RFC3339),
+		CompletedAt:       completedAt,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	var completedAt string
+	if s.SeriesProgressCompletedAt.Valid {
+		completedAt = s.SeriesProgressCompletedAt.Time.Format(time.RFC3339)
+	}
+
+	return &SeriesModel{
+		ID:                s.ID,
+		Title:             s.Title,
+		Slug:              s.Slug,
+		LanguageSlug:      s.LanguageSlug,
+		Description:       s.Description,
+		CompletedSections: s.SeriesProgressCompletedSections,
+		TotalSections:     s.SectionsCount,
+		CompletedLessons:  s.SeriesProgressCompletedLessons,
+		TotalLessons:      s.LessonsCount,
+		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          s.SeriesProgressViewedAt.Time.Format(time.RFC3339),
@@ -721,6 +916,11 @@ func (s *FindPaginatedDiscoverySeriesWithAuthorRow) ToSeriesModel() *SeriesModel
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -750,6 +950,11 @@ func (s *FindFilteredDiscoverySeriesWithAuthorRow) ToSeriesModel() *SeriesModel
 		TotalSections: s.SectionsCount,
 		TotalLessons:  s.LessonsCount,
 		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:     s.WatchTimeSeconds,
 		ReadTime:      s.ReadTimeSeconds,
 		Author: SeriesAuthor{
@@ -791,6 +996,11 @@ func (s *FindPaginatedDiscoverySeriesWithAuthorAndProgressRow) ToSeriesModel() *
 		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -834,6 +1044,244 @@ func (s *FindFilteredDiscoverySeriesWithAuthorAndProgressRow) ToSeriesModel() *S
 		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
 		TotalLessons:      s.LessonsCount,
 		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:         s.WatchTimeSeconds,
+		ReadTime:          s.ReadTimeSeconds,
+		ViewedAt:          viewedAt,
+		CompletedAt:       completedAt,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindPaginatedSeriesWithAuthorSortByRatingRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	return &SeriesModel{
+		ID:            s.ID,
+		Title:         s.Title,
+		Slug:          s.Slug,
+		LanguageSlug:  s.LanguageSlug,
+		Description:   s.Description,
+		TotalSections: s.SectionsCount,
+		TotalLessons:  s.LessonsCount,
+		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:     s.WatchTimeSeconds,
+		ReadTime:      s.ReadTimeSeconds,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindPaginatedPublishedSeriesWithAuthorSortByRatingRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	return &SeriesModel{
+		ID:               s.ID,
+		Title:            s.Title,
+		Slug:             s.Slug,
+		LanguageSlug:     s.LanguageSlug,
+		Description:      s.Description,
+		TotalSections:    s.SectionsCount,
+		CompletedLessons: 0,
+		TotalLessons:     s.LessonsCount,
+		IsPublished:      s.IsPublished,
+		Price:            s.Price,
+		Currency:         s.Currency,
+		IsMembersOnly:    s.IsMembersOnly,
+		ReviewsCount:     s.ReviewsCount,
+		Rating:           calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:        s.WatchTimeSeconds,
+		ReadTime:         s.ReadTimeSeconds,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindFilteredPublishedSeriesWithAuthorSortByRatingRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	return &SeriesModel{
+		ID:            s.ID,
+		Title:         s.Title,
+		Slug:          s.Slug,
+		LanguageSlug:  s.LanguageSlug,
+		Description:   s.Description,
+		TotalSections: s.SectionsCount,
+		TotalLessons:  s.LessonsCount,
+		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:     s.WatchTimeSeconds,
+		ReadTime:      s.ReadTimeSeconds,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindFilteredSeriesWithAuthorSortByRatingRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	return &SeriesModel{
+		ID:            s.ID,
+		Title:         s.Title,
+		Slug:          s.Slug,
+		LanguageSlug:  s.LanguageSlug,
+		Description:   s.Description,
+		TotalSections: s.SectionsCount,
+		TotalLessons:  s.LessonsCount,
+		IsPublished:   s.IsPublished,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		IsMembersOnly: s.IsMembersOnly,
+		ReviewsCount:  s.ReviewsCount,
+		Rating:        calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:     s.WatchTimeSeconds,
+		ReadTime:      s.ReadTimeSeconds,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	var viewedAt string
+	if s.SeriesProgressViewedAt.Valid {
+		viewedAt = s.SeriesProgressViewedAt.Time.Format(time.RFC3339)
+	}
+
+	var completedAt string
+	if s.SeriesProgressCompletedAt.Valid {
+		completedAt = s.SeriesProgressCompletedAt.Time.Format(time.RFC3339)
+	}
+
+	return &SeriesModel{
+		ID:                s.ID,
+		Title:             s.Title,
+		Slug:              s.Slug,
+		LanguageSlug:      s.LanguageSlug,
+		Description:       s.Description,
+		CompletedSections: s.SeriesProgressCompletedSections.Int16,
+		TotalSections:     s.SectionsCount,
+		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
+		TotalLessons:      s.LessonsCount,
+		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
+		WatchTime:         s.WatchTimeSeconds,
+		ReadTime:          s.ReadTimeSeconds,
+		ViewedAt:          viewedAt,
+		CompletedAt:       completedAt,
+		Author: SeriesAuthor{
+			ID:        s.AuthorID,
+			FirstName: s.AuthorFirstName,
+			LastName:  s.AuthorLastName,
+		},
+		Picture: picture,
+	}
+}
+
+func (s *FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingRow) ToSeriesModel() *SeriesModel {
+	var picture *SeriesPictureIDAndEXT
+	if s.PictureID.Valid && s.PictureExt.Valid {
+		picture = &SeriesPictureIDAndEXT{
+			ID:  s.PictureID.Bytes,
+			EXT: s.PictureExt.String,
+		}
+	}
+
+	var viewedAt string
+	if s.SeriesProgressViewedAt.Valid {
+		viewedAt = s.SeriesProgressViewedAt.Time.Format(time.RFC3339)
+	}
+
+	var completedAt string
+	if s.SeriesProgressCompletedAt.Valid {
+		completedAt = s.SeriesProgressCompletedAt.Time.Format(time.RFC3339)
+	}
+
+	return &SeriesModel{
+		ID:                s.ID,
+		Title:             s.Title,
+		Slug:              s.Slug,
+		LanguageSlug:      s.LanguageSlug,
+		Description:       s.Description,
+		CompletedSections: s.SeriesProgressCompletedSections.Int16,
+		TotalSections:     s.SectionsCount,
+		CompletedLessons:  s.SeriesProgressCompletedLessons.Int16,
+		TotalLessons:      s.LessonsCount,
+		IsPublished:       s.IsPublished,
+		Price:             s.Price,
+		Currency:          s.Currency,
+		IsMembersOnly:     s.IsMembersOnly,
+		ReviewsCount:      s.ReviewsCount,
+		Rating:            calculateSeriesRating(s.RatingTotal, s.ReviewsCount),
 		WatchTime:         s.WatchTimeSeconds,
 		ReadTime:          s.ReadTimeSeconds,
 		ViewedAt:          viewedAt,
@@ -11,6 +11,22 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addSeriesRatingTotal = `-- name: AddSeriesRatingTotal :exec
+UPDATE "series" SET
+  "rating_total" = "rating_total" + $2
+WHERE "slug" = $1
+`
+
+type AddSeriesRatingTotalParams struct {
+	Slug        string
+	RatingTotal int32
+}
+
+func (q *Queries) AddSeriesRatingTotal(ctx context.Context, arg AddSeriesRatingTotalParams) error {
+	_, err := q.db.Exec(ctx, addSeriesRatingTotal, arg.Slug, arg.RatingTotal)
+	return err
+}
+
 const addSeriesReadTime = `-- name: AddSeriesReadTime :exec
 UPDATE "series" SET
   "read_time_seconds" = "read_time_seconds" + $1,
@@ -28,6 +44,23 @@ func (q *Queries) AddSeriesReadTime(ctx context.Context, arg AddSeriesReadTimePa
 	return err
 }
 
+const addSeriesReview = `-- name: AddSeriesReview :exec
+UPDATE "series" SET
+  "reviews_count" = "reviews_count" + 1,
+  "rating_total" = "rating_total" + $2
+WHERE "slug" = $1
+`
+
+type AddSeriesReviewParams struct {
+	Slug        string
+	RatingTotal int32
+}
+
+func (q *Queries) AddSeriesReview(ctx context.Context, arg AddSeriesReviewParams) error {
+	_, err := q.db.Exec(ctx, addSeriesReview, arg.Slug, arg.RatingTotal)
+	return err
+}
+
 const addSeriesSectionsCount = `-- name: AddSeriesSectionsCount :exec
 UPDATE "series" SET
   "sections_count" = "sections_count" + 1,
@@ -72,6 +105,28 @@ func (q *Queries) AddSeriesWatchTime(ctx context.Context, arg AddSeriesWatchTime
 	return err
 }
 
+const clearSeriesPublishAt = `-- name: ClearSeriesPublishAt :exec
+UPDATE "series" SET
+  "publish_at" = NULL
+WHERE "id" = $1 AND "publish_at" <= now()
+`
+
+func (q *Queries) ClearSeriesPublishAt(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, clearSeriesPublishAt, id)
+	return err
+}
+
+const clearSeriesUnpublishAt = `-- name: ClearSeriesUnpublishAt :exec
+UPDATE "series" SET
+  "unpublish_at" = NULL
+WHERE "id" = $1 AND "unpublish_at" <= now()
+`
+
+func (q *Queries) ClearSeriesUnpublishAt(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, clearSeriesUnpublishAt, id)
+	return err
+}
+
 const countAllFilteredPublishedSeries = `-- name: CountAllFilteredPublishedSeries :one
 SELECT COUNT("series"."id") AS "count" FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
@@ -220,7 +275,7 @@ INSERT INTO "series" (
   $3,
   $4,
   $5
-) RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at
+) RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
 `
 
 type CreateSeriesParams struct {
@@ -270,6 +325,14 @@ func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (Ser
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
 	)
 	return i, err
 }
@@ -343,7 +406,7 @@ func (q *Queries) DeleteSeriesById(ctx context.Context, id int32) error {
 
 const findFilteredDiscoverySeriesWithAuthor = `-- name: FindFilteredDiscoverySeriesWithAuthor :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -384,6 +447,14 @@ type FindFilteredDiscoverySeriesWithAuthorRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
@@ -413,6 +484,14 @@ func (q *Queries) FindFilteredDiscoverySeriesWithAuthor(ctx context.Context, arg
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -430,7 +509,7 @@ func (q *Queries) FindFilteredDiscoverySeriesWithAuthor(ctx context.Context, arg
 
 const findFilteredDiscoverySeriesWithAuthorAndProgress = `-- name: FindFilteredDiscoverySeriesWithAuthorAndProgress :many
 SELECT
-    series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+    series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
     "users"."first_name" AS "author_first_name",
     "users"."last_name" AS "author_last_name",
     "series_progress"."id" AS "series_progress_id",
@@ -481,6 +560,14 @@ type FindFilteredDiscoverySeriesWithAuthorAndProgressRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
 	AuthorFirstName                 string
 	AuthorLastName                  string
 	SeriesProgressID                pgtype.Int4
@@ -520,6 +607,14 @@ func (q *Queries) FindFilteredDiscoverySeriesWithAuthorAndProgress(ctx context.C
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.SeriesProgressID,
@@ -542,7 +637,7 @@ func (q *Queries) FindFilteredDiscoverySeriesWithAuthorAndProgress(ctx context.C
 
 const findFilteredPublishedSeriesWithAuthorAndProgressSortByID = `-- name: FindFilteredPublishedSeriesWithAuthorAndProgressSortByID :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_progress"."id" AS "series_progress_id",
@@ -593,6 +688,14 @@ type FindFilteredPublishedSeriesWithAuthorAndProgressSortByIDRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
 	AuthorFirstName                 string
 	AuthorLastName                  string
 	SeriesProgressID                pgtype.Int4
@@ -633,6 +736,146 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorAndProgressSortByID(ctx c
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.SeriesProgressID,
+			&i.SeriesProgressCompletedSections,
+			&i.SeriesProgressCompletedLessons,
+			&i.SeriesProgressViewedAt,
+			&i.SeriesProgressCompletedAt,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findFilteredPublishedSeriesWithAuthorAndProgressSortByRating = `-- name: FindFilteredPublishedSeriesWithAuthorAndProgressSortByRating :many
+SELECT
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."language_slug" = $3 AND
+  "series"."is_published" = true AND
+  (
+    "series"."title" ILIKE $2 OR
+    "users"."first_name" ILIKE $2 OR
+    "users"."last_name" ILIKE $2
+  )
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $4 OFFSET $5
+`
+
+type FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingParams struct {
+	UserID       int32
+	Title        string
+	LanguageSlug string
+	Limit        int32
+	Offset       int32
+}
+
+type FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingRow struct {
+	ID                              int32
+	Title                           string
+	Slug                            string
+	Description                     string
+	SectionsCount                   int16
+	LessonsCount                    int16
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	IsPublished                     bool
+	LanguageSlug                    string
+	AuthorID                        int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
+	AuthorFirstName                 string
+	AuthorLastName                  string
+	SeriesProgressID                pgtype.Int4
+	SeriesProgressCompletedSections pgtype.Int2
+	SeriesProgressCompletedLessons  pgtype.Int2
+	SeriesProgressViewedAt          pgtype.Timestamp
+	SeriesProgressCompletedAt       pgtype.Timestamp
+	PictureID                       pgtype.UUID
+	PictureExt                      pgtype.Text
+}
+
+func (q *Queries) FindFilteredPublishedSeriesWithAuthorAndProgressSortByRating(ctx context.Context, arg FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingParams) ([]FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingRow, error) {
+	rows, err := q.db.Query(ctx, findFilteredPublishedSeriesWithAuthorAndProgressSortByRating,
+		arg.UserID,
+		arg.Title,
+		arg.LanguageSlug,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingRow{}
+	for rows.Next() {
+		var i FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.SeriesProgressID,
@@ -655,7 +898,7 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorAndProgressSortByID(ctx c
 
 const findFilteredPublishedSeriesWithAuthorAndProgressSortBySlug = `-- name: FindFilteredPublishedSeriesWithAuthorAndProgressSortBySlug :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_progress"."id" AS "series_progress_id",
@@ -706,6 +949,14 @@ type FindFilteredPublishedSeriesWithAuthorAndProgressSortBySlugRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
 	AuthorFirstName                 string
 	AuthorLastName                  string
 	SeriesProgressID                pgtype.Int4
@@ -746,6 +997,14 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorAndProgressSortBySlug(ctx
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.SeriesProgressID,
@@ -768,7 +1027,7 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorAndProgressSortBySlug(ctx
 
 const findFilteredPublishedSeriesWithAuthorSortByID = `-- name: FindFilteredPublishedSeriesWithAuthorSortByID :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -809,6 +1068,14 @@ type FindFilteredPublishedSeriesWithAuthorSortByIDRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
@@ -843,6 +1110,14 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortByID(ctx context.Cont
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -858,9 +1133,9 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortByID(ctx context.Cont
 	return items, nil
 }
 
-const findFilteredPublishedSeriesWithAuthorSortBySlug = `-- name: FindFilteredPublishedSeriesWithAuthorSortBySlug :many
+const findFilteredPublishedSeriesWithAuthorSortByRating = `-- name: FindFilteredPublishedSeriesWithAuthorSortByRating :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -876,18 +1151,21 @@ WHERE
         "users"."first_name" ILIKE $2 OR
         "users"."last_name" ILIKE $2
     )
-ORDER BY "series"."slug" ASC
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
 LIMIT $3 OFFSET $4
 `
 
-type FindFilteredPublishedSeriesWithAuthorSortBySlugParams struct {
+type FindFilteredPublishedSeriesWithAuthorSortByRatingParams struct {
 	LanguageSlug string
 	Title        string
 	Limit        int32
 	Offset       int32
 }
 
-type FindFilteredPublishedSeriesWithAuthorSortBySlugRow struct {
+type FindFilteredPublishedSeriesWithAuthorSortByRatingRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -901,14 +1179,22 @@ type FindFilteredPublishedSeriesWithAuthorSortBySlugRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortBySlug(ctx context.Context, arg FindFilteredPublishedSeriesWithAuthorSortBySlugParams) ([]FindFilteredPublishedSeriesWithAuthorSortBySlugRow, error) {
-	rows, err := q.db.Query(ctx, findFilteredPublishedSeriesWithAuthorSortBySlug,
+func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortByRating(ctx context.Context, arg FindFilteredPublishedSeriesWithAuthorSortByRatingParams) ([]FindFilteredPublishedSeriesWithAuthorSortByRatingRow, error) {
+	rows, err := q.db.Query(ctx, findFilteredPublishedSeriesWithAuthorSortByRating,
 		arg.LanguageSlug,
 		arg.Title,
 		arg.Limit,
@@ -918,9 +1204,9 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortBySlug(ctx context.Co
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindFilteredPublishedSeriesWithAuthorSortBySlugRow{}
+	items := []FindFilteredPublishedSeriesWithAuthorSortByRatingRow{}
 	for rows.Next() {
-		var i FindFilteredPublishedSeriesWithAuthorSortBySlugRow
+		var i FindFilteredPublishedSeriesWithAuthorSortByRatingRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -935,6 +1221,14 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortBySlug(ctx context.Co
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -950,9 +1244,9 @@ func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortBySlug(ctx context.Co
 	return items, nil
 }
 
-const findFilteredSeriesWithAuthorSortByID = `-- name: FindFilteredSeriesWithAuthorSortByID :many
+const findFilteredPublishedSeriesWithAuthorSortBySlug = `-- name: FindFilteredPublishedSeriesWithAuthorSortBySlug :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -962,23 +1256,24 @@ INNER JOIN "users" ON "series"."author_id" = "users"."id"
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
 WHERE
     "series"."language_slug" = $1 AND
+    "series"."is_published" = true AND
     (
         "series"."title" ILIKE $2 OR
         "users"."first_name" ILIKE $2 OR
         "users"."last_name" ILIKE $2
     )
-ORDER BY "series"."id" DESC
+ORDER BY "series"."slug" ASC
 LIMIT $3 OFFSET $4
 `
 
-type FindFilteredSeriesWithAuthorSortByIDParams struct {
+type FindFilteredPublishedSeriesWithAuthorSortBySlugParams struct {
 	LanguageSlug string
 	Title        string
 	Limit        int32
 	Offset       int32
 }
 
-type FindFilteredSeriesWithAuthorSortByIDRow struct {
+type FindFilteredPublishedSeriesWithAuthorSortBySlugRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -992,14 +1287,22 @@ type FindFilteredSeriesWithAuthorSortByIDRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindFilteredSeriesWithAuthorSortByID(ctx context.Context, arg FindFilteredSeriesWithAuthorSortByIDParams) ([]FindFilteredSeriesWithAuthorSortByIDRow, error) {
-	rows, err := q.db.Query(ctx, findFilteredSeriesWithAuthorSortByID,
+func (q *Queries) FindFilteredPublishedSeriesWithAuthorSortBySlug(ctx context.Context, arg FindFilteredPublishedSeriesWithAuthorSortBySlugParams) ([]FindFilteredPublishedSeriesWithAuthorSortBySlugRow, error) {
+	rows, err := q.db.Query(ctx, findFilteredPublishedSeriesWithAuthorSortBySlug,
 		arg.LanguageSlug,
 		arg.Title,
 		arg.Limit,
@@ -1009,9 +1312,9 @@ func (q *Queries) FindFilteredSeriesWithAuthorSortByID(ctx context.Context, arg
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindFilteredSeriesWithAuthorSortByIDRow{}
+	items := []FindFilteredPublishedSeriesWithAuthorSortBySlugRow{}
 	for rows.Next() {
-		var i FindFilteredSeriesWithAuthorSortByIDRow
+		var i FindFilteredPublishedSeriesWithAuthorSortBySlugRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1026,6 +1329,14 @@ func (q *Queries) FindFilteredSeriesWithAuthorSortByID(ctx context.Context, arg
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -1041,9 +1352,9 @@ func (q *Queries) FindFilteredSeriesWithAuthorSortByID(ctx context.Context, arg
 	return items, nil
 }
 
-const findFilteredSeriesWithAuthorSortBySlug = `-- name: FindFilteredSeriesWithAuthorSortBySlug :many
+const findFilteredSeriesWithAuthorSortByID = `-- name: FindFilteredSeriesWithAuthorSortByID :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -1058,18 +1369,18 @@ WHERE
         "users"."first_name" ILIKE $2 OR
         "users"."last_name" ILIKE $2
     )
-ORDER BY "series"."slug" ASC
+ORDER BY "series"."id" DESC
 LIMIT $3 OFFSET $4
 `
 
-type FindFilteredSeriesWithAuthorSortBySlugParams struct {
+type FindFilteredSeriesWithAuthorSortByIDParams struct {
 	LanguageSlug string
 	Title        string
 	Limit        int32
 	Offset       int32
 }
 
-type FindFilteredSeriesWithAuthorSortBySlugRow struct {
+type FindFilteredSeriesWithAuthorSortByIDRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -1083,14 +1394,22 @@ type FindFilteredSeriesWithAuthorSortBySlugRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindFilteredSeriesWithAuthorSortBySlug(ctx context.Context, arg FindFilteredSeriesWithAuthorSortBySlugParams) ([]FindFilteredSeriesWithAuthorSortBySlugRow, error) {
-	rows, err := q.db.Query(ctx, findFilteredSeriesWithAuthorSortBySlug,
+func (q *Queries) FindFilteredSeriesWithAuthorSortByID(ctx context.Context, arg FindFilteredSeriesWithAuthorSortByIDParams) ([]FindFilteredSeriesWithAuthorSortByIDRow, error) {
+	rows, err := q.db.Query(ctx, findFilteredSeriesWithAuthorSortByID,
 		arg.LanguageSlug,
 		arg.Title,
 		arg.Limit,
@@ -1100,9 +1419,9 @@ func (q *Queries) FindFilteredSeriesWithAuthorSortBySlug(ctx context.Context, ar
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindFilteredSeriesWithAuthorSortBySlugRow{}
+	items := []FindFilteredSeriesWithAuthorSortByIDRow{}
 	for rows.Next() {
-		var i FindFilteredSeriesWithAuthorSortBySlugRow
+		var i FindFilteredSeriesWithAuthorSortByIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1117,6 +1436,14 @@ func (q *Queries) FindFilteredSeriesWithAuthorSortBySlug(ctx context.Context, ar
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -1132,9 +1459,9 @@ func (q *Queries) FindFilteredSeriesWithAuthorSortBySlug(ctx context.Context, ar
 	return items, nil
 }
 
-const findPaginatedDiscoverySeriesWithAuthor = `-- name: FindPaginatedDiscoverySeriesWithAuthor :many
+const findFilteredSeriesWithAuthorSortByRating = `-- name: FindFilteredSeriesWithAuthorSortByRating :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -1142,17 +1469,28 @@ SELECT
 FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
-WHERE "series"."is_published" = true
-ORDER BY "series"."slug" ASC
-LIMIT $1 OFFSET $2
+WHERE
+    "series"."language_slug" = $1 AND
+    (
+        "series"."title" ILIKE $2 OR
+        "users"."first_name" ILIKE $2 OR
+        "users"."last_name" ILIKE $2
+    )
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $3 OFFSET $4
 `
 
-type FindPaginatedDiscoverySeriesWithAuthorParams struct {
-	Limit  int32
-	Offset int32
+type FindFilteredSeriesWithAuthorSortByRatingParams struct {
+	LanguageSlug string
+	Title        string
+	Limit        int32
+	Offset       int32
 }
 
-type FindPaginatedDiscoverySeriesWithAuthorRow struct {
+type FindFilteredSeriesWithAuthorSortByRatingRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -1166,21 +1504,34 @@ type FindPaginatedDiscoverySeriesWithAuthorRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindPaginatedDiscoverySeriesWithAuthor(ctx context.Context, arg FindPaginatedDiscoverySeriesWithAuthorParams) ([]FindPaginatedDiscoverySeriesWithAuthorRow, error) {
-	rows, err := q.db.Query(ctx, findPaginatedDiscoverySeriesWithAuthor, arg.Limit, arg.Offset)
+func (q *Queries) FindFilteredSeriesWithAuthorSortByRating(ctx context.Context, arg FindFilteredSeriesWithAuthorSortByRatingParams) ([]FindFilteredSeriesWithAuthorSortByRatingRow, error) {
+	rows, err := q.db.Query(ctx, findFilteredSeriesWithAuthorSortByRating,
+		arg.LanguageSlug,
+		arg.Title,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindPaginatedDiscoverySeriesWithAuthorRow{}
+	items := []FindFilteredSeriesWithAuthorSortByRatingRow{}
 	for rows.Next() {
-		var i FindPaginatedDiscoverySeriesWithAuthorRow
+		var i FindFilteredSeriesWithAuthorSortByRatingRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1195,6 +1546,14 @@ func (q *Queries) FindPaginatedDiscoverySeriesWithAuthor(ctx context.Context, ar
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -1210,51 +1569,260 @@ func (q *Queries) FindPaginatedDiscoverySeriesWithAuthor(ctx context.Context, ar
 	return items, nil
 }
 
-const findPaginatedDiscoverySeriesWithAuthorAndProgress = `-- name: FindPaginatedDiscoverySeriesWithAuthorAndProgress :many
+const findFilteredSeriesWithAuthorSortBySlug = `-- name: FindFilteredSeriesWithAuthorSortBySlug :many
 SELECT
-    series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
-    "users"."first_name" AS "author_first_name",
-    "users"."last_name" AS "author_last_name",
-    "series_progress"."id" AS "series_progress_id",
-    "series_progress"."completed_sections" AS "series_progress_completed_sections",
-    "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
-    "series_progress"."viewed_at" AS "series_progress_viewed_at",
-    "series_progress"."completed_at" AS "series_progress_completed_at",
-    "series_pictures"."id" AS "picture_id",
-    "series_pictures"."ext" AS "picture_ext"
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
 FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
-LEFT JOIN "series_progress" ON (
-    "series"."slug" = "series_progress"."series_slug" AND
-    "series_progress"."user_id" = $1
-)
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
 WHERE
-    "series"."is_published" = true
+    "series"."language_slug" = $1 AND
+    (
+        "series"."title" ILIKE $2 OR
+        "users"."first_name" ILIKE $2 OR
+        "users"."last_name" ILIKE $2
+    )
 ORDER BY "series"."slug" ASC
-LIMIT $2 OFFSET $3
+LIMIT $3 OFFSET $4
 `
 
-type FindPaginatedDiscoverySeriesWithAuthorAndProgressParams struct {
-	UserID int32
-	Limit  int32
-	Offset int32
+type FindFilteredSeriesWithAuthorSortBySlugParams struct {
+	LanguageSlug string
+	Title        string
+	Limit        int32
+	Offset       int32
 }
 
-type FindPaginatedDiscoverySeriesWithAuthorAndProgressRow struct {
-	ID                              int32
-	Title                           string
-	Slug                            string
-	Description                     string
-	SectionsCount                   int16
-	LessonsCount                    int16
-	WatchTimeSeconds                int32
-	ReadTimeSeconds                 int32
-	IsPublished                     bool
-	LanguageSlug                    string
-	AuthorID                        int32
-	CreatedAt                       pgtype.Timestamp
+type FindFilteredSeriesWithAuthorSortBySlugRow struct {
+	ID               int32
+	Title            string
+	Slug             string
+	Description      string
+	SectionsCount    int16
+	LessonsCount     int16
+	WatchTimeSeconds int32
+	ReadTimeSeconds  int32
+	IsPublished      bool
+	LanguageSlug     string
+	AuthorID         int32
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
+	AuthorFirstName  string
+	AuthorLastName   string
+	PictureID        pgtype.UUID
+	PictureExt       pgtype.Text
+}
+
+func (q *Queries) FindFilteredSeriesWithAuthorSortBySlug(ctx context.Context, arg FindFilteredSeriesWithAuthorSortBySlugParams) ([]FindFilteredSeriesWithAuthorSortBySlugRow, error) {
+	rows, err := q.db.Query(ctx, findFilteredSeriesWithAuthorSortBySlug,
+		arg.LanguageSlug,
+		arg.Title,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindFilteredSeriesWithAuthorSortBySlugRow{}
+	for rows.Next() {
+		var i FindFilteredSeriesWithAuthorSortBySlugRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedDiscoverySeriesWithAuthor = `-- name: FindPaginatedDiscoverySeriesWithAuthor :many
+SELECT
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE "series"."is_published" = true
+ORDER BY "series"."slug" ASC
+LIMIT $1 OFFSET $2
+`
+
+type FindPaginatedDiscoverySeriesWithAuthorParams struct {
+	Limit  int32
+	Offset int32
+}
+
+type FindPaginatedDiscoverySeriesWithAuthorRow struct {
+	ID               int32
+	Title            string
+	Slug             string
+	Description      string
+	SectionsCount    int16
+	LessonsCount     int16
+	WatchTimeSeconds int32
+	ReadTimeSeconds  int32
+	IsPublished      bool
+	LanguageSlug     string
+	AuthorID         int32
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
+	AuthorFirstName  string
+	AuthorLastName   string
+	PictureID        pgtype.UUID
+	PictureExt       pgtype.Text
+}
+
+func (q *Queries) FindPaginatedDiscoverySeriesWithAuthor(ctx context.Context, arg FindPaginatedDiscoverySeriesWithAuthorParams) ([]FindPaginatedDiscoverySeriesWithAuthorRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedDiscoverySeriesWithAuthor, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedDiscoverySeriesWithAuthorRow{}
+	for rows.Next() {
+		var i FindPaginatedDiscoverySeriesWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedDiscoverySeriesWithAuthorAndProgress = `-- name: FindPaginatedDiscoverySeriesWithAuthorAndProgress :many
+SELECT
+    series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name",
+    "series_progress"."id" AS "series_progress_id",
+    "series_progress"."completed_sections" AS "series_progress_completed_sections",
+    "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+    "series_progress"."viewed_at" AS "series_progress_viewed_at",
+    "series_progress"."completed_at" AS "series_progress_completed_at",
+    "series_pictures"."id" AS "picture_id",
+    "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+    "series"."is_published" = true
+ORDER BY "series"."slug" ASC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedDiscoverySeriesWithAuthorAndProgressParams struct {
+	UserID int32
+	Limit  int32
+	Offset int32
+}
+
+type FindPaginatedDiscoverySeriesWithAuthorAndProgressRow struct {
+	ID                              int32
+	Title                           string
+	Slug                            string
+	Description                     string
+	SectionsCount                   int16
+	LessonsCount                    int16
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	IsPublished                     bool
+	LanguageSlug                    string
+	AuthorID                        int32
+	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
 	AuthorFirstName                 string
 	AuthorLastName                  string
 	SeriesProgressID                pgtype.Int4
@@ -1289,6 +1857,14 @@ func (q *Queries) FindPaginatedDiscoverySeriesWithAuthorAndProgress(ctx context.
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.SeriesProgressID,
@@ -1311,7 +1887,7 @@ func (q *Queries) FindPaginatedDiscoverySeriesWithAuthorAndProgress(ctx context.
 
 const findPaginatedPublishedSeriesWithAuthorAndInnerProgress = `-- name: FindPaginatedPublishedSeriesWithAuthorAndInnerProgress :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_progress"."id" AS "series_progress_id",
@@ -1356,6 +1932,14 @@ type FindPaginatedPublishedSeriesWithAuthorAndInnerProgressRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
 	AuthorFirstName                 string
 	AuthorLastName                  string
 	SeriesProgressID                int32
@@ -1395,6 +1979,14 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndInnerProgress(ctx con
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.SeriesProgressID,
@@ -1417,7 +2009,7 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndInnerProgress(ctx con
 
 const findPaginatedPublishedSeriesWithAuthorAndProgressSortByID = `-- name: FindPaginatedPublishedSeriesWithAuthorAndProgressSortByID :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_progress"."id" AS "series_progress_id",
@@ -1462,6 +2054,14 @@ type FindPaginatedPublishedSeriesWithAuthorAndProgressSortByIDRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
 	AuthorFirstName                 string
 	AuthorLastName                  string
 	SeriesProgressID                pgtype.Int4
@@ -1501,6 +2101,14 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortByID(ctx
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.SeriesProgressID,
@@ -1521,9 +2129,9 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortByID(ctx
 	return items, nil
 }
 
-const findPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug = `-- name: FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug :many
+const findPaginatedPublishedSeriesWithAuthorAndProgressSortByRating = `-- name: FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRating :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_progress"."id" AS "series_progress_id",
@@ -1543,18 +2151,21 @@ LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
 WHERE
   "series"."language_slug" = $2 AND
   "series"."is_published" = true
-ORDER BY "series"."slug" ASC
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
 LIMIT $3 OFFSET $4
 `
 
-type FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugParams struct {
+type FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingParams struct {
 	UserID       int32
 	LanguageSlug string
 	Limit        int32
 	Offset       int32
 }
 
-type FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow struct {
+type FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingRow struct {
 	ID                              int32
 	Title                           string
 	Slug                            string
@@ -1568,6 +2179,14 @@ type FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow struct {
 	AuthorID                        int32
 	CreatedAt                       pgtype.Timestamp
 	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
 	AuthorFirstName                 string
 	AuthorLastName                  string
 	SeriesProgressID                pgtype.Int4
@@ -1579,8 +2198,8 @@ type FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow struct {
 	PictureExt                      pgtype.Text
 }
 
-func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugParams) ([]FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow, error) {
-	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug,
+func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRating(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingParams) ([]FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorAndProgressSortByRating,
 		arg.UserID,
 		arg.LanguageSlug,
 		arg.Limit,
@@ -1590,9 +2209,9 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug(ct
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow{}
+	items := []FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingRow{}
 	for rows.Next() {
-		var i FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow
+		var i FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1607,6 +2226,14 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug(ct
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.SeriesProgressID,
@@ -1627,58 +2254,86 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug(ct
 	return items, nil
 }
 
-const findPaginatedPublishedSeriesWithAuthorSortByID = `-- name: FindPaginatedPublishedSeriesWithAuthorSortByID :many
+const findPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug = `-- name: FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
   "series_pictures"."id" AS "picture_id",
   "series_pictures"."ext" AS "picture_ext"
 FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
 WHERE
-    "series"."language_slug" = $1 AND
-    "series"."is_published" = true
-ORDER BY "series"."id" DESC
-LIMIT $2 OFFSET $3
+  "series"."language_slug" = $2 AND
+  "series"."is_published" = true
+ORDER BY "series"."slug" ASC
+LIMIT $3 OFFSET $4
 `
 
-type FindPaginatedPublishedSeriesWithAuthorSortByIDParams struct {
+type FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugParams struct {
+	UserID       int32
 	LanguageSlug string
 	Limit        int32
 	Offset       int32
 }
 
-type FindPaginatedPublishedSeriesWithAuthorSortByIDRow struct {
-	ID               int32
-	Title            string
-	Slug             string
-	Description      string
-	SectionsCount    int16
-	LessonsCount     int16
-	WatchTimeSeconds int32
-	ReadTimeSeconds  int32
-	IsPublished      bool
-	LanguageSlug     string
-	AuthorID         int32
-	CreatedAt        pgtype.Timestamp
-	UpdatedAt        pgtype.Timestamp
-	AuthorFirstName  string
-	AuthorLastName   string
-	PictureID        pgtype.UUID
-	PictureExt       pgtype.Text
+type FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow struct {
+	ID                              int32
+	Title                           string
+	Slug                            string
+	Description                     string
+	SectionsCount                   int16
+	LessonsCount                    int16
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	IsPublished                     bool
+	LanguageSlug                    string
+	AuthorID                        int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
+	AuthorFirstName                 string
+	AuthorLastName                  string
+	SeriesProgressID                pgtype.Int4
+	SeriesProgressCompletedSections pgtype.Int2
+	SeriesProgressCompletedLessons  pgtype.Int2
+	SeriesProgressViewedAt          pgtype.Timestamp
+	SeriesProgressCompletedAt       pgtype.Timestamp
+	PictureID                       pgtype.UUID
+	PictureExt                      pgtype.Text
 }
 
-func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortByID(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorSortByIDParams) ([]FindPaginatedPublishedSeriesWithAuthorSortByIDRow, error) {
-	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorSortByID, arg.LanguageSlug, arg.Limit, arg.Offset)
+func (q *Queries) FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugParams) ([]FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindPaginatedPublishedSeriesWithAuthorSortByIDRow{}
+	items := []FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow{}
 	for rows.Next() {
-		var i FindPaginatedPublishedSeriesWithAuthorSortByIDRow
+		var i FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlugRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1693,8 +2348,21 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortByID(ctx context.Con
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
+			&i.SeriesProgressID,
+			&i.SeriesProgressCompletedSections,
+			&i.SeriesProgressCompletedLessons,
+			&i.SeriesProgressViewedAt,
+			&i.SeriesProgressCompletedAt,
 			&i.PictureID,
 			&i.PictureExt,
 		); err != nil {
@@ -1708,9 +2376,9 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortByID(ctx context.Con
 	return items, nil
 }
 
-const findPaginatedPublishedSeriesWithAuthorSortBySlug = `-- name: FindPaginatedPublishedSeriesWithAuthorSortBySlug :many
+const findPaginatedPublishedSeriesWithAuthorSortByID = `-- name: FindPaginatedPublishedSeriesWithAuthorSortByID :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -1721,17 +2389,17 @@ LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
 WHERE
     "series"."language_slug" = $1 AND
     "series"."is_published" = true
-ORDER BY "series"."slug" ASC
+ORDER BY "series"."id" DESC
 LIMIT $2 OFFSET $3
 `
 
-type FindPaginatedPublishedSeriesWithAuthorSortBySlugParams struct {
+type FindPaginatedPublishedSeriesWithAuthorSortByIDParams struct {
 	LanguageSlug string
 	Limit        int32
 	Offset       int32
 }
 
-type FindPaginatedPublishedSeriesWithAuthorSortBySlugRow struct {
+type FindPaginatedPublishedSeriesWithAuthorSortByIDRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -1745,21 +2413,29 @@ type FindPaginatedPublishedSeriesWithAuthorSortBySlugRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortBySlug(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorSortBySlugParams) ([]FindPaginatedPublishedSeriesWithAuthorSortBySlugRow, error) {
-	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorSortBySlug, arg.LanguageSlug, arg.Limit, arg.Offset)
+func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortByID(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorSortByIDParams) ([]FindPaginatedPublishedSeriesWithAuthorSortByIDRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorSortByID, arg.LanguageSlug, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindPaginatedPublishedSeriesWithAuthorSortBySlugRow{}
+	items := []FindPaginatedPublishedSeriesWithAuthorSortByIDRow{}
 	for rows.Next() {
-		var i FindPaginatedPublishedSeriesWithAuthorSortBySlugRow
+		var i FindPaginatedPublishedSeriesWithAuthorSortByIDRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1774,6 +2450,14 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortBySlug(ctx context.C
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -1789,9 +2473,9 @@ func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortBySlug(ctx context.C
 	return items, nil
 }
 
-const findPaginatedSeriesWithAuthorSortByID = `-- name: FindPaginatedSeriesWithAuthorSortByID :many
+const findPaginatedPublishedSeriesWithAuthorSortByRating = `-- name: FindPaginatedPublishedSeriesWithAuthorSortByRating :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -1799,18 +2483,23 @@ SELECT
 FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
-WHERE "series"."language_slug" = $1
-ORDER BY "series"."id" DESC
+WHERE
+    "series"."language_slug" = $1 AND
+    "series"."is_published" = true
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
 LIMIT $2 OFFSET $3
 `
 
-type FindPaginatedSeriesWithAuthorSortByIDParams struct {
+type FindPaginatedPublishedSeriesWithAuthorSortByRatingParams struct {
 	LanguageSlug string
 	Limit        int32
 	Offset       int32
 }
 
-type FindPaginatedSeriesWithAuthorSortByIDRow struct {
+type FindPaginatedPublishedSeriesWithAuthorSortByRatingRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -1824,21 +2513,29 @@ type FindPaginatedSeriesWithAuthorSortByIDRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindPaginatedSeriesWithAuthorSortByID(ctx context.Context, arg FindPaginatedSeriesWithAuthorSortByIDParams) ([]FindPaginatedSeriesWithAuthorSortByIDRow, error) {
-	rows, err := q.db.Query(ctx, findPaginatedSeriesWithAuthorSortByID, arg.LanguageSlug, arg.Limit, arg.Offset)
+func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortByRating(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorSortByRatingParams) ([]FindPaginatedPublishedSeriesWithAuthorSortByRatingRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorSortByRating, arg.LanguageSlug, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindPaginatedSeriesWithAuthorSortByIDRow{}
+	items := []FindPaginatedPublishedSeriesWithAuthorSortByRatingRow{}
 	for rows.Next() {
-		var i FindPaginatedSeriesWithAuthorSortByIDRow
+		var i FindPaginatedPublishedSeriesWithAuthorSortByRatingRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1853,6 +2550,14 @@ func (q *Queries) FindPaginatedSeriesWithAuthorSortByID(ctx context.Context, arg
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -1868,9 +2573,9 @@ func (q *Queries) FindPaginatedSeriesWithAuthorSortByID(ctx context.Context, arg
 	return items, nil
 }
 
-const findPaginatedSeriesWithAuthorSortBySlug = `-- name: FindPaginatedSeriesWithAuthorSortBySlug :many
+const findPaginatedPublishedSeriesWithAuthorSortBySlug = `-- name: FindPaginatedPublishedSeriesWithAuthorSortBySlug :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -1878,18 +2583,20 @@ SELECT
 FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
-WHERE "series"."language_slug" = $1
+WHERE
+    "series"."language_slug" = $1 AND
+    "series"."is_published" = true
 ORDER BY "series"."slug" ASC
 LIMIT $2 OFFSET $3
 `
 
-type FindPaginatedSeriesWithAuthorSortBySlugParams struct {
+type FindPaginatedPublishedSeriesWithAuthorSortBySlugParams struct {
 	LanguageSlug string
 	Limit        int32
 	Offset       int32
 }
 
-type FindPaginatedSeriesWithAuthorSortBySlugRow struct {
+type FindPaginatedPublishedSeriesWithAuthorSortBySlugRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -1903,21 +2610,29 @@ type FindPaginatedSeriesWithAuthorSortBySlugRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindPaginatedSeriesWithAuthorSortBySlug(ctx context.Context, arg FindPaginatedSeriesWithAuthorSortBySlugParams) ([]FindPaginatedSeriesWithAuthorSortBySlugRow, error) {
-	rows, err := q.db.Query(ctx, findPaginatedSeriesWithAuthorSortBySlug, arg.LanguageSlug, arg.Limit, arg.Offset)
+func (q *Queries) FindPaginatedPublishedSeriesWithAuthorSortBySlug(ctx context.Context, arg FindPaginatedPublishedSeriesWithAuthorSortBySlugParams) ([]FindPaginatedPublishedSeriesWithAuthorSortBySlugRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedPublishedSeriesWithAuthorSortBySlug, arg.LanguageSlug, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []FindPaginatedSeriesWithAuthorSortBySlugRow{}
+	items := []FindPaginatedPublishedSeriesWithAuthorSortBySlugRow{}
 	for rows.Next() {
-		var i FindPaginatedSeriesWithAuthorSortBySlugRow
+		var i FindPaginatedPublishedSeriesWithAuthorSortBySlugRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
@@ -1932,6 +2647,14 @@ func (q *Queries) FindPaginatedSeriesWithAuthorSortBySlug(ctx context.Context, a
 			&i.AuthorID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
 			&i.AuthorFirstName,
 			&i.AuthorLastName,
 			&i.PictureID,
@@ -1947,131 +2670,104 @@ func (q *Queries) FindPaginatedSeriesWithAuthorSortBySlug(ctx context.Context, a
 	return items, nil
 }
 
-const findPublishedSeriesBySlugAndLanguageSlug = `-- name: FindPublishedSeriesBySlugAndLanguageSlug :one
-SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at FROM "series"
-WHERE
-    "slug" = $1 AND
-    "language_slug" = $2 AND
-    "is_published" = true
-LIMIT 1
-`
-
-type FindPublishedSeriesBySlugAndLanguageSlugParams struct {
-	Slug         string
-	LanguageSlug string
-}
-
-func (q *Queries) FindPublishedSeriesBySlugAndLanguageSlug(ctx context.Context, arg FindPublishedSeriesBySlugAndLanguageSlugParams) (Series, error) {
-	row := q.db.QueryRow(ctx, findPublishedSeriesBySlugAndLanguageSlug, arg.Slug, arg.LanguageSlug)
-	var i Series
-	err := row.Scan(
-		&i.ID,
-		&i.Title,
-		&i.Slug,
-		&i.Description,
-		&i.SectionsCount,
-		&i.LessonsCount,
-		&i.WatchTimeSeconds,
-		&i.ReadTimeSeconds,
-		&i.IsPublished,
-		&i.LanguageSlug,
-		&i.AuthorID,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
-}
-
-const findPublishedSeriesBySlugWithAuthorAndProgress = `-- name: FindPublishedSeriesBySlugWithAuthorAndProgress :one
+const findPaginatedSeriesWithAuthorSortByID = `-- name: FindPaginatedSeriesWithAuthorSortByID :many
 SELECT
-    series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
-    "users"."first_name" AS "author_first_name",
-    "users"."last_name" AS "author_last_name",
-    "series_progress"."id" AS "series_progress_id",
-    "series_progress"."completed_sections" AS "series_progress_completed_sections",
-    "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
-    "series_progress"."viewed_at" AS "series_progress_viewed_at",
-    "series_progress"."completed_at" AS "series_progress_completed_at",
-    "series_pictures"."id" AS "picture_id",
-    "series_pictures"."ext" AS "picture_ext"
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
 FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
-LEFT JOIN "series_progress" ON (
-    "series"."slug" = "series_progress"."series_slug" AND
-    "series_progress"."user_id" = $1
-)
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
-WHERE
-    "series"."slug" = $2 AND
-    "series"."language_slug" = $3 AND
-    "series"."is_published" = true
-LIMIT 1
+WHERE "series"."language_slug" = $1
+ORDER BY "series"."id" DESC
+LIMIT $2 OFFSET $3
 `
 
-type FindPublishedSeriesBySlugWithAuthorAndProgressParams struct {
-	UserID       int32
-	Slug         string
+type FindPaginatedSeriesWithAuthorSortByIDParams struct {
 	LanguageSlug string
+	Limit        int32
+	Offset       int32
 }
 
-type FindPublishedSeriesBySlugWithAuthorAndProgressRow struct {
-	ID                              int32
-	Title                           string
-	Slug                            string
-	Description                     string
-	SectionsCount                   int16
-	LessonsCount                    int16
-	WatchTimeSeconds                int32
-	ReadTimeSeconds                 int32
-	IsPublished                     bool
-	LanguageSlug                    string
-	AuthorID                        int32
-	CreatedAt                       pgtype.Timestamp
-	UpdatedAt                       pgtype.Timestamp
-	AuthorFirstName                 string
-	AuthorLastName                  string
-	SeriesProgressID                pgtype.Int4
-	SeriesProgressCompletedSections pgtype.Int2
-	SeriesProgressCompletedLessons  pgtype.Int2
-	SeriesProgressViewedAt          pgtype.Timestamp
-	SeriesProgressCompletedAt       pgtype.Timestamp
-	PictureID                       pgtype.UUID
-	PictureExt                      pgtype.Text
+type FindPaginatedSeriesWithAuthorSortByIDRow struct {
+	ID               int32
+	Title            string
+	Slug             string
+	Description      string
+	SectionsCount    int16
+	LessonsCount     int16
+	WatchTimeSeconds int32
+	ReadTimeSeconds  int32
+	IsPublished      bool
+	LanguageSlug     string
+	AuthorID         int32
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
+	AuthorFirstName  string
+	AuthorLastName   string
+	PictureID        pgtype.UUID
+	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindPublishedSeriesBySlugWithAuthorAndProgress(ctx context.Context, arg FindPublishedSeriesBySlugWithAuthorAndProgressParams) (FindPublishedSeriesBySlugWithAuthorAndProgressRow, error) {
-	row := q.db.QueryRow(ctx, findPublishedSeriesBySlugWithAuthorAndProgress, arg.UserID, arg.Slug, arg.LanguageSlug)
-	var i FindPublishedSeriesBySlugWithAuthorAndProgressRow
-	err := row.Scan(
-		&i.ID,
-		&i.Title,
-		&i.Slug,
-		&i.Description,
-		&i.SectionsCount,
-		&i.LessonsCount,
-		&i.WatchTimeSeconds,
-		&i.ReadTimeSeconds,
-		&i.IsPublished,
-		&i.LanguageSlug,
-		&i.AuthorID,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.AuthorFirstName,
-		&i.AuthorLastName,
-		&i.SeriesProgressID,
-		&i.SeriesProgressCompletedSections,
-		&i.SeriesProgressCompletedLessons,
-		&i.SeriesProgressViewedAt,
-		&i.SeriesProgressCompletedAt,
-		&i.PictureID,
-		&i.PictureExt,
-	)
-	return i, err
+func (q *Queries) FindPaginatedSeriesWithAuthorSortByID(ctx context.Context, arg FindPaginatedSeriesWithAuthorSortByIDParams) ([]FindPaginatedSeriesWithAuthorSortByIDRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedSeriesWithAuthorSortByID, arg.LanguageSlug, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedSeriesWithAuthorSortByIDRow{}
+	for rows.Next() {
+		var i FindPaginatedSeriesWithAuthorSortByIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const findPublishedSeriesBySlugsWithAuthor = `-- name: FindPublishedSeriesBySlugsWithAuthor :one
+const findPaginatedSeriesWithAuthorSortByRating = `-- name: FindPaginatedSeriesWithAuthorSortByRating :many
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -2079,19 +2775,21 @@ SELECT
 FROM "series"
 INNER JOIN "users" ON "series"."author_id" = "users"."id"
 LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
-WHERE
-  "series"."slug" = $1 AND
-  "series"."language_slug" = $2 AND
-  "series"."is_published" = true
-LIMIT 1
+WHERE "series"."language_slug" = $1
+ORDER BY
+    ("series"."rating_total"::real / GREATEST("series"."reviews_count", 1)) DESC,
+    "series"."reviews_count" DESC,
+    "series"."id" DESC
+LIMIT $2 OFFSET $3
 `
 
-type FindPublishedSeriesBySlugsWithAuthorParams struct {
-	Slug         string
+type FindPaginatedSeriesWithAuthorSortByRatingParams struct {
 	LanguageSlug string
+	Limit        int32
+	Offset       int32
 }
 
-type FindPublishedSeriesBySlugsWithAuthorRow struct {
+type FindPaginatedSeriesWithAuthorSortByRatingRow struct {
 	ID               int32
 	Title            string
 	Slug             string
@@ -2105,21 +2803,413 @@ type FindPublishedSeriesBySlugsWithAuthorRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
 	PictureExt       pgtype.Text
 }
 
-func (q *Queries) FindPublishedSeriesBySlugsWithAuthor(ctx context.Context, arg FindPublishedSeriesBySlugsWithAuthorParams) (FindPublishedSeriesBySlugsWithAuthorRow, error) {
-	row := q.db.QueryRow(ctx, findPublishedSeriesBySlugsWithAuthor, arg.Slug, arg.LanguageSlug)
-	var i FindPublishedSeriesBySlugsWithAuthorRow
-	err := row.Scan(
-		&i.ID,
-		&i.Title,
-		&i.Slug,
-		&i.Description,
-		&i.SectionsCount,
+func (q *Queries) FindPaginatedSeriesWithAuthorSortByRating(ctx context.Context, arg FindPaginatedSeriesWithAuthorSortByRatingParams) ([]FindPaginatedSeriesWithAuthorSortByRatingRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedSeriesWithAuthorSortByRating, arg.LanguageSlug, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedSeriesWithAuthorSortByRatingRow{}
+	for rows.Next() {
+		var i FindPaginatedSeriesWithAuthorSortByRatingRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedSeriesWithAuthorSortBySlug = `-- name: FindPaginatedSeriesWithAuthorSortBySlug :many
+SELECT
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE "series"."language_slug" = $1
+ORDER BY "series"."slug" ASC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedSeriesWithAuthorSortBySlugParams struct {
+	LanguageSlug string
+	Limit        int32
+	Offset       int32
+}
+
+type FindPaginatedSeriesWithAuthorSortBySlugRow struct {
+	ID               int32
+	Title            string
+	Slug             string
+	Description      string
+	SectionsCount    int16
+	LessonsCount     int16
+	WatchTimeSeconds int32
+	ReadTimeSeconds  int32
+	IsPublished      bool
+	LanguageSlug     string
+	AuthorID         int32
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
+	AuthorFirstName  string
+	AuthorLastName   string
+	PictureID        pgtype.UUID
+	PictureExt       pgtype.Text
+}
+
+func (q *Queries) FindPaginatedSeriesWithAuthorSortBySlug(ctx context.Context, arg FindPaginatedSeriesWithAuthorSortBySlugParams) ([]FindPaginatedSeriesWithAuthorSortBySlugRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedSeriesWithAuthorSortBySlug, arg.LanguageSlug, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedSeriesWithAuthorSortBySlugRow{}
+	for rows.Next() {
+		var i FindPaginatedSeriesWithAuthorSortBySlugRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPublishedSeriesByAuthorID = `-- name: FindPublishedSeriesByAuthorID :many
+SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only FROM "series"
+WHERE "author_id" = $1 AND "is_published" = true
+`
+
+func (q *Queries) FindPublishedSeriesByAuthorID(ctx context.Context, authorID int32) ([]Series, error) {
+	rows, err := q.db.Query(ctx, findPublishedSeriesByAuthorID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Series{}
+	for rows.Next() {
+		var i Series
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPublishedSeriesBySlugAndLanguageSlug = `-- name: FindPublishedSeriesBySlugAndLanguageSlug :one
+SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only FROM "series"
+WHERE
+    "slug" = $1 AND
+    "language_slug" = $2 AND
+    "is_published" = true
+LIMIT 1
+`
+
+type FindPublishedSeriesBySlugAndLanguageSlugParams struct {
+	Slug         string
+	LanguageSlug string
+}
+
+func (q *Queries) FindPublishedSeriesBySlugAndLanguageSlug(ctx context.Context, arg FindPublishedSeriesBySlugAndLanguageSlugParams) (Series, error) {
+	row := q.db.QueryRow(ctx, findPublishedSeriesBySlugAndLanguageSlug, arg.Slug, arg.LanguageSlug)
+	var i Series
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.LanguageSlug,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
+	)
+	return i, err
+}
+
+const findPublishedSeriesBySlugWithAuthorAndProgress = `-- name: FindPublishedSeriesBySlugWithAuthorAndProgress :one
+SELECT
+    series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name",
+    "series_progress"."id" AS "series_progress_id",
+    "series_progress"."completed_sections" AS "series_progress_completed_sections",
+    "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+    "series_progress"."viewed_at" AS "series_progress_viewed_at",
+    "series_progress"."completed_at" AS "series_progress_completed_at",
+    "series_pictures"."id" AS "picture_id",
+    "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+    "series"."slug" = $2 AND
+    "series"."language_slug" = $3 AND
+    "series"."is_published" = true
+LIMIT 1
+`
+
+type FindPublishedSeriesBySlugWithAuthorAndProgressParams struct {
+	UserID       int32
+	Slug         string
+	LanguageSlug string
+}
+
+type FindPublishedSeriesBySlugWithAuthorAndProgressRow struct {
+	ID                              int32
+	Title                           string
+	Slug                            string
+	Description                     string
+	SectionsCount                   int16
+	LessonsCount                    int16
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	IsPublished                     bool
+	LanguageSlug                    string
+	AuthorID                        int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
+	AuthorFirstName                 string
+	AuthorLastName                  string
+	SeriesProgressID                pgtype.Int4
+	SeriesProgressCompletedSections pgtype.Int2
+	SeriesProgressCompletedLessons  pgtype.Int2
+	SeriesProgressViewedAt          pgtype.Timestamp
+	SeriesProgressCompletedAt       pgtype.Timestamp
+	PictureID                       pgtype.UUID
+	PictureExt                      pgtype.Text
+}
+
+func (q *Queries) FindPublishedSeriesBySlugWithAuthorAndProgress(ctx context.Context, arg FindPublishedSeriesBySlugWithAuthorAndProgressParams) (FindPublishedSeriesBySlugWithAuthorAndProgressRow, error) {
+	row := q.db.QueryRow(ctx, findPublishedSeriesBySlugWithAuthorAndProgress, arg.UserID, arg.Slug, arg.LanguageSlug)
+	var i FindPublishedSeriesBySlugWithAuthorAndProgressRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.LanguageSlug,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
+		&i.AuthorFirstName,
+		&i.AuthorLastName,
+		&i.SeriesProgressID,
+		&i.SeriesProgressCompletedSections,
+		&i.SeriesProgressCompletedLessons,
+		&i.SeriesProgressViewedAt,
+		&i.SeriesProgressCompletedAt,
+		&i.PictureID,
+		&i.PictureExt,
+	)
+	return i, err
+}
+
+const findPublishedSeriesBySlugsWithAuthor = `-- name: FindPublishedSeriesBySlugsWithAuthor :one
+SELECT
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."slug" = $1 AND
+  "series"."language_slug" = $2 AND
+  "series"."is_published" = true
+LIMIT 1
+`
+
+type FindPublishedSeriesBySlugsWithAuthorParams struct {
+	Slug         string
+	LanguageSlug string
+}
+
+type FindPublishedSeriesBySlugsWithAuthorRow struct {
+	ID               int32
+	Title            string
+	Slug             string
+	Description      string
+	SectionsCount    int16
+	LessonsCount     int16
+	WatchTimeSeconds int32
+	ReadTimeSeconds  int32
+	IsPublished      bool
+	LanguageSlug     string
+	AuthorID         int32
+	CreatedAt        pgtype.Timestamp
+	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
+	AuthorFirstName  string
+	AuthorLastName   string
+	PictureID        pgtype.UUID
+	PictureExt       pgtype.Text
+}
+
+func (q *Queries) FindPublishedSeriesBySlugsWithAuthor(ctx context.Context, arg FindPublishedSeriesBySlugsWithAuthorParams) (FindPublishedSeriesBySlugsWithAuthorRow, error) {
+	row := q.db.QueryRow(ctx, findPublishedSeriesBySlugsWithAuthor, arg.Slug, arg.LanguageSlug)
+	var i FindPublishedSeriesBySlugsWithAuthorRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
 		&i.LessonsCount,
 		&i.WatchTimeSeconds,
 		&i.ReadTimeSeconds,
@@ -2128,16 +3218,274 @@ func (q *Queries) FindPublishedSeriesBySlugsWithAuthor(ctx context.Context, arg
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
 		&i.AuthorFirstName,
 		&i.AuthorLastName,
 		&i.PictureID,
 		&i.PictureExt,
 	)
-	return i, err
+	return i, err
+}
+
+const findPublishedSeriesWithAuthorAndInnerProgressAfterCursor = `-- name: FindPublishedSeriesWithAuthorAndInnerProgressAfterCursor :many
+SELECT
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+INNER JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."language_slug" = $2 AND
+  "series"."is_published" = true AND
+  ("series_progress"."viewed_at", "series_progress"."id") < ($3::timestamp, $4::int)
+ORDER BY "series_progress"."viewed_at" DESC, "series_progress"."id" DESC
+LIMIT $5
+`
+
+type FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorParams struct {
+	UserID       int32
+	LanguageSlug string
+	ViewedAt     pgtype.Timestamp
+	ID           int32
+	Limit        int32
+}
+
+type FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorRow struct {
+	ID                              int32
+	Title                           string
+	Slug                            string
+	Description                     string
+	SectionsCount                   int16
+	LessonsCount                    int16
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	IsPublished                     bool
+	LanguageSlug                    string
+	AuthorID                        int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
+	AuthorFirstName                 string
+	AuthorLastName                  string
+	SeriesProgressID                int32
+	SeriesProgressCompletedSections int16
+	SeriesProgressCompletedLessons  int16
+	SeriesProgressViewedAt          pgtype.Timestamp
+	SeriesProgressCompletedAt       pgtype.Timestamp
+	PictureID                       pgtype.UUID
+	PictureExt                      pgtype.Text
+}
+
+func (q *Queries) FindPublishedSeriesWithAuthorAndInnerProgressAfterCursor(ctx context.Context, arg FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorParams) ([]FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorRow, error) {
+	rows, err := q.db.Query(ctx, findPublishedSeriesWithAuthorAndInnerProgressAfterCursor,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.ViewedAt,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorRow{}
+	for rows.Next() {
+		var i FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.SeriesProgressID,
+			&i.SeriesProgressCompletedSections,
+			&i.SeriesProgressCompletedLessons,
+			&i.SeriesProgressViewedAt,
+			&i.SeriesProgressCompletedAt,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPublishedSeriesWithAuthorAndInnerProgressBeforeCursor = `-- name: FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursor :many
+SELECT
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
+  "users"."first_name" AS "author_first_name",
+  "users"."last_name" AS "author_last_name",
+  "series_progress"."id" AS "series_progress_id",
+  "series_progress"."completed_sections" AS "series_progress_completed_sections",
+  "series_progress"."completed_lessons" AS "series_progress_completed_lessons",
+  "series_progress"."viewed_at" AS "series_progress_viewed_at",
+  "series_progress"."completed_at" AS "series_progress_completed_at",
+  "series_pictures"."id" AS "picture_id",
+  "series_pictures"."ext" AS "picture_ext"
+FROM "series"
+INNER JOIN "users" ON "series"."author_id" = "users"."id"
+INNER JOIN "series_progress" ON (
+    "series"."slug" = "series_progress"."series_slug" AND
+    "series_progress"."user_id" = $1
+)
+LEFT JOIN "series_pictures" ON "series"."id" = "series_pictures"."series_id"
+WHERE
+  "series"."language_slug" = $2 AND
+  "series"."is_published" = true AND
+  ("series_progress"."viewed_at", "series_progress"."id") > ($3::timestamp, $4::int)
+ORDER BY "series_progress"."viewed_at" ASC, "series_progress"."id" ASC
+LIMIT $5
+`
+
+type FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorParams struct {
+	UserID       int32
+	LanguageSlug string
+	ViewedAt     pgtype.Timestamp
+	ID           int32
+	Limit        int32
+}
+
+type FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorRow struct {
+	ID                              int32
+	Title                           string
+	Slug                            string
+	Description                     string
+	SectionsCount                   int16
+	LessonsCount                    int16
+	WatchTimeSeconds                int32
+	ReadTimeSeconds                 int32
+	IsPublished                     bool
+	LanguageSlug                    string
+	AuthorID                        int32
+	CreatedAt                       pgtype.Timestamp
+	UpdatedAt                       pgtype.Timestamp
+	ReviewsCount                    int32
+	RatingTotal                     int32
+	PublishAt                       pgtype.Timestamp
+	UnpublishAt                     pgtype.Timestamp
+	EditorialStatus                 string
+	Price                           int32
+	Currency                        string
+	IsMembersOnly                   bool
+	AuthorFirstName                 string
+	AuthorLastName                  string
+	SeriesProgressID                int32
+	SeriesProgressCompletedSections int16
+	SeriesProgressCompletedLessons  int16
+	SeriesProgressViewedAt          pgtype.Timestamp
+	SeriesProgressCompletedAt       pgtype.Timestamp
+	PictureID                       pgtype.UUID
+	PictureExt                      pgtype.Text
+}
+
+func (q *Queries) FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursor(ctx context.Context, arg FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorParams) ([]FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorRow, error) {
+	rows, err := q.db.Query(ctx, findPublishedSeriesWithAuthorAndInnerProgressBeforeCursor,
+		arg.UserID,
+		arg.LanguageSlug,
+		arg.ViewedAt,
+		arg.ID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorRow{}
+	for rows.Next() {
+		var i FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+			&i.SeriesProgressID,
+			&i.SeriesProgressCompletedSections,
+			&i.SeriesProgressCompletedLessons,
+			&i.SeriesProgressViewedAt,
+			&i.SeriesProgressCompletedAt,
+			&i.PictureID,
+			&i.PictureExt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const findSeriesById = `-- name: FindSeriesById :one
-SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at FROM "series"
+SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only FROM "series"
 WHERE "id" = $1 LIMIT 1
 `
 
@@ -2158,12 +3506,20 @@ func (q *Queries) FindSeriesById(ctx context.Context, id int32) (Series, error)
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
 	)
 	return i, err
 }
 
 const findSeriesBySlugAndLanguageSlug = `-- name: FindSeriesBySlugAndLanguageSlug :one
-SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at FROM "series"
+SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only FROM "series"
 WHERE "slug" = $1 AND "language_slug" = $2
 LIMIT 1
 `
@@ -2190,13 +3546,21 @@ func (q *Queries) FindSeriesBySlugAndLanguageSlug(ctx context.Context, arg FindS
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
 	)
 	return i, err
 }
 
 const findSeriesBySlugWithAuthor = `-- name: FindSeriesBySlugWithAuthor :one
 SELECT
-  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at,
+  series.id, series.title, series.slug, series.description, series.sections_count, series.lessons_count, series.watch_time_seconds, series.read_time_seconds, series.is_published, series.language_slug, series.author_id, series.created_at, series.updated_at, series.reviews_count, series.rating_total, series.publish_at, series.unpublish_at, series.editorial_status, series.price, series.currency, series.is_members_only,
   "users"."first_name" AS "author_first_name",
   "users"."last_name" AS "author_last_name",
   "series_pictures"."id" AS "picture_id",
@@ -2229,6 +3593,14 @@ type FindSeriesBySlugWithAuthorRow struct {
 	AuthorID         int32
 	CreatedAt        pgtype.Timestamp
 	UpdatedAt        pgtype.Timestamp
+	ReviewsCount     int32
+	RatingTotal      int32
+	PublishAt        pgtype.Timestamp
+	UnpublishAt      pgtype.Timestamp
+	EditorialStatus  string
+	Price            int32
+	Currency         string
+	IsMembersOnly    bool
 	AuthorFirstName  string
 	AuthorLastName   string
 	PictureID        pgtype.UUID
@@ -2252,6 +3624,14 @@ func (q *Queries) FindSeriesBySlugWithAuthor(ctx context.Context, arg FindSeries
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
 		&i.AuthorFirstName,
 		&i.AuthorLastName,
 		&i.PictureID,
@@ -2260,6 +3640,55 @@ func (q *Queries) FindSeriesBySlugWithAuthor(ctx context.Context, arg FindSeries
 	return i, err
 }
 
+const findSeriesDueForScheduling = `-- name: FindSeriesDueForScheduling :many
+SELECT id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only FROM "series"
+WHERE "publish_at" <= now() OR "unpublish_at" <= now()
+ORDER BY "id" ASC
+LIMIT $1
+`
+
+func (q *Queries) FindSeriesDueForScheduling(ctx context.Context, limit int32) ([]Series, error) {
+	rows, err := q.db.Query(ctx, findSeriesDueForScheduling, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Series{}
+	for rows.Next() {
+		var i Series
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Slug,
+			&i.Description,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.IsPublished,
+			&i.LanguageSlug,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ReviewsCount,
+			&i.RatingTotal,
+			&i.PublishAt,
+			&i.UnpublishAt,
+			&i.EditorialStatus,
+			&i.Price,
+			&i.Currency,
+			&i.IsMembersOnly,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const incrementSeriesLessonsCount = `-- name: IncrementSeriesLessonsCount :exec
 UPDATE "series" SET
   "lessons_count" = "lessons_count" + 1,
@@ -2280,6 +3709,81 @@ func (q *Queries) IncrementSeriesLessonsCount(ctx context.Context, arg Increment
 	return err
 }
 
+const reassignSeriesAuthor = `-- name: ReassignSeriesAuthor :exec
+UPDATE "series" SET
+  "author_id" = $1
+WHERE "author_id" = $2
+`
+
+type ReassignSeriesAuthorParams struct {
+	NewAuthorID int32
+	OldAuthorID int32
+}
+
+func (q *Queries) ReassignSeriesAuthor(ctx context.Context, arg ReassignSeriesAuthorParams) error {
+	_, err := q.db.Exec(ctx, reassignSeriesAuthor, arg.NewAuthorID, arg.OldAuthorID)
+	return err
+}
+
+const removeSeriesReview = `-- name: RemoveSeriesReview :exec
+UPDATE "series" SET
+  "reviews_count" = "reviews_count" - 1,
+  "rating_total" = "rating_total" - $2
+WHERE "slug" = $1
+`
+
+type RemoveSeriesReviewParams struct {
+	Slug        string
+	RatingTotal int32
+}
+
+func (q *Queries) RemoveSeriesReview(ctx context.Context, arg RemoveSeriesReviewParams) error {
+	_, err := q.db.Exec(ctx, removeSeriesReview, arg.Slug, arg.RatingTotal)
+	return err
+}
+
+const toggleSeriesIsPublished = `-- name: ToggleSeriesIsPublished :one
+UPDATE "series" SET
+  "is_published" = NOT "is_published",
+  "updated_at" = now()
+WHERE "id" = $1 AND "is_published" = $2
+RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
+`
+
+type ToggleSeriesIsPublishedParams struct {
+	ID          int32
+	IsPublished bool
+}
+
+func (q *Queries) ToggleSeriesIsPublished(ctx context.Context, arg ToggleSeriesIsPublishedParams) (Series, error) {
+	row := q.db.QueryRow(ctx, toggleSeriesIsPublished, arg.ID, arg.IsPublished)
+	var i Series
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.LanguageSlug,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
+	)
+	return i, err
+}
+
 const updateSeries = `-- name: UpdateSeries :one
 UPDATE "series" SET
   "title" = $1,
@@ -2287,7 +3791,7 @@ UPDATE "series" SET
   "description" = $3,
   "updated_at" = now()
 WHERE "id" = $4
-RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at
+RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
 `
 
 type UpdateSeriesParams struct {
@@ -2319,6 +3823,98 @@ func (q *Queries) UpdateSeries(ctx context.Context, arg UpdateSeriesParams) (Ser
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
+	)
+	return i, err
+}
+
+const updateSeriesEditorialStatus = `-- name: UpdateSeriesEditorialStatus :one
+UPDATE "series" SET
+  "editorial_status" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
+`
+
+type UpdateSeriesEditorialStatusParams struct {
+	EditorialStatus string
+	ID              int32
+}
+
+func (q *Queries) UpdateSeriesEditorialStatus(ctx context.Context, arg UpdateSeriesEditorialStatusParams) (Series, error) {
+	row := q.db.QueryRow(ctx, updateSeriesEditorialStatus, arg.EditorialStatus, arg.ID)
+	var i Series
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.LanguageSlug,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
+	)
+	return i, err
+}
+
+const updateSeriesIsMembersOnly = `-- name: UpdateSeriesIsMembersOnly :one
+UPDATE "series" SET
+  "is_members_only" = $1,
+  "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
+`
+
+type UpdateSeriesIsMembersOnlyParams struct {
+	IsMembersOnly bool
+	ID            int32
+}
+
+func (q *Queries) UpdateSeriesIsMembersOnly(ctx context.Context, arg UpdateSeriesIsMembersOnlyParams) (Series, error) {
+	row := q.db.QueryRow(ctx, updateSeriesIsMembersOnly, arg.IsMembersOnly, arg.ID)
+	var i Series
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.LanguageSlug,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
 	)
 	return i, err
 }
@@ -2328,7 +3924,7 @@ UPDATE "series" SET
   "is_published" = $1,
   "updated_at" = now()
 WHERE "id" = $2
-RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at
+RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
 `
 
 type UpdateSeriesIsPublishedParams struct {
@@ -2353,6 +3949,102 @@ func (q *Queries) UpdateSeriesIsPublished(ctx context.Context, arg UpdateSeriesI
 		&i.AuthorID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
+	)
+	return i, err
+}
+
+const updateSeriesPrice = `-- name: UpdateSeriesPrice :one
+UPDATE "series" SET
+  "price" = $1,
+  "currency" = $2,
+  "updated_at" = now()
+WHERE "id" = $3
+RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
+`
+
+type UpdateSeriesPriceParams struct {
+	Price    int32
+	Currency string
+	ID       int32
+}
+
+func (q *Queries) UpdateSeriesPrice(ctx context.Context, arg UpdateSeriesPriceParams) (Series, error) {
+	row := q.db.QueryRow(ctx, updateSeriesPrice, arg.Price, arg.Currency, arg.ID)
+	var i Series
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.LanguageSlug,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
+	)
+	return i, err
+}
+
+const updateSeriesSchedule = `-- name: UpdateSeriesSchedule :one
+UPDATE "series" SET
+  "publish_at" = $1,
+  "unpublish_at" = $2,
+  "updated_at" = now()
+WHERE "id" = $3
+RETURNING id, title, slug, description, sections_count, lessons_count, watch_time_seconds, read_time_seconds, is_published, language_slug, author_id, created_at, updated_at, reviews_count, rating_total, publish_at, unpublish_at, editorial_status, price, currency, is_members_only
+`
+
+type UpdateSeriesScheduleParams struct {
+	PublishAt   pgtype.Timestamp
+	UnpublishAt pgtype.Timestamp
+	ID          int32
+}
+
+func (q *Queries) UpdateSeriesSchedule(ctx context.Context, arg UpdateSeriesScheduleParams) (Series, error) {
+	row := q.db.QueryRow(ctx, updateSeriesSchedule, arg.PublishAt, arg.UnpublishAt, arg.ID)
+	var i Series
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Slug,
+		&i.Description,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.IsPublished,
+		&i.LanguageSlug,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReviewsCount,
+		&i.RatingTotal,
+		&i.PublishAt,
+		&i.UnpublishAt,
+		&i.EditorialStatus,
+		&i.Price,
+		&i.Currency,
+		&i.IsMembersOnly,
 	)
 	return i, err
 }
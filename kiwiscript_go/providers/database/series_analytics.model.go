@@ -0,0 +1,100 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+type LessonFunnelStatsModel struct {
+	ID                int32
+	SectionID         int32
+	Title             string
+	Position          int16
+	Learners          int32
+	Completions       int32
+	Opens             int32
+	EngagedLearners   int32
+	TimeOnTaskSeconds int32
+}
+
+func (r *FindLessonFunnelStatsBySlugsRow) ToLessonFunnelStatsModel() LessonFunnelStatsModel {
+	return LessonFunnelStatsModel(*r)
+}
+
+// DropOffs counts the learners that opened the lesson without completing it.
+func (l *LessonFunnelStatsModel) DropOffs() int32 {
+	return max(l.Learners-l.Completions, 0)
+}
+
+func (l *LessonFunnelStatsModel) DropOffRate() float32 {
+	if l.Learners == 0 {
+		return 0
+	}
+	return float32(l.DropOffs()) / float32(l.Learners)
+}
+
+// AverageTimeOnTask is the mean time, in seconds, learners with tracked
+// engagement spent on the lesson.
+func (l *LessonFunnelStatsModel) AverageTimeOnTask() int32 {
+	if l.EngagedLearners == 0 {
+		return 0
+	}
+	return l.TimeOnTaskSeconds / l.EngagedLearners
+}
+
+type SectionFunnelStatsModel struct {
+	ID          int32
+	Title       string
+	Position    int16
+	Learners    int32
+	Completions int32
+	Lessons     []LessonFunnelStatsModel
+}
+
+type SeriesAnalyticsModel struct {
+	From                    time.Time
+	To                      time.Time
+	Learners                int32
+	Enrollments             int32
+	Completions             int32
+	Certificates            int32
+	MedianCompletionSeconds int32
+	TopViewedLessons        []LessonFunnelStatsModel
+	DropOffLessons          []LessonFunnelStatsModel
+	RefreshedAt             time.Time
+}
+
+type SeriesAnalyticsBucketModel struct {
+	Start        time.Time
+	Learners     int32
+	Enrollments  int32
+	Completions  int32
+	Certificates int32
+}
+
+type SeriesAnalyticsTimelineModel struct {
+	From     time.Time
+	To       time.Time
+	Interval string
+	Buckets  []SeriesAnalyticsBucketModel
+}
+
+type SeriesAnalyticsFunnelModel struct {
+	From     time.Time
+	To       time.Time
+	Sections []SectionFunnelStatsModel
+}
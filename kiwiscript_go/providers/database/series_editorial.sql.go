@@ -0,0 +1,261 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: series_editorial.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countSeriesEditorialReviewersBySeriesSlug = `-- name: CountSeriesEditorialReviewersBySeriesSlug :one
+SELECT COUNT("id") FROM "series_editorial_reviewers"
+WHERE "series_slug" = $1
+`
+
+func (q *Queries) CountSeriesEditorialReviewersBySeriesSlug(ctx context.Context, seriesSlug string) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeriesEditorialReviewersBySeriesSlug, seriesSlug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createSeriesChangeRequest = `-- name: CreateSeriesChangeRequest :one
+INSERT INTO "series_change_requests" (
+  "comment",
+  "language_slug",
+  "series_slug",
+  "reviewer_id"
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4
+) RETURNING id, comment, language_slug, series_slug, reviewer_id, resolved_at, created_at
+`
+
+type CreateSeriesChangeRequestParams struct {
+	Comment      string
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewerID   int32
+}
+
+func (q *Queries) CreateSeriesChangeRequest(ctx context.Context, arg CreateSeriesChangeRequestParams) (SeriesChangeRequest, error) {
+	row := q.db.QueryRow(ctx, createSeriesChangeRequest,
+		arg.Comment,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.ReviewerID,
+	)
+	var i SeriesChangeRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Comment,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.ReviewerID,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createSeriesEditorialReviewer = `-- name: CreateSeriesEditorialReviewer :one
+
+
+INSERT INTO "series_editorial_reviewers" (
+  "language_slug",
+  "series_slug",
+  "user_id"
+) VALUES (
+  $1,
+  $2,
+  $3
+) RETURNING id, language_slug, series_slug, user_id, created_at
+`
+
+type CreateSeriesEditorialReviewerParams struct {
+	LanguageSlug string
+	SeriesSlug   string
+	UserID       int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateSeriesEditorialReviewer(ctx context.Context, arg CreateSeriesEditorialReviewerParams) (SeriesEditorialReviewer, error) {
+	row := q.db.QueryRow(ctx, createSeriesEditorialReviewer, arg.LanguageSlug, arg.SeriesSlug, arg.UserID)
+	var i SeriesEditorialReviewer
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteSeriesEditorialReviewerByID = `-- name: DeleteSeriesEditorialReviewerByID :exec
+DELETE FROM "series_editorial_reviewers"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteSeriesEditorialReviewerByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteSeriesEditorialReviewerByID, id)
+	return err
+}
+
+const findSeriesChangeRequestsWithReviewerBySeriesSlug = `-- name: FindSeriesChangeRequestsWithReviewerBySeriesSlug :many
+SELECT
+  series_change_requests.id, series_change_requests.comment, series_change_requests.language_slug, series_change_requests.series_slug, series_change_requests.reviewer_id, series_change_requests.resolved_at, series_change_requests.created_at,
+  "users"."first_name" AS "reviewer_first_name",
+  "users"."last_name" AS "reviewer_last_name"
+FROM "series_change_requests"
+INNER JOIN "users" ON "series_change_requests"."reviewer_id" = "users"."id"
+WHERE "series_change_requests"."series_slug" = $1
+ORDER BY "series_change_requests"."id" DESC
+`
+
+type FindSeriesChangeRequestsWithReviewerBySeriesSlugRow struct {
+	ID                int32
+	Comment           string
+	LanguageSlug      string
+	SeriesSlug        string
+	ReviewerID        int32
+	ResolvedAt        pgtype.Timestamp
+	CreatedAt         pgtype.Timestamp
+	ReviewerFirstName string
+	ReviewerLastName  string
+}
+
+func (q *Queries) FindSeriesChangeRequestsWithReviewerBySeriesSlug(ctx context.Context, seriesSlug string) ([]FindSeriesChangeRequestsWithReviewerBySeriesSlugRow, error) {
+	rows, err := q.db.Query(ctx, findSeriesChangeRequestsWithReviewerBySeriesSlug, seriesSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindSeriesChangeRequestsWithReviewerBySeriesSlugRow{}
+	for rows.Next() {
+		var i FindSeriesChangeRequestsWithReviewerBySeriesSlugRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Comment,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.ReviewerID,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+			&i.ReviewerFirstName,
+			&i.ReviewerLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSeriesEditorialReviewerBySeriesSlugAndUserID = `-- name: FindSeriesEditorialReviewerBySeriesSlugAndUserID :one
+SELECT id, language_slug, series_slug, user_id, created_at FROM "series_editorial_reviewers"
+WHERE "series_slug" = $1 AND "user_id" = $2
+LIMIT 1
+`
+
+type FindSeriesEditorialReviewerBySeriesSlugAndUserIDParams struct {
+	SeriesSlug string
+	UserID     int32
+}
+
+func (q *Queries) FindSeriesEditorialReviewerBySeriesSlugAndUserID(ctx context.Context, arg FindSeriesEditorialReviewerBySeriesSlugAndUserIDParams) (SeriesEditorialReviewer, error) {
+	row := q.db.QueryRow(ctx, findSeriesEditorialReviewerBySeriesSlugAndUserID, arg.SeriesSlug, arg.UserID)
+	var i SeriesEditorialReviewer
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findSeriesEditorialReviewersWithUserBySeriesSlug = `-- name: FindSeriesEditorialReviewersWithUserBySeriesSlug :many
+SELECT
+  series_editorial_reviewers.id, series_editorial_reviewers.language_slug, series_editorial_reviewers.series_slug, series_editorial_reviewers.user_id, series_editorial_reviewers.created_at,
+  "users"."first_name" AS "user_first_name",
+  "users"."last_name" AS "user_last_name"
+FROM "series_editorial_reviewers"
+INNER JOIN "users" ON "series_editorial_reviewers"."user_id" = "users"."id"
+WHERE "series_editorial_reviewers"."series_slug" = $1
+ORDER BY "series_editorial_reviewers"."id" ASC
+`
+
+type FindSeriesEditorialReviewersWithUserBySeriesSlugRow struct {
+	ID            int32
+	LanguageSlug  string
+	SeriesSlug    string
+	UserID        int32
+	CreatedAt     pgtype.Timestamp
+	UserFirstName string
+	UserLastName  string
+}
+
+func (q *Queries) FindSeriesEditorialReviewersWithUserBySeriesSlug(ctx context.Context, seriesSlug string) ([]FindSeriesEditorialReviewersWithUserBySeriesSlugRow, error) {
+	rows, err := q.db.Query(ctx, findSeriesEditorialReviewersWithUserBySeriesSlug, seriesSlug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindSeriesEditorialReviewersWithUserBySeriesSlugRow{}
+	for rows.Next() {
+		var i FindSeriesEditorialReviewersWithUserBySeriesSlugRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UserFirstName,
+			&i.UserLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const resolveSeriesChangeRequests = `-- name: ResolveSeriesChangeRequests :exec
+UPDATE "series_change_requests" SET
+  "resolved_at" = now()
+WHERE "series_slug" = $1 AND "resolved_at" IS NULL
+`
+
+func (q *Queries) ResolveSeriesChangeRequests(ctx context.Context, seriesSlug string) error {
+	_, err := q.db.Exec(ctx, resolveSeriesChangeRequests, seriesSlug)
+	return err
+}
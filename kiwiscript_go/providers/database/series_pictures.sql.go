@@ -78,6 +78,16 @@ func (q *Queries) DeleteSeriesPicture(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteSeriesPicturesByAuthorID = `-- name: DeleteSeriesPicturesByAuthorID :exec
+DELETE FROM "series_pictures"
+WHERE "author_id" = $1
+`
+
+func (q *Queries) DeleteSeriesPicturesByAuthorID(ctx context.Context, authorID int32) error {
+	_, err := q.db.Exec(ctx, deleteSeriesPicturesByAuthorID, authorID)
+	return err
+}
+
 const findSeriesPictureBySeriesID = `-- name: FindSeriesPictureBySeriesID :one
 SELECT id, series_id, author_id, ext, created_at, updated_at FROM "series_pictures"
 WHERE "series_id" = $1
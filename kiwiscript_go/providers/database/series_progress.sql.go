@@ -7,6 +7,8 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const countSeriesProgressBySeriesSlug = `-- name: CountSeriesProgressBySeriesSlug :one
@@ -28,13 +30,15 @@ INSERT INTO "series_progress" (
   "language_slug",
   "series_slug",
   "language_progress_id",
-  "user_id"
+  "user_id",
+  "series_version_id"
 ) VALUES (
   $1,
   $2,
   $3,
-  $4
-) RETURNING id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at
+  $4,
+  $5
+) RETURNING id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at, series_version_id
 `
 
 type CreateSeriesProgressParams struct {
@@ -42,6 +46,7 @@ type CreateSeriesProgressParams struct {
 	SeriesSlug         string
 	LanguageProgressID int32
 	UserID             int32
+	SeriesVersionID    pgtype.Int4
 }
 
 // Copyright (C) 2024 Afonso Barracha
@@ -66,6 +71,7 @@ func (q *Queries) CreateSeriesProgress(ctx context.Context, arg CreateSeriesProg
 		arg.SeriesSlug,
 		arg.LanguageProgressID,
 		arg.UserID,
+		arg.SeriesVersionID,
 	)
 	var i SeriesProgress
 	err := row.Scan(
@@ -81,6 +87,7 @@ func (q *Queries) CreateSeriesProgress(ctx context.Context, arg CreateSeriesProg
 		&i.ViewedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesVersionID,
 	)
 	return i, err
 }
@@ -124,8 +131,75 @@ func (q *Queries) DeleteSeriesProgress(ctx context.Context, id int32) error {
 	return err
 }
 
+const findAllSeriesProgressByUserID = `-- name: FindAllSeriesProgressByUserID :many
+SELECT id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at, series_version_id FROM "series_progress"
+WHERE "user_id" = $1
+ORDER BY "id" ASC
+`
+
+func (q *Queries) FindAllSeriesProgressByUserID(ctx context.Context, userID int32) ([]SeriesProgress, error) {
+	rows, err := q.db.Query(ctx, findAllSeriesProgressByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeriesProgress{}
+	for rows.Next() {
+		var i SeriesProgress
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.SeriesSlug,
+			&i.LanguageSlug,
+			&i.LanguageProgressID,
+			&i.CompletedSections,
+			&i.CompletedLessons,
+			&i.PartsCount,
+			&i.CompletedAt,
+			&i.ViewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SeriesVersionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSeriesProgressByID = `-- name: FindSeriesProgressByID :one
+SELECT id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at, series_version_id FROM "series_progress"
+WHERE "id" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindSeriesProgressByID(ctx context.Context, id int32) (SeriesProgress, error) {
+	row := q.db.QueryRow(ctx, findSeriesProgressByID, id)
+	var i SeriesProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.SeriesSlug,
+		&i.LanguageSlug,
+		&i.LanguageProgressID,
+		&i.CompletedSections,
+		&i.CompletedLessons,
+		&i.PartsCount,
+		&i.CompletedAt,
+		&i.ViewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SeriesVersionID,
+	)
+	return i, err
+}
+
 const findSeriesProgressByLanguageProgressID = `-- name: FindSeriesProgressByLanguageProgressID :many
-SELECT id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at FROM "series_progress"
+SELECT id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at, series_version_id FROM "series_progress"
 WHERE "language_progress_id" = $1
 ORDER BY "viewed_at" DESC
 `
@@ -152,6 +226,7 @@ func (q *Queries) FindSeriesProgressByLanguageProgressID(ctx context.Context, la
 			&i.ViewedAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SeriesVersionID,
 		); err != nil {
 			return nil, err
 		}
@@ -164,7 +239,7 @@ func (q *Queries) FindSeriesProgressByLanguageProgressID(ctx context.Context, la
 }
 
 const findSeriesProgressBySlugAndUserID = `-- name: FindSeriesProgressBySlugAndUserID :one
-SELECT id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at FROM "series_progress"
+SELECT id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at, series_version_id FROM "series_progress"
 WHERE
     "language_slug" = $1 AND
     "series_slug" = $2 AND
@@ -194,6 +269,7 @@ func (q *Queries) FindSeriesProgressBySlugAndUserID(ctx context.Context, arg Fin
 		&i.ViewedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesVersionID,
 	)
 	return i, err
 }
@@ -222,7 +298,7 @@ UPDATE "series_progress" SET
     END
 FROM "series"
 WHERE "series_progress"."id" = $1
-RETURNING series_progress.id, series_progress.user_id, series_progress.series_slug, series_progress.language_slug, series_progress.language_progress_id, series_progress.completed_sections, series_progress.completed_lessons, series_progress.parts_count, series_progress.completed_at, series_progress.viewed_at, series_progress.created_at, series_progress.updated_at
+RETURNING series_progress.id, series_progress.user_id, series_progress.series_slug, series_progress.language_slug, series_progress.language_progress_id, series_progress.completed_sections, series_progress.completed_lessons, series_progress.parts_count, series_progress.completed_at, series_progress.viewed_at, series_progress.created_at, series_progress.updated_at, series_progress.series_version_id
 `
 
 func (q *Queries) IncrementSeriesProgressCompletedSections(ctx context.Context, id int32) (SeriesProgress, error) {
@@ -241,6 +317,48 @@ func (q *Queries) IncrementSeriesProgressCompletedSections(ctx context.Context,
 		&i.ViewedAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SeriesVersionID,
+	)
+	return i, err
+}
+
+const incrementSeriesProgressCompletedSectionsInSeriesVersion = `-- name: IncrementSeriesProgressCompletedSectionsInSeriesVersion :one
+UPDATE "series_progress" SET
+    "completed_sections" = "completed_sections" + 1,
+    "completed_lessons" = "completed_lessons" + 1,
+    "completed_at" = CASE
+        WHEN (
+            "completed_sections" + 1 >= $1::smallint AND
+            "completed_at" IS NULL
+        ) THEN (NOW())
+        ELSE "completed_at"
+    END
+WHERE "id" = $2
+RETURNING id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at, series_version_id
+`
+
+type IncrementSeriesProgressCompletedSectionsInSeriesVersionParams struct {
+	SectionsCount int16
+	ID            int32
+}
+
+func (q *Queries) IncrementSeriesProgressCompletedSectionsInSeriesVersion(ctx context.Context, arg IncrementSeriesProgressCompletedSectionsInSeriesVersionParams) (SeriesProgress, error) {
+	row := q.db.QueryRow(ctx, incrementSeriesProgressCompletedSectionsInSeriesVersion, arg.SectionsCount, arg.ID)
+	var i SeriesProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.SeriesSlug,
+		&i.LanguageSlug,
+		&i.LanguageProgressID,
+		&i.CompletedSections,
+		&i.CompletedLessons,
+		&i.PartsCount,
+		&i.CompletedAt,
+		&i.ViewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SeriesVersionID,
 	)
 	return i, err
 }
@@ -261,6 +379,64 @@ func (q *Queries) RemoveSeriesProgressCompletedLessons(ctx context.Context, arg
 	return err
 }
 
+const updateSeriesProgressSeriesVersion = `-- name: UpdateSeriesProgressSeriesVersion :one
+UPDATE "series_progress" SET
+    "series_version_id" = $1,
+    "completed_lessons" = (
+        SELECT COALESCE(SUM("section_progress"."completed_lessons"), 0)::smallint
+        FROM "section_progress"
+        WHERE "section_progress"."series_progress_id" = "series_progress"."id"
+    ),
+    "completed_sections" = (
+        SELECT COUNT("section_progress"."id")::smallint
+        FROM "section_progress"
+        WHERE
+            "section_progress"."series_progress_id" = "series_progress"."id" AND
+            "section_progress"."completed_at" IS NOT NULL
+    ),
+    "completed_at" = CASE
+        WHEN (
+            SELECT COUNT("section_progress"."id")
+            FROM "section_progress"
+            WHERE
+                "section_progress"."series_progress_id" = "series_progress"."id" AND
+                "section_progress"."completed_at" IS NOT NULL
+        ) >= $2::smallint
+            THEN COALESCE("series_progress"."completed_at", NOW())
+        ELSE NULL
+    END,
+    "updated_at" = NOW()
+WHERE "series_progress"."id" = $3
+RETURNING id, user_id, series_slug, language_slug, language_progress_id, completed_sections, completed_lessons, parts_count, completed_at, viewed_at, created_at, updated_at, series_version_id
+`
+
+type UpdateSeriesProgressSeriesVersionParams struct {
+	SeriesVersionID pgtype.Int4
+	SectionsCount   int16
+	ID              int32
+}
+
+func (q *Queries) UpdateSeriesProgressSeriesVersion(ctx context.Context, arg UpdateSeriesProgressSeriesVersionParams) (SeriesProgress, error) {
+	row := q.db.QueryRow(ctx, updateSeriesProgressSeriesVersion, arg.SeriesVersionID, arg.SectionsCount, arg.ID)
+	var i SeriesProgress
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.SeriesSlug,
+		&i.LanguageSlug,
+		&i.LanguageProgressID,
+		&i.CompletedSections,
+		&i.CompletedLessons,
+		&i.PartsCount,
+		&i.CompletedAt,
+		&i.ViewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SeriesVersionID,
+	)
+	return i, err
+}
+
 const updateSeriesProgressViewedAt = `-- name: UpdateSeriesProgressViewedAt :exec
 UPDATE "series_progress" SET
   "viewed_at" = NOW()
@@ -0,0 +1,137 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type SeriesReviewModel struct {
+	ID            int32
+	Rating        int16
+	Review        string
+	Reply         string
+	ReplyAuthorID int32
+	RepliedAt     string
+	IsHidden      bool
+	LanguageSlug  string
+	SeriesSlug    string
+	Author        SeriesAuthor
+	CreatedAt     string
+	UpdatedAt     string
+}
+
+func newSeriesReviewModel(
+	id int32,
+	rating int16,
+	review,
+	reply string,
+	replyAuthorID pgtype.Int4,
+	repliedAt pgtype.Timestamp,
+	isHidden bool,
+	languageSlug,
+	seriesSlug string,
+	author SeriesAuthor,
+	createdAt,
+	updatedAt pgtype.Timestamp,
+) *SeriesReviewModel {
+	var repliedAtStr string
+	if repliedAt.Valid {
+		repliedAtStr = repliedAt.Time.Format(time.RFC3339)
+	}
+
+	return &SeriesReviewModel{
+		ID:            id,
+		Rating:        rating,
+		Review:        review,
+		Reply:         reply,
+		ReplyAuthorID: replyAuthorID.Int32,
+		RepliedAt:     repliedAtStr,
+		IsHidden:      isHidden,
+		LanguageSlug:  languageSlug,
+		SeriesSlug:    seriesSlug,
+		Author:        author,
+		CreatedAt:     createdAt.Time.Format(time.RFC3339),
+		UpdatedAt:     updatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (r *FindSeriesReviewByIDWithAuthorRow) ToSeriesReviewModel() *SeriesReviewModel {
+	return newSeriesReviewModel(
+		r.ID,
+		r.Rating,
+		r.Review,
+		r.Reply,
+		r.ReplyAuthorID,
+		r.RepliedAt,
+		r.IsHidden,
+		r.LanguageSlug,
+		r.SeriesSlug,
+		SeriesAuthor{
+			ID:        r.UserID,
+			FirstName: r.AuthorFirstName,
+			LastName:  r.AuthorLastName,
+		},
+		r.CreatedAt,
+		r.UpdatedAt,
+	)
+}
+
+func (r *FindPaginatedSeriesReviewsWithAuthorRow) ToSeriesReviewModel() *SeriesReviewModel {
+	return newSeriesReviewModel(
+		r.ID,
+		r.Rating,
+		r.Review,
+		r.Reply,
+		r.ReplyAuthorID,
+		r.RepliedAt,
+		r.IsHidden,
+		r.LanguageSlug,
+		r.SeriesSlug,
+		SeriesAuthor{
+			ID:        r.UserID,
+			FirstName: r.AuthorFirstName,
+			LastName:  r.AuthorLastName,
+		},
+		r.CreatedAt,
+		r.UpdatedAt,
+	)
+}
+
+func (r *FindAllPaginatedSeriesReviewsWithAuthorRow) ToSeriesReviewModel() *SeriesReviewModel {
+	return newSeriesReviewModel(
+		r.ID,
+		r.Rating,
+		r.Review,
+		r.Reply,
+		r.ReplyAuthorID,
+		r.RepliedAt,
+		r.IsHidden,
+		r.LanguageSlug,
+		r.SeriesSlug,
+		SeriesAuthor{
+			ID:        r.UserID,
+			FirstName: r.AuthorFirstName,
+			LastName:  r.AuthorLastName,
+		},
+		r.CreatedAt,
+		r.UpdatedAt,
+	)
+}
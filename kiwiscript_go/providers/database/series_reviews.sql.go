@@ -0,0 +1,500 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: series_reviews.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countAllSeriesReviewsBySeriesSlug = `-- name: CountAllSeriesReviewsBySeriesSlug :one
+SELECT COUNT("id") FROM "series_reviews"
+WHERE "series_slug" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountAllSeriesReviewsBySeriesSlug(ctx context.Context, seriesSlug string) (int64, error) {
+	row := q.db.QueryRow(ctx, countAllSeriesReviewsBySeriesSlug, seriesSlug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSeriesReviewsBySeriesSlug = `-- name: CountSeriesReviewsBySeriesSlug :one
+SELECT COUNT("id") FROM "series_reviews"
+WHERE "series_slug" = $1 AND "is_hidden" = false
+LIMIT 1
+`
+
+func (q *Queries) CountSeriesReviewsBySeriesSlug(ctx context.Context, seriesSlug string) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeriesReviewsBySeriesSlug, seriesSlug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createSeriesReview = `-- name: CreateSeriesReview :one
+
+INSERT INTO "series_reviews" (
+    "rating",
+    "review",
+    "language_slug",
+    "series_slug",
+    "user_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING id, rating, review, reply, reply_author_id, replied_at, is_hidden, language_slug, series_slug, user_id, created_at, updated_at
+`
+
+type CreateSeriesReviewParams struct {
+	Rating       int16
+	Review       string
+	LanguageSlug string
+	SeriesSlug   string
+	UserID       int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateSeriesReview(ctx context.Context, arg CreateSeriesReviewParams) (SeriesReview, error) {
+	row := q.db.QueryRow(ctx, createSeriesReview,
+		arg.Rating,
+		arg.Review,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+		arg.UserID,
+	)
+	var i SeriesReview
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteSeriesReviewByID = `-- name: DeleteSeriesReviewByID :exec
+DELETE FROM "series_reviews"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteSeriesReviewByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteSeriesReviewByID, id)
+	return err
+}
+
+const findAllPaginatedSeriesReviewsWithAuthor = `-- name: FindAllPaginatedSeriesReviewsWithAuthor :many
+SELECT
+    series_reviews.id, series_reviews.rating, series_reviews.review, series_reviews.reply, series_reviews.reply_author_id, series_reviews.replied_at, series_reviews.is_hidden, series_reviews.language_slug, series_reviews.series_slug, series_reviews.user_id, series_reviews.created_at, series_reviews.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "series_reviews"
+INNER JOIN "users" ON "series_reviews"."user_id" = "users"."id"
+WHERE "series_reviews"."series_slug" = $1
+ORDER BY "series_reviews"."created_at" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindAllPaginatedSeriesReviewsWithAuthorParams struct {
+	SeriesSlug string
+	Limit      int32
+	Offset     int32
+}
+
+type FindAllPaginatedSeriesReviewsWithAuthorRow struct {
+	ID              int32
+	Rating          int16
+	Review          string
+	Reply           string
+	ReplyAuthorID   pgtype.Int4
+	RepliedAt       pgtype.Timestamp
+	IsHidden        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	UserID          int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindAllPaginatedSeriesReviewsWithAuthor(ctx context.Context, arg FindAllPaginatedSeriesReviewsWithAuthorParams) ([]FindAllPaginatedSeriesReviewsWithAuthorRow, error) {
+	rows, err := q.db.Query(ctx, findAllPaginatedSeriesReviewsWithAuthor, arg.SeriesSlug, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindAllPaginatedSeriesReviewsWithAuthorRow{}
+	for rows.Next() {
+		var i FindAllPaginatedSeriesReviewsWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Rating,
+			&i.Review,
+			&i.Reply,
+			&i.ReplyAuthorID,
+			&i.RepliedAt,
+			&i.IsHidden,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedSeriesReviewsWithAuthor = `-- name: FindPaginatedSeriesReviewsWithAuthor :many
+SELECT
+    series_reviews.id, series_reviews.rating, series_reviews.review, series_reviews.reply, series_reviews.reply_author_id, series_reviews.replied_at, series_reviews.is_hidden, series_reviews.language_slug, series_reviews.series_slug, series_reviews.user_id, series_reviews.created_at, series_reviews.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "series_reviews"
+INNER JOIN "users" ON "series_reviews"."user_id" = "users"."id"
+WHERE
+    "series_reviews"."series_slug" = $1 AND
+    "series_reviews"."is_hidden" = false
+ORDER BY "series_reviews"."created_at" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedSeriesReviewsWithAuthorParams struct {
+	SeriesSlug string
+	Limit      int32
+	Offset     int32
+}
+
+type FindPaginatedSeriesReviewsWithAuthorRow struct {
+	ID              int32
+	Rating          int16
+	Review          string
+	Reply           string
+	ReplyAuthorID   pgtype.Int4
+	RepliedAt       pgtype.Timestamp
+	IsHidden        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	UserID          int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindPaginatedSeriesReviewsWithAuthor(ctx context.Context, arg FindPaginatedSeriesReviewsWithAuthorParams) ([]FindPaginatedSeriesReviewsWithAuthorRow, error) {
+	rows, err := q.db.Query(ctx, findPaginatedSeriesReviewsWithAuthor, arg.SeriesSlug, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindPaginatedSeriesReviewsWithAuthorRow{}
+	for rows.Next() {
+		var i FindPaginatedSeriesReviewsWithAuthorRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Rating,
+			&i.Review,
+			&i.Reply,
+			&i.ReplyAuthorID,
+			&i.RepliedAt,
+			&i.IsHidden,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorFirstName,
+			&i.AuthorLastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSeriesReviewByIDAndSeriesSlug = `-- name: FindSeriesReviewByIDAndSeriesSlug :one
+SELECT id, rating, review, reply, reply_author_id, replied_at, is_hidden, language_slug, series_slug, user_id, created_at, updated_at FROM "series_reviews"
+WHERE "id" = $1 AND "series_slug" = $2
+LIMIT 1
+`
+
+type FindSeriesReviewByIDAndSeriesSlugParams struct {
+	ID         int32
+	SeriesSlug string
+}
+
+func (q *Queries) FindSeriesReviewByIDAndSeriesSlug(ctx context.Context, arg FindSeriesReviewByIDAndSeriesSlugParams) (SeriesReview, error) {
+	row := q.db.QueryRow(ctx, findSeriesReviewByIDAndSeriesSlug, arg.ID, arg.SeriesSlug)
+	var i SeriesReview
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findSeriesReviewByIDForUpdate = `-- name: FindSeriesReviewByIDForUpdate :one
+SELECT id, rating, review, reply, reply_author_id, replied_at, is_hidden, language_slug, series_slug, user_id, created_at, updated_at FROM "series_reviews"
+WHERE "id" = $1
+LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) FindSeriesReviewByIDForUpdate(ctx context.Context, id int32) (SeriesReview, error) {
+	row := q.db.QueryRow(ctx, findSeriesReviewByIDForUpdate, id)
+	var i SeriesReview
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findSeriesReviewByIDWithAuthor = `-- name: FindSeriesReviewByIDWithAuthor :one
+SELECT
+    series_reviews.id, series_reviews.rating, series_reviews.review, series_reviews.reply, series_reviews.reply_author_id, series_reviews.replied_at, series_reviews.is_hidden, series_reviews.language_slug, series_reviews.series_slug, series_reviews.user_id, series_reviews.created_at, series_reviews.updated_at,
+    "users"."first_name" AS "author_first_name",
+    "users"."last_name" AS "author_last_name"
+FROM "series_reviews"
+INNER JOIN "users" ON "series_reviews"."user_id" = "users"."id"
+WHERE "series_reviews"."id" = $1
+LIMIT 1
+`
+
+type FindSeriesReviewByIDWithAuthorRow struct {
+	ID              int32
+	Rating          int16
+	Review          string
+	Reply           string
+	ReplyAuthorID   pgtype.Int4
+	RepliedAt       pgtype.Timestamp
+	IsHidden        bool
+	LanguageSlug    string
+	SeriesSlug      string
+	UserID          int32
+	CreatedAt       pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	AuthorFirstName string
+	AuthorLastName  string
+}
+
+func (q *Queries) FindSeriesReviewByIDWithAuthor(ctx context.Context, id int32) (FindSeriesReviewByIDWithAuthorRow, error) {
+	row := q.db.QueryRow(ctx, findSeriesReviewByIDWithAuthor, id)
+	var i FindSeriesReviewByIDWithAuthorRow
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AuthorFirstName,
+		&i.AuthorLastName,
+	)
+	return i, err
+}
+
+const findSeriesReviewByUserIDAndSeriesSlug = `-- name: FindSeriesReviewByUserIDAndSeriesSlug :one
+SELECT id, rating, review, reply, reply_author_id, replied_at, is_hidden, language_slug, series_slug, user_id, created_at, updated_at FROM "series_reviews"
+WHERE "user_id" = $1 AND "series_slug" = $2
+LIMIT 1
+`
+
+type FindSeriesReviewByUserIDAndSeriesSlugParams struct {
+	UserID     int32
+	SeriesSlug string
+}
+
+func (q *Queries) FindSeriesReviewByUserIDAndSeriesSlug(ctx context.Context, arg FindSeriesReviewByUserIDAndSeriesSlugParams) (SeriesReview, error) {
+	row := q.db.QueryRow(ctx, findSeriesReviewByUserIDAndSeriesSlug, arg.UserID, arg.SeriesSlug)
+	var i SeriesReview
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateSeriesReview = `-- name: UpdateSeriesReview :one
+UPDATE "series_reviews" SET
+    "rating" = $1,
+    "review" = $2,
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING id, rating, review, reply, reply_author_id, replied_at, is_hidden, language_slug, series_slug, user_id, created_at, updated_at
+`
+
+type UpdateSeriesReviewParams struct {
+	Rating int16
+	Review string
+	ID     int32
+}
+
+func (q *Queries) UpdateSeriesReview(ctx context.Context, arg UpdateSeriesReviewParams) (SeriesReview, error) {
+	row := q.db.QueryRow(ctx, updateSeriesReview, arg.Rating, arg.Review, arg.ID)
+	var i SeriesReview
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateSeriesReviewIsHidden = `-- name: UpdateSeriesReviewIsHidden :one
+UPDATE "series_reviews" SET
+    "is_hidden" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, rating, review, reply, reply_author_id, replied_at, is_hidden, language_slug, series_slug, user_id, created_at, updated_at
+`
+
+type UpdateSeriesReviewIsHiddenParams struct {
+	IsHidden bool
+	ID       int32
+}
+
+func (q *Queries) UpdateSeriesReviewIsHidden(ctx context.Context, arg UpdateSeriesReviewIsHiddenParams) (SeriesReview, error) {
+	row := q.db.QueryRow(ctx, updateSeriesReviewIsHidden, arg.IsHidden, arg.ID)
+	var i SeriesReview
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateSeriesReviewReply = `-- name: UpdateSeriesReviewReply :one
+UPDATE "series_reviews" SET
+    "reply" = $1,
+    "reply_author_id" = $2,
+    "replied_at" = now(),
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING id, rating, review, reply, reply_author_id, replied_at, is_hidden, language_slug, series_slug, user_id, created_at, updated_at
+`
+
+type UpdateSeriesReviewReplyParams struct {
+	Reply         string
+	ReplyAuthorID pgtype.Int4
+	ID            int32
+}
+
+func (q *Queries) UpdateSeriesReviewReply(ctx context.Context, arg UpdateSeriesReviewReplyParams) (SeriesReview, error) {
+	row := q.db.QueryRow(ctx, updateSeriesReviewReply, arg.Reply, arg.ReplyAuthorID, arg.ID)
+	var i SeriesReview
+	err := row.Scan(
+		&i.ID,
+		&i.Rating,
+		&i.Review,
+		&i.Reply,
+		&i.ReplyAuthorID,
+		&i.RepliedAt,
+		&i.IsHidden,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
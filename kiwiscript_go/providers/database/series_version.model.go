@@ -0,0 +1,87 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+type SeriesVersionModel struct {
+	ID            int32
+	Version       int16
+	Title         string
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionsCount int16
+	LessonsCount  int16
+	WatchTime     int32
+	ReadTime      int32
+	CreatedAt     string
+}
+
+func (v *SeriesVersion) ToSeriesVersionModel() *SeriesVersionModel {
+	return &SeriesVersionModel{
+		ID:            v.ID,
+		Version:       v.Version,
+		Title:         v.Title,
+		LanguageSlug:  v.LanguageSlug,
+		SeriesSlug:    v.SeriesSlug,
+		SectionsCount: v.SectionsCount,
+		LessonsCount:  v.LessonsCount,
+		WatchTime:     v.WatchTimeSeconds,
+		ReadTime:      v.ReadTimeSeconds,
+		CreatedAt:     v.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+// SeriesProgressVersionModel is the version a learner is pinned to, a zero
+// Version means the progress predates versioning and follows the live series.
+type SeriesProgressVersionModel struct {
+	LanguageSlug      string
+	SeriesSlug        string
+	Version           int16
+	LatestVersion     int16
+	CompletedSections int16
+	TotalSections     int16
+	CompletedLessons  int16
+	TotalLessons      int16
+	CompletedAt       string
+}
+
+func (p *SeriesProgress) ToSeriesProgressVersionModel(pinned, latest *SeriesVersion) *SeriesProgressVersionModel {
+	var completedAt string
+	if p.CompletedAt.Valid {
+		completedAt = p.CompletedAt.Time.Format(time.RFC3339)
+	}
+
+	model := &SeriesProgressVersionModel{
+		LanguageSlug:      p.LanguageSlug,
+		SeriesSlug:        p.SeriesSlug,
+		CompletedSections: p.CompletedSections,
+		CompletedLessons:  p.CompletedLessons,
+		CompletedAt:       completedAt,
+	}
+	if pinned != nil {
+		model.Version = pinned.Version
+		model.TotalSections = pinned.SectionsCount
+		model.TotalLessons = pinned.LessonsCount
+	}
+	if latest != nil {
+		model.LatestVersion = latest.Version
+	}
+
+	return model
+}
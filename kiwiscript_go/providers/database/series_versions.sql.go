@@ -0,0 +1,428 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: series_versions.sql
+
+package db
+
+import (
+	"context"
+)
+
+const countSeriesVersionLessonsBySectionID = `-- name: CountSeriesVersionLessonsBySectionID :one
+SELECT COUNT("id") FROM "series_version_lessons"
+WHERE
+  "series_version_id" = $1 AND
+  "section_id" = $2
+LIMIT 1
+`
+
+type CountSeriesVersionLessonsBySectionIDParams struct {
+	SeriesVersionID int32
+	SectionID       int32
+}
+
+func (q *Queries) CountSeriesVersionLessonsBySectionID(ctx context.Context, arg CountSeriesVersionLessonsBySectionIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeriesVersionLessonsBySectionID, arg.SeriesVersionID, arg.SectionID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSeriesVersionsBySeriesSlug = `-- name: CountSeriesVersionsBySeriesSlug :one
+SELECT COUNT("id") FROM "series_versions"
+WHERE "series_slug" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountSeriesVersionsBySeriesSlug(ctx context.Context, seriesSlug string) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeriesVersionsBySeriesSlug, seriesSlug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createSeriesVersion = `-- name: CreateSeriesVersion :one
+
+
+INSERT INTO "series_versions" (
+  "language_slug",
+  "series_slug",
+  "version",
+  "title",
+  "sections_count",
+  "lessons_count",
+  "watch_time_seconds",
+  "read_time_seconds",
+  "author_id"
+)
+SELECT
+  "series"."language_slug",
+  "series"."slug",
+  (
+    SELECT COALESCE(MAX("previous"."version"), 0) + 1
+    FROM "series_versions" AS "previous"
+    WHERE "previous"."series_slug" = "series"."slug"
+  )::smallint,
+  "series"."title",
+  (
+    SELECT COUNT(DISTINCT "lessons"."section_id")
+    FROM "lessons"
+    INNER JOIN "sections" ON "sections"."id" = "lessons"."section_id"
+    WHERE
+      "lessons"."series_slug" = "series"."slug" AND
+      "lessons"."is_published" = true AND
+      "sections"."is_published" = true
+  )::smallint,
+  (
+    SELECT COUNT("lessons"."id")
+    FROM "lessons"
+    INNER JOIN "sections" ON "sections"."id" = "lessons"."section_id"
+    WHERE
+      "lessons"."series_slug" = "series"."slug" AND
+      "lessons"."is_published" = true AND
+      "sections"."is_published" = true
+  )::smallint,
+  "series"."watch_time_seconds",
+  "series"."read_time_seconds",
+  $1
+FROM "series"
+WHERE "series"."id" = $2
+RETURNING id, language_slug, series_slug, version, title, sections_count, lessons_count, watch_time_seconds, read_time_seconds, author_id, created_at
+`
+
+type CreateSeriesVersionParams struct {
+	AuthorID int32
+	SeriesID int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateSeriesVersion(ctx context.Context, arg CreateSeriesVersionParams) (SeriesVersion, error) {
+	row := q.db.QueryRow(ctx, createSeriesVersion, arg.AuthorID, arg.SeriesID)
+	var i SeriesVersion
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Version,
+		&i.Title,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.AuthorID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createSeriesVersionLessons = `-- name: CreateSeriesVersionLessons :exec
+INSERT INTO "series_version_lessons" (
+  "series_version_id",
+  "section_id",
+  "lesson_id",
+  "section_position",
+  "position"
+)
+SELECT
+  $1,
+  "lessons"."section_id",
+  "lessons"."id",
+  "sections"."position",
+  "lessons"."position"
+FROM "lessons"
+INNER JOIN "sections" ON "sections"."id" = "lessons"."section_id"
+WHERE
+  "lessons"."series_slug" = $2 AND
+  "lessons"."is_published" = true AND
+  "sections"."is_published" = true
+`
+
+type CreateSeriesVersionLessonsParams struct {
+	SeriesVersionID int32
+	SeriesSlug      string
+}
+
+func (q *Queries) CreateSeriesVersionLessons(ctx context.Context, arg CreateSeriesVersionLessonsParams) error {
+	_, err := q.db.Exec(ctx, createSeriesVersionLessons, arg.SeriesVersionID, arg.SeriesSlug)
+	return err
+}
+
+const deleteLessonProgressNotInSeriesVersion = `-- name: DeleteLessonProgressNotInSeriesVersion :exec
+DELETE FROM "lesson_progress"
+WHERE
+  "lesson_progress"."series_progress_id" = $1 AND
+  NOT EXISTS (
+    SELECT 1 FROM "series_version_lessons"
+    WHERE
+      "series_version_lessons"."series_version_id" = $2 AND
+      "series_version_lessons"."lesson_id" = "lesson_progress"."lesson_id"
+  )
+`
+
+type DeleteLessonProgressNotInSeriesVersionParams struct {
+	SeriesProgressID int32
+	SeriesVersionID  int32
+}
+
+func (q *Queries) DeleteLessonProgressNotInSeriesVersion(ctx context.Context, arg DeleteLessonProgressNotInSeriesVersionParams) error {
+	_, err := q.db.Exec(ctx, deleteLessonProgressNotInSeriesVersion, arg.SeriesProgressID, arg.SeriesVersionID)
+	return err
+}
+
+const deleteSectionProgressNotInSeriesVersion = `-- name: DeleteSectionProgressNotInSeriesVersion :exec
+DELETE FROM "section_progress"
+WHERE
+  "section_progress"."series_progress_id" = $1 AND
+  NOT EXISTS (
+    SELECT 1 FROM "series_version_lessons"
+    WHERE
+      "series_version_lessons"."series_version_id" = $2 AND
+      "series_version_lessons"."section_id" = "section_progress"."section_id"
+  )
+`
+
+type DeleteSectionProgressNotInSeriesVersionParams struct {
+	SeriesProgressID int32
+	SeriesVersionID  int32
+}
+
+func (q *Queries) DeleteSectionProgressNotInSeriesVersion(ctx context.Context, arg DeleteSectionProgressNotInSeriesVersionParams) error {
+	_, err := q.db.Exec(ctx, deleteSectionProgressNotInSeriesVersion, arg.SeriesProgressID, arg.SeriesVersionID)
+	return err
+}
+
+const findLatestSeriesVersionBySeriesSlug = `-- name: FindLatestSeriesVersionBySeriesSlug :one
+SELECT id, language_slug, series_slug, version, title, sections_count, lessons_count, watch_time_seconds, read_time_seconds, author_id, created_at FROM "series_versions"
+WHERE "series_slug" = $1
+ORDER BY "version" DESC
+LIMIT 1
+`
+
+func (q *Queries) FindLatestSeriesVersionBySeriesSlug(ctx context.Context, seriesSlug string) (SeriesVersion, error) {
+	row := q.db.QueryRow(ctx, findLatestSeriesVersionBySeriesSlug, seriesSlug)
+	var i SeriesVersion
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Version,
+		&i.Title,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.AuthorID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findLessonProgressMovedInSeriesVersion = `-- name: FindLessonProgressMovedInSeriesVersion :many
+SELECT
+  "lesson_progress"."id",
+  "series_version_lessons"."section_id"
+FROM "lesson_progress"
+INNER JOIN "series_version_lessons" ON (
+  "series_version_lessons"."lesson_id" = "lesson_progress"."lesson_id" AND
+  "series_version_lessons"."series_version_id" = $1
+)
+WHERE
+  "lesson_progress"."series_progress_id" = $2 AND
+  "series_version_lessons"."section_id" <> "lesson_progress"."section_id"
+`
+
+type FindLessonProgressMovedInSeriesVersionParams struct {
+	SeriesVersionID  int32
+	SeriesProgressID int32
+}
+
+type FindLessonProgressMovedInSeriesVersionRow struct {
+	ID        int32
+	SectionID int32
+}
+
+func (q *Queries) FindLessonProgressMovedInSeriesVersion(ctx context.Context, arg FindLessonProgressMovedInSeriesVersionParams) ([]FindLessonProgressMovedInSeriesVersionRow, error) {
+	rows, err := q.db.Query(ctx, findLessonProgressMovedInSeriesVersion, arg.SeriesVersionID, arg.SeriesProgressID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindLessonProgressMovedInSeriesVersionRow{}
+	for rows.Next() {
+		var i FindLessonProgressMovedInSeriesVersionRow
+		if err := rows.Scan(&i.ID, &i.SectionID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedSeriesVersionsBySeriesSlug = `-- name: FindPaginatedSeriesVersionsBySeriesSlug :many
+SELECT id, language_slug, series_slug, version, title, sections_count, lessons_count, watch_time_seconds, read_time_seconds, author_id, created_at FROM "series_versions"
+WHERE "series_slug" = $1
+ORDER BY "version" DESC
+OFFSET $2 LIMIT $3
+`
+
+type FindPaginatedSeriesVersionsBySeriesSlugParams struct {
+	SeriesSlug string
+	Offset     int32
+	Limit      int32
+}
+
+func (q *Queries) FindPaginatedSeriesVersionsBySeriesSlug(ctx context.Context, arg FindPaginatedSeriesVersionsBySeriesSlugParams) ([]SeriesVersion, error) {
+	rows, err := q.db.Query(ctx, findPaginatedSeriesVersionsBySeriesSlug, arg.SeriesSlug, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SeriesVersion{}
+	for rows.Next() {
+		var i SeriesVersion
+		if err := rows.Scan(
+			&i.ID,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.Version,
+			&i.Title,
+			&i.SectionsCount,
+			&i.LessonsCount,
+			&i.WatchTimeSeconds,
+			&i.ReadTimeSeconds,
+			&i.AuthorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSeriesVersionByID = `-- name: FindSeriesVersionByID :one
+SELECT id, language_slug, series_slug, version, title, sections_count, lessons_count, watch_time_seconds, read_time_seconds, author_id, created_at FROM "series_versions"
+WHERE "id" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindSeriesVersionByID(ctx context.Context, id int32) (SeriesVersion, error) {
+	row := q.db.QueryRow(ctx, findSeriesVersionByID, id)
+	var i SeriesVersion
+	err := row.Scan(
+		&i.ID,
+		&i.LanguageSlug,
+		&i.SeriesSlug,
+		&i.Version,
+		&i.Title,
+		&i.SectionsCount,
+		&i.LessonsCount,
+		&i.WatchTimeSeconds,
+		&i.ReadTimeSeconds,
+		&i.AuthorID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findSeriesVersionLessonByLessonID = `-- name: FindSeriesVersionLessonByLessonID :one
+SELECT id, series_version_id, section_id, lesson_id, section_position, position FROM "series_version_lessons"
+WHERE
+  "series_version_id" = $1 AND
+  "lesson_id" = $2
+LIMIT 1
+`
+
+type FindSeriesVersionLessonByLessonIDParams struct {
+	SeriesVersionID int32
+	LessonID        int32
+}
+
+func (q *Queries) FindSeriesVersionLessonByLessonID(ctx context.Context, arg FindSeriesVersionLessonByLessonIDParams) (SeriesVersionLesson, error) {
+	row := q.db.QueryRow(ctx, findSeriesVersionLessonByLessonID, arg.SeriesVersionID, arg.LessonID)
+	var i SeriesVersionLesson
+	err := row.Scan(
+		&i.ID,
+		&i.SeriesVersionID,
+		&i.SectionID,
+		&i.LessonID,
+		&i.SectionPosition,
+		&i.Position,
+	)
+	return i, err
+}
+
+const reassignSeriesVersionsAuthor = `-- name: ReassignSeriesVersionsAuthor :exec
+UPDATE "series_versions" SET
+  "author_id" = $1
+WHERE "author_id" = $2
+`
+
+type ReassignSeriesVersionsAuthorParams struct {
+	NewAuthorID int32
+	OldAuthorID int32
+}
+
+func (q *Queries) ReassignSeriesVersionsAuthor(ctx context.Context, arg ReassignSeriesVersionsAuthorParams) error {
+	_, err := q.db.Exec(ctx, reassignSeriesVersionsAuthor, arg.NewAuthorID, arg.OldAuthorID)
+	return err
+}
+
+const recountSectionProgressInSeriesVersion = `-- name: RecountSectionProgressInSeriesVersion :exec
+UPDATE "section_progress" SET
+  "completed_lessons" = "totals"."completed_lessons",
+  "completed_at" = CASE
+    WHEN "totals"."completed_lessons" = "totals"."lessons_count"
+      THEN COALESCE("section_progress"."completed_at", NOW())
+    ELSE NULL
+  END,
+  "updated_at" = NOW()
+FROM (
+  SELECT
+    "series_version_lessons"."section_id",
+    COUNT("series_version_lessons"."id")::smallint AS "lessons_count",
+    COUNT("lesson_progress"."completed_at")::smallint AS "completed_lessons"
+  FROM "series_version_lessons"
+  LEFT JOIN "lesson_progress" ON (
+    "lesson_progress"."lesson_id" = "series_version_lessons"."lesson_id" AND
+    "lesson_progress"."series_progress_id" = $1
+  )
+  WHERE "series_version_lessons"."series_version_id" = $2
+  GROUP BY "series_version_lessons"."section_id"
+) AS "totals"
+WHERE
+  "section_progress"."series_progress_id" = $1 AND
+  "section_progress"."section_id" = "totals"."section_id"
+`
+
+type RecountSectionProgressInSeriesVersionParams struct {
+	SeriesProgressID int32
+	SeriesVersionID  int32
+}
+
+func (q *Queries) RecountSectionProgressInSeriesVersion(ctx context.Context, arg RecountSectionProgressInSeriesVersionParams) error {
+	_, err := q.db.Exec(ctx, recountSectionProgressInSeriesVersion, arg.SeriesProgressID, arg.SeriesVersionID)
+	return err
+}
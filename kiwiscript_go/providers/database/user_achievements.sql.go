@@ -0,0 +1,82 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: user_achievements.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createUserAchievement = `-- name: CreateUserAchievement :execrows
+
+
+INSERT INTO "user_achievements" (
+    "user_id",
+    "achievement_slug"
+) VALUES (
+    $1,
+    $2
+) ON CONFLICT ("user_id", "achievement_slug") DO NOTHING
+`
+
+type CreateUserAchievementParams struct {
+	UserID          int32
+	AchievementSlug string
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateUserAchievement(ctx context.Context, arg CreateUserAchievementParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createUserAchievement, arg.UserID, arg.AchievementSlug)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findUserAchievementsByUserID = `-- name: FindUserAchievementsByUserID :many
+SELECT id, user_id, achievement_slug, unlocked_at FROM "user_achievements"
+WHERE "user_id" = $1
+ORDER BY "unlocked_at" ASC
+`
+
+func (q *Queries) FindUserAchievementsByUserID(ctx context.Context, userID int32) ([]UserAchievement, error) {
+	rows, err := q.db.Query(ctx, findUserAchievementsByUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserAchievement{}
+	for rows.Next() {
+		var i UserAchievement
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.AchievementSlug,
+			&i.UnlockedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,198 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: user_data_exports.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimPendingUserDataExports = `-- name: ClaimPendingUserDataExports :many
+UPDATE "user_data_exports" SET
+    "status" = 'processing',
+    "updated_at" = now()
+WHERE "id" IN (
+    SELECT "id" FROM "user_data_exports"
+    WHERE "status" = 'pending'
+    ORDER BY "created_at" ASC
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, user_id, status, expires_at, created_at, updated_at
+`
+
+func (q *Queries) ClaimPendingUserDataExports(ctx context.Context, limit int32) ([]UserDataExport, error) {
+	rows, err := q.db.Query(ctx, claimPendingUserDataExports, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserDataExport{}
+	for rows.Next() {
+		var i UserDataExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeUserDataExport = `-- name: CompleteUserDataExport :exec
+UPDATE "user_data_exports" SET
+    "status" = 'completed',
+    "expires_at" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+`
+
+type CompleteUserDataExportParams struct {
+	ExpiresAt pgtype.Timestamp
+	ID        uuid.UUID
+}
+
+func (q *Queries) CompleteUserDataExport(ctx context.Context, arg CompleteUserDataExportParams) error {
+	_, err := q.db.Exec(ctx, completeUserDataExport, arg.ExpiresAt, arg.ID)
+	return err
+}
+
+const createUserDataExport = `-- name: CreateUserDataExport :one
+
+
+INSERT INTO "user_data_exports" (
+    "id",
+    "user_id"
+) VALUES (
+    $1,
+    $2
+) RETURNING id, user_id, status, expires_at, created_at, updated_at
+`
+
+type CreateUserDataExportParams struct {
+	ID     uuid.UUID
+	UserID int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateUserDataExport(ctx context.Context, arg CreateUserDataExportParams) (UserDataExport, error) {
+	row := q.db.QueryRow(ctx, createUserDataExport, arg.ID, arg.UserID)
+	var i UserDataExport
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const expireUserDataExport = `-- name: ExpireUserDataExport :exec
+UPDATE "user_data_exports" SET
+    "status" = 'expired',
+    "updated_at" = now()
+WHERE "id" = $1
+`
+
+func (q *Queries) ExpireUserDataExport(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, expireUserDataExport, id)
+	return err
+}
+
+const failUserDataExport = `-- name: FailUserDataExport :exec
+UPDATE "user_data_exports" SET
+    "status" = 'failed',
+    "updated_at" = now()
+WHERE "id" = $1
+`
+
+func (q *Queries) FailUserDataExport(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, failUserDataExport, id)
+	return err
+}
+
+const findExpiredUserDataExports = `-- name: FindExpiredUserDataExports :many
+SELECT id, user_id, status, expires_at, created_at, updated_at FROM "user_data_exports"
+WHERE "status" = 'completed' AND "expires_at" <= now()
+ORDER BY "expires_at" ASC
+LIMIT $1
+`
+
+func (q *Queries) FindExpiredUserDataExports(ctx context.Context, limit int32) ([]UserDataExport, error) {
+	rows, err := q.db.Query(ctx, findExpiredUserDataExports, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserDataExport{}
+	for rows.Next() {
+		var i UserDataExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findLatestUserDataExportByUserID = `-- name: FindLatestUserDataExportByUserID :one
+SELECT id, user_id, status, expires_at, created_at, updated_at FROM "user_data_exports"
+WHERE "user_id" = $1
+ORDER BY "created_at" DESC
+LIMIT 1
+`
+
+func (q *Queries) FindLatestUserDataExportByUserID(ctx context.Context, userID int32) (UserDataExport, error) {
+	row := q.db.QueryRow(ctx, findLatestUserDataExportByUserID, userID)
+	var i UserDataExport
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,171 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: user_learning_stats.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addUserLearningStatsXp = `-- name: AddUserLearningStatsXp :one
+UPDATE "user_learning_stats" SET
+    "xp_total" = "user_learning_stats"."xp_total" + $1::int,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, user_id, timezone, current_streak, longest_streak, last_activity_date, xp_total, created_at, updated_at
+`
+
+type AddUserLearningStatsXpParams struct {
+	Points int32
+	ID     int32
+}
+
+func (q *Queries) AddUserLearningStatsXp(ctx context.Context, arg AddUserLearningStatsXpParams) (UserLearningStat, error) {
+	row := q.db.QueryRow(ctx, addUserLearningStatsXp, arg.Points, arg.ID)
+	var i UserLearningStat
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Timezone,
+		&i.CurrentStreak,
+		&i.LongestStreak,
+		&i.LastActivityDate,
+		&i.XpTotal,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createUserLearningStats = `-- name: CreateUserLearningStats :one
+
+
+INSERT INTO "user_learning_stats" (
+    "user_id"
+) VALUES (
+    $1
+) RETURNING id, user_id, timezone, current_streak, longest_streak, last_activity_date, xp_total, created_at, updated_at
+`
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateUserLearningStats(ctx context.Context, userID int32) (UserLearningStat, error) {
+	row := q.db.QueryRow(ctx, createUserLearningStats, userID)
+	var i UserLearningStat
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Timezone,
+		&i.CurrentStreak,
+		&i.LongestStreak,
+		&i.LastActivityDate,
+		&i.XpTotal,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findUserLearningStatsByUserID = `-- name: FindUserLearningStatsByUserID :one
+SELECT id, user_id, timezone, current_streak, longest_streak, last_activity_date, xp_total, created_at, updated_at FROM "user_learning_stats"
+WHERE "user_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindUserLearningStatsByUserID(ctx context.Context, userID int32) (UserLearningStat, error) {
+	row := q.db.QueryRow(ctx, findUserLearningStatsByUserID, userID)
+	var i UserLearningStat
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Timezone,
+		&i.CurrentStreak,
+		&i.LongestStreak,
+		&i.LastActivityDate,
+		&i.XpTotal,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateUserLearningStatsStreak = `-- name: UpdateUserLearningStatsStreak :one
+UPDATE "user_learning_stats" SET
+    "current_streak" = $1,
+    "longest_streak" = GREATEST("user_learning_stats"."longest_streak", $1),
+    "last_activity_date" = $2,
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING id, user_id, timezone, current_streak, longest_streak, last_activity_date, xp_total, created_at, updated_at
+`
+
+type UpdateUserLearningStatsStreakParams struct {
+	CurrentStreak    int32
+	LastActivityDate pgtype.Date
+	ID               int32
+}
+
+func (q *Queries) UpdateUserLearningStatsStreak(ctx context.Context, arg UpdateUserLearningStatsStreakParams) (UserLearningStat, error) {
+	row := q.db.QueryRow(ctx, updateUserLearningStatsStreak, arg.CurrentStreak, arg.LastActivityDate, arg.ID)
+	var i UserLearningStat
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Timezone,
+		&i.CurrentStreak,
+		&i.LongestStreak,
+		&i.LastActivityDate,
+		&i.XpTotal,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateUserLearningStatsTimezone = `-- name: UpdateUserLearningStatsTimezone :one
+UPDATE "user_learning_stats" SET
+    "timezone" = $1,
+    "updated_at" = now()
+WHERE "id" = $2
+RETURNING id, user_id, timezone, current_streak, longest_streak, last_activity_date, xp_total, created_at, updated_at
+`
+
+type UpdateUserLearningStatsTimezoneParams struct {
+	Timezone string
+	ID       int32
+}
+
+func (q *Queries) UpdateUserLearningStatsTimezone(ctx context.Context, arg UpdateUserLearningStatsTimezoneParams) (UserLearningStat, error) {
+	row := q.db.QueryRow(ctx, updateUserLearningStatsTimezone, arg.Timezone, arg.ID)
+	var i UserLearningStat
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Timezone,
+		&i.CurrentStreak,
+		&i.LongestStreak,
+		&i.LastActivityDate,
+		&i.XpTotal,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
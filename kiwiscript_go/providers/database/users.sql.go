@@ -16,7 +16,7 @@ UPDATE "users" SET
   "is_confirmed" = true,
   "version" = "version" + 1
 WHERE "id" = $1
-RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at
+RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at
 `
 
 func (q *Queries) ConfirmUser(ctx context.Context, id int32) (User, error) {
@@ -35,10 +35,22 @@ func (q *Queries) ConfirmUser(ctx context.Context, id int32) (User, error) {
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const createPurgedUserObjects = `-- name: CreatePurgedUserObjects :exec
+INSERT INTO "purged_user_objects" ("user_id")
+VALUES ($1)
+ON CONFLICT ("user_id") DO NOTHING
+`
+
+func (q *Queries) CreatePurgedUserObjects(ctx context.Context, userID int32) error {
+	_, err := q.db.Exec(ctx, createPurgedUserObjects, userID)
+	return err
+}
+
 const createUserWithPassword = `-- name: CreateUserWithPassword :one
 
 INSERT INTO "users" (
@@ -55,7 +67,7 @@ INSERT INTO "users" (
   $4,
   $5,
   false
-) RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at
+) RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at
 `
 
 type CreateUserWithPasswordParams struct {
@@ -104,6 +116,7 @@ func (q *Queries) CreateUserWithPassword(ctx context.Context, arg CreateUserWith
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -121,7 +134,7 @@ INSERT INTO "users" (
   $3,
   $4,
   true
-) RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at
+) RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at
 `
 
 type CreateUserWithoutPasswordParams struct {
@@ -152,6 +165,7 @@ func (q *Queries) CreateUserWithoutPassword(ctx context.Context, arg CreateUserW
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -165,6 +179,16 @@ func (q *Queries) DeleteAllUsers(ctx context.Context) error {
 	return err
 }
 
+const deletePurgedUserObjects = `-- name: DeletePurgedUserObjects :exec
+DELETE FROM "purged_user_objects"
+WHERE "user_id" = $1
+`
+
+func (q *Queries) DeletePurgedUserObjects(ctx context.Context, userID int32) error {
+	_, err := q.db.Exec(ctx, deletePurgedUserObjects, userID)
+	return err
+}
+
 const deleteUserById = `-- name: DeleteUserById :exec
 DELETE FROM "users"
 WHERE "id" = $1
@@ -175,9 +199,53 @@ func (q *Queries) DeleteUserById(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteUserDueForPurgeByID = `-- name: DeleteUserDueForPurgeByID :execrows
+DELETE FROM "users"
+WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "deleted_at" <= $2
+`
+
+type DeleteUserDueForPurgeByIDParams struct {
+	ID        int32
+	DeletedAt pgtype.Timestamp
+}
+
+func (q *Queries) DeleteUserDueForPurgeByID(ctx context.Context, arg DeleteUserDueForPurgeByIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserDueForPurgeByID, arg.ID, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findPurgedUserObjects = `-- name: FindPurgedUserObjects :many
+SELECT "user_id" FROM "purged_user_objects"
+ORDER BY "created_at" ASC
+LIMIT $1
+`
+
+func (q *Queries) FindPurgedUserObjects(ctx context.Context, limit int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, findPurgedUserObjects, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var user_id int32
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findStaffUserByIdWithProfileAndPicture = `-- name: FindStaffUserByIdWithProfileAndPicture :one
 SELECT
-    users.id, users.first_name, users.last_name, users.location, users.email, users.version, users.is_admin, users.is_staff, users.is_confirmed, users.password, users.created_at, users.updated_at,
+    users.id, users.first_name, users.last_name, users.location, users.email, users.version, users.is_admin, users.is_staff, users.is_confirmed, users.password, users.created_at, users.updated_at, users.deleted_at,
     "user_profiles"."id" AS "profile_id",
     "user_profiles"."bio" AS "profile_bio",
     "user_profiles"."github" AS "profile_github",
@@ -204,6 +272,7 @@ type FindStaffUserByIdWithProfileAndPictureRow struct {
 	Password        pgtype.Text
 	CreatedAt       pgtype.Timestamp
 	UpdatedAt       pgtype.Timestamp
+	DeletedAt       pgtype.Timestamp
 	ProfileID       pgtype.Int4
 	ProfileBio      pgtype.Text
 	ProfileGithub   pgtype.Text
@@ -229,6 +298,7 @@ func (q *Queries) FindStaffUserByIdWithProfileAndPicture(ctx context.Context, id
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 		&i.ProfileID,
 		&i.ProfileBio,
 		&i.ProfileGithub,
@@ -240,8 +310,36 @@ func (q *Queries) FindStaffUserByIdWithProfileAndPicture(ctx context.Context, id
 	return i, err
 }
 
+const findSuccessorAdminUser = `-- name: FindSuccessorAdminUser :one
+SELECT id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at FROM "users"
+WHERE "is_admin" = true AND "deleted_at" IS NULL AND "id" <> $1
+ORDER BY "id" ASC
+LIMIT 1
+`
+
+func (q *Queries) FindSuccessorAdminUser(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRow(ctx, findSuccessorAdminUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Location,
+		&i.Email,
+		&i.Version,
+		&i.IsAdmin,
+		&i.IsStaff,
+		&i.IsConfirmed,
+		&i.Password,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const findUserByEmail = `-- name: FindUserByEmail :one
-SELECT id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at FROM "users"
+SELECT id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at FROM "users"
 WHERE "email" = $1 LIMIT 1
 `
 
@@ -261,12 +359,13 @@ func (q *Queries) FindUserByEmail(ctx context.Context, email string) (User, erro
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const findUserById = `-- name: FindUserById :one
-SELECT id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at FROM "users"
+SELECT id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at FROM "users"
 WHERE "id" = $1 LIMIT 1
 `
 
@@ -286,17 +385,138 @@ func (q *Queries) FindUserById(ctx context.Context, id int32) (User, error) {
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
+const findUserDueForPurgeByIDForUpdate = `-- name: FindUserDueForPurgeByIDForUpdate :one
+SELECT id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at FROM "users"
+WHERE "id" = $1 AND "deleted_at" IS NOT NULL AND "deleted_at" <= $2
+LIMIT 1
+FOR UPDATE
+`
+
+type FindUserDueForPurgeByIDForUpdateParams struct {
+	ID        int32
+	DeletedAt pgtype.Timestamp
+}
+
+// Locks the row so a concurrent restore waits for the purge to either finish or roll back
+func (q *Queries) FindUserDueForPurgeByIDForUpdate(ctx context.Context, arg FindUserDueForPurgeByIDForUpdateParams) (User, error) {
+	row := q.db.QueryRow(ctx, findUserDueForPurgeByIDForUpdate, arg.ID, arg.DeletedAt)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Location,
+		&i.Email,
+		&i.Version,
+		&i.IsAdmin,
+		&i.IsStaff,
+		&i.IsConfirmed,
+		&i.Password,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const findUsersDueForPurge = `-- name: FindUsersDueForPurge :many
+SELECT id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at FROM "users"
+WHERE "deleted_at" IS NOT NULL AND "deleted_at" <= $1
+ORDER BY "deleted_at" ASC
+LIMIT $2
+`
+
+type FindUsersDueForPurgeParams struct {
+	DeletedAt pgtype.Timestamp
+	Limit     int32
+}
+
+func (q *Queries) FindUsersDueForPurge(ctx context.Context, arg FindUsersDueForPurgeParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, findUsersDueForPurge, arg.DeletedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Location,
+			&i.Email,
+			&i.Version,
+			&i.IsAdmin,
+			&i.IsStaff,
+			&i.IsConfirmed,
+			&i.Password,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreUserById = `-- name: RestoreUserById :one
+UPDATE "users" SET
+  "deleted_at" = NULL
+WHERE "id" = $1
+RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at
+`
+
+func (q *Queries) RestoreUserById(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRow(ctx, restoreUserById, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Location,
+		&i.Email,
+		&i.Version,
+		&i.IsAdmin,
+		&i.IsStaff,
+		&i.IsConfirmed,
+		&i.Password,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const softDeleteUserById = `-- name: SoftDeleteUserById :exec
+UPDATE "users" SET
+  "deleted_at" = now(),
+  "version" = "version" + 1
+WHERE "id" = $1
+`
+
+func (q *Queries) SoftDeleteUserById(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, softDeleteUserById, id)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE "users" SET
   "first_name" = $1,
   "last_name" = $2,
   "location" = $3
 WHERE "id" = $4
-RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at
+RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at
 `
 
 type UpdateUserParams struct {
@@ -327,6 +547,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -336,7 +557,7 @@ UPDATE "users" SET
   "email" = $1,
   "version" = "version" + 1
 WHERE "id" = $2
-RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at
+RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at
 `
 
 type UpdateUserEmailParams struct {
@@ -360,6 +581,7 @@ func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
@@ -386,7 +608,7 @@ UPDATE "users" SET
   "password" = $1,
   "version" = "version" + 1
 WHERE "id" = $2
-RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at
+RETURNING id, first_name, last_name, location, email, version, is_admin, is_staff, is_confirmed, password, created_at, updated_at, deleted_at
 `
 
 type UpdateUserPasswordParams struct {
@@ -410,6 +632,27 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
 	)
 	return i, err
 }
+
+const userHasAuthoredContent = `-- name: UserHasAuthoredContent :one
+SELECT (
+  EXISTS (SELECT 1 FROM "languages" WHERE "languages"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "series" WHERE "series"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "series_versions" WHERE "series_versions"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "sections" WHERE "sections"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "lessons" WHERE "lessons"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "lesson_articles" WHERE "lesson_articles"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "lesson_videos" WHERE "lesson_videos"."author_id" = $1) OR
+  EXISTS (SELECT 1 FROM "coupons" WHERE "coupons"."created_by_id" = $1)
+)::boolean AS "has_content"
+`
+
+func (q *Queries) UserHasAuthoredContent(ctx context.Context, authorID int32) (bool, error) {
+	row := q.db.QueryRow(ctx, userHasAuthoredContent, authorID)
+	var has_content bool
+	err := row.Scan(&has_content)
+	return has_content, err
+}
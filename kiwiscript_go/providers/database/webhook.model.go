@@ -0,0 +1,95 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import "time"
+
+type WebhookModel struct {
+	ID        int32
+	URL       string
+	Events    []string
+	IsActive  bool
+	CreatedAt string
+	UpdatedAt string
+}
+
+func (w *Webhook) ToWebhookModel() *WebhookModel {
+	return &WebhookModel{
+		ID:        w.ID,
+		URL:       w.Url,
+		Events:    w.Events,
+		IsActive:  w.IsActive,
+		CreatedAt: w.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt: w.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+type WebhookDeliveryModel struct {
+	ID             string
+	WebhookID      int32
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int16
+	ResponseStatus int16
+	ResponseBody   string
+	NextAttemptAt  string
+	DeliveredAt    string
+	CreatedAt      string
+}
+
+func (d *WebhookDelivery) ToWebhookDeliveryModel() *WebhookDeliveryModel {
+	var nextAttemptAt, deliveredAt string
+	if d.NextAttemptAt.Valid {
+		nextAttemptAt = d.NextAttemptAt.Time.Format(time.RFC3339)
+	}
+	if d.DeliveredAt.Valid {
+		deliveredAt = d.DeliveredAt.Time.Format(time.RFC3339)
+	}
+
+	return &WebhookDeliveryModel{
+		ID:             d.ID.String(),
+		WebhookID:      d.WebhookID,
+		Event:          d.Event,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		ResponseBody:   d.ResponseBody,
+		NextAttemptAt:  nextAttemptAt,
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      d.CreatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func (d *ClaimDueWebhookDeliveriesWithWebhookRow) ToWebhookDelivery() *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		Event:          d.Event,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		ResponseBody:   d.ResponseBody,
+		NextAttemptAt:  d.NextAttemptAt,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
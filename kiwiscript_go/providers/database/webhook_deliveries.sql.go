@@ -0,0 +1,367 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: webhook_deliveries.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimDueWebhookDeliveriesWithWebhook = `-- name: ClaimDueWebhookDeliveriesWithWebhook :many
+UPDATE "webhook_deliveries" SET
+    "next_attempt_at" = now() + interval '5 minutes',
+    "updated_at" = now()
+FROM "webhooks"
+WHERE
+    "webhooks"."id" = "webhook_deliveries"."webhook_id" AND
+    "webhook_deliveries"."id" IN (
+        SELECT "due"."id" FROM "webhook_deliveries" AS "due"
+        INNER JOIN "webhooks" AS "due_webhooks" ON "due_webhooks"."id" = "due"."webhook_id"
+        WHERE
+            "due"."status" = 'pending' AND
+            "due"."next_attempt_at" <= now() AND
+            "due_webhooks"."is_active" = true
+        ORDER BY "due"."next_attempt_at" ASC
+        LIMIT $1
+        FOR UPDATE OF "due" SKIP LOCKED
+    )
+RETURNING
+    webhook_deliveries.id, webhook_deliveries.webhook_id, webhook_deliveries.event, webhook_deliveries.payload, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.response_status, webhook_deliveries.response_body, webhook_deliveries.next_attempt_at, webhook_deliveries.delivered_at, webhook_deliveries.created_at, webhook_deliveries.updated_at,
+    "webhooks"."url" AS "webhook_url",
+    "webhooks"."secret" AS "webhook_secret"
+`
+
+type ClaimDueWebhookDeliveriesWithWebhookRow struct {
+	ID             uuid.UUID
+	WebhookID      int32
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       int16
+	ResponseStatus int16
+	ResponseBody   string
+	NextAttemptAt  pgtype.Timestamp
+	DeliveredAt    pgtype.Timestamp
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+	WebhookUrl     string
+	WebhookSecret  string
+}
+
+// Claimed deliveries are leased so concurrent workers skip them while they are being sent
+func (q *Queries) ClaimDueWebhookDeliveriesWithWebhook(ctx context.Context, limit int32) ([]ClaimDueWebhookDeliveriesWithWebhookRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWebhookDeliveriesWithWebhook, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClaimDueWebhookDeliveriesWithWebhookRow{}
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesWithWebhookRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.Event,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WebhookUrl,
+			&i.WebhookSecret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countWebhookDeliveriesByWebhookID = `-- name: CountWebhookDeliveriesByWebhookID :one
+SELECT COUNT("id") FROM "webhook_deliveries"
+WHERE "webhook_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountWebhookDeliveriesByWebhookID(ctx context.Context, webhookID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countWebhookDeliveriesByWebhookID, webhookID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDueWebhookDelivery = `-- name: CreateDueWebhookDelivery :exec
+INSERT INTO "webhook_deliveries" (
+    "id",
+    "webhook_id",
+    "event",
+    "payload",
+    "next_attempt_at"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    now()
+)
+`
+
+type CreateDueWebhookDeliveryParams struct {
+	ID        uuid.UUID
+	WebhookID int32
+	Event     string
+	Payload   string
+}
+
+// Event deliveries are written in the transaction that produced the event and
+// sent by the worker, so they are due straight away
+func (q *Queries) CreateDueWebhookDelivery(ctx context.Context, arg CreateDueWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, createDueWebhookDelivery,
+		arg.ID,
+		arg.WebhookID,
+		arg.Event,
+		arg.Payload,
+	)
+	return err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+
+INSERT INTO "webhook_deliveries" (
+    "id",
+    "webhook_id",
+    "event",
+    "payload",
+    "next_attempt_at"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    now() + interval '5 minutes'
+) RETURNING id, webhook_id, event, payload, status, attempts, response_status, response_body, next_attempt_at, delivered_at, created_at, updated_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	ID        uuid.UUID
+	WebhookID int32
+	Event     string
+	Payload   string
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+// Redeliveries are sent inline, the delivery is only due for the worker once
+// its lease expires without the send having recorded an outcome
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookDelivery,
+		arg.ID,
+		arg.WebhookID,
+		arg.Event,
+		arg.Payload,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findPaginatedWebhookDeliveriesByWebhookID = `-- name: FindPaginatedWebhookDeliveriesByWebhookID :many
+SELECT id, webhook_id, event, payload, status, attempts, response_status, response_body, next_attempt_at, delivered_at, created_at, updated_at FROM "webhook_deliveries"
+WHERE "webhook_id" = $1
+ORDER BY "created_at" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedWebhookDeliveriesByWebhookIDParams struct {
+	WebhookID int32
+	Limit     int32
+	Offset    int32
+}
+
+func (q *Queries) FindPaginatedWebhookDeliveriesByWebhookID(ctx context.Context, arg FindPaginatedWebhookDeliveriesByWebhookIDParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, findPaginatedWebhookDeliveriesByWebhookID, arg.WebhookID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.Event,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.NextAttemptAt,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findWebhookDeliveryByIDAndWebhookID = `-- name: FindWebhookDeliveryByIDAndWebhookID :one
+SELECT id, webhook_id, event, payload, status, attempts, response_status, response_body, next_attempt_at, delivered_at, created_at, updated_at FROM "webhook_deliveries"
+WHERE "id" = $1 AND "webhook_id" = $2
+LIMIT 1
+`
+
+type FindWebhookDeliveryByIDAndWebhookIDParams struct {
+	ID        uuid.UUID
+	WebhookID int32
+}
+
+func (q *Queries) FindWebhookDeliveryByIDAndWebhookID(ctx context.Context, arg FindWebhookDeliveryByIDAndWebhookIDParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, findWebhookDeliveryByIDAndWebhookID, arg.ID, arg.WebhookID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateWebhookDeliveryFailed = `-- name: UpdateWebhookDeliveryFailed :one
+UPDATE "webhook_deliveries" SET
+    "status" = $1,
+    "attempts" = "attempts" + 1,
+    "response_status" = $2,
+    "response_body" = $3,
+    "next_attempt_at" = $4,
+    "updated_at" = now()
+WHERE "id" = $5
+RETURNING id, webhook_id, event, payload, status, attempts, response_status, response_body, next_attempt_at, delivered_at, created_at, updated_at
+`
+
+type UpdateWebhookDeliveryFailedParams struct {
+	Status         string
+	ResponseStatus int16
+	ResponseBody   string
+	NextAttemptAt  pgtype.Timestamp
+	ID             uuid.UUID
+}
+
+func (q *Queries) UpdateWebhookDeliveryFailed(ctx context.Context, arg UpdateWebhookDeliveryFailedParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, updateWebhookDeliveryFailed,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.ResponseBody,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateWebhookDeliverySucceeded = `-- name: UpdateWebhookDeliverySucceeded :one
+UPDATE "webhook_deliveries" SET
+    "status" = 'success',
+    "attempts" = "attempts" + 1,
+    "response_status" = $1,
+    "response_body" = $2,
+    "next_attempt_at" = NULL,
+    "delivered_at" = now(),
+    "updated_at" = now()
+WHERE "id" = $3
+RETURNING id, webhook_id, event, payload, status, attempts, response_status, response_body, next_attempt_at, delivered_at, created_at, updated_at
+`
+
+type UpdateWebhookDeliverySucceededParams struct {
+	ResponseStatus int16
+	ResponseBody   string
+	ID             uuid.UUID
+}
+
+func (q *Queries) UpdateWebhookDeliverySucceeded(ctx context.Context, arg UpdateWebhookDeliverySucceededParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, updateWebhookDeliverySucceeded, arg.ResponseStatus, arg.ResponseBody, arg.ID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.NextAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,229 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: webhooks.sql
+
+package db
+
+import (
+	"context"
+)
+
+const countWebhooks = `-- name: CountWebhooks :one
+SELECT COUNT("id") FROM "webhooks"
+LIMIT 1
+`
+
+func (q *Queries) CountWebhooks(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countWebhooks)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createWebhook = `-- name: CreateWebhook :one
+
+INSERT INTO "webhooks" (
+    "url",
+    "secret",
+    "events",
+    "author_id"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING id, url, secret, events, is_active, author_id, created_at, updated_at
+`
+
+type CreateWebhookParams struct {
+	Url      string
+	Secret   string
+	Events   []string
+	AuthorID int32
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, createWebhook,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.AuthorID,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.IsActive,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhookByID = `-- name: DeleteWebhookByID :exec
+DELETE FROM "webhooks"
+WHERE "id" = $1
+`
+
+func (q *Queries) DeleteWebhookByID(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteWebhookByID, id)
+	return err
+}
+
+const findActiveWebhooksByEvent = `-- name: FindActiveWebhooksByEvent :many
+SELECT id, url, secret, events, is_active, author_id, created_at, updated_at FROM "webhooks"
+WHERE "is_active" = true AND $1::varchar = ANY("events")
+ORDER BY "id" ASC
+`
+
+func (q *Queries) FindActiveWebhooksByEvent(ctx context.Context, event string) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, findActiveWebhooksByEvent, event)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Webhook{}
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.IsActive,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPaginatedWebhooks = `-- name: FindPaginatedWebhooks :many
+SELECT id, url, secret, events, is_active, author_id, created_at, updated_at FROM "webhooks"
+ORDER BY "id" ASC
+LIMIT $1 OFFSET $2
+`
+
+type FindPaginatedWebhooksParams struct {
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) FindPaginatedWebhooks(ctx context.Context, arg FindPaginatedWebhooksParams) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, findPaginatedWebhooks, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Webhook{}
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.IsActive,
+			&i.AuthorID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findWebhookByID = `-- name: FindWebhookByID :one
+SELECT id, url, secret, events, is_active, author_id, created_at, updated_at FROM "webhooks"
+WHERE "id" = $1
+LIMIT 1
+`
+
+func (q *Queries) FindWebhookByID(ctx context.Context, id int32) (Webhook, error) {
+	row := q.db.QueryRow(ctx, findWebhookByID, id)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.IsActive,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateWebhook = `-- name: UpdateWebhook :one
+UPDATE "webhooks" SET
+    "url" = $1,
+    "secret" = $2,
+    "events" = $3,
+    "is_active" = $4,
+    "updated_at" = now()
+WHERE "id" = $5
+RETURNING id, url, secret, events, is_active, author_id, created_at, updated_at
+`
+
+type UpdateWebhookParams struct {
+	Url      string
+	Secret   string
+	Events   []string
+	IsActive bool
+	ID       int32
+}
+
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, updateWebhook,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+		arg.IsActive,
+		arg.ID,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.IsActive,
+		&i.AuthorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
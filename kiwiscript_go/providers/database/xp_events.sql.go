@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.26.0
+// source: xp_events.sql
+
+package db
+
+import (
+	"context"
+)
+
+const countXpEventsBySourceAndUserID = `-- name: CountXpEventsBySourceAndUserID :many
+SELECT
+    "source",
+    COUNT("id") AS "count"
+FROM "xp_events"
+WHERE "user_id" = $1
+GROUP BY "source"
+`
+
+type CountXpEventsBySourceAndUserIDRow struct {
+	Source string
+	Count  int64
+}
+
+func (q *Queries) CountXpEventsBySourceAndUserID(ctx context.Context, userID int32) ([]CountXpEventsBySourceAndUserIDRow, error) {
+	rows, err := q.db.Query(ctx, countXpEventsBySourceAndUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountXpEventsBySourceAndUserIDRow{}
+	for rows.Next() {
+		var i CountXpEventsBySourceAndUserIDRow
+		if err := rows.Scan(&i.Source, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countXpEventsByUserID = `-- name: CountXpEventsByUserID :one
+SELECT COUNT("id") FROM "xp_events"
+WHERE "user_id" = $1
+LIMIT 1
+`
+
+func (q *Queries) CountXpEventsByUserID(ctx context.Context, userID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countXpEventsByUserID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createXpEvent = `-- name: CreateXpEvent :execrows
+
+
+INSERT INTO "xp_events" (
+    "user_id",
+    "source",
+    "reference",
+    "points",
+    "language_slug",
+    "series_slug"
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) ON CONFLICT ("user_id", "source", "reference") DO NOTHING
+`
+
+type CreateXpEventParams struct {
+	UserID       int32
+	Source       string
+	Reference    string
+	Points       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+func (q *Queries) CreateXpEvent(ctx context.Context, arg CreateXpEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createXpEvent,
+		arg.UserID,
+		arg.Source,
+		arg.Reference,
+		arg.Points,
+		arg.LanguageSlug,
+		arg.SeriesSlug,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const findPaginatedXpEventsByUserID = `-- name: FindPaginatedXpEventsByUserID :many
+SELECT id, user_id, source, reference, points, language_slug, series_slug, created_at FROM "xp_events"
+WHERE "user_id" = $1
+ORDER BY "id" DESC
+LIMIT $2 OFFSET $3
+`
+
+type FindPaginatedXpEventsByUserIDParams struct {
+	UserID int32
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) FindPaginatedXpEventsByUserID(ctx context.Context, arg FindPaginatedXpEventsByUserIDParams) ([]XpEvent, error) {
+	rows, err := q.db.Query(ctx, findPaginatedXpEventsByUserID, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []XpEvent{}
+	for rows.Next() {
+		var i XpEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Source,
+			&i.Reference,
+			&i.Points,
+			&i.LanguageSlug,
+			&i.SeriesSlug,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
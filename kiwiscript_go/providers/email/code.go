@@ -82,5 +82,5 @@ func (m *Mail) SendCodeEmail(ctx context.Context, opts CodeEmailOptions) error {
 		return err
 	}
 
-	return m.sendMail(opts.Email, "Access Code", emailContent.String())
+	return m.sendMail(ctx, opts.Email, "Access Code", emailContent.String())
 }
@@ -86,5 +86,5 @@ func (m *Mail) SendConfirmationEmail(ctx context.Context, opts ConfirmationEmail
 		return err
 	}
 
-	return m.sendMail(opts.Email, "Email Confirmation", emailContent.String())
+	return m.sendMail(ctx, opts.Email, "Email Confirmation", emailContent.String())
 }
@@ -0,0 +1,94 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+)
+
+const dataExportTemplate = `
+<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="UTF-8">
+	<meta http-equiv="X-UA-Compatible" content="IE=edge">
+	<meta name="viewport" content="width=device-width, initial-scale=1.0">
+	<title>Your Data Export</title>
+</head>
+<body>
+	<h1>Your Data Export</h1>
+	<br/>
+	<p>Hello {{.FirstName}} {{.LastName}}</p>
+	<br/>
+	<p>The export of your Kiwi Script data is ready. Please click the link below to download it.</p>
+	<a href="{{.DownloadURL}}">Download Data</a>
+	<p><small>Or copy this link: {{.DownloadURL}}</small></p>
+	<br/>
+	<p>For your security the link expires on {{.ExpiresAt}}, after which the export is deleted.</p>
+	<p>If you did not request this export, please change your password.</p>
+	<br/>
+	<p>Thank you,</p>
+	<p>Kiwi Script Team</p>
+</body>
+`
+
+type dataExportEmailData struct {
+	FirstName   string
+	LastName    string
+	DownloadURL string
+	ExpiresAt   string
+}
+
+type DataExportEmailOptions struct {
+	RequestID   string
+	Email       string
+	FirstName   string
+	LastName    string
+	DownloadURL string
+	ExpiresAt   string
+}
+
+func (m *Mail) SendDataExportEmail(ctx context.Context, opts DataExportEmailOptions) error {
+	log := m.buildLogger(opts.RequestID, "SendDataExportEmail").With(
+		"firstName", opts.FirstName,
+		"lastName", opts.LastName,
+	)
+	log.DebugContext(ctx, "Sending data export email...")
+	t, err := template.New("data_export").Parse(dataExportTemplate)
+
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to parse email template", "error", err)
+		return err
+	}
+
+	data := dataExportEmailData{
+		FirstName:   opts.FirstName,
+		LastName:    opts.LastName,
+		DownloadURL: opts.DownloadURL,
+		ExpiresAt:   opts.ExpiresAt,
+	}
+	var emailContent bytes.Buffer
+	if err := t.Execute(&emailContent, data); err != nil {
+		log.ErrorContext(ctx, "Failed to execute email template", "error", err)
+		return err
+	}
+
+	return m.sendMail(ctx, opts.Email, "Your Data Export", emailContent.String())
+}
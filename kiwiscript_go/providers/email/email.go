@@ -18,8 +18,13 @@
 package email
 
 import (
+	"context"
+	"github.com/kiwiscript/kiwiscript_go/providers/telemetry"
 	"github.com/kiwiscript/kiwiscript_go/utils"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"log/slog"
+	"net"
 	"net/smtp"
 )
 
@@ -43,10 +48,43 @@ func NewMail(log *slog.Logger, username, password, port, host, name, frontendDom
 	}
 }
 
-func (m *Mail) sendMail(to string, subject, body string) error {
+func (m *Mail) sendMail(ctx context.Context, to string, subject, body string) error {
+	_, span := telemetry.StartSpan(ctx, "smtp.SendMail", trace.SpanKindClient, attribute.String("email.subject", subject))
 	addr := m.host + ":" + m.port
 	msg := []byte("To: " + to + "\r\n" + "Subject: " + subject + "\r\n" + body)
-	return smtp.SendMail(addr, m.auth, m.address, []string{to}, msg)
+	err := smtp.SendMail(addr, m.auth, m.address, []string{to}, msg)
+	if err != nil {
+		telemetry.RecordEmailSendFailure(subject)
+	}
+
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// Ping dials the SMTP server and exchanges greetings without sending any mail,
+// the context deadline bounds both the dial and the conversation.
+func (m *Mail) Ping(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.host+":"+m.port)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		return err
+	}
+	if err := client.Hello("localhost"); err != nil {
+		return err
+	}
+	return client.Quit()
 }
 
 func (m *Mail) buildUrl(path, token string) string {
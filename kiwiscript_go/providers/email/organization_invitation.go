@@ -0,0 +1,94 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+)
+
+const organizationInvitationPath = "organizations/invitations"
+
+const organizationInvitationTemplate = `
+<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="UTF-8">
+	<meta http-equiv="X-UA-Compatible" content="IE=edge">
+	<meta name="viewport" content="width=device-width, initial-scale=1.0">
+	<title>Organization Invitation</title>
+</head>
+<body>
+	<h1>Organization Invitation</h1>
+	<br/>
+	<p>Hello,</p>
+	<br/>
+	<p>{{.InviterName}} invited you to join {{.OrganizationName}} on Kiwi Script. Please click the link below to accept the invitation.</p>
+	<a href="{{.InvitationURL}}">Accept Invitation</a>
+	<p><small>Or copy this link: {{.InvitationURL}}</small></p>
+	<br/>
+	<p>The invitation expires on {{.ExpiresAt}}, you need to sign in with this email address to accept it.</p>
+	<br/>
+	<p>Thank you,</p>
+	<p>Kiwi Script Team</p>
+</body>
+`
+
+type organizationInvitationEmailData struct {
+	InviterName      string
+	OrganizationName string
+	InvitationURL    string
+	ExpiresAt        string
+}
+
+type OrganizationInvitationEmailOptions struct {
+	RequestID        string
+	Email            string
+	InviterName      string
+	OrganizationName string
+	InvitationToken  string
+	ExpiresAt        string
+}
+
+func (m *Mail) SendOrganizationInvitationEmail(ctx context.Context, opts OrganizationInvitationEmailOptions) error {
+	log := m.buildLogger(opts.RequestID, "SendOrganizationInvitationEmail").With(
+		"organizationName", opts.OrganizationName,
+	)
+	log.DebugContext(ctx, "Sending organization invitation email...")
+	t, err := template.New("organization_invitation").Parse(organizationInvitationTemplate)
+
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to parse email template", "error", err)
+		return err
+	}
+
+	data := organizationInvitationEmailData{
+		InviterName:      opts.InviterName,
+		OrganizationName: opts.OrganizationName,
+		InvitationURL:    m.buildUrl(organizationInvitationPath, opts.InvitationToken),
+		ExpiresAt:        opts.ExpiresAt,
+	}
+	var emailContent bytes.Buffer
+	if err := t.Execute(&emailContent, data); err != nil {
+		log.ErrorContext(ctx, "Failed to execute email template", "error", err)
+		return err
+	}
+
+	return m.sendMail(ctx, opts.Email, "Organization Invitation", emailContent.String())
+}
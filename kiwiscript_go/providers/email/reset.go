@@ -88,5 +88,5 @@ func (m *Mail) SendResetEmail(ctx context.Context, opts ResetEmailOptions) error
 		return err
 	}
 
-	return m.sendMail(opts.Email, "Password Reset", emailContent.String())
+	return m.sendMail(ctx, opts.Email, "Password Reset", emailContent.String())
 }
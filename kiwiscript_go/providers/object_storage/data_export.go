@@ -0,0 +1,77 @@
+package objstg
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/uuid"
+)
+
+type UploadDataExportOptions struct {
+	RequestID string
+	UserID    int32
+	ExportID  uuid.UUID
+	Body      io.Reader
+}
+
+func (o *ObjectStorage) UploadDataExport(ctx context.Context, opts UploadDataExportOptions) error {
+	log := o.buildLogger(opts.RequestID, "UploadDataExport").With(
+		"userId", opts.UserID,
+		"exportId", opts.ExportID.String(),
+	)
+	log.InfoContext(ctx, "Uploading data export...")
+
+	input := s3.PutObjectInput{
+		Bucket:      aws.String(o.bucket),
+		Key:         aws.String(makeKey(opts.UserID, opts.ExportID, zipExt)),
+		Body:        opts.Body,
+		ContentType: aws.String(zipMime),
+	}
+	if _, err := o.putClient.PutObject(ctx, &input); err != nil {
+		log.ErrorContext(ctx, "Error uploading data export", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+type GetDataExportURLOptions struct {
+	RequestID string
+	UserID    int32
+	ExportID  uuid.UUID
+	ExpiresIn time.Duration
+}
+
+func (o *ObjectStorage) GetDataExportUrl(ctx context.Context, opts GetDataExportURLOptions) (string, error) {
+	log := o.buildLogger(opts.RequestID, "GetDataExportUrl").With(
+		"userId", opts.UserID,
+		"exportId", opts.ExportID.String(),
+	)
+	log.DebugContext(ctx, "Getting data export URL...")
+
+	req, err := o.getClient.PresignGetObject(
+		ctx,
+		&s3.GetObjectInput{
+			Bucket: aws.String(o.bucket),
+			Key:    aws.String(makeKey(opts.UserID, opts.ExportID, zipExt)),
+			ResponseContentDisposition: aws.String(
+				fmt.Sprintf(`attachment; filename="kiwiscript-data-%s.zip"`, opts.ExportID.String()),
+			),
+		},
+		s3.WithPresignExpires(opts.ExpiresIn),
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Error getting data export URL", "error", err)
+		return "", err
+	}
+
+	return req.URL, nil
+}
+
+func (o *ObjectStorage) DeleteDataExport(ctx context.Context, userID int32, exportID uuid.UUID) error {
+	return o.DeleteFile(ctx, userID, exportID, zipExt)
+}
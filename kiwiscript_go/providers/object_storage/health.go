@@ -0,0 +1,15 @@
+package objstg
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func (o *ObjectStorage) Ping(ctx context.Context) error {
+	_, err := o.putClient.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(o.bucket),
+	})
+	return err
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/kiwiscript/kiwiscript_go/utils"
 	"io"
 	"log/slog"
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -123,7 +125,7 @@ func (o *ObjectStorage) GetFileUrl(ctx context.Context, opts GetFileURLOptions)
 			Bucket: aws.String(o.bucket),
 			Key:    aws.String(makeKey(opts.UserID, opts.FileID, opts.FileExt)),
 		},
-		s3.WithPresignExpires(time.Hour*24),
+		s3.WithPresignExpires(time.Hour),
 	)
 	if err != nil {
 		log.ErrorContext(ctx, "Error getting file URL", "error", err)
@@ -132,3 +134,47 @@ func (o *ObjectStorage) GetFileUrl(ctx context.Context, opts GetFileURLOptions)
 
 	return req.URL, nil
 }
+
+type GetFileDownloadURLOptions struct {
+	RequestID string
+	UserID    int32
+	FileID    uuid.UUID
+	FileExt   string
+	FileName  string
+	ExpiresIn time.Duration
+}
+
+// GetFileDownloadUrl presigns a URL that is not cached, it lives for ExpiresIn
+// and makes the browser save the object under the file name.
+func (o *ObjectStorage) GetFileDownloadUrl(ctx context.Context, opts GetFileDownloadURLOptions) (string, error) {
+	log := o.buildLogger(opts.RequestID, "GetFileDownloadUrl").With(
+		"userID", opts.UserID,
+		"fileID", opts.FileID.String(),
+		"fileExt", opts.FileExt,
+		"expiresIn", opts.ExpiresIn,
+	)
+	log.DebugContext(ctx, "Getting file download URL...")
+
+	fileName := opts.FileName
+	if !strings.HasSuffix(strings.ToLower(fileName), "."+opts.FileExt) {
+		fileName += "." + opts.FileExt
+	}
+
+	req, err := o.getClient.PresignGetObject(
+		ctx,
+		&s3.GetObjectInput{
+			Bucket: aws.String(o.bucket),
+			Key:    aws.String(makeKey(opts.UserID, opts.FileID, opts.FileExt)),
+			ResponseContentDisposition: aws.String(
+				mime.FormatMediaType("attachment", map[string]string{"filename": fileName}),
+			),
+		},
+		s3.WithPresignExpires(opts.ExpiresIn),
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Error getting file download URL", "error", err)
+		return "", err
+	}
+
+	return req.URL, nil
+}
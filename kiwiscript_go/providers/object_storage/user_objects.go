@@ -0,0 +1,64 @@
+package objstg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func makeUserPrefix(userId int32) string {
+	return fmt.Sprintf("%d/", userId)
+}
+
+// DeleteUserObjects removes every object stored under the user's key prefix,
+// returning the number of deleted objects.
+func (o *ObjectStorage) DeleteUserObjects(ctx context.Context, requestID string, userID int32) (int, error) {
+	log := o.buildLogger(requestID, "DeleteUserObjects").With("userId", userID)
+	log.InfoContext(ctx, "Deleting user objects...")
+
+	paginator := s3.NewListObjectsV2Paginator(o.putClient, &s3.ListObjectsV2Input{
+		Bucket: aws.String(o.bucket),
+		Prefix: aws.String(makeUserPrefix(userID)),
+	})
+
+	deleted := 0
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			log.ErrorContext(ctx, "Error listing user objects", "error", err)
+			return deleted, err
+		}
+		if len(page.Contents) == 0 {
+			continue
+		}
+
+		objects := make([]types.ObjectIdentifier, len(page.Contents))
+		for i, obj := range page.Contents {
+			objects[i] = types.ObjectIdentifier{Key: obj.Key}
+		}
+
+		output, err := o.putClient.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(o.bucket),
+			Delete: &types.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Error deleting user objects", "error", err)
+			return deleted, err
+		}
+		if len(output.Errors) > 0 {
+			log.ErrorContext(ctx, "Some user objects failed to delete", "errors", len(output.Errors))
+			return deleted, fmt.Errorf("failed to delete %d objects", len(output.Errors))
+		}
+
+		deleted += len(objects)
+	}
+
+	log.InfoContext(ctx, "Deleted user objects", "count", deleted)
+	return deleted, nil
+}
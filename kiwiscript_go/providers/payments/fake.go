@@ -0,0 +1,70 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package payments
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// Fake issues checkout sessions without calling any provider, its webhooks
+// are signed and parsed exactly like Stripe's so the whole flow can be
+// exercised locally.
+type Fake struct {
+	webhookSecret  string
+	frontendDomain string
+	log            *slog.Logger
+}
+
+func newFake(log *slog.Logger, webhookSecret, frontendDomain string) *Fake {
+	return &Fake{
+		webhookSecret:  webhookSecret,
+		frontendDomain: frontendDomain,
+		log:            log,
+	}
+}
+
+func (f *Fake) Name() string {
+	return ProviderFake
+}
+
+func (f *Fake) CreateCheckoutSession(
+	ctx context.Context,
+	opts CreateCheckoutSessionOptions,
+) (*CheckoutSession, error) {
+	log := buildLogger(f.log, opts.RequestID, "payments.fake", "CreateCheckoutSession").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+
+	successURL, _ := checkoutReturnURLs(f.frontendDomain, opts.LanguageSlug, opts.SeriesSlug)
+	sessionID := "cs_fake_" + uuid.NewString()
+
+	log.DebugContext(ctx, "Fake checkout session created", "sessionId", sessionID)
+	return &CheckoutSession{
+		ID:  sessionID,
+		URL: fmt.Sprintf("%s&session_id=%s", successURL, sessionID),
+	}, nil
+}
+
+func (f *Fake) ParseWebhookEvent(payload []byte, signature string) (*WebhookEvent, error) {
+	return parseWebhookEvent(f.webhookSecret, payload, signature)
+}
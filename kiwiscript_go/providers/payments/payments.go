@@ -0,0 +1,213 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package payments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiwiscript/kiwiscript_go/utils"
+)
+
+const (
+	ProviderStripe string = "stripe"
+	ProviderFake   string = "fake"
+
+	EventCheckoutCompleted string = "checkout.session.completed"
+	EventCheckoutExpired   string = "checkout.session.expired"
+
+	PaymentStatusPaid string = "paid"
+
+	HeaderSignature string = "Stripe-Signature"
+
+	signatureTolerance time.Duration = 5 * time.Minute
+)
+
+var (
+	ErrInvalidSignature     = errors.New("invalid webhook signature")
+	ErrMissingWebhookSecret = errors.New("missing webhook secret")
+)
+
+type CreateCheckoutSessionOptions struct {
+	RequestID         string
+	ClientReferenceID string
+	CustomerEmail     string
+	LanguageSlug      string
+	SeriesSlug        string
+	ProductName       string
+	Amount            int32
+	Currency          string
+}
+
+type CheckoutSession struct {
+	ID  string
+	URL string
+}
+
+type WebhookEvent struct {
+	ID            string
+	Type          string
+	SessionID     string
+	PaymentStatus string
+	AmountTotal   int32
+}
+
+// Provider is implemented by every payment provider, checkouts are hosted by
+// the provider and confirmed through its signed webhooks.
+type Provider interface {
+	Name() string
+	CreateCheckoutSession(ctx context.Context, opts CreateCheckoutSessionOptions) (*CheckoutSession, error)
+	ParseWebhookEvent(payload []byte, signature string) (*WebhookEvent, error)
+}
+
+// NewPayments builds the configured provider, the fake one never leaves the
+// process and is meant for development and tests. Every provider needs a
+// webhook secret as unsigned webhooks would complete payments.
+func NewPayments(
+	log *slog.Logger,
+	provider,
+	secretKey,
+	webhookSecret,
+	frontendDomain string,
+) (Provider, error) {
+	if webhookSecret == "" {
+		return nil, ErrMissingWebhookSecret
+	}
+
+	switch provider {
+	case ProviderStripe:
+		if secretKey == "" {
+			return nil, errors.New("missing stripe secret key")
+		}
+		return newStripe(log, secretKey, webhookSecret, frontendDomain), nil
+	case ProviderFake:
+		return newFake(log, webhookSecret, frontendDomain), nil
+	default:
+		return nil, fmt.Errorf("unknown payments provider %q", provider)
+	}
+}
+
+// Sign returns a Stripe-Signature header value for the payload, the timestamp
+// is part of the signed content so replays outside the tolerance are rejected.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, computeSignature(secret, timestamp, payload))
+}
+
+func computeSignature(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func verifySignature(secret string, payload []byte, header string, now time.Time) error {
+	var timestamp int64
+	signatures := make([]string, 0, 1)
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return ErrInvalidSignature
+			}
+			timestamp = parsed
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	if timestamp == 0 || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+	if now.Sub(time.Unix(timestamp, 0)).Abs() > signatureTolerance {
+		return ErrInvalidSignature
+	}
+
+	expected := computeSignature(secret, timestamp, payload)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+type webhookEventPayload struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object struct {
+			ID            string `json:"id"`
+			PaymentStatus string `json:"payment_status"`
+			AmountTotal   int32  `json:"amount_total"`
+		} `json:"object"`
+	} `json:"data"`
+}
+
+func parseWebhookEvent(secret string, payload []byte, signature string) (*WebhookEvent, error) {
+	if secret == "" {
+		return nil, ErrMissingWebhookSecret
+	}
+	if err := verifySignature(secret, payload, signature, time.Now()); err != nil {
+		return nil, err
+	}
+
+	var event webhookEventPayload
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	return &WebhookEvent{
+		ID:            event.ID,
+		Type:          event.Type,
+		SessionID:     event.Data.Object.ID,
+		PaymentStatus: event.Data.Object.PaymentStatus,
+		AmountTotal:   event.Data.Object.AmountTotal,
+	}, nil
+}
+
+func checkoutReturnURLs(frontendDomain, languageSlug, seriesSlug string) (string, string) {
+	seriesURL := fmt.Sprintf("https://%s/languages/%s/series/%s", frontendDomain, languageSlug, seriesSlug)
+	return seriesURL + "?checkout=success", seriesURL + "?checkout=cancel"
+}
+
+func buildLogger(log *slog.Logger, requestID, location, function string) *slog.Logger {
+	return utils.BuildLogger(log, utils.LoggerOptions{
+		Layer:     utils.ProvidersLogLayer,
+		Location:  location,
+		Function:  function,
+		RequestID: requestID,
+	})
+}
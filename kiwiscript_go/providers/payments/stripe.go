@@ -0,0 +1,149 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package payments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	stripeCheckoutSessionsURL string        = "https://api.stripe.com/v1/checkout/sessions"
+	stripeRequestTimeout      time.Duration = 10 * time.Second
+	stripeMaxResponseBody     int64         = 1 << 16
+)
+
+type Stripe struct {
+	client         *http.Client
+	secretKey      string
+	webhookSecret  string
+	frontendDomain string
+	log            *slog.Logger
+}
+
+func newStripe(log *slog.Logger, secretKey, webhookSecret, frontendDomain string) *Stripe {
+	return &Stripe{
+		client:         &http.Client{Timeout: stripeRequestTimeout},
+		secretKey:      secretKey,
+		webhookSecret:  webhookSecret,
+		frontendDomain: frontendDomain,
+		log:            log,
+	}
+}
+
+func (s *Stripe) Name() string {
+	return ProviderStripe
+}
+
+type stripeCheckoutSessionResponse struct {
+	ID    string `json:"id"`
+	URL   string `json:"url"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (s *Stripe) CreateCheckoutSession(
+	ctx context.Context,
+	opts CreateCheckoutSessionOptions,
+) (*CheckoutSession, error) {
+	log := buildLogger(s.log, opts.RequestID, "payments.stripe", "CreateCheckoutSession").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.DebugContext(ctx, "Creating checkout session...")
+
+	successURL, cancelURL := checkoutReturnURLs(s.frontendDomain, opts.LanguageSlug, opts.SeriesSlug)
+	form := url.Values{}
+	form.Set("mode", "payment")
+	form.Set("success_url", successURL)
+	form.Set("cancel_url", cancelURL)
+	form.Set("client_reference_id", opts.ClientReferenceID)
+	form.Set("customer_email", opts.CustomerEmail)
+	form.Set("line_items[0][quantity]", "1")
+	form.Set("line_items[0][price_data][currency]", opts.Currency)
+	form.Set("line_items[0][price_data][unit_amount]", strconv.Itoa(int(opts.Amount)))
+	form.Set("line_items[0][price_data][product_data][name]", opts.ProductName)
+	form.Set("metadata[language_slug]", opts.LanguageSlug)
+	form.Set("metadata[series_slug]", opts.SeriesSlug)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		stripeCheckoutSessionsURL,
+		strings.NewReader(form.Encode()),
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to build checkout session request", "error", err)
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+s.secretKey)
+	req.Header.Set("Idempotency-Key", opts.ClientReferenceID)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to request checkout session", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.ErrorContext(ctx, "Failed to close response body", "error", err)
+		}
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, stripeMaxResponseBody))
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to read checkout session response", "error", err)
+		return nil, err
+	}
+
+	var session stripeCheckoutSessionResponse
+	if err := json.Unmarshal(body, &session); err != nil {
+		log.ErrorContext(ctx, "Failed to parse checkout session response", "error", err)
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		message := "unknown error"
+		if session.Error != nil {
+			message = session.Error.Message
+		}
+
+		log.ErrorContext(ctx, "Checkout session request failed", "status", res.StatusCode, "message", message)
+		return nil, fmt.Errorf("checkout session request failed with status %d: %s", res.StatusCode, message)
+	}
+
+	log.DebugContext(ctx, "Checkout session created", "sessionId", session.ID)
+	return &CheckoutSession{
+		ID:  session.ID,
+		URL: session.URL,
+	}, nil
+}
+
+func (s *Stripe) ParseWebhookEvent(payload []byte, signature string) (*WebhookEvent, error) {
+	return parseWebhookEvent(s.webhookSecret, payload, signature)
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package telemetry
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FiberMiddleware starts the server span of each request, tags it with the request ID
+// stored under requestIDKey and records the request latency per route.
+func FiberMiddleware(requestIDKey string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		carrier := propagation.MapCarrier{}
+		for key, values := range c.GetReqHeaders() {
+			if len(values) > 0 {
+				carrier.Set(key, values[0])
+			}
+		}
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), carrier)
+
+		if requestID, ok := c.Locals(requestIDKey).(string); ok {
+			ctx = WithRequestID(ctx, requestID)
+		}
+
+		method := c.Method()
+		ctx, span := StartSpan(
+			ctx,
+			method,
+			trace.SpanKindServer,
+			semconv.HTTPRequestMethodKey.String(method),
+			semconv.URLPath(c.Path()),
+		)
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+		if err != nil {
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+		route := c.Route().Path
+		span.SetName(method + " " + route)
+		span.SetAttributes(
+			semconv.HTTPRoute(route),
+			semconv.HTTPResponseStatusCode(status),
+		)
+		EndSpan(span, err)
+		ObserveHTTPRequest(method, route, status, time.Since(start))
+		return nil
+	}
+}
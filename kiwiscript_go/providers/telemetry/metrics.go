@@ -0,0 +1,150 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package telemetry
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace string = "kiwiscript"
+
+const (
+	CacheResultHit   string = "hit"
+	CacheResultMiss  string = "miss"
+	CacheResultError string = "error"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	httpRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by route.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "route", "status"},
+	)
+	cacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "cache",
+			Name:      "requests_total",
+			Help:      "Cache lookups by operation and result (hit, miss or error).",
+		},
+		[]string{"operation", "result"},
+	)
+	emailSendFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "email",
+			Name:      "send_failures_total",
+			Help:      "Emails that failed to be sent by subject.",
+		},
+		[]string{"subject"},
+	)
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		cacheRequests,
+		emailSendFailures,
+	)
+}
+
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func RecordCacheResult(operation, result string) {
+	cacheRequests.WithLabelValues(operation, result).Inc()
+}
+
+func RecordEmailSendFailure(subject string) {
+	emailSendFailures.WithLabelValues(subject).Inc()
+}
+
+type dbPoolCollector struct {
+	pool                 *pgxpool.Pool
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+}
+
+func newDBPoolDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "db_pool", name), help, nil, nil)
+}
+
+// RegisterDBPoolCollector exposes the pgx pool statistics, it must only be called once per pool.
+func RegisterDBPoolCollector(pool *pgxpool.Pool) error {
+	return registry.Register(&dbPoolCollector{
+		pool:                 pool,
+		acquiredConns:        newDBPoolDesc("acquired_conns", "Connections currently in use."),
+		idleConns:            newDBPoolDesc("idle_conns", "Idle connections in the pool."),
+		totalConns:           newDBPoolDesc("total_conns", "Total connections in the pool."),
+		maxConns:             newDBPoolDesc("max_conns", "Maximum size of the pool."),
+		acquireCount:         newDBPoolDesc("acquire_total", "Successful connection acquisitions."),
+		acquireDuration:      newDBPoolDesc("acquire_duration_seconds_total", "Time spent acquiring connections."),
+		canceledAcquireCount: newDBPoolDesc("canceled_acquire_total", "Acquisitions canceled by the context."),
+		emptyAcquireCount:    newDBPoolDesc("empty_acquire_total", "Acquisitions that waited for a connection."),
+	})
+}
+
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.canceledAcquireCount
+	ch <- c.emptyAcquireCount
+}
+
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package telemetry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const sqlcNamePrefix string = "-- name: "
+
+// QueryTracer creates a span for every pgx query, named after the sqlc query.
+type QueryTracer struct{}
+
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{}
+}
+
+func queryName(sql string) string {
+	if !strings.HasPrefix(sql, sqlcNamePrefix) {
+		return "db.query"
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(sql, sqlcNamePrefix))
+	if len(fields) == 0 {
+		return "db.query"
+	}
+	return "db." + fields[0]
+}
+
+func (t *QueryTracer) TraceQueryStart(
+	ctx context.Context,
+	_ *pgx.Conn,
+	data pgx.TraceQueryStartData,
+) context.Context {
+	ctx, _ = StartSpan(
+		ctx,
+		queryName(data.SQL),
+		trace.SpanKindClient,
+		semconv.DBSystemPostgreSQL,
+		semconv.DBQueryText(data.SQL),
+	)
+	return ctx
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err == pgx.ErrNoRows {
+		span.End()
+		return
+	}
+
+	EndSpan(span, data.Err)
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kiwiscript/kiwiscript_go/utils"
+)
+
+const (
+	EventCertificateIssued string = "certificate.issued"
+	EventSeriesPublished   string = "series.published"
+	EventUserRegistered    string = "user.registered"
+
+	HeaderEvent     string = "X-KiwiScript-Event"
+	HeaderDelivery  string = "X-KiwiScript-Delivery"
+	HeaderTimestamp string = "X-KiwiScript-Timestamp"
+	HeaderSignature string = "X-KiwiScript-Signature"
+
+	requestTimeout  time.Duration = 10 * time.Second
+	maxResponseBody int64         = 2048
+)
+
+type Webhooks struct {
+	client *http.Client
+	log    *slog.Logger
+}
+
+func NewWebhooks(log *slog.Logger) *Webhooks {
+	return &Webhooks{
+		client: &http.Client{Timeout: requestTimeout},
+		log:    log,
+	}
+}
+
+// Sign returns the HMAC-SHA256 of "<timestamp>.<payload>" keyed with the webhook secret,
+// receivers must recompute it with the X-KiwiScript-Timestamp header and the raw body.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type DeliverOptions struct {
+	RequestID  string
+	URL        string
+	Secret     string
+	DeliveryID string
+	Event      string
+	Payload    []byte
+}
+
+type DeliverResult struct {
+	Status int
+	Body   string
+}
+
+func (w *Webhooks) Deliver(ctx context.Context, opts DeliverOptions) (*DeliverResult, error) {
+	log := w.buildLogger(opts.RequestID, "Deliver").With(
+		"deliveryId", opts.DeliveryID,
+		"event", opts.Event,
+	)
+	log.DebugContext(ctx, "Delivering webhook...")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, opts.URL, bytes.NewReader(opts.Payload))
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to build webhook request", "error", err)
+		return nil, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "KiwiScript-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, opts.Event)
+	req.Header.Set(HeaderDelivery, opts.DeliveryID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(opts.Secret, timestamp, opts.Payload))
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		log.WarnContext(ctx, "Failed to send webhook request", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			log.ErrorContext(ctx, "Failed to close response body", "error", err)
+		}
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseBody))
+	if err != nil {
+		log.WarnContext(ctx, "Failed to read webhook response body", "error", err)
+	}
+
+	log.DebugContext(ctx, "Webhook delivered", "status", res.StatusCode)
+	return &DeliverResult{
+		Status: res.StatusCode,
+		Body:   string(body),
+	}, nil
+}
+
+func (w *Webhooks) buildLogger(requestID, function string) *slog.Logger {
+	return utils.BuildLogger(w.log, utils.LoggerOptions{
+		Layer:     utils.ProvidersLogLayer,
+		Location:  "webhooks",
+		Function:  function,
+		RequestID: requestID,
+	})
+}
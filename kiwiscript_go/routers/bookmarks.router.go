@@ -0,0 +1,43 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import "github.com/kiwiscript/kiwiscript_go/paths"
+
+const (
+	seriesBookmarkPath = seriesPath + "/:seriesSlug" + paths.BookmarkPath
+	lessonBookmarkPath = lessonsPath + "/:lessonID" + paths.BookmarkPath
+)
+
+func (r *Router) BookmarksPrivateRoutes() {
+	seriesBookmark := r.router.Group(
+		seriesBookmarkPath,
+		r.controllers.UserMiddleware,
+	)
+
+	seriesBookmark.Post("/", r.controllers.CreateSeriesBookmark)
+	seriesBookmark.Delete("/", r.controllers.DeleteSeriesBookmark)
+
+	lessonBookmark := r.router.Group(
+		lessonBookmarkPath,
+		r.controllers.UserMiddleware,
+	)
+
+	lessonBookmark.Post("/", r.controllers.CreateLessonBookmark)
+	lessonBookmark.Delete("/", r.controllers.DeleteLessonBookmark)
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import "github.com/kiwiscript/kiwiscript_go/paths"
+
+const seriesEnrollmentPath = seriesPath + "/:seriesSlug"
+
+func (r *Router) PaymentsPublicRoutes() {
+	payments := r.router.Group(paths.PaymentsV1)
+
+	payments.Post(paths.WebhookPath, r.controllers.HandlePaymentWebhook)
+}
+
+func (r *Router) EnrollmentsPrivateRoutes() {
+	enrollments := r.router.Group(
+		seriesEnrollmentPath,
+		r.controllers.UserMiddleware,
+	)
+
+	enrollments.Get(paths.EnrollmentPath, r.controllers.GetEnrollment)
+	enrollments.Post(paths.EnrollmentPath, r.controllers.CreateEnrollment)
+	enrollments.Post(paths.CheckoutPath, r.controllers.CreateCheckout)
+}
@@ -23,4 +23,6 @@ func (r *Router) HealthRoutes() {
 	health := r.router.Group(paths.HealthPath)
 
 	health.Get("/", r.controllers.HealthCheck)
+	health.Get(paths.LivePath, r.controllers.LivenessCheck)
+	health.Get(paths.ReadyPath, r.controllers.ReadinessCheck)
 }
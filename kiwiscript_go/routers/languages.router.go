@@ -22,7 +22,7 @@ import "github.com/kiwiscript/kiwiscript_go/paths"
 func (r *Router) LanguagePublicRoutes() {
 	languages := r.router.Group(paths.LanguagePathV1)
 
-	languages.Get("/", r.controllers.GetLanguages)
+	languages.Get("/", r.controllers.CatalogCacheMiddleware(languagesCachePolicy), r.controllers.GetLanguages)
 	languages.Get("/:languageSlug", r.controllers.GetLanguage)
 }
 
@@ -36,4 +36,5 @@ func (r *Router) LanguageAdminRoutes() {
 	languages.Post("/", r.controllers.CreateLanguage)
 	languages.Put("/:languageSlug", r.controllers.UpdateLanguage)
 	languages.Delete("/:languageSlug", r.controllers.DeleteLanguage)
+	languages.Patch("/:languageSlug"+paths.EditorialReviewPath, r.controllers.UpdateLanguageRequiresReview)
 }
@@ -32,7 +32,7 @@ const lessonArticlePath = paths.LanguagePathV1 +
 func (r *Router) LessonArticlePublicRoutes() {
 	lessonArticle := r.router.Group(lessonArticlePath)
 
-	lessonArticle.Get("/", r.controllers.GetLessonArticle)
+	lessonArticle.Get("/", r.controllers.CatalogCacheMiddleware(lessonArticleCachePolicy), r.controllers.GetLessonArticle)
 }
 
 func (r *Router) LessonArticleStaffRoutes() {
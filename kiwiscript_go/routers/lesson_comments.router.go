@@ -0,0 +1,55 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import "github.com/kiwiscript/kiwiscript_go/paths"
+
+const lessonCommentsPath = lessonsPath + "/:lessonID" + paths.CommentsPath
+
+func (r *Router) LessonCommentsPublicRoutes() {
+	lessonComments := r.router.Group(lessonCommentsPath)
+
+	lessonComments.Get("/", r.controllers.GetLessonComments)
+	lessonComments.Get("/:commentID", r.controllers.GetLessonComment)
+	lessonComments.Get("/:commentID"+paths.RepliesPath, r.controllers.GetLessonCommentReplies)
+}
+
+func (r *Router) LessonCommentsPrivateRoutes() {
+	lessonComments := r.router.Group(
+		lessonCommentsPath,
+		r.controllers.UserMiddleware,
+	)
+
+	lessonComments.Post("/", r.controllers.CreateLessonComment)
+	lessonComments.Put("/:commentID", r.controllers.UpdateLessonComment)
+	lessonComments.Delete("/:commentID", r.controllers.DeleteLessonComment)
+	lessonComments.Post("/:commentID"+paths.RepliesPath, r.controllers.CreateLessonCommentReply)
+	lessonComments.Post("/:commentID"+paths.UpvotePath, r.controllers.UpvoteLessonComment)
+	lessonComments.Delete("/:commentID"+paths.UpvotePath, r.controllers.RemoveLessonCommentUpvote)
+}
+
+func (r *Router) LessonCommentsStaffRoutes() {
+	lessonComments := r.router.Group(
+		lessonCommentsPath,
+		r.controllers.StaffUserMiddleware,
+	)
+
+	lessonComments.Patch("/:commentID"+paths.AnswerPath, r.controllers.UpdateLessonCommentIsAnswer)
+	lessonComments.Patch("/:commentID"+paths.HidePath, r.controllers.UpdateLessonCommentIsHidden)
+	lessonComments.Patch("/:commentID"+paths.LockPath, r.controllers.UpdateLessonCommentIsLocked)
+}
@@ -17,6 +17,7 @@ func (r *Router) LessonFilesPublicRoutes() {
 
 	lessonFiles.Get("/", r.controllers.GetLessonFiles)
 	lessonFiles.Get("/:fileID", r.controllers.GetLessonFile)
+	lessonFiles.Post("/:fileID"+paths.DownloadPath, r.controllers.DownloadLessonFile)
 }
 
 func (r *Router) LessonFilesStaffRoutes() {
@@ -0,0 +1,46 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import "github.com/kiwiscript/kiwiscript_go/paths"
+
+const (
+	lessonNotesPath = lessonsPath + "/:lessonID" + paths.NotesPath
+	seriesNotesPath = seriesPath + "/:seriesSlug" + paths.NotesPath
+)
+
+func (r *Router) LessonNotesPrivateRoutes() {
+	lessonNotes := r.router.Group(
+		lessonNotesPath,
+		r.controllers.UserMiddleware,
+	)
+
+	lessonNotes.Get("/", r.controllers.GetLessonNotes)
+	lessonNotes.Post("/", r.controllers.CreateLessonNote)
+	lessonNotes.Get("/:noteID", r.controllers.GetLessonNote)
+	lessonNotes.Put("/:noteID", r.controllers.UpdateLessonNote)
+	lessonNotes.Delete("/:noteID", r.controllers.DeleteLessonNote)
+
+	seriesNotes := r.router.Group(
+		seriesNotesPath,
+		r.controllers.UserMiddleware,
+	)
+
+	seriesNotes.Get("/", r.controllers.GetSeriesLessonNotes)
+	seriesNotes.Get(paths.ExportPath, r.controllers.ExportSeriesLessonNotes)
+}
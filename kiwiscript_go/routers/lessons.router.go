@@ -30,7 +30,7 @@ const lessonsPath = paths.LanguagePathV1 +
 func (r *Router) LessonsPublicRoutes() {
 	lessons := r.router.Group(lessonsPath)
 
-	lessons.Get("/", r.controllers.GetLessons)
+	lessons.Get("/", r.controllers.CatalogCacheMiddleware(catalogListCachePolicy), r.controllers.GetLessons)
 	lessons.Get("/:lessonID", r.controllers.GetLesson)
 }
 
@@ -42,7 +42,10 @@ func (r *Router) LessonsStaffRoutes() {
 	)
 
 	lessons.Post("/", r.controllers.CreateLesson)
+	lessons.Patch("/order", r.controllers.ReorderLessons)
 	lessons.Put("/:lessonID", r.controllers.UpdateLesson)
 	lessons.Delete("/:lessonID", r.controllers.DeleteLesson)
 	lessons.Patch("/:lessonID/publish", r.controllers.UpdateLessonIsPublished)
+	lessons.Put("/:lessonID"+paths.SchedulePath, r.controllers.UpdateLessonSchedule)
+	lessons.Patch("/:lessonID"+paths.PreviewPath, r.controllers.UpdateLessonIsPreview)
 }
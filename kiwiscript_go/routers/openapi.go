@@ -0,0 +1,1132 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/openapi"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+)
+
+const (
+	authTag              string = "Auth"
+	bookmarksTag         string = "Bookmarks"
+	certificatesTag      string = "Certificates"
+	cohortsTag           string = "Cohorts"
+	couponsTag           string = "Coupons"
+	docsTag              string = "Docs"
+	editorialTag         string = "Editorial"
+	enrollmentsTag       string = "Enrollments"
+	healthTag            string = "Health"
+	languageProgressTag  string = "Language Progress"
+	languagesTag         string = "Languages"
+	lessonArticlesTag    string = "Lesson Articles"
+	lessonCommentsTag    string = "Lesson Comments"
+	lessonEventsTag      string = "Lesson Events"
+	lessonFilesTag       string = "Lesson Files"
+	lessonNotesTag       string = "Lesson Notes"
+	lessonProgressTag    string = "Lesson Progress"
+	lessonVideosTag      string = "Lesson Videos"
+	lessonsTag           string = "Lessons"
+	oauthTag             string = "OAuth"
+	organizationsTag     string = "Organizations"
+	paymentsTag          string = "Payments"
+	scheduledReleasesTag string = "Scheduled Releases"
+	sectionProgressTag   string = "Section Progress"
+	sectionsTag          string = "Sections"
+	seriesTag            string = "Series"
+	seriesAnalyticsTag   string = "Series Analytics"
+	seriesPicturesTag    string = "Series Pictures"
+	seriesProgressTag    string = "Series Progress"
+	seriesReviewsTag     string = "Series Reviews"
+	seriesVersionsTag    string = "Series Versions"
+	usersTag             string = "Users"
+	webhooksTag          string = "Webhooks"
+)
+
+const cursorPaginationDescription string = "Passing the cursor query parameter, even empty for the first page, " +
+	"switches to keyset pagination: offset is ignored, the links carry opaque cursors and count is only " +
+	"returned when withCount is true."
+
+const editorialDescription string = "Open to the series author and its reviewers. Authors submit for review " +
+	"and move back to draft, reviewers approve or request changes with a comment."
+
+const scheduleDescription string = "Replaces the whole schedule, dates are RFC 3339 and must be in the future, " +
+	"an empty date clears that side. Due transitions are performed every minute by the scheduler."
+
+const versionedDescription string = "The If-Match header must hold the ETag of the last read, a missing header or * returns a 428. " +
+	"When the resource changed in the meantime a 412 is returned with its current representation and ETag."
+
+const analyticsDescription string = "Open to the series author. Dates are inclusive UTC days and default to the " +
+	"last 30 days, ranges are capped at 366 days. Counts come from aggregates refreshed every 15 minutes."
+
+const lessonAccessDescription string = "Preview lessons are served to everyone. Other lessons of members only " +
+	"series answer anonymous visitors with a 403 SignInRequired error carrying the lesson teaser, and the ones " +
+	"of paid series are only served to their author and enrolled users, anyone else gets a 402."
+
+// operations documents every routed handler by name, paths, path parameters
+// and security are read from the routes themselves when building the document.
+var operations = map[string]openapi.Operation{
+	"SignUp": {
+		Tags:      []string{authTag},
+		Body:      dtos.SignUpBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.MessageResponse{}}},
+	},
+	"ConfirmEmail": {
+		Tags:      []string{authTag},
+		Body:      dtos.ConfirmBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.AuthResponse{}}},
+	},
+	"SignIn": {
+		Tags:      []string{authTag},
+		Body:      dtos.SignInBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.MessageResponse{}}},
+	},
+	"ConfirmSignIn": {
+		Tags:      []string{authTag},
+		Body:      dtos.ConfirmSignInBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.AuthResponse{}}},
+	},
+	"Refresh": {
+		Tags:      []string{authTag},
+		Body:      dtos.RefreshBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.AuthResponse{}}},
+	},
+	"ForgotPassword": {
+		Tags:      []string{authTag},
+		Body:      dtos.ForgotPasswordBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.MessageResponse{}}},
+	},
+	"ResetPassword": {
+		Tags:      []string{authTag},
+		Body:      dtos.ResetPasswordBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.MessageResponse{}}},
+	},
+	"SignOut": {
+		Tags:      []string{authTag},
+		Body:      dtos.SignOutBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"UpdatePassword": {
+		Tags:      []string{authTag},
+		Body:      dtos.UpdatePasswordBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.AuthResponse{}}},
+	},
+	"UpdateEmail": {
+		Tags:      []string{authTag},
+		Body:      dtos.UpdateEmailBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.AuthResponse{}}},
+	},
+	"CreateSeriesBookmark": {
+		Tags:      []string{bookmarksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.SeriesBookmarkResponse{}}},
+	},
+	"DeleteSeriesBookmark": {
+		Tags:      []string{bookmarksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"CreateLessonBookmark": {
+		Tags:      []string{bookmarksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonBookmarkResponse{}}},
+	},
+	"DeleteLessonBookmark": {
+		Tags:      []string{bookmarksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetCertificate": {
+		Tags:      []string{certificatesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.CertificateResponse{}}},
+	},
+	"GetUserCertificates": {
+		Tags:      []string{certificatesTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.CertificateResponse]{}}},
+	},
+	"CreateCohort": {
+		Tags:      []string{cohortsTag},
+		Body:      dtos.CohortBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.CohortResponse{}}},
+	},
+	"GetCohorts": {
+		Tags:      []string{cohortsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.CohortResponse]{}}},
+	},
+	"AddCohortMember": {
+		Description: "The user needs to be a member of the organization.",
+		Tags:        []string{cohortsTag},
+		Body:        dtos.CohortMemberBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"RemoveCohortMember": {
+		Tags:      []string{cohortsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"AssignCohortSeries": {
+		Description: "Assigns a published series to the cohort or updates its due date, " +
+			"the due date is RFC 3339 and an empty one means no deadline. " +
+			"Paid series need an organization license with a seat for every member.",
+		Tags:      []string{cohortsTag},
+		Body:      dtos.CohortSeriesBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.CohortSeriesResponse{}}},
+	},
+	"UnassignCohortSeries": {
+		Tags:      []string{cohortsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetCohortProgress": {
+		Description: "One row per cohort member and assigned series, members that never started a series " +
+			"have zeroed counters.",
+		Tags:      []string{cohortsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: []dtos.CohortProgressResponse{}}},
+	},
+	"ExportCohortProgress": {
+		Tags:      []string{cohortsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, ContentType: "text/csv", Body: ""}},
+	},
+	"CreateCoupon": {
+		Description: "Codes are stored in upper case, a coupon without series applies to every paid series.",
+		Tags:        []string{couponsTag},
+		Body:        dtos.CouponBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.CouponResponse{}}},
+	},
+	"GetCoupons": {
+		Tags:      []string{couponsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.CouponResponse]{}}},
+	},
+	"UpdateCouponIsActive": {
+		Tags:      []string{couponsTag},
+		Body:      dtos.CouponIsActiveBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.CouponResponse{}}},
+	},
+	"GetSeriesEditorial": {
+		Description: editorialDescription,
+		Tags:        []string{editorialTag},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesEditorialResponse{}}},
+	},
+	"UpdateSeriesEditorialStatus": {
+		Description: editorialDescription,
+		Tags:        []string{editorialTag},
+		Body:        dtos.SeriesEditorialStatusBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesEditorialResponse{}}},
+	},
+	"AddSeriesEditorialReviewer": {
+		Description: "Only the series author can assign reviewers, who must be staff.",
+		Tags:        []string{editorialTag},
+		Body:        dtos.SeriesEditorialReviewerBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.SeriesEditorialReviewerResponse{}}},
+	},
+	"RemoveSeriesEditorialReviewer": {
+		Tags:      []string{editorialTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetLessonEditorialComments": {
+		Description: editorialDescription,
+		Tags:        []string{editorialTag},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: []dtos.LessonEditorialCommentResponse{}}},
+	},
+	"CreateLessonEditorialComment": {
+		Description: editorialDescription,
+		Tags:        []string{editorialTag},
+		Body:        dtos.LessonEditorialCommentBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonEditorialCommentResponse{}}},
+	},
+	"UpdateLessonEditorialCommentIsResolved": {
+		Description: editorialDescription,
+		Tags:        []string{editorialTag},
+		Body:        dtos.LessonEditorialCommentIsResolvedBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonEditorialCommentResponse{}}},
+	},
+	"GetEnrollment": {
+		Tags:      []string{enrollmentsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.EnrollmentResponse{}}},
+	},
+	"CreateEnrollment": {
+		Description: "Free series enroll anyone, paid series only enroll members of a cohort they were assigned to.",
+		Tags:        []string{enrollmentsTag},
+		Responses:   []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.EnrollmentResponse{}}},
+	},
+	"CreateCheckout": {
+		Description: "Opens a checkout session with the payment provider, the enrollment is created once it " +
+			"confirms the payment. A coupon covering the whole price enrolls the user straight away.",
+		Tags:      []string{enrollmentsTag},
+		Body:      dtos.CheckoutBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.CheckoutResponse{}}},
+	},
+	"HandlePaymentWebhook": {
+		Description: "Receives the payment provider events, signed in the Stripe-Signature header.",
+		Tags:        []string{paymentsTag},
+		Responses:   []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"HealthCheck": {
+		Tags:      []string{healthTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK}},
+	},
+	"LivenessCheck": {
+		Tags:      []string{healthTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.HealthResponse{}}},
+	},
+	"ReadinessCheck": {
+		Tags: []string{healthTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.HealthResponse{}},
+			{Status: fiber.StatusServiceUnavailable, Body: dtos.HealthResponse{}},
+		},
+	},
+	"GetViewedLanguages": {
+		Tags:      []string{languageProgressTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LanguageResponse]{}}},
+	},
+	"CreateOrUpdateLanguageProgress": {
+		Tags: []string{languageProgressTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusCreated, Body: dtos.LanguageResponse{}},
+			{Status: fiber.StatusOK, Body: dtos.LanguageResponse{}},
+		},
+	},
+	"ResetLanguageProgress": {
+		Tags:      []string{languageProgressTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetLanguages": {
+		Tags:  []string{languagesTag},
+		Query: dtos.GetLanguagesQueryParams{},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LanguageResponse]{}},
+			{Status: fiber.StatusNotModified},
+		},
+	},
+	"GetLanguage": {
+		Tags:      []string{languagesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LanguageResponse{}}},
+	},
+	"CreateLanguage": {
+		Tags:      []string{languagesTag},
+		Body:      dtos.LanguageBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LanguageResponse{}}},
+	},
+	"UpdateLanguage": {
+		Tags:      []string{languagesTag},
+		Body:      dtos.LanguageBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LanguageResponse{}}},
+	},
+	"UpdateLanguageRequiresReview": {
+		Description: "When enabled, series of the language must be approved through the editorial workflow before being published.",
+		Tags:        []string{languagesTag},
+		Body:        dtos.LanguageRequiresReviewBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LanguageResponse{}}},
+	},
+	"DeleteLanguage": {
+		Tags:      []string{languagesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetLessonArticle": {
+		Description: lessonAccessDescription,
+		Tags:        []string{lessonArticlesTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonArticleResponse{}},
+			{Status: fiber.StatusNotModified},
+			{Status: fiber.StatusForbidden, Body: dtos.SignInRequiredResponse{}},
+		},
+	},
+	"CreateLessonArticle": {
+		Tags:      []string{lessonArticlesTag},
+		Body:      dtos.LessonArticleBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonArticleResponse{}}},
+	},
+	"UpdateLessonArticle": {
+		Description: versionedDescription,
+		Tags:        []string{lessonArticlesTag},
+		Body:        dtos.LessonArticleBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonArticleResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.LessonArticleResponse{}},
+		},
+	},
+	"DeleteLessonArticle": {
+		Description: versionedDescription,
+		Tags:        []string{lessonArticlesTag},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusNoContent},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.LessonArticleResponse{}},
+		},
+	},
+	"GetLessonComments": {
+		Tags:      []string{lessonCommentsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LessonCommentResponse]{}}},
+	},
+	"GetLessonComment": {
+		Tags:      []string{lessonCommentsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonCommentResponse{}}},
+	},
+	"GetLessonCommentReplies": {
+		Tags:      []string{lessonCommentsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LessonCommentResponse]{}}},
+	},
+	"CreateLessonComment": {
+		Tags:      []string{lessonCommentsTag},
+		Body:      dtos.LessonCommentBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonCommentResponse{}}},
+	},
+	"UpdateLessonComment": {
+		Tags:      []string{lessonCommentsTag},
+		Body:      dtos.LessonCommentBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonCommentResponse{}}},
+	},
+	"DeleteLessonComment": {
+		Tags:      []string{lessonCommentsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"CreateLessonCommentReply": {
+		Tags:      []string{lessonCommentsTag},
+		Body:      dtos.LessonCommentBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonCommentResponse{}}},
+	},
+	"UpvoteLessonComment": {
+		Tags:      []string{lessonCommentsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonCommentResponse{}}},
+	},
+	"RemoveLessonCommentUpvote": {
+		Tags:      []string{lessonCommentsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonCommentResponse{}}},
+	},
+	"UpdateLessonCommentIsAnswer": {
+		Tags:      []string{lessonCommentsTag},
+		Body:      dtos.LessonCommentIsAnswerBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonCommentResponse{}}},
+	},
+	"UpdateLessonCommentIsHidden": {
+		Tags:      []string{lessonCommentsTag},
+		Body:      dtos.LessonCommentIsHiddenBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonCommentResponse{}}},
+	},
+	"UpdateLessonCommentIsLocked": {
+		Tags:      []string{lessonCommentsTag},
+		Body:      dtos.LessonCommentIsLockedBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonCommentResponse{}}},
+	},
+	"CreateLessonEvents": {
+		Description: "Accepts up to 100 events that occurred in the last 24 hours on a lesson with progress, " +
+			"events are deduplicated by their ID. Time on task and engagement rollups are computed hourly.",
+		Tags:      []string{lessonEventsTag},
+		Body:      dtos.LessonEventsBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusAccepted, Body: dtos.LessonEventsResponse{}}},
+	},
+	"GetLessonFiles": {
+		Description: lessonAccessDescription,
+		Tags:        []string{lessonFilesTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: []dtos.LessonFileResponse{}},
+			{Status: fiber.StatusForbidden, Body: dtos.SignInRequiredResponse{}},
+		},
+	},
+	"GetLessonFile": {
+		Description: lessonAccessDescription + " The file URL is its download endpoint, the object itself is " +
+			"only reachable through a short lived URL issued by it.",
+		Tags: []string{lessonFilesTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonFileResponse{}},
+			{Status: fiber.StatusForbidden, Body: dtos.SignInRequiredResponse{}},
+		},
+	},
+	"DownloadLessonFile": {
+		Description: lessonAccessDescription + " The URL expires after 5 minutes and every call counts as a " +
+			"download of the file, staff downloads are not counted.",
+		Tags: []string{lessonFilesTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonFileDownloadResponse{}},
+			{Status: fiber.StatusForbidden, Body: dtos.SignInRequiredResponse{}},
+		},
+	},
+	"UploadLessonFile": {
+		Tags:      []string{lessonFilesTag},
+		Multipart: true,
+		Body:      dtos.LessonFileBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonFileResponse{}}},
+	},
+	"UpdateLessonFile": {
+		Tags:      []string{lessonFilesTag},
+		Body:      dtos.LessonFileBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonFileResponse{}}},
+	},
+	"DeleteLessonFile": {
+		Tags:      []string{lessonFilesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetLessonNotes": {
+		Tags:      []string{lessonNotesTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LessonNoteResponse]{}}},
+	},
+	"CreateLessonNote": {
+		Tags:      []string{lessonNotesTag},
+		Body:      dtos.LessonNoteBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonNoteResponse{}}},
+	},
+	"GetLessonNote": {
+		Tags:      []string{lessonNotesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonNoteResponse{}}},
+	},
+	"UpdateLessonNote": {
+		Tags:      []string{lessonNotesTag},
+		Body:      dtos.LessonNoteBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonNoteResponse{}}},
+	},
+	"DeleteLessonNote": {
+		Tags:      []string{lessonNotesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetSeriesLessonNotes": {
+		Tags:      []string{lessonNotesTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LessonNoteResponse]{}}},
+	},
+	"ExportSeriesLessonNotes": {
+		Tags:      []string{lessonNotesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, ContentType: "text/markdown", Body: ""}},
+	},
+	"GetCurrentLesson": {
+		Tags:      []string{lessonProgressTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonResponse{}}},
+	},
+	"CreateOrUpdateLessonProgress": {
+		Tags: []string{lessonProgressTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusCreated, Body: dtos.LessonResponse{}},
+			{Status: fiber.StatusOK, Body: dtos.LessonResponse{}},
+		},
+	},
+	"ResetLessonProgress": {
+		Tags:      []string{lessonProgressTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"CompleteLessonProgress": {
+		Tags:      []string{lessonProgressTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonResponse{}}},
+	},
+	"GetLessonVideo": {
+		Description: lessonAccessDescription,
+		Tags:        []string{lessonVideosTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonVideoResponse{}},
+			{Status: fiber.StatusForbidden, Body: dtos.SignInRequiredResponse{}},
+		},
+	},
+	"CreateLessonVideo": {
+		Tags:      []string{lessonVideosTag},
+		Body:      dtos.LessonVideoBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonVideoResponse{}}},
+	},
+	"UpdateLessonVideo": {
+		Tags:      []string{lessonVideosTag},
+		Body:      dtos.LessonVideoBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LessonVideoResponse{}}},
+	},
+	"DeleteLessonVideo": {
+		Tags:      []string{lessonVideosTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetLessons": {
+		Description: cursorPaginationDescription,
+		Tags:        []string{lessonsTag},
+		Query:       dtos.CursorPaginationQueryParams{},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LessonResponse]{}},
+			{Status: fiber.StatusNotModified},
+		},
+	},
+	"GetLesson": {
+		Description: lessonAccessDescription,
+		Tags:        []string{lessonsTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonResponse{}},
+			{Status: fiber.StatusForbidden, Body: dtos.SignInRequiredResponse{}},
+		},
+	},
+	"CreateLesson": {
+		Tags:      []string{lessonsTag},
+		Body:      dtos.CreateLessonBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.LessonResponse{}}},
+	},
+	"UpdateLesson": {
+		Description: versionedDescription,
+		Tags:        []string{lessonsTag},
+		Body:        dtos.UpdateLessonBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.LessonResponse{}},
+		},
+	},
+	"DeleteLesson": {
+		Description: versionedDescription,
+		Tags:        []string{lessonsTag},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusNoContent},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.LessonResponse{}},
+		},
+	},
+	"ReorderLessons": {
+		Description: "Takes every lesson id of the section in the new order, ids of lessons from other sections " +
+			"of the same series move those lessons into this section. The ETag of the order is returned on the " +
+			"lessons list to staff. " + versionedDescription,
+		Tags:    []string{lessonsTag},
+		Body:    dtos.ReorderLessonsBody{},
+		IfMatch: true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: []dtos.LessonResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: []dtos.LessonResponse{}},
+		},
+	},
+	"UpdateLessonIsPublished": {
+		Description: versionedDescription,
+		Tags:        []string{lessonsTag},
+		Body:        dtos.UpdateIsPublishedBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.LessonResponse{}},
+		},
+	},
+	"UpdateLessonIsPreview": {
+		Description: versionedDescription,
+		Tags:        []string{lessonsTag},
+		Body:        dtos.LessonPreviewBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.LessonResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.LessonResponse{}},
+		},
+	},
+	"GitHubSignIn": {
+		Tags:      []string{oauthTag},
+		Responses: []openapi.Response{{Status: fiber.StatusTemporaryRedirect}},
+	},
+	"GitHubCallback": {
+		Tags:      []string{oauthTag},
+		Responses: []openapi.Response{{Status: fiber.StatusFound}},
+	},
+	"GoogleSignIn": {
+		Tags:      []string{oauthTag},
+		Responses: []openapi.Response{{Status: fiber.StatusTemporaryRedirect}},
+	},
+	"GoogleCallback": {
+		Tags:      []string{oauthTag},
+		Responses: []openapi.Response{{Status: fiber.StatusFound}},
+	},
+	"OAuthToken": {
+		Tags:      []string{oauthTag},
+		Body:      dtos.OAuthTokenBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.AuthResponse{}}},
+	},
+	"CreateOrganization": {
+		Description: "The user creating the organization becomes its owner and first admin.",
+		Tags:        []string{organizationsTag},
+		Body:        dtos.OrganizationBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.OrganizationResponse{}}},
+	},
+	"GetOrganizations": {
+		Tags:      []string{organizationsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.OrganizationResponse]{}}},
+	},
+	"GetOrganization": {
+		Tags:      []string{organizationsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.OrganizationResponse{}}},
+	},
+	"GetOrganizationMembers": {
+		Tags:      []string{organizationsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.OrganizationMemberResponse]{}}},
+	},
+	"DeleteOrganizationMember": {
+		Description: "Also removes the member from every cohort of the organization.",
+		Tags:        []string{organizationsTag},
+		Responses:   []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"CreateOrganizationInvitation": {
+		Description: "Emails an invitation valid for 7 days, inviting the same email again replaces it.",
+		Tags:        []string{organizationsTag},
+		Body:        dtos.OrganizationInvitationBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.OrganizationInvitationResponse{}}},
+	},
+	"AcceptOrganizationInvitation": {
+		Description: "Only the user the invitation was sent to can accept it.",
+		Tags:        []string{organizationsTag},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.OrganizationResponse{}}},
+	},
+	"UpsertOrganizationLicense": {
+		Description: "Platform admins only, grants the organization seats of a paid series. " +
+			"Cohorts can only be assigned a paid series while the licensed seats cover every member.",
+		Tags:      []string{organizationsTag},
+		Body:      dtos.OrganizationLicenseBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.OrganizationLicenseResponse{}}},
+	},
+	"DeleteOrganizationLicense": {
+		Description: "Platform admins only, cohort members lose access to the series paid content.",
+		Tags:        []string{organizationsTag},
+		Responses:   []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"UpdateSeriesSchedule": {
+		Description: scheduleDescription,
+		Tags:        []string{scheduledReleasesTag},
+		Body:        dtos.UpdateScheduleBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.ScheduleResponse{}}},
+	},
+	"UpdateSectionSchedule": {
+		Description: scheduleDescription + " " + versionedDescription,
+		Tags:        []string{scheduledReleasesTag},
+		Body:        dtos.UpdateScheduleBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.ScheduleResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.ScheduleResponse{}},
+		},
+	},
+	"UpdateLessonSchedule": {
+		Description: scheduleDescription + " " + versionedDescription,
+		Tags:        []string{scheduledReleasesTag},
+		Body:        dtos.UpdateScheduleBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.ScheduleResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.ScheduleResponse{}},
+		},
+	},
+	"GetScheduledReleases": {
+		Description: "Lists every pending publish and unpublish of series, sections and lessons, soonest first.",
+		Tags:        []string{scheduledReleasesTag},
+		Query:       dtos.PaginationQueryParams{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.ScheduledReleaseResponse]{}}},
+	},
+	"GetCurrentSection": {
+		Tags:      []string{sectionProgressTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SectionResponse{}}},
+	},
+	"CreateOrUpdateSectionProgress": {
+		Tags: []string{sectionProgressTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusCreated, Body: dtos.SectionResponse{}},
+			{Status: fiber.StatusOK, Body: dtos.SectionResponse{}},
+		},
+	},
+	"ResetSectionProgress": {
+		Tags:      []string{sectionProgressTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetSections": {
+		Tags:  []string{sectionsTag},
+		Query: dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.SectionResponse]{}},
+			{Status: fiber.StatusNotModified},
+		},
+	},
+	"GetSection": {
+		Tags:      []string{sectionsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SectionResponse{}}},
+	},
+	"CreateSection": {
+		Tags:      []string{sectionsTag},
+		Body:      dtos.CreateSectionBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.SectionResponse{}}},
+	},
+	"UpdateSection": {
+		Description: versionedDescription,
+		Tags:        []string{sectionsTag},
+		Body:        dtos.UpdateSectionBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.SectionResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.SectionResponse{}},
+		},
+	},
+	"DeleteSection": {
+		Description: versionedDescription,
+		Tags:        []string{sectionsTag},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusNoContent},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.SectionResponse{}},
+		},
+	},
+	"ReorderSections": {
+		Description: "Takes every section id of the series in the new order. The ETag of the order is returned " +
+			"on the sections list to staff. " + versionedDescription,
+		Tags:    []string{sectionsTag},
+		Body:    dtos.ReorderSectionsBody{},
+		IfMatch: true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: []dtos.SectionResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: []dtos.SectionResponse{}},
+		},
+	},
+	"UpdateSectionIsPublished": {
+		Description: versionedDescription,
+		Tags:        []string{sectionsTag},
+		Body:        dtos.UpdateIsPublishedBody{},
+		IfMatch:     true,
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.SectionResponse{}},
+			{Status: fiber.StatusPreconditionFailed, Body: dtos.SectionResponse{}},
+		},
+	},
+	"GetPaginatedSeries": {
+		Tags:  []string{seriesTag},
+		Query: dtos.SeriesQueryParams{},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.SeriesResponse]{}},
+			{Status: fiber.StatusNotModified},
+		},
+	},
+	"GetSingleSeries": {
+		Tags:      []string{seriesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesResponse{}}},
+	},
+	"GetDiscoverySeries": {
+		Tags:      []string{seriesTag},
+		Query:     dtos.DiscoverySeriesQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.SeriesResponse]{}}},
+	},
+	"CreateSeries": {
+		Tags:      []string{seriesTag},
+		Body:      dtos.CreateSeriesBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.SeriesResponse{}}},
+	},
+	"UpdateSeries": {
+		Tags:      []string{seriesTag},
+		Body:      dtos.UpdateSeriesBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesResponse{}}},
+	},
+	"DeleteSeries": {
+		Tags:      []string{seriesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"UpdateSeriesIsPublished": {
+		Tags:      []string{seriesTag},
+		Body:      dtos.UpdateIsPublishedBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesResponse{}}},
+	},
+	"UpdateSeriesIsMembersOnly": {
+		Description: "Members only series keep their preview lessons public, the rest require signing in.",
+		Tags:        []string{seriesTag},
+		Body:        dtos.SeriesMembersOnlyBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesResponse{}}},
+	},
+	"UpdateSeriesPrice": {
+		Description: "Prices are in the currency's minor unit, zero makes the series free. Enrolled users keep their access.",
+		Tags:        []string{seriesTag},
+		Body:        dtos.SeriesPriceBody{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesResponse{}}},
+	},
+	"GetSeriesAnalytics": {
+		Description: analyticsDescription,
+		Tags:        []string{seriesAnalyticsTag},
+		Query:       dtos.SeriesAnalyticsQueryParams{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesAnalyticsResponse{}}},
+	},
+	"GetSeriesAnalyticsTimeline": {
+		Description: analyticsDescription,
+		Tags:        []string{seriesAnalyticsTag},
+		Query:       dtos.SeriesAnalyticsTimelineQueryParams{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesAnalyticsTimelineResponse{}}},
+	},
+	"GetSeriesAnalyticsFunnel": {
+		Description: analyticsDescription,
+		Tags:        []string{seriesAnalyticsTag},
+		Query:       dtos.SeriesAnalyticsQueryParams{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesAnalyticsFunnelResponse{}}},
+	},
+	"GetSeriesPicture": {
+		Tags:      []string{seriesPicturesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesPictureResponse{}}},
+	},
+	"UploadSeriesPicture": {
+		Tags:      []string{seriesPicturesTag},
+		Multipart: true,
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.SeriesPictureResponse{}}},
+	},
+	"DeleteSeriesPicture": {
+		Tags:      []string{seriesPicturesTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetPaginatedViewedSeries": {
+		Description: cursorPaginationDescription,
+		Tags:        []string{seriesProgressTag},
+		Query:       dtos.CursorPaginationQueryParams{},
+		Responses:   []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.SeriesResponse]{}}},
+	},
+	"CreateOrUpdateSeriesProgress": {
+		Tags: []string{seriesProgressTag},
+		Responses: []openapi.Response{
+			{Status: fiber.StatusCreated, Body: dtos.SeriesResponse{}},
+			{Status: fiber.StatusOK, Body: dtos.SeriesResponse{}},
+		},
+	},
+	"ResetSeriesProgress": {
+		Tags:      []string{seriesProgressTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetSeriesReviews": {
+		Tags:      []string{seriesReviewsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.SeriesReviewResponse]{}}},
+	},
+	"GetSeriesReview": {
+		Tags:      []string{seriesReviewsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesReviewResponse{}}},
+	},
+	"CreateSeriesReview": {
+		Tags:      []string{seriesReviewsTag},
+		Body:      dtos.SeriesReviewBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.SeriesReviewResponse{}}},
+	},
+	"UpdateSeriesReview": {
+		Tags:      []string{seriesReviewsTag},
+		Body:      dtos.SeriesReviewBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesReviewResponse{}}},
+	},
+	"DeleteSeriesReview": {
+		Tags:      []string{seriesReviewsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"ReplySeriesReview": {
+		Tags:      []string{seriesReviewsTag},
+		Body:      dtos.SeriesReviewReplyBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesReviewResponse{}}},
+	},
+	"UpdateSeriesReviewIsHidden": {
+		Tags:      []string{seriesReviewsTag},
+		Body:      dtos.SeriesReviewIsHiddenBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesReviewResponse{}}},
+	},
+	"PublishSeriesVersion": {
+		Description: "Snapshots the published sections and lessons of the series as its next version, " +
+			"new learners are pinned to it while existing ones keep their version until they migrate.",
+		Tags:      []string{seriesVersionsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.SeriesVersionResponse{}}},
+	},
+	"GetSeriesVersions": {
+		Tags:      []string{seriesVersionsTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.SeriesVersionResponse]{}}},
+	},
+	"GetSeriesProgressVersion": {
+		Tags:      []string{seriesVersionsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesProgressVersionResponse{}}},
+	},
+	"MigrateSeriesProgressVersion": {
+		Description: "Moves the progress to the latest version, completed lessons are kept by lesson identity " +
+			"and lessons no longer in the series lose their progress.",
+		Tags:      []string{seriesVersionsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.SeriesProgressVersionResponse{}}},
+	},
+	"GetCurrentAccount": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserResponse{}}},
+	},
+	"UpdateCurrentAccount": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Body:          dtos.UpdateUserBody{},
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserResponse{}}},
+	},
+	"DeleteCurrentAccount": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Body:          dtos.DeleteUserBody{},
+		Responses:     []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetCurrentAccountProfile": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserProfileResponse{}}},
+	},
+	"CreateUserProfile": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Body:          dtos.UserProfileBody{},
+		Responses:     []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.UserProfileResponse{}}},
+	},
+	"UpdateUserProfile": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Body:          dtos.UserProfileBody{},
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserProfileResponse{}}},
+	},
+	"DeleteUserProfile": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetCurrentAccountPicture": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserPictureResponse{}}},
+	},
+	"UploadUserPicture": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Multipart:     true,
+		Responses:     []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.UserPictureResponse{}}},
+	},
+	"DeleteUserPicture": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetMySeriesBookmarks": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Query:         dtos.PaginationQueryParams{},
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.SeriesBookmarkResponse]{}}},
+	},
+	"GetMyLessonBookmarks": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Query:         dtos.PaginationQueryParams{},
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.LessonBookmarkResponse]{}}},
+	},
+	"GetMyLearningStats": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LearningStatsResponse{}}},
+	},
+	"UpdateMyLearningStatsTimezone": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Body:          dtos.LearningStatsTimezoneBody{},
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.LearningStatsResponse{}}},
+	},
+	"GetMyXpEvents": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Query:         dtos.PaginationQueryParams{},
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.XpEventResponse]{}}},
+	},
+	"GetMyAchievements": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: []dtos.AchievementResponse{}}},
+	},
+	"GetMyDataExport": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserDataExportResponse{}}},
+	},
+	"RequestMyDataExport": {
+		Tags:          []string{usersTag},
+		Authenticated: true,
+		Responses:     []openapi.Response{{Status: fiber.StatusAccepted, Body: dtos.UserDataExportResponse{}}},
+	},
+	"GetUser": {
+		Tags:      []string{usersTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserResponse{}}},
+	},
+	"GetUserProfile": {
+		Tags:      []string{usersTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserProfileResponse{}}},
+	},
+	"GetUserPicture": {
+		Tags:      []string{usersTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.UserPictureResponse{}}},
+	},
+	"GetWebhooks": {
+		Tags:      []string{webhooksTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.WebhookResponse]{}}},
+	},
+	"CreateWebhook": {
+		Tags:      []string{webhooksTag},
+		Body:      dtos.CreateWebhookBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.WebhookResponse{}}},
+	},
+	"GetWebhook": {
+		Tags:      []string{webhooksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.WebhookResponse{}}},
+	},
+	"UpdateWebhook": {
+		Tags:      []string{webhooksTag},
+		Body:      dtos.UpdateWebhookBody{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.WebhookResponse{}}},
+	},
+	"DeleteWebhook": {
+		Tags:      []string{webhooksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusNoContent}},
+	},
+	"GetWebhookDeliveries": {
+		Tags:      []string{webhooksTag},
+		Query:     dtos.PaginationQueryParams{},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.PaginatedResponse[dtos.WebhookDeliveryResponse]{}}},
+	},
+	"GetWebhookDelivery": {
+		Tags:      []string{webhooksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: dtos.WebhookDeliveryResponse{}}},
+	},
+	"RedeliverWebhookDelivery": {
+		Tags:      []string{webhooksTag},
+		Responses: []openapi.Response{{Status: fiber.StatusCreated, Body: dtos.WebhookDeliveryResponse{}}},
+	},
+	"GetOpenAPIDocument": {
+		Tags:      []string{docsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, Body: map[string]any{}}},
+	},
+	"GetAPIDocs": {
+		Tags:      []string{docsTag},
+		Responses: []openapi.Response{{Status: fiber.StatusOK, ContentType: fiber.MIMETextHTMLCharsetUTF8, Body: ""}},
+	},
+}
+
+func (r *Router) DocsRoutes() {
+	r.router.Get(paths.OpenAPIPath, r.controllers.GetOpenAPIDocument)
+	r.router.Get(paths.DocsPath, r.controllers.GetAPIDocs)
+}
+
+// BuildOpenAPIDocument needs to be called after every route is loaded, it
+// returns the drift between the registered routes and the documented operations.
+func (r *Router) BuildOpenAPIDocument(backendDomain string) (*openapi.Document, []string) {
+	return openapi.Build(r.app, openapi.Spec{
+		Title:       "KiwiScript API",
+		Version:     "1.0.0",
+		Description: "API for KiwiScript, a platform to learn programming languages.",
+		ServerURL:   "https://" + backendDomain + "/api",
+		PathPrefix:  "/api",
+		Operations:  operations,
+		AuthMiddlewares: []string{
+			openapi.HandlerName(r.controllers.UserMiddleware),
+			openapi.HandlerName(r.controllers.StaffUserMiddleware),
+			openapi.HandlerName(r.controllers.AdminUserMiddleware),
+		},
+		RoleMiddlewares: []string{
+			openapi.HandlerName(r.controllers.StaffUserMiddleware),
+			openapi.HandlerName(r.controllers.AdminUserMiddleware),
+		},
+		ErrorBody:           exceptions.RequestError{},
+		ValidationErrorBody: exceptions.RequestValidationError{},
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import "github.com/kiwiscript/kiwiscript_go/paths"
+
+const (
+	organizationPath = "/:organizationID"
+	cohortPath       = organizationPath + paths.CohortsPath + "/:cohortID"
+)
+
+func (r *Router) OrganizationsPrivateRoutes() {
+	organizations := r.router.Group(paths.OrganizationsV1, r.controllers.UserMiddleware)
+
+	organizations.Post(paths.InvitationsPath+"/:token"+paths.AcceptPath, r.controllers.AcceptOrganizationInvitation)
+	organizations.Get("/", r.controllers.GetOrganizations)
+	organizations.Post("/", r.controllers.CreateOrganization)
+	organizations.Get(organizationPath, r.controllers.GetOrganization)
+	organizations.Get(organizationPath+paths.MembersPath, r.controllers.GetOrganizationMembers)
+	organizations.Delete(organizationPath+paths.MembersPath+"/:userID", r.controllers.DeleteOrganizationMember)
+	organizations.Post(organizationPath+paths.InvitationsPath, r.controllers.CreateOrganizationInvitation)
+	organizations.Put(organizationPath+paths.LicensesPath+"/:seriesSlug", r.controllers.UpsertOrganizationLicense)
+	organizations.Delete(organizationPath+paths.LicensesPath+"/:seriesSlug", r.controllers.DeleteOrganizationLicense)
+	organizations.Get(organizationPath+paths.CohortsPath, r.controllers.GetCohorts)
+	organizations.Post(organizationPath+paths.CohortsPath, r.controllers.CreateCohort)
+	organizations.Post(cohortPath+paths.MembersPath, r.controllers.AddCohortMember)
+	organizations.Delete(cohortPath+paths.MembersPath+"/:userID", r.controllers.RemoveCohortMember)
+	organizations.Put(cohortPath+paths.SeriesPath+"/:seriesSlug", r.controllers.AssignCohortSeries)
+	organizations.Delete(cohortPath+paths.SeriesPath+"/:seriesSlug", r.controllers.UnassignCohortSeries)
+	organizations.Get(cohortPath+paths.ProgressPath, r.controllers.GetCohortProgress)
+	organizations.Get(cohortPath+paths.ProgressPath+paths.ExportPath, r.controllers.ExportCohortProgress)
+}
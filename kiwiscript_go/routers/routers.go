@@ -18,18 +18,39 @@
 package routers
 
 import (
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/kiwiscript/kiwiscript_go/controllers"
 )
 
+// Cache policies of the public catalog routes, languages and articles rarely
+// change so they are kept longer than the paginated lists.
+var (
+	languagesCachePolicy = controllers.CachePolicy{
+		Scope:  controllers.CacheScopeLanguages,
+		MaxAge: 5 * time.Minute,
+	}
+	catalogListCachePolicy = controllers.CachePolicy{
+		Scope:  controllers.CacheScopeLanguage,
+		MaxAge: time.Minute,
+	}
+	lessonArticleCachePolicy = controllers.CachePolicy{
+		Scope:  controllers.CacheScopeLanguage,
+		MaxAge: 5 * time.Minute,
+	}
+)
+
 type Router struct {
+	app         *fiber.App
 	router      fiber.Router
 	controllers *controllers.Controllers
 }
 
 func NewRouter(app *fiber.App, controllers *controllers.Controllers) *Router {
 	return &Router{
+		app:         app,
 		router:      app.Group("/api"),
 		controllers: controllers,
 	}
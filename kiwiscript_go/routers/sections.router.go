@@ -28,7 +28,7 @@ const sectionPath = paths.LanguagePathV1 +
 func (r *Router) SectionPublicRoutes() {
 	section := r.router.Group(sectionPath)
 
-	section.Get("/", r.controllers.GetSections)
+	section.Get("/", r.controllers.CatalogCacheMiddleware(catalogListCachePolicy), r.controllers.GetSections)
 	section.Get("/:sectionID", r.controllers.GetSection)
 }
 
@@ -40,7 +40,9 @@ func (r *Router) SectionStaffRoutes() {
 	)
 
 	section.Post("/", r.controllers.CreateSection)
+	section.Patch("/order", r.controllers.ReorderSections)
 	section.Put("/:sectionID", r.controllers.UpdateSection)
 	section.Delete("/:sectionID", r.controllers.DeleteSection)
 	section.Patch("/:sectionID/publish", r.controllers.UpdateSectionIsPublished)
+	section.Put("/:sectionID"+paths.SchedulePath, r.controllers.UpdateSectionSchedule)
 }
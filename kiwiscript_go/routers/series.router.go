@@ -7,7 +7,7 @@ const seriesPath = paths.LanguagePathV1 + "/:languageSlug" + paths.SeriesPath
 func (r *Router) SeriesPublicRoutes() {
 	series := r.router.Group(seriesPath)
 
-	series.Get("/", r.controllers.GetPaginatedSeries)
+	series.Get("/", r.controllers.CatalogCacheMiddleware(catalogListCachePolicy), r.controllers.GetPaginatedSeries)
 	series.Get("/:seriesSlug", r.controllers.GetSingleSeries)
 }
 
@@ -27,4 +27,7 @@ func (r *Router) SeriesStaffRoutes() {
 	series.Put("/:seriesSlug", r.controllers.UpdateSeries)
 	series.Delete("/:seriesSlug", r.controllers.DeleteSeries)
 	series.Patch("/:seriesSlug/publish", r.controllers.UpdateSeriesIsPublished)
+	series.Put("/:seriesSlug"+paths.SchedulePath, r.controllers.UpdateSeriesSchedule)
+	series.Put("/:seriesSlug"+paths.PricePath, r.controllers.UpdateSeriesPrice)
+	series.Patch("/:seriesSlug"+paths.MembersOnlyPath, r.controllers.UpdateSeriesIsMembersOnly)
 }
@@ -0,0 +1,54 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import "github.com/kiwiscript/kiwiscript_go/paths"
+
+const seriesReviewsPath = paths.LanguagePathV1 +
+	"/:languageSlug" +
+	paths.SeriesPath +
+	"/:seriesSlug" +
+	paths.ReviewsPath
+
+func (r *Router) SeriesReviewsPublicRoutes() {
+	seriesReviews := r.router.Group(seriesReviewsPath)
+
+	seriesReviews.Get("/", r.controllers.GetSeriesReviews)
+	seriesReviews.Get("/:reviewID", r.controllers.GetSeriesReview)
+}
+
+func (r *Router) SeriesReviewsPrivateRoutes() {
+	seriesReviews := r.router.Group(
+		seriesReviewsPath,
+		r.controllers.UserMiddleware,
+	)
+
+	seriesReviews.Post("/", r.controllers.CreateSeriesReview)
+	seriesReviews.Put("/:reviewID", r.controllers.UpdateSeriesReview)
+	seriesReviews.Delete("/:reviewID", r.controllers.DeleteSeriesReview)
+}
+
+func (r *Router) SeriesReviewsStaffRoutes() {
+	seriesReviews := r.router.Group(
+		seriesReviewsPath,
+		r.controllers.StaffUserMiddleware,
+	)
+
+	seriesReviews.Put("/:reviewID"+paths.ReplyPath, r.controllers.ReplySeriesReview)
+	seriesReviews.Patch("/:reviewID"+paths.HidePath, r.controllers.UpdateSeriesReviewIsHidden)
+}
@@ -20,11 +20,16 @@ package routers
 import "github.com/kiwiscript/kiwiscript_go/paths"
 
 const (
-	myProfilePath   = paths.MePath + paths.ProfilePath
-	myPicturePath   = paths.MePath + paths.PicturePath
-	userIDPath      = "/:userID"
-	userProfilePath = userIDPath + paths.ProfilePath
-	userPicturePath = userIDPath + paths.PicturePath
+	myProfilePath      = paths.MePath + paths.ProfilePath
+	myPicturePath      = paths.MePath + paths.PicturePath
+	myBookmarksPath    = paths.MePath + paths.BookmarksPath
+	myStreakPath       = paths.MePath + paths.StreakPath
+	myXpPath           = paths.MePath + paths.XpPath
+	myAchievementsPath = paths.MePath + paths.AchievementsPath
+	myDataExportPath   = paths.MePath + paths.DataExportPath
+	userIDPath         = "/:userID"
+	userProfilePath    = userIDPath + paths.ProfilePath
+	userPicturePath    = userIDPath + paths.PicturePath
 )
 
 func (r *Router) UsersRoutes() {
@@ -43,6 +48,17 @@ func (r *Router) UsersRoutes() {
 	users.Post(myPicturePath, r.controllers.UploadUserPicture)
 	users.Delete(myPicturePath, r.controllers.DeleteUserPicture)
 
+	users.Get(myBookmarksPath+paths.SeriesPath, r.controllers.GetMySeriesBookmarks)
+	users.Get(myBookmarksPath+paths.LessonsPath, r.controllers.GetMyLessonBookmarks)
+
+	users.Get(myStreakPath, r.controllers.GetMyLearningStats)
+	users.Put(myStreakPath, r.controllers.UpdateMyLearningStatsTimezone)
+	users.Get(myXpPath, r.controllers.GetMyXpEvents)
+	users.Get(myAchievementsPath, r.controllers.GetMyAchievements)
+
+	users.Get(myDataExportPath, r.controllers.GetMyDataExport)
+	users.Post(myDataExportPath, r.controllers.RequestMyDataExport)
+
 	users.Get(userIDPath, r.controllers.GetUser)
 	users.Get(userProfilePath, r.controllers.GetUserProfile)
 	users.Get(userPicturePath, r.controllers.GetUserPicture)
@@ -0,0 +1,39 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import "github.com/kiwiscript/kiwiscript_go/paths"
+
+const webhookDeliveriesPath = "/:webhookID" + paths.DeliveriesPath
+
+func (r *Router) WebhooksAdminRoutes() {
+	webhooks := r.router.Group(
+		paths.WebhooksV1,
+		r.controllers.AccessClaimsMiddleware,
+		r.controllers.AdminUserMiddleware,
+	)
+
+	webhooks.Get("/", r.controllers.GetWebhooks)
+	webhooks.Post("/", r.controllers.CreateWebhook)
+	webhooks.Get("/:webhookID", r.controllers.GetWebhook)
+	webhooks.Put("/:webhookID", r.controllers.UpdateWebhook)
+	webhooks.Delete("/:webhookID", r.controllers.DeleteWebhook)
+	webhooks.Get(webhookDeliveriesPath, r.controllers.GetWebhookDeliveries)
+	webhooks.Get(webhookDeliveriesPath+"/:deliveryID", r.controllers.GetWebhookDelivery)
+	webhooks.Post(webhookDeliveriesPath+"/:deliveryID"+paths.RedeliverPath, r.controllers.RedeliverWebhookDelivery)
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const achievementsLocation string = "achievements"
+
+type achievementProgress struct {
+	LessonsCompleted  int64
+	SectionsCompleted int64
+	SeriesCompleted   int64
+	LongestStreak     int32
+	XpTotal           int32
+}
+
+type achievement struct {
+	Slug        string
+	Name        string
+	Description string
+	IsUnlocked  func(p *achievementProgress) bool
+}
+
+var achievementsCatalogue = []achievement{
+	{
+		Slug:        "first-lesson",
+		Name:        "First Steps",
+		Description: "Complete your first lesson",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.LessonsCompleted >= 1 },
+	},
+	{
+		Slug:        "ten-lessons",
+		Name:        "Getting The Hang Of It",
+		Description: "Complete 10 lessons",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.LessonsCompleted >= 10 },
+	},
+	{
+		Slug:        "fifty-lessons",
+		Name:        "Lesson Devourer",
+		Description: "Complete 50 lessons",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.LessonsCompleted >= 50 },
+	},
+	{
+		Slug:        "first-section",
+		Name:        "Section Cleared",
+		Description: "Complete your first section",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.SectionsCompleted >= 1 },
+	},
+	{
+		Slug:        "first-series",
+		Name:        "Series Finisher",
+		Description: "Complete your first series",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.SeriesCompleted >= 1 },
+	},
+	{
+		Slug:        "five-series",
+		Name:        "Polyglot",
+		Description: "Complete 5 series",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.SeriesCompleted >= 5 },
+	},
+	{
+		Slug:        "three-day-streak",
+		Name:        "Warming Up",
+		Description: "Learn 3 days in a row",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.LongestStreak >= 3 },
+	},
+	{
+		Slug:        "seven-day-streak",
+		Name:        "On Fire",
+		Description: "Learn 7 days in a row",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.LongestStreak >= 7 },
+	},
+	{
+		Slug:        "thirty-day-streak",
+		Name:        "Unstoppable",
+		Description: "Learn 30 days in a row",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.LongestStreak >= 30 },
+	},
+	{
+		Slug:        "thousand-xp",
+		Name:        "Kiwi Scholar",
+		Description: "Earn 1000 XP",
+		IsUnlocked:  func(p *achievementProgress) bool { return p.XpTotal >= 1000 },
+	},
+}
+
+func (s *Services) unlockAchievements(ctx context.Context, log *slog.Logger, stats *db.UserLearningStat) {
+	counts, err := s.database.CountXpEventsBySourceAndUserID(ctx, stats.UserID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count xp events by source", "error", err)
+		return
+	}
+
+	progress := achievementProgress{
+		LongestStreak: stats.LongestStreak,
+		XpTotal:       stats.XpTotal,
+	}
+	for _, c := range counts {
+		switch c.Source {
+		case XpSourceLesson:
+			progress.LessonsCompleted = c.Count
+		case XpSourceSection:
+			progress.SectionsCompleted = c.Count
+		case XpSourceSeries:
+			progress.SeriesCompleted = c.Count
+		}
+	}
+
+	for _, a := range achievementsCatalogue {
+		if !a.IsUnlocked(&progress) {
+			continue
+		}
+
+		rows, err := s.database.CreateUserAchievement(ctx, db.CreateUserAchievementParams{
+			UserID:          stats.UserID,
+			AchievementSlug: a.Slug,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to create user achievement", "error", err, "achievementSlug", a.Slug)
+			continue
+		}
+		if rows > 0 {
+			log.InfoContext(ctx, "Achievement unlocked", "achievementSlug", a.Slug)
+		}
+	}
+}
+
+type FindUserAchievementsOptions struct {
+	RequestID string
+	UserID    int32
+}
+
+func (s *Services) FindUserAchievements(
+	ctx context.Context,
+	opts FindUserAchievementsOptions,
+) ([]db.AchievementModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, achievementsLocation, "FindUserAchievements")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, achievementsLocation, "FindUserAchievements").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Finding user achievements...")
+
+	userAchievements, err := s.database.FindUserAchievementsByUserID(ctx, opts.UserID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find user achievements", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	unlockedAt := make(map[string]string, len(userAchievements))
+	for _, ua := range userAchievements {
+		unlockedAt[ua.AchievementSlug] = ua.UnlockedAtString()
+	}
+
+	achievementModels := make([]db.AchievementModel, 0, len(achievementsCatalogue))
+	for _, a := range achievementsCatalogue {
+		at, ok := unlockedAt[a.Slug]
+		achievementModels = append(achievementModels, db.AchievementModel{
+			Slug:        a.Slug,
+			Name:        a.Name,
+			Description: a.Description,
+			IsUnlocked:  ok,
+			UnlockedAt:  at,
+		})
+	}
+
+	return achievementModels, nil
+}
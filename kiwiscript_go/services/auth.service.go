@@ -71,6 +71,9 @@ func (s *Services) sendConfirmationEmail(
 }
 
 func (s *Services) SignUp(ctx context.Context, opts SignUpOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, authLocation, "SignUp")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "SignUp").With(
 		"firstName", opts.FirstName,
 		"lastName", opts.LastName,
@@ -152,6 +155,9 @@ type ConfirmEmailOptions struct {
 }
 
 func (s *Services) ConfirmEmail(ctx context.Context, opts ConfirmEmailOptions) (*AuthResponse, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, authLocation, "ConfirmEmail")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "ConfirmEmail")
 	log.InfoContext(ctx, "Confirming email...")
 
@@ -202,6 +208,9 @@ type SignInOptions struct {
 }
 
 func (s *Services) SignIn(ctx context.Context, opts SignInOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, authLocation, "SignIn")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "SignIn")
 	log.InfoContext(ctx, "Signing in...")
 
@@ -270,6 +279,9 @@ type TwoFactorOptions struct {
 }
 
 func (s *Services) TwoFactor(ctx context.Context, opts TwoFactorOptions) (*AuthResponse, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, authLocation, "TwoFactor")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "TwoFactor")
 	log.InfoContext(ctx, "Confirming two factor code...")
 
@@ -301,6 +313,11 @@ func (s *Services) TwoFactor(ctx context.Context, opts TwoFactorOptions) (*AuthR
 		return nil, exceptions.NewValidationError(errMsg)
 	}
 
+	user, serviceErr = s.restoreDeletedUser(ctx, log, user)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	return s.generateAuthResponse(ctx, log, "Confirmed two factor successfully", user)
 }
 
@@ -310,6 +327,9 @@ type RefreshOptions struct {
 }
 
 func (s *Services) Refresh(ctx context.Context, opts RefreshOptions) (*AuthResponse, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, authLocation, "Refresh")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "Refresh")
 	log.InfoContext(ctx, "Refreshing access token...")
 
@@ -354,6 +374,9 @@ type SignOutOptions struct {
 }
 
 func (s *Services) SignOut(ctx context.Context, opts SignOutOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, authLocation, "SignOut")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "SignOut")
 	log.InfoContext(ctx, "Signing out...")
 
@@ -385,6 +408,9 @@ type UpdatePasswordOptions struct {
 }
 
 func (s *Services) UpdatePassword(ctx context.Context, opts UpdatePasswordOptions) (*AuthResponse, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, authLocation, "UpdatePassword")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "UpdatePassword").With(
 		"userId", opts.UserID,
 		"userVersion", opts.UserVersion,
@@ -491,6 +517,9 @@ type ForgotPasswordOptions struct {
 }
 
 func (s *Services) ForgotPassword(ctx context.Context, opts ForgotPasswordOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, authLocation, "ForgotPassword")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "ForgotPassword")
 	log.InfoContext(ctx, "Reset password")
 
@@ -538,6 +567,9 @@ type ResetPasswordOptions struct {
 }
 
 func (s *Services) ResetPassword(ctx context.Context, opts ResetPasswordOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, authLocation, "ResetPassword")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "ResetPassword")
 	log.InfoContext(ctx, "Resetting password...")
 
@@ -645,6 +677,9 @@ type UpdateEmailOptions struct {
 }
 
 func (s *Services) UpdateEmail(ctx context.Context, opts UpdateEmailOptions) (*AuthResponse, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, authLocation, "UpdateEmail")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, authLocation, "UpdateEmail").With(
 		"userId", opts.UserID,
 		"userVersion", opts.UserVersion,
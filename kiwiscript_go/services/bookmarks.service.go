@@ -0,0 +1,314 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const bookmarksLocation string = "bookmarks"
+
+type SeriesBookmarkOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+func (s *Services) CreateSeriesBookmark(
+	ctx context.Context,
+	opts SeriesBookmarkOptions,
+) (*db.SeriesBookmarkModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, bookmarksLocation, "CreateSeriesBookmark")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, bookmarksLocation, "CreateSeriesBookmark").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Creating series bookmark...")
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if _, err := s.database.FindSeriesBookmarkByUserIDAndSeriesSlug(
+		ctx,
+		db.FindSeriesBookmarkByUserIDAndSeriesSlugParams{
+			UserID:     opts.UserID,
+			SeriesSlug: series.Slug,
+		},
+	); err == nil {
+		log.WarnContext(ctx, "Series already bookmarked")
+		return nil, exceptions.NewConflictError("Series already bookmarked")
+	}
+
+	bookmark, err := s.database.CreateSeriesBookmark(ctx, db.CreateSeriesBookmarkParams{
+		LanguageSlug: series.LanguageSlug,
+		SeriesSlug:   series.Slug,
+		UserID:       opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create series bookmark", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series bookmark created successfully")
+	return bookmark.ToSeriesBookmarkModel(series.Title), nil
+}
+
+func (s *Services) DeleteSeriesBookmark(ctx context.Context, opts SeriesBookmarkOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, bookmarksLocation, "DeleteSeriesBookmark")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, bookmarksLocation, "DeleteSeriesBookmark").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Deleting series bookmark...")
+
+	bookmark, err := s.database.FindSeriesBookmarkByUserIDAndSeriesSlug(
+		ctx,
+		db.FindSeriesBookmarkByUserIDAndSeriesSlugParams{
+			UserID:     opts.UserID,
+			SeriesSlug: opts.SeriesSlug,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Series bookmark not found", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	if bookmark.LanguageSlug != opts.LanguageSlug {
+		log.WarnContext(ctx, "Series bookmark does not belong to the language")
+		return exceptions.NewNotFoundError()
+	}
+
+	if err := s.database.DeleteSeriesBookmarkByID(ctx, bookmark.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete series bookmark", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series bookmark deleted successfully")
+	return nil
+}
+
+type LessonBookmarkOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+}
+
+func (s *Services) CreateLessonBookmark(
+	ctx context.Context,
+	opts LessonBookmarkOptions,
+) (*db.LessonBookmarkModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, bookmarksLocation, "CreateLessonBookmark")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, bookmarksLocation, "CreateLessonBookmark").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+	)
+	log.InfoContext(ctx, "Creating lesson bookmark...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if _, err := s.database.FindLessonBookmarkByUserIDAndLessonID(
+		ctx,
+		db.FindLessonBookmarkByUserIDAndLessonIDParams{
+			UserID:   opts.UserID,
+			LessonID: lesson.ID,
+		},
+	); err == nil {
+		log.WarnContext(ctx, "Lesson already bookmarked")
+		return nil, exceptions.NewConflictError("Lesson already bookmarked")
+	}
+
+	bookmark, err := s.database.CreateLessonBookmark(ctx, db.CreateLessonBookmarkParams{
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+		UserID:       opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create lesson bookmark", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson bookmark created successfully")
+	return bookmark.ToLessonBookmarkModel(lesson.Title), nil
+}
+
+func (s *Services) DeleteLessonBookmark(ctx context.Context, opts LessonBookmarkOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, bookmarksLocation, "DeleteLessonBookmark")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, bookmarksLocation, "DeleteLessonBookmark").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+	)
+	log.InfoContext(ctx, "Deleting lesson bookmark...")
+
+	bookmark, err := s.database.FindLessonBookmarkByUserIDAndLessonID(
+		ctx,
+		db.FindLessonBookmarkByUserIDAndLessonIDParams{
+			UserID:   opts.UserID,
+			LessonID: opts.LessonID,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Lesson bookmark not found", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	if bookmark.LanguageSlug != opts.LanguageSlug ||
+		bookmark.SeriesSlug != opts.SeriesSlug ||
+		bookmark.SectionID != opts.SectionID {
+		log.WarnContext(ctx, "Lesson bookmark does not belong to the lesson")
+		return exceptions.NewNotFoundError()
+	}
+
+	if err := s.database.DeleteLessonBookmarkByID(ctx, bookmark.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete lesson bookmark", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson bookmark deleted successfully")
+	return nil
+}
+
+type FindPaginatedBookmarksOptions struct {
+	RequestID string
+	UserID    int32
+	Offset    int32
+	Limit     int32
+}
+
+func (s *Services) FindPaginatedSeriesBookmarks(
+	ctx context.Context,
+	opts FindPaginatedBookmarksOptions,
+) ([]db.SeriesBookmarkModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, bookmarksLocation, "FindPaginatedSeriesBookmarks")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, bookmarksLocation, "FindPaginatedSeriesBookmarks").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Finding paginated series bookmarks...")
+
+	count, err := s.database.CountSeriesBookmarksByUserID(ctx, opts.UserID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count series bookmarks", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No series bookmarks found", "count", count)
+		return make([]db.SeriesBookmarkModel, 0), 0, nil
+	}
+
+	bookmarks, err := s.database.FindPaginatedSeriesBookmarksWithSeriesByUserID(
+		ctx,
+		db.FindPaginatedSeriesBookmarksWithSeriesByUserIDParams{
+			UserID: opts.UserID,
+			Limit:  opts.Limit,
+			Offset: opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated series bookmarks", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	bookmarkModels := make([]db.SeriesBookmarkModel, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		bookmarkModels = append(bookmarkModels, *b.ToSeriesBookmarkModel())
+	}
+
+	return bookmarkModels, count, nil
+}
+
+func (s *Services) FindPaginatedLessonBookmarks(
+	ctx context.Context,
+	opts FindPaginatedBookmarksOptions,
+) ([]db.LessonBookmarkModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, bookmarksLocation, "FindPaginatedLessonBookmarks")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, bookmarksLocation, "FindPaginatedLessonBookmarks").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Finding paginated lesson bookmarks...")
+
+	count, err := s.database.CountLessonBookmarksByUserID(ctx, opts.UserID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count lesson bookmarks", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No lesson bookmarks found", "count", count)
+		return make([]db.LessonBookmarkModel, 0), 0, nil
+	}
+
+	bookmarks, err := s.database.FindPaginatedLessonBookmarksWithLessonByUserID(
+		ctx,
+		db.FindPaginatedLessonBookmarksWithLessonByUserIDParams{
+			UserID: opts.UserID,
+			Limit:  opts.Limit,
+			Offset: opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated lesson bookmarks", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	bookmarkModels := make([]db.LessonBookmarkModel, 0, len(bookmarks))
+	for _, b := range bookmarks {
+		bookmarkModels = append(bookmarkModels, *b.ToLessonBookmarkModel())
+	}
+
+	return bookmarkModels, count, nil
+}
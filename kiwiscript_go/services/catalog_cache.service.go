@@ -0,0 +1,134 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	cc "github.com/kiwiscript/kiwiscript_go/providers/cache"
+)
+
+const (
+	catalogCacheLocation string = "catalog_cache"
+
+	CatalogLanguagesScope string = "languages"
+)
+
+func CatalogLanguageScope(languageSlug string) string {
+	return "language:" + languageSlug
+}
+
+type FindCatalogVersionOptions struct {
+	RequestID string
+	Scope     string
+}
+
+func (s *Services) FindCatalogVersion(ctx context.Context, opts FindCatalogVersionOptions) (int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, catalogCacheLocation, "FindCatalogVersion")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, catalogCacheLocation, "FindCatalogVersion").With(
+		"scope", opts.Scope,
+	)
+	log.DebugContext(ctx, "Finding catalog version...")
+
+	version, err := s.cache.GetCatalogVersion(ctx, cc.GetCatalogVersionOptions{
+		RequestID: opts.RequestID,
+		Scope:     opts.Scope,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Failed to get catalog version", "error", err)
+		return 0, exceptions.NewServerError()
+	}
+
+	return version, nil
+}
+
+type FindCachedResponseOptions struct {
+	RequestID string
+	Key       string
+}
+
+// FindCachedResponse returns a nil body on a cache miss.
+func (s *Services) FindCachedResponse(ctx context.Context, opts FindCachedResponseOptions) ([]byte, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, catalogCacheLocation, "FindCachedResponse")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, catalogCacheLocation, "FindCachedResponse").With(
+		"key", opts.Key,
+	)
+	log.DebugContext(ctx, "Finding cached response...")
+
+	body, err := s.cache.GetResponse(ctx, cc.GetResponseOptions{
+		RequestID: opts.RequestID,
+		Key:       opts.Key,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Failed to get cached response", "error", err)
+		return nil, exceptions.NewServerError()
+	}
+
+	return body, nil
+}
+
+type CacheResponseOptions struct {
+	RequestID string
+	Key       string
+	Body      []byte
+	TTL       time.Duration
+}
+
+func (s *Services) CacheResponse(ctx context.Context, opts CacheResponseOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, catalogCacheLocation, "CacheResponse")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, catalogCacheLocation, "CacheResponse").With(
+		"key", opts.Key,
+	)
+	log.DebugContext(ctx, "Caching response...")
+
+	if err := s.cache.AddResponse(ctx, cc.AddResponseOptions(opts)); err != nil {
+		log.WarnContext(ctx, "Failed to cache response", "error", err)
+		return exceptions.NewServerError()
+	}
+
+	return nil
+}
+
+// invalidateCatalog bumps the catalog versions a change to the languages'
+// content touches, the languages list is always included since it embeds
+// counts.
+// A failure is only logged so the write itself still succeeds, stale
+// entries then live until their TTL runs out.
+func (s *Services) invalidateCatalog(ctx context.Context, log *slog.Logger, requestID string, languageSlugs ...string) {
+	scopes := make([]string, 0, len(languageSlugs)+1)
+	scopes = append(scopes, CatalogLanguagesScope)
+	for _, languageSlug := range languageSlugs {
+		scopes = append(scopes, CatalogLanguageScope(languageSlug))
+	}
+
+	if err := s.cache.BumpCatalogVersions(ctx, cc.BumpCatalogVersionsOptions{
+		RequestID: requestID,
+		Scopes:    scopes,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to invalidate catalog cache", "error", err)
+	}
+}
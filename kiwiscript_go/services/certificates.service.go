@@ -37,6 +37,9 @@ func (s *Services) FindPaginatedCertificates(
 	ctx context.Context,
 	opts FindPaginatedCertificatesOptions,
 ) ([]db.FindPaginatedCertificatesByUserIDRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, certificatesLocation, "FindPaginatedCertificates")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, certificatesLocation, "FindPaginatedCertificates").With(
 		"userId", opts.UserID,
 		"offset", opts.Offset,
@@ -79,6 +82,9 @@ func (s *Services) FindCertificateByID(
 	ctx context.Context,
 	opts FindCertificateByIDOptions,
 ) (*db.FindCertificateByIDWithUserAndLanguageRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, certificatesLocation, "FindCertificateByID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, "certificatesLocation", "FindCertificateByID").With(
 		"id", opts.ID.String(),
 	)
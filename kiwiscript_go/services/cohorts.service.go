@@ -0,0 +1,557 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const cohortsLocation string = "cohorts"
+
+type findOrganizationCohortOptions struct {
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	CohortID       int32
+}
+
+// findOrganizationCohort finds a cohort of an organization managed by the user
+func (s *Services) findOrganizationCohort(
+	ctx context.Context,
+	log *slog.Logger,
+	opts findOrganizationCohortOptions,
+) (*db.Cohort, *exceptions.ServiceError) {
+	organization, serviceErr := s.assertOrganizationAccess(ctx, log, assertOrganizationAccessOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		RequireAdmin:   true,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	cohort, err := s.database.FindCohortByOrganizationIDAndID(ctx, db.FindCohortByOrganizationIDAndIDParams{
+		OrganizationID: organization.ID,
+		ID:             opts.CohortID,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Cohort not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &cohort, nil
+}
+
+type CreateCohortOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	Name           string
+}
+
+func (s *Services) CreateCohort(
+	ctx context.Context,
+	opts CreateCohortOptions,
+) (*db.Cohort, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "CreateCohort")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "CreateCohort").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"name", opts.Name,
+	)
+	log.InfoContext(ctx, "Creating cohort...")
+
+	organization, serviceErr := s.assertOrganizationAccess(ctx, log, assertOrganizationAccessOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		RequireAdmin:   true,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	cohort, err := s.database.CreateCohort(ctx, db.CreateCohortParams{
+		OrganizationID: organization.ID,
+		Name:           opts.Name,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create cohort", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Cohort created", "cohortId", cohort.ID)
+	return &cohort, nil
+}
+
+type FindPaginatedCohortsOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	Offset         int32
+	Limit          int32
+}
+
+func (s *Services) FindPaginatedCohorts(
+	ctx context.Context,
+	opts FindPaginatedCohortsOptions,
+) ([]db.Cohort, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "FindPaginatedCohorts")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "FindPaginatedCohorts").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated cohorts...")
+
+	organization, serviceErr := s.assertOrganizationAccess(ctx, log, assertOrganizationAccessOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		RequireAdmin:   true,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	count, err := s.database.CountCohortsByOrganizationID(ctx, organization.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count cohorts", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	if count == 0 {
+		log.DebugContext(ctx, "No cohorts found", "count", count)
+		return make([]db.Cohort, 0), 0, nil
+	}
+
+	cohorts, err := s.database.FindPaginatedCohortsByOrganizationID(
+		ctx,
+		db.FindPaginatedCohortsByOrganizationIDParams{
+			OrganizationID: organization.ID,
+			Offset:         opts.Offset,
+			Limit:          opts.Limit,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find cohorts", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Cohorts found", "count", count)
+	return cohorts, count, nil
+}
+
+type AddCohortMemberOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	CohortID       int32
+	MemberID       int32
+}
+
+func (s *Services) AddCohortMember(
+	ctx context.Context,
+	opts AddCohortMemberOptions,
+) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "AddCohortMember")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "AddCohortMember").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"cohortId", opts.CohortID,
+		"memberId", opts.MemberID,
+	)
+	log.InfoContext(ctx, "Adding cohort member...")
+
+	cohort, serviceErr := s.findOrganizationCohort(ctx, log, findOrganizationCohortOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		CohortID:       opts.CohortID,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if _, err := s.database.FindOrganizationMember(ctx, db.FindOrganizationMemberParams{
+		OrganizationID: cohort.OrganizationID,
+		UserID:         opts.MemberID,
+	}); err != nil {
+		log.WarnContext(ctx, "User is not a member of the organization", "error", err)
+		return exceptions.NewValidationError("User is not a member of the organization")
+	}
+
+	if seriesSlug, err := s.database.FindFullOrganizationLicenseSeriesSlugByCohortID(
+		ctx,
+		db.FindFullOrganizationLicenseSeriesSlugByCohortIDParams{
+			CohortID: cohort.ID,
+			UserID:   opts.MemberID,
+		},
+	); err == nil {
+		log.WarnContext(ctx, "Organization license has no free seats", "seriesSlug", seriesSlug)
+		return exceptions.NewConflictError("Organization license has no free seats for the series " + seriesSlug)
+	} else if err != pgx.ErrNoRows {
+		log.ErrorContext(ctx, "Failed to find full organization license", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	if err := s.database.CreateCohortMember(ctx, db.CreateCohortMemberParams{
+		CohortID: cohort.ID,
+		UserID:   opts.MemberID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to create cohort member", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Cohort member added")
+	return nil
+}
+
+type RemoveCohortMemberOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	CohortID       int32
+	MemberID       int32
+}
+
+func (s *Services) RemoveCohortMember(
+	ctx context.Context,
+	opts RemoveCohortMemberOptions,
+) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "RemoveCohortMember")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "RemoveCohortMember").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"cohortId", opts.CohortID,
+		"memberId", opts.MemberID,
+	)
+	log.InfoContext(ctx, "Removing cohort member...")
+
+	cohort, serviceErr := s.findOrganizationCohort(ctx, log, findOrganizationCohortOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		CohortID:       opts.CohortID,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if err := s.database.DeleteCohortMember(ctx, db.DeleteCohortMemberParams{
+		CohortID: cohort.ID,
+		UserID:   opts.MemberID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to delete cohort member", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Cohort member removed")
+	return nil
+}
+
+type AssignCohortSeriesOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	CohortID       int32
+	LanguageSlug   string
+	SeriesSlug     string
+	DueAt          *time.Time
+}
+
+func (s *Services) AssignCohortSeries(
+	ctx context.Context,
+	opts AssignCohortSeriesOptions,
+) (*db.CohortSeries, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "AssignCohortSeries")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "AssignCohortSeries").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"cohortId", opts.CohortID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Assigning cohort series...")
+
+	cohort, serviceErr := s.findOrganizationCohort(ctx, log, findOrganizationCohortOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		CohortID:       opts.CohortID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.Price > 0 {
+		if serviceErr := s.assertOrganizationLicenseSeats(ctx, log, cohort, series.Slug); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
+	var dueAt pgtype.Timestamp
+	if opts.DueAt != nil {
+		if opts.DueAt.Before(time.Now()) {
+			log.WarnContext(ctx, "Due date is in the past")
+			return nil, exceptions.NewValidationError("Due date must be in the future")
+		}
+		dueAt = pgtype.Timestamp{Time: opts.DueAt.UTC(), Valid: true}
+	}
+
+	cohortSeries, err := s.database.UpsertCohortSeries(ctx, db.UpsertCohortSeriesParams{
+		CohortID:     cohort.ID,
+		LanguageSlug: series.LanguageSlug,
+		SeriesSlug:   series.Slug,
+		DueAt:        dueAt,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to upsert cohort series", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Cohort series assigned")
+	return &cohortSeries, nil
+}
+
+type UnassignCohortSeriesOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	CohortID       int32
+	SeriesSlug     string
+}
+
+func (s *Services) UnassignCohortSeries(
+	ctx context.Context,
+	opts UnassignCohortSeriesOptions,
+) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "UnassignCohortSeries")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "UnassignCohortSeries").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"cohortId", opts.CohortID,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Unassigning cohort series...")
+
+	cohort, serviceErr := s.findOrganizationCohort(ctx, log, findOrganizationCohortOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		CohortID:       opts.CohortID,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	cohortSeries, err := s.database.FindCohortSeriesByCohortIDAndSeriesSlug(
+		ctx,
+		db.FindCohortSeriesByCohortIDAndSeriesSlugParams{
+			CohortID:   cohort.ID,
+			SeriesSlug: opts.SeriesSlug,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Cohort series not found", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	if err := s.database.DeleteCohortSeries(ctx, cohortSeries.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete cohort series", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Cohort series unassigned")
+	return nil
+}
+
+type FindCohortProgressOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	CohortID       int32
+}
+
+func (s *Services) FindCohortProgress(
+	ctx context.Context,
+	opts FindCohortProgressOptions,
+) ([]db.CohortProgressModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "FindCohortProgress")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "FindCohortProgress").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"cohortId", opts.CohortID,
+	)
+	log.InfoContext(ctx, "Finding cohort progress...")
+
+	cohort, serviceErr := s.findOrganizationCohort(ctx, log, findOrganizationCohortOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		CohortID:       opts.CohortID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	rows, err := s.database.FindCohortProgress(ctx, cohort.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find cohort progress", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	now := time.Now()
+	progress := make([]db.CohortProgressModel, len(rows))
+	for i, row := range rows {
+		progress[i] = *row.ToCohortProgressModel(now)
+	}
+
+	log.InfoContext(ctx, "Cohort progress found", "count", len(progress))
+	return progress, nil
+}
+
+// escapeCSVCell prefixes values that spreadsheet apps would evaluate as
+// formulas, names and titles are user input and end up in the export.
+func escapeCSVCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
+var cohortProgressCSVHeader = []string{
+	"user_id",
+	"first_name",
+	"last_name",
+	"email",
+	"language_slug",
+	"series_slug",
+	"series_title",
+	"due_at",
+	"is_overdue",
+	"started_sections",
+	"completed_sections",
+	"total_sections",
+	"completed_lessons",
+	"total_lessons",
+	"completed_at",
+	"certificate_id",
+}
+
+func (s *Services) ExportCohortProgress(
+	ctx context.Context,
+	opts FindCohortProgressOptions,
+) (string, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, cohortsLocation, "ExportCohortProgress")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, cohortsLocation, "ExportCohortProgress").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"cohortId", opts.CohortID,
+	)
+	log.InfoContext(ctx, "Exporting cohort progress...")
+
+	progress, serviceErr := s.FindCohortProgress(ctx, opts)
+	if serviceErr != nil {
+		return "", serviceErr
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(cohortProgressCSVHeader); err != nil {
+		log.ErrorContext(ctx, "Failed to write CSV header", "error", err)
+		return "", exceptions.NewServerError()
+	}
+	for _, p := range progress {
+		if err := writer.Write([]string{
+			strconv.Itoa(int(p.UserID)),
+			escapeCSVCell(p.FirstName),
+			escapeCSVCell(p.LastName),
+			escapeCSVCell(p.Email),
+			p.LanguageSlug,
+			p.SeriesSlug,
+			escapeCSVCell(p.SeriesTitle),
+			p.DueAt,
+			strconv.FormatBool(p.IsOverdue),
+			strconv.Itoa(int(p.StartedSections)),
+			strconv.Itoa(int(p.CompletedSections)),
+			strconv.Itoa(int(p.TotalSections)),
+			strconv.Itoa(int(p.CompletedLessons)),
+			strconv.Itoa(int(p.TotalLessons)),
+			p.CompletedAt,
+			p.CertificateID,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to write CSV row", "error", err)
+			return "", exceptions.NewServerError()
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		log.ErrorContext(ctx, "Failed to flush CSV", "error", err)
+		return "", exceptions.NewServerError()
+	}
+
+	log.InfoContext(ctx, "Cohort progress exported", "rows", len(progress))
+	return buf.String(), nil
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const couponsLocation string = "coupons"
+
+type CreateCouponOptions struct {
+	RequestID      string
+	UserID         int32
+	Code           string
+	LanguageSlug   string
+	SeriesSlug     string
+	PercentOff     int16
+	MaxRedemptions int32
+	ExpiresAt      *time.Time
+}
+
+func (s *Services) CreateCoupon(ctx context.Context, opts CreateCouponOptions) (*db.Coupon, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, couponsLocation, "CreateCoupon")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, couponsLocation, "CreateCoupon").With(
+		"userId", opts.UserID,
+		"code", opts.Code,
+		"seriesSlug", opts.SeriesSlug,
+		"percentOff", opts.PercentOff,
+	)
+	log.InfoContext(ctx, "Creating coupon...")
+
+	var seriesSlug pgtype.Text
+	var expiresAt pgtype.Timestamp
+	if opts.SeriesSlug != "" {
+		if _, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+			RequestID:    opts.RequestID,
+			LanguageSlug: opts.LanguageSlug,
+			SeriesSlug:   opts.SeriesSlug,
+		}); serviceErr != nil {
+			return nil, serviceErr
+		}
+
+		seriesSlug = pgtype.Text{String: opts.SeriesSlug, Valid: true}
+	}
+	if opts.ExpiresAt != nil {
+		if !opts.ExpiresAt.After(time.Now()) {
+			log.WarnContext(ctx, "Coupon expiration is in the past")
+			return nil, exceptions.NewValidationError("Expiration date must be in the future")
+		}
+
+		expiresAt = pgtype.Timestamp{Time: *opts.ExpiresAt, Valid: true}
+	}
+
+	coupon, err := s.database.CreateCoupon(ctx, db.CreateCouponParams{
+		Code:           strings.ToUpper(opts.Code),
+		SeriesSlug:     seriesSlug,
+		PercentOff:     opts.PercentOff,
+		MaxRedemptions: opts.MaxRedemptions,
+		ExpiresAt:      expiresAt,
+		CreatedByID:    opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create coupon", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Coupon created", "couponId", coupon.ID)
+	return &coupon, nil
+}
+
+type FindPaginatedCouponsOptions struct {
+	RequestID string
+	Offset    int32
+	Limit     int32
+}
+
+func (s *Services) FindPaginatedCoupons(
+	ctx context.Context,
+	opts FindPaginatedCouponsOptions,
+) ([]db.Coupon, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, couponsLocation, "FindPaginatedCoupons")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, couponsLocation, "FindPaginatedCoupons").With(
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated coupons...")
+
+	count, err := s.database.CountCoupons(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count coupons", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	if count == 0 {
+		log.DebugContext(ctx, "No coupons found", "count", count)
+		return make([]db.Coupon, 0), 0, nil
+	}
+
+	coupons, err := s.database.FindPaginatedCoupons(ctx, db.FindPaginatedCouponsParams{
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated coupons", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	return coupons, count, nil
+}
+
+type UpdateCouponIsActiveOptions struct {
+	RequestID string
+	CouponID  int32
+	IsActive  bool
+}
+
+func (s *Services) UpdateCouponIsActive(
+	ctx context.Context,
+	opts UpdateCouponIsActiveOptions,
+) (*db.Coupon, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, couponsLocation, "UpdateCouponIsActive")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, couponsLocation, "UpdateCouponIsActive").With(
+		"couponId", opts.CouponID,
+		"isActive", opts.IsActive,
+	)
+	log.InfoContext(ctx, "Updating coupon is active...")
+
+	coupon, err := s.database.UpdateCouponIsActive(ctx, db.UpdateCouponIsActiveParams{
+		IsActive: opts.IsActive,
+		ID:       opts.CouponID,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Failed to update coupon", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Coupon updated")
+	return &coupon, nil
+}
@@ -0,0 +1,377 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const deletedUsersLocation string = "deleted_users"
+
+const deletedUsersPurgeBatchSize int32 = 10
+
+// deletedUsersPurgeLockKey identifies the postgres advisory lock that makes
+// sure a single app instance purges deleted users at a time.
+const deletedUsersPurgeLockKey int64 = 4_105_119_244
+
+// reassignDeletedUserContent hands the content authored by a deleted user over to
+// an admin, archiving (unpublishing) the series so they can be reviewed before
+// going live again. Pictures and files are removed as their objects live under
+// the deleted user's key prefix. It runs inside the purge transaction and
+// returns the languages of the archived series.
+func (s *Services) reassignDeletedUserContent(
+	ctx context.Context,
+	requestID string,
+	qrs *db.Queries,
+	user *db.User,
+) ([]string, *exceptions.ServiceError) {
+	log := s.buildLogger(requestID, deletedUsersLocation, "reassignDeletedUserContent").With(
+		"userId", user.ID,
+	)
+	log.InfoContext(ctx, "Reassigning deleted user content...")
+
+	hasContent, err := qrs.UserHasAuthoredContent(ctx, user.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to check authored content", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if !hasContent {
+		log.InfoContext(ctx, "User has no authored content")
+		return nil, nil
+	}
+
+	successor, err := qrs.FindSuccessorAdminUser(ctx, user.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find an admin to hand the content over to", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	publishedSeries, err := qrs.FindPublishedSeriesByAuthorID(ctx, user.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find published series", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	languageSlugs := make([]string, 0, len(publishedSeries))
+	for _, series := range publishedSeries {
+		if _, err = qrs.ToggleSeriesIsPublished(ctx, db.ToggleSeriesIsPublishedParams{
+			ID:          series.ID,
+			IsPublished: true,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to archive series", "error", err, "seriesId", series.ID)
+			return nil, exceptions.FromDBError(err)
+		}
+		if err = qrs.DecrementLanguageSeriesCount(ctx, series.LanguageSlug); err != nil {
+			log.ErrorContext(ctx, "Failed to decrement language series count", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+		languageSlugs = append(languageSlugs, series.LanguageSlug)
+	}
+
+	if err = qrs.DeleteSeriesPicturesByAuthorID(ctx, user.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete series pictures", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.DeleteLessonFilesByAuthorID(ctx, user.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete lesson files", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	if err = qrs.ReassignLanguagesAuthor(ctx, db.ReassignLanguagesAuthorParams{
+		NewAuthorID: successor.ID,
+		OldAuthorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign languages", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.ReassignSeriesAuthor(ctx, db.ReassignSeriesAuthorParams{
+		NewAuthorID: successor.ID,
+		OldAuthorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign series", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.ReassignSeriesVersionsAuthor(ctx, db.ReassignSeriesVersionsAuthorParams{
+		NewAuthorID: successor.ID,
+		OldAuthorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign series versions", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.ReassignSectionsAuthor(ctx, db.ReassignSectionsAuthorParams{
+		NewAuthorID: successor.ID,
+		OldAuthorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign sections", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.ReassignLessonsAuthor(ctx, db.ReassignLessonsAuthorParams{
+		NewAuthorID: successor.ID,
+		OldAuthorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign lessons", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.ReassignLessonArticlesAuthor(ctx, db.ReassignLessonArticlesAuthorParams{
+		NewAuthorID: successor.ID,
+		OldAuthorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign lesson articles", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.ReassignLessonVideosAuthor(ctx, db.ReassignLessonVideosAuthorParams{
+		NewAuthorID: successor.ID,
+		OldAuthorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign lesson videos", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if err = qrs.ReassignCouponsCreator(ctx, db.ReassignCouponsCreatorParams{
+		NewCreatorID: successor.ID,
+		OldCreatorID: user.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reassign coupons", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Reassigned deleted user content", "successorId", successor.ID)
+	return languageSlugs, nil
+}
+
+// transferDeletedUserOrganizations hands the organizations owned by the user
+// over to their longest standing admin, or member promoted to admin, while the
+// organizations without other members are deleted with the user.
+func (s *Services) transferDeletedUserOrganizations(
+	ctx context.Context,
+	requestID string,
+	qrs *db.Queries,
+	user *db.User,
+) *exceptions.ServiceError {
+	log := s.buildLogger(requestID, deletedUsersLocation, "transferDeletedUserOrganizations").With(
+		"userId", user.ID,
+	)
+	log.InfoContext(ctx, "Transferring deleted user organizations...")
+
+	organizations, err := qrs.FindOrganizationsByOwnerIDForUpdate(ctx, user.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find owned organizations", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	for _, organization := range organizations {
+		successor, err := qrs.FindOrganizationSuccessorMember(ctx, db.FindOrganizationSuccessorMemberParams{
+			OrganizationID: organization.ID,
+			UserID:         user.ID,
+		})
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				log.InfoContext(ctx, "Deleting organization without other members", "organizationId", organization.ID)
+				if err := qrs.DeleteOrganizationByID(ctx, organization.ID); err != nil {
+					log.ErrorContext(ctx, "Failed to delete organization", "error", err, "organizationId", organization.ID)
+					return exceptions.FromDBError(err)
+				}
+				continue
+			}
+
+			log.ErrorContext(ctx, "Failed to find organization successor", "error", err, "organizationId", organization.ID)
+			return exceptions.FromDBError(err)
+		}
+
+		if successor.Role != db.OrganizationRoleAdmin {
+			if err := qrs.UpdateOrganizationMemberRole(ctx, db.UpdateOrganizationMemberRoleParams{
+				Role: db.OrganizationRoleAdmin,
+				ID:   successor.ID,
+			}); err != nil {
+				log.ErrorContext(ctx, "Failed to promote organization successor", "error", err, "memberId", successor.ID)
+				return exceptions.FromDBError(err)
+			}
+		}
+		if err := qrs.UpdateOrganizationOwner(ctx, db.UpdateOrganizationOwnerParams{
+			OwnerID: successor.UserID,
+			ID:      organization.ID,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to transfer organization", "error", err, "organizationId", organization.ID)
+			return exceptions.FromDBError(err)
+		}
+	}
+
+	log.InfoContext(ctx, "Transferred deleted user organizations", "organizationsCount", len(organizations))
+	return nil
+}
+
+// purgeDeletedUser hard deletes the user together with their objects, the user
+// row stays locked for the whole purge and every step is guarded by the grace
+// period so a user restored in the meantime is left untouched.
+func (s *Services) purgeDeletedUser(
+	ctx context.Context,
+	requestID string,
+	user *db.User,
+	deletedBefore pgtype.Timestamp,
+) {
+	log := s.buildLogger(requestID, deletedUsersLocation, "purgeDeletedUser").With(
+		"userId", user.ID,
+	)
+	log.InfoContext(ctx, "Purging deleted user...")
+
+	var serviceErr *exceptions.ServiceError
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return
+	}
+	var languageSlugs []string
+	var purged bool
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil && len(languageSlugs) > 0 {
+			s.invalidateCatalog(ctx, log, requestID, languageSlugs...)
+		}
+		if purged {
+			s.deletePurgedUserObjects(ctx, requestID, user.ID)
+		}
+	}()
+
+	if _, err = qrs.FindUserDueForPurgeByIDForUpdate(ctx, db.FindUserDueForPurgeByIDForUpdateParams{
+		ID:        user.ID,
+		DeletedAt: deletedBefore,
+	}); err != nil {
+		if err == pgx.ErrNoRows {
+			log.InfoContext(ctx, "User is no longer due for purge")
+			return
+		}
+
+		log.ErrorContext(ctx, "Failed to lock user due for purge", "error", err)
+		return
+	}
+
+	if languageSlugs, serviceErr = s.reassignDeletedUserContent(ctx, requestID, qrs, user); serviceErr != nil {
+		log.ErrorContext(ctx, "Failed to reassign user content, retrying later", "error", serviceErr)
+		return
+	}
+	if serviceErr = s.transferDeletedUserOrganizations(ctx, requestID, qrs, user); serviceErr != nil {
+		log.ErrorContext(ctx, "Failed to transfer user organizations, retrying later", "error", serviceErr)
+		return
+	}
+
+	deleted, err := qrs.DeleteUserDueForPurgeByID(ctx, db.DeleteUserDueForPurgeByIDParams{
+		ID:        user.ID,
+		DeletedAt: deletedBefore,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to delete user", "error", err)
+		return
+	}
+	if deleted == 0 {
+		log.WarnContext(ctx, "User was not deleted as it is no longer due for purge")
+		return
+	}
+
+	if err = qrs.CreatePurgedUserObjects(ctx, user.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to record user objects for deletion", "error", err)
+		return
+	}
+
+	purged = true
+	log.InfoContext(ctx, "Purged deleted user successfully")
+}
+
+// deletePurgedUserObjects removes the stored objects of a user whose purge already
+// committed, the pending record is only cleared once they are gone so a failure is
+// retried on the next run.
+func (s *Services) deletePurgedUserObjects(ctx context.Context, requestID string, userID int32) {
+	log := s.buildLogger(requestID, deletedUsersLocation, "deletePurgedUserObjects").With(
+		"userId", userID,
+	)
+	log.InfoContext(ctx, "Deleting purged user objects...")
+
+	if _, err := s.objStg.DeleteUserObjects(ctx, requestID, userID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete user objects, retrying later", "error", err)
+		return
+	}
+	if err := s.database.DeletePurgedUserObjects(ctx, userID); err != nil {
+		log.ErrorContext(ctx, "Failed to clear purged user objects record", "error", err)
+		return
+	}
+
+	log.InfoContext(ctx, "Deleted purged user objects successfully")
+}
+
+// PurgeDeletedUsers permanently removes the users whose deletion grace period has elapsed,
+// only one app instance purges at a time.
+func (s *Services) PurgeDeletedUsers(ctx context.Context, requestID string, gracePeriod time.Duration) {
+	ctx, span := s.startSpan(ctx, deletedUsersLocation, "PurgeDeletedUsers")
+	defer span.End()
+
+	log := s.buildLogger(requestID, deletedUsersLocation, "PurgeDeletedUsers")
+	log.DebugContext(ctx, "Purging deleted users...")
+
+	acquired, err := s.database.WithAdvisoryLock(ctx, deletedUsersPurgeLockKey, func(ctx context.Context) {
+		pendingUserIDs, err := s.database.FindPurgedUserObjects(ctx, deletedUsersPurgeBatchSize)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find purged user objects", "error", err)
+			return
+		}
+
+		for _, userID := range pendingUserIDs {
+			s.deletePurgedUserObjects(ctx, requestID, userID)
+		}
+
+		deletedBefore := pgtype.Timestamp{Time: time.Now().Add(-gracePeriod), Valid: true}
+		users, err := s.database.FindUsersDueForPurge(ctx, db.FindUsersDueForPurgeParams{
+			DeletedAt: deletedBefore,
+			Limit:     deletedUsersPurgeBatchSize,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find users due for purge", "error", err)
+			return
+		}
+
+		for i := range users {
+			s.purgeDeletedUser(ctx, requestID, &users[i], deletedBefore)
+		}
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to take the deleted users purge lock", "error", err)
+		return
+	}
+	if !acquired {
+		log.DebugContext(ctx, "Deleted users are being purged by another instance")
+	}
+}
+
+// RunDeletedUsersPurgeWorker purges deleted users until the context is cancelled.
+func (s *Services) RunDeletedUsersPurgeWorker(ctx context.Context, interval, gracePeriod time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.PurgeDeletedUsers(ctx, uuid.NewString(), gracePeriod)
+		}
+	}
+}
@@ -0,0 +1,698 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const editorialLocation string = "editorial"
+
+const (
+	EditorialStatusDraft            string = "draft"
+	EditorialStatusInReview         string = "in_review"
+	EditorialStatusChangesRequested string = "changes_requested"
+	EditorialStatusApproved         string = "approved"
+)
+
+type editorialRole int
+
+const (
+	editorialRoleAuthor editorialRole = iota
+	editorialRoleReviewer
+)
+
+// editorialTransitions lists, per target status, who can move the series
+// there and from which statuses.
+var editorialTransitions = map[string]struct {
+	role editorialRole
+	from []string
+}{
+	EditorialStatusDraft: {
+		role: editorialRoleAuthor,
+		from: []string{EditorialStatusInReview, EditorialStatusChangesRequested, EditorialStatusApproved},
+	},
+	EditorialStatusInReview: {
+		role: editorialRoleAuthor,
+		from: []string{EditorialStatusDraft, EditorialStatusChangesRequested},
+	},
+	EditorialStatusChangesRequested: {
+		role: editorialRoleReviewer,
+		from: []string{EditorialStatusInReview},
+	},
+	EditorialStatusApproved: {
+		role: editorialRoleReviewer,
+		from: []string{EditorialStatusInReview},
+	},
+}
+
+// assertEditorialAccess lets through the author of the series and its
+// reviewers, returning the role of the user.
+func (s *Services) assertEditorialAccess(
+	ctx context.Context,
+	log *slog.Logger,
+	series *db.Series,
+	userID int32,
+) (editorialRole, *exceptions.ServiceError) {
+	if series.AuthorID == userID {
+		return editorialRoleAuthor, nil
+	}
+
+	if _, err := s.database.FindSeriesEditorialReviewerBySeriesSlugAndUserID(
+		ctx,
+		db.FindSeriesEditorialReviewerBySeriesSlugAndUserIDParams{
+			SeriesSlug: series.Slug,
+			UserID:     userID,
+		},
+	); err != nil {
+		if err == pgx.ErrNoRows {
+			log.WarnContext(ctx, "User is neither the author nor a reviewer of the series")
+			return 0, exceptions.NewForbiddenError()
+		}
+
+		log.ErrorContext(ctx, "Failed to find series reviewer", "error", err)
+		return 0, exceptions.FromDBError(err)
+	}
+
+	return editorialRoleReviewer, nil
+}
+
+type FindSeriesEditorialOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+func (s *Services) FindSeriesEditorial(
+	ctx context.Context,
+	opts FindSeriesEditorialOptions,
+) (*db.SeriesEditorialModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, editorialLocation, "FindSeriesEditorial")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, editorialLocation, "FindSeriesEditorial").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding series editorial...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if _, serviceErr := s.assertEditorialAccess(ctx, log, series, opts.UserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	return s.buildSeriesEditorial(ctx, log, series)
+}
+
+func (s *Services) buildSeriesEditorial(
+	ctx context.Context,
+	log *slog.Logger,
+	series *db.Series,
+) (*db.SeriesEditorialModel, *exceptions.ServiceError) {
+	language, serviceErr := s.FindLanguageBySlug(ctx, series.LanguageSlug)
+	if serviceErr != nil {
+		log.ErrorContext(ctx, "Failed to find series language", "error", serviceErr)
+		return nil, serviceErr
+	}
+
+	reviewers, err := s.database.FindSeriesEditorialReviewersWithUserBySeriesSlug(ctx, series.Slug)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series reviewers", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	changeRequests, err := s.database.FindSeriesChangeRequestsWithReviewerBySeriesSlug(ctx, series.Slug)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series change requests", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	reviewerModels := make([]db.SeriesEditorialReviewerModel, 0, len(reviewers))
+	for i := range reviewers {
+		reviewerModels = append(reviewerModels, *reviewers[i].ToSeriesEditorialReviewerModel())
+	}
+
+	changeRequestModels := make([]db.SeriesChangeRequestModel, 0, len(changeRequests))
+	for i := range changeRequests {
+		changeRequestModels = append(changeRequestModels, *changeRequests[i].ToSeriesChangeRequestModel())
+	}
+
+	return &db.SeriesEditorialModel{
+		LanguageSlug:   series.LanguageSlug,
+		SeriesSlug:     series.Slug,
+		Status:         series.EditorialStatus,
+		RequiresReview: language.RequiresEditorialReview,
+		IsPublished:    series.IsPublished,
+		Reviewers:      reviewerModels,
+		ChangeRequests: changeRequestModels,
+	}, nil
+}
+
+type UpdateSeriesEditorialStatusOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	Status       string
+	Comment      string
+}
+
+func (s *Services) UpdateSeriesEditorialStatus(
+	ctx context.Context,
+	opts UpdateSeriesEditorialStatusOptions,
+) (*db.SeriesEditorialModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, editorialLocation, "UpdateSeriesEditorialStatus")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, editorialLocation, "UpdateSeriesEditorialStatus").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"status", opts.Status,
+	)
+	log.InfoContext(ctx, "Updating series editorial status...")
+
+	transition, ok := editorialTransitions[opts.Status]
+	if !ok {
+		log.WarnContext(ctx, "Unknown editorial status")
+		return nil, exceptions.NewValidationError("Unknown editorial status")
+	}
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	role, serviceErr := s.assertEditorialAccess(ctx, log, series, opts.UserID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if role != transition.role {
+		log.WarnContext(ctx, "User cannot perform this editorial transition", "role", role)
+		return nil, exceptions.NewForbiddenError()
+	}
+
+	allowed := false
+	for _, from := range transition.from {
+		if series.EditorialStatus == from {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		log.WarnContext(ctx, "Invalid editorial transition", "currentStatus", series.EditorialStatus)
+		return nil, exceptions.NewValidationError("Series cannot move from " + series.EditorialStatus + " to " + opts.Status)
+	}
+	if opts.Status == EditorialStatusDraft && series.IsPublished {
+		log.WarnContext(ctx, "Published series cannot go back to draft")
+		return nil, exceptions.NewValidationError("Series must be unpublished to go back to draft")
+	}
+	if opts.Status == EditorialStatusChangesRequested && opts.Comment == "" {
+		log.WarnContext(ctx, "Change requests need a comment")
+		return nil, exceptions.NewValidationError("Change requests must have a comment")
+	}
+	if opts.Status == EditorialStatusInReview {
+		count, err := s.database.CountSeriesEditorialReviewersBySeriesSlug(ctx, series.Slug)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count series reviewers", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+		if count == 0 {
+			log.WarnContext(ctx, "Series has no reviewers")
+			return nil, exceptions.NewValidationError("Series must have reviewers to be submitted for review")
+		}
+	}
+
+	if serviceErr := s.persistSeriesEditorialStatus(ctx, log, series, opts); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Series editorial status updated")
+	return s.buildSeriesEditorial(ctx, log, series)
+}
+
+func (s *Services) persistSeriesEditorialStatus(
+	ctx context.Context,
+	log *slog.Logger,
+	series *db.Series,
+	opts UpdateSeriesEditorialStatusOptions,
+) (serviceErr *exceptions.ServiceError) {
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, series.LanguageSlug)
+		}
+	}()
+
+	*series, err = qrs.UpdateSeriesEditorialStatus(ctx, db.UpdateSeriesEditorialStatusParams{
+		ID:              series.ID,
+		EditorialStatus: opts.Status,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update series editorial status", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	switch opts.Status {
+	case EditorialStatusChangesRequested:
+		if _, err = qrs.CreateSeriesChangeRequest(ctx, db.CreateSeriesChangeRequestParams{
+			Comment:      opts.Comment,
+			LanguageSlug: series.LanguageSlug,
+			SeriesSlug:   series.Slug,
+			ReviewerID:   opts.UserID,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to create series change request", "error", err)
+			return exceptions.FromDBError(err)
+		}
+	case EditorialStatusInReview:
+		// Resubmitting addresses every open change request
+		if err = qrs.ResolveSeriesChangeRequests(ctx, series.Slug); err != nil {
+			log.ErrorContext(ctx, "Failed to resolve series change requests", "error", err)
+			return exceptions.FromDBError(err)
+		}
+	}
+
+	return nil
+}
+
+type AddSeriesEditorialReviewerOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewerID   int32
+}
+
+func (s *Services) AddSeriesEditorialReviewer(
+	ctx context.Context,
+	opts AddSeriesEditorialReviewerOptions,
+) (*db.SeriesEditorialReviewerModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, editorialLocation, "AddSeriesEditorialReviewer")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, editorialLocation, "AddSeriesEditorialReviewer").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewerId", opts.ReviewerID,
+	)
+	log.InfoContext(ctx, "Adding series editorial reviewer...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.AuthorID != opts.UserID {
+		log.WarnContext(ctx, "Only the series author can manage reviewers", "authorId", series.AuthorID)
+		return nil, exceptions.NewForbiddenError()
+	}
+	if opts.ReviewerID == series.AuthorID {
+		log.WarnContext(ctx, "Author cannot review their own series")
+		return nil, exceptions.NewValidationError("Author cannot review their own series")
+	}
+
+	reviewer, serviceErr := s.FindUserByID(ctx, FindUserByIDOptions{
+		RequestID: opts.RequestID,
+		ID:        opts.ReviewerID,
+	})
+	if serviceErr != nil {
+		log.WarnContext(ctx, "Reviewer not found", "error", serviceErr)
+		return nil, serviceErr
+	}
+	if !reviewer.IsStaff {
+		log.WarnContext(ctx, "Reviewer is not staff")
+		return nil, exceptions.NewValidationError("Reviewer must be a staff user")
+	}
+
+	if _, err := s.database.FindSeriesEditorialReviewerBySeriesSlugAndUserID(
+		ctx,
+		db.FindSeriesEditorialReviewerBySeriesSlugAndUserIDParams{
+			SeriesSlug: series.Slug,
+			UserID:     reviewer.ID,
+		},
+	); err == nil {
+		log.WarnContext(ctx, "User is already a reviewer")
+		return nil, exceptions.NewConflictError("User is already a reviewer")
+	} else if err != pgx.ErrNoRows {
+		log.ErrorContext(ctx, "Failed to find series reviewer", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	seriesReviewer, err := s.database.CreateSeriesEditorialReviewer(ctx, db.CreateSeriesEditorialReviewerParams{
+		LanguageSlug: series.LanguageSlug,
+		SeriesSlug:   series.Slug,
+		UserID:       reviewer.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create series reviewer", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series editorial reviewer added")
+	return seriesReviewer.ToSeriesEditorialReviewerModel(reviewer), nil
+}
+
+type RemoveSeriesEditorialReviewerOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewerID   int32
+}
+
+func (s *Services) RemoveSeriesEditorialReviewer(
+	ctx context.Context,
+	opts RemoveSeriesEditorialReviewerOptions,
+) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, editorialLocation, "RemoveSeriesEditorialReviewer")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, editorialLocation, "RemoveSeriesEditorialReviewer").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewerId", opts.ReviewerID,
+	)
+	log.InfoContext(ctx, "Removing series editorial reviewer...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if series.AuthorID != opts.UserID {
+		log.WarnContext(ctx, "Only the series author can manage reviewers", "authorId", series.AuthorID)
+		return exceptions.NewForbiddenError()
+	}
+
+	seriesReviewer, err := s.database.FindSeriesEditorialReviewerBySeriesSlugAndUserID(
+		ctx,
+		db.FindSeriesEditorialReviewerBySeriesSlugAndUserIDParams{
+			SeriesSlug: series.Slug,
+			UserID:     opts.ReviewerID,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Series reviewer not found", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	if series.EditorialStatus == EditorialStatusInReview {
+		count, err := s.database.CountSeriesEditorialReviewersBySeriesSlug(ctx, series.Slug)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count series reviewers", "error", err)
+			return exceptions.FromDBError(err)
+		}
+		if count <= 1 {
+			log.WarnContext(ctx, "Cannot remove the last reviewer of a series in review")
+			return exceptions.NewConflictError("Series in review must keep at least one reviewer")
+		}
+	}
+
+	if err := s.database.DeleteSeriesEditorialReviewerByID(ctx, seriesReviewer.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete series reviewer", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series editorial reviewer removed")
+	return nil
+}
+
+type findEditorialLessonOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+}
+
+// findEditorialLesson finds a lesson, published or not, after checking
+// that the user takes part in the editorial review of its series.
+func (s *Services) findEditorialLesson(
+	ctx context.Context,
+	log *slog.Logger,
+	opts findEditorialLessonOptions,
+) (*db.Lesson, *exceptions.ServiceError) {
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if _, serviceErr := s.assertEditorialAccess(ctx, log, series, opts.UserID); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	return s.FindLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+}
+
+type FindLessonEditorialCommentsOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+}
+
+func (s *Services) FindLessonEditorialComments(
+	ctx context.Context,
+	opts FindLessonEditorialCommentsOptions,
+) ([]db.LessonEditorialCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, editorialLocation, "FindLessonEditorialComments")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, editorialLocation, "FindLessonEditorialComments").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+	)
+	log.InfoContext(ctx, "Finding lesson editorial comments...")
+
+	lesson, serviceErr := s.findEditorialLesson(ctx, log, findEditorialLessonOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	comments, err := s.database.FindLessonEditorialCommentsWithAuthorByLessonID(ctx, lesson.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson editorial comments", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	models := make([]db.LessonEditorialCommentModel, 0, len(comments))
+	for i := range comments {
+		models = append(models, *comments[i].ToLessonEditorialCommentModel())
+	}
+
+	log.InfoContext(ctx, "Lesson editorial comments found")
+	return models, nil
+}
+
+type CreateLessonEditorialCommentOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	Body         string
+}
+
+func (s *Services) CreateLessonEditorialComment(
+	ctx context.Context,
+	opts CreateLessonEditorialCommentOptions,
+) (*db.LessonEditorialCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, editorialLocation, "CreateLessonEditorialComment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, editorialLocation, "CreateLessonEditorialComment").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+	)
+	log.InfoContext(ctx, "Creating lesson editorial comment...")
+
+	lesson, serviceErr := s.findEditorialLesson(ctx, log, findEditorialLessonOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	author, serviceErr := s.FindUserByID(ctx, FindUserByIDOptions{
+		RequestID: opts.RequestID,
+		ID:        opts.UserID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	comment, err := s.database.CreateLessonEditorialComment(ctx, db.CreateLessonEditorialCommentParams{
+		Body:         opts.Body,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		LessonID:     lesson.ID,
+		AuthorID:     author.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create lesson editorial comment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson editorial comment created")
+	return comment.ToLessonEditorialCommentModel(author), nil
+}
+
+type UpdateLessonEditorialCommentIsResolvedOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+	IsResolved   bool
+}
+
+func (s *Services) UpdateLessonEditorialCommentIsResolved(
+	ctx context.Context,
+	opts UpdateLessonEditorialCommentIsResolvedOptions,
+) (*db.LessonEditorialCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, editorialLocation, "UpdateLessonEditorialCommentIsResolved")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, editorialLocation, "UpdateLessonEditorialCommentIsResolved").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+		"isResolved", opts.IsResolved,
+	)
+	log.InfoContext(ctx, "Updating lesson editorial comment is resolved...")
+
+	lesson, serviceErr := s.findEditorialLesson(ctx, log, findEditorialLessonOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	comment, err := s.database.FindLessonEditorialCommentByLessonIDAndID(
+		ctx,
+		db.FindLessonEditorialCommentByLessonIDAndIDParams{
+			LessonID: lesson.ID,
+			ID:       opts.CommentID,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Lesson editorial comment not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	author, serviceErr := s.FindUserByID(ctx, FindUserByIDOptions{
+		RequestID: opts.RequestID,
+		ID:        comment.AuthorID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if comment.IsResolved == opts.IsResolved {
+		log.InfoContext(ctx, "Lesson editorial comment already has the given resolution")
+		return comment.ToLessonEditorialCommentModel(author), nil
+	}
+
+	comment, err = s.database.UpdateLessonEditorialCommentIsResolved(
+		ctx,
+		db.UpdateLessonEditorialCommentIsResolvedParams{
+			IsResolved: opts.IsResolved,
+			ID:         comment.ID,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson editorial comment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson editorial comment updated")
+	return comment.ToLessonEditorialCommentModel(author), nil
+}
@@ -0,0 +1,212 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const enrollmentsLocation string = "enrollments"
+
+type assertLessonContentAccessOptions struct {
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	IsPreview    bool
+}
+
+// assertLessonContentAccess gates the article, video and files of paid series
+// lessons to their author and enrolled users, and the ones of members only
+// series to signed in users, previews stay open to everyone.
+// Anonymous callers are passed with a zero user id.
+func (s *Services) assertLessonContentAccess(
+	ctx context.Context,
+	log *slog.Logger,
+	opts assertLessonContentAccessOptions,
+) *exceptions.ServiceError {
+	if opts.IsPreview {
+		return nil
+	}
+
+	series, err := s.database.FindSeriesBySlugAndLanguageSlug(ctx, db.FindSeriesBySlugAndLanguageSlugParams{
+		Slug:         opts.SeriesSlug,
+		LanguageSlug: opts.LanguageSlug,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Series not found", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	if series.IsMembersOnly && opts.UserID == 0 {
+		log.InfoContext(ctx, "Anonymous user cannot access members only lesson content")
+		return exceptions.NewSignInRequiredError()
+	}
+	if series.Price == 0 || (opts.UserID != 0 && series.AuthorID == opts.UserID) {
+		return nil
+	}
+	if opts.UserID == 0 {
+		log.InfoContext(ctx, "Anonymous user cannot access paid lesson content")
+		return exceptions.NewPaymentRequiredError()
+	}
+
+	hasAccess, err := s.database.HasSeriesAccess(ctx, db.HasSeriesAccessParams{
+		UserID:     opts.UserID,
+		SeriesSlug: opts.SeriesSlug,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to check series access", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	if !hasAccess {
+		log.InfoContext(ctx, "User is not enrolled in the series")
+		return exceptions.NewPaymentRequiredError()
+	}
+
+	return nil
+}
+
+type FindEnrollmentOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+func (s *Services) FindEnrollment(
+	ctx context.Context,
+	opts FindEnrollmentOptions,
+) (*db.Enrollment, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, enrollmentsLocation, "FindEnrollment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, enrollmentsLocation, "FindEnrollment").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding enrollment...")
+
+	enrollment, err := s.database.FindEnrollmentByUserIDAndSeriesSlug(
+		ctx,
+		db.FindEnrollmentByUserIDAndSeriesSlugParams{
+			UserID:     opts.UserID,
+			SeriesSlug: opts.SeriesSlug,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Enrollment not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if enrollment.LanguageSlug != opts.LanguageSlug {
+		log.WarnContext(ctx, "Enrollment belongs to another language")
+		return nil, exceptions.NewNotFoundError()
+	}
+
+	log.InfoContext(ctx, "Enrollment found")
+	return &enrollment, nil
+}
+
+type CreateEnrollmentOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+// CreateEnrollment enrolls the user for free in free series, or through an
+// organization license when one of their cohorts was assigned a paid series.
+// Other paid series must go through checkout.
+func (s *Services) CreateEnrollment(
+	ctx context.Context,
+	opts CreateEnrollmentOptions,
+) (*db.Enrollment, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, enrollmentsLocation, "CreateEnrollment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, enrollmentsLocation, "CreateEnrollment").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Creating enrollment...")
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if _, err := s.database.FindEnrollmentByUserIDAndSeriesSlug(
+		ctx,
+		db.FindEnrollmentByUserIDAndSeriesSlugParams{
+			UserID:     opts.UserID,
+			SeriesSlug: series.Slug,
+		},
+	); err == nil {
+		log.WarnContext(ctx, "User is already enrolled in the series")
+		return nil, exceptions.NewConflictError("Already enrolled in the series")
+	} else if err != pgx.ErrNoRows {
+		log.ErrorContext(ctx, "Failed to find enrollment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	params := db.CreateEnrollmentParams{
+		UserID:       opts.UserID,
+		LanguageSlug: series.LanguageSlug,
+		SeriesSlug:   series.Slug,
+		Source:       db.EnrollmentSourceFree,
+	}
+	if series.Price > 0 {
+		organizationID, err := s.database.FindOrganizationLicenseByUserIDAndSeriesSlug(
+			ctx,
+			db.FindOrganizationLicenseByUserIDAndSeriesSlugParams{
+				UserID:     opts.UserID,
+				SeriesSlug: series.Slug,
+			},
+		)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				log.InfoContext(ctx, "Paid series requires checkout")
+				return nil, exceptions.NewPaymentRequiredError()
+			}
+
+			log.ErrorContext(ctx, "Failed to find organization license", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+
+		params.Source = db.EnrollmentSourceOrganization
+		params.OrganizationID = pgtype.Int4{Int32: organizationID, Valid: true}
+	}
+
+	enrollment, err := s.database.CreateEnrollment(ctx, params)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create enrollment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Enrollment created", "enrollmentId", enrollment.ID, "source", enrollment.Source)
+	return &enrollment, nil
+}
@@ -19,6 +19,9 @@ type FindFileURLOptions struct {
 }
 
 func (s *Services) FindFileURL(ctx context.Context, opts FindFileURLOptions) (string, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, filesLocation, "FindFileURL")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, filesLocation, "FindFileURL").With(
 		"userId", opts.UserID,
 		"fileId", opts.FileID,
@@ -79,6 +82,9 @@ func (c *FileURLsContainer) Get(fileID uuid.UUID) (string, bool) {
 }
 
 func (s *Services) FindFileURLs(ctx context.Context, opts []FindFileURLOptions) (*FileURLsContainer, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, filesLocation, "FindFileURLs")
+	defer span.End()
+
 	var wg sync.WaitGroup
 	container := FileURLsContainer{
 		urls: make(map[uuid.UUID]string),
@@ -0,0 +1,123 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	healthLocation string = "health"
+
+	HealthStatusUp   string = "up"
+	HealthStatusDown string = "down"
+
+	HealthDependencyPostgres string = "postgres"
+	HealthDependencyRedis    string = "redis"
+	HealthDependencyS3       string = "s3"
+	HealthDependencySMTP     string = "smtp"
+
+	healthCheckTimeout time.Duration = 2 * time.Second
+)
+
+type DependencyHealth struct {
+	Name    string
+	Status  string
+	Latency time.Duration
+	Error   string
+}
+
+type ReadinessReport struct {
+	Ready        bool
+	ShuttingDown bool
+	Dependencies []DependencyHealth
+}
+
+// BeginShutdown flips the service into draining mode, from then on readiness
+// checks fail so load balancers stop routing new traffic to this instance.
+func (s *Services) BeginShutdown() {
+	s.shuttingDown.Store(true)
+}
+
+func (s *Services) IsShuttingDown() bool {
+	return s.shuttingDown.Load()
+}
+
+func checkDependency(ctx context.Context, name string, ping func(context.Context) error) DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := ping(ctx)
+	health := DependencyHealth{
+		Name:    name,
+		Status:  HealthStatusUp,
+		Latency: time.Since(start),
+	}
+	if err != nil {
+		health.Status = HealthStatusDown
+		health.Error = err.Error()
+	}
+
+	return health
+}
+
+func (s *Services) CheckReadiness(ctx context.Context, requestID string) *ReadinessReport {
+	ctx, span := s.startSpan(ctx, healthLocation, "CheckReadiness")
+	defer span.End()
+	log := s.buildLogger(requestID, healthLocation, "CheckReadiness")
+	log.InfoContext(ctx, "Checking readiness...")
+
+	pings := []struct {
+		name string
+		ping func(context.Context) error
+	}{
+		{HealthDependencyPostgres, s.database.Ping},
+		{HealthDependencyRedis, s.cache.Ping},
+		{HealthDependencyS3, s.objStg.Ping},
+		{HealthDependencySMTP, s.mail.Ping},
+	}
+	dependencies := make([]DependencyHealth, len(pings))
+
+	var wg sync.WaitGroup
+	for i, p := range pings {
+		wg.Add(1)
+		go func(i int, name string, ping func(context.Context) error) {
+			defer wg.Done()
+			dependencies[i] = checkDependency(ctx, name, ping)
+		}(i, p.name, p.ping)
+	}
+	wg.Wait()
+
+	shuttingDown := s.IsShuttingDown()
+	report := &ReadinessReport{
+		Ready:        !shuttingDown,
+		ShuttingDown: shuttingDown,
+		Dependencies: dependencies,
+	}
+	for _, dependency := range dependencies {
+		if dependency.Status != HealthStatusUp {
+			log.WarnContext(ctx, "Dependency is down", "dependency", dependency.Name, "error", dependency.Error)
+			report.Ready = false
+		}
+	}
+
+	return report
+}
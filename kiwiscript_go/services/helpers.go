@@ -18,9 +18,15 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/providers/telemetry"
 	"github.com/kiwiscript/kiwiscript_go/utils"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (s *Services) buildLogger(requestID, location, function string) *slog.Logger {
@@ -31,3 +37,118 @@ func (s *Services) buildLogger(requestID, location, function string) *slog.Logge
 		RequestID: requestID,
 	})
 }
+
+func (s *Services) startSpan(ctx context.Context, location, function string) (context.Context, trace.Span) {
+	return telemetry.StartSpan(ctx, "services."+location+"."+function, trace.SpanKindInternal)
+}
+
+// CursorPage is a keyset paginated page, Count is only set when the caller
+// asked for the total since counting defeats the point of keyset queries.
+type CursorPage[T any] struct {
+	Items []T
+	Count *int64
+	Next  *utils.Cursor
+	Prev  *utils.Cursor
+}
+
+// NextCursor encodes the cursor of the next page, empty on the last one.
+func (p *CursorPage[T]) NextCursor() string {
+	if p.Next == nil {
+		return ""
+	}
+
+	return p.Next.Encode()
+}
+
+// PrevCursor encodes the cursor of the previous page, empty on the first one.
+func (p *CursorPage[T]) PrevCursor() string {
+	if p.Prev == nil {
+		return ""
+	}
+
+	return p.Prev.Encode()
+}
+
+// newCursorPage builds a page from rows fetched with limit + 1, rows from a
+// backward cursor come in reverse order and are flipped back.
+func newCursorPage[T any, V any](
+	rows []V,
+	limit int32,
+	cursor *utils.Cursor,
+	keyOf func(*V, bool) *utils.Cursor,
+	mapper func(*V) *T,
+) CursorPage[T] {
+	hasMore := int32(len(rows)) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	items := make([]T, 0, len(rows))
+	for i := range rows {
+		items = append(items, *mapper(&rows[i]))
+	}
+
+	page := CursorPage[T]{Items: items}
+	if len(rows) == 0 {
+		return page
+	}
+
+	if hasMore || backward {
+		page.Next = keyOf(&rows[len(rows)-1], false)
+	}
+	if (backward && hasMore) || (!backward && cursor != nil) {
+		page.Prev = keyOf(&rows[0], true)
+	}
+
+	return page
+}
+
+// AnyVersion skips the optimistic concurrency check, it is reserved to internal
+// callers such as the scheduler as requests must always send a version.
+const AnyVersion int32 = 0
+
+func assertVersion(ctx context.Context, log *slog.Logger, expected, current int32) *exceptions.ServiceError {
+	if expected != AnyVersion && expected != current {
+		log.WarnContext(ctx, "Stale version", "expectedVersion", expected, "currentVersion", current)
+		return exceptions.NewPreconditionFailedError()
+	}
+
+	return nil
+}
+
+// versionedUpdateError maps the error of an update guarded by the version column,
+// no rows means that the version changed between the read and the write.
+func versionedUpdateError(err error) *exceptions.ServiceError {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return exceptions.NewPreconditionFailedError()
+	}
+
+	return exceptions.FromDBError(err)
+}
+
+// isPermutation checks that ids holds every id of current exactly once, ids
+// are already known to be unique from the request validation.
+func isPermutation(ids, current []int32) bool {
+	if len(ids) != len(current) {
+		return false
+	}
+
+	idSet := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		idSet[id] = true
+	}
+	for _, id := range current {
+		if !idSet[id] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -32,6 +32,9 @@ type FindLanguageProgressOptions struct {
 }
 
 func (s *Services) FindLanguageProgressBySlug(ctx context.Context, opts FindLanguageProgressOptions) (*db.LanguageProgress, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languageProgressLocation, "FindLanguageProgressBySlug")
+	defer span.End()
+
 	log := s.log.WithGroup("service.language.FindLanguageProgressByUserIDAndLanguageSlug").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -78,6 +81,9 @@ func (s *Services) CreateOrUpdateLanguageProgress(
 	ctx context.Context,
 	opts CreateOrUpdateLanguageProgressOptions,
 ) (*db.Language, *db.LanguageProgress, bool, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languageProgressLocation, "CreateOrUpdateLanguageProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languageProgressLocation, "CreateOrUpdateLanguageProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -119,6 +125,9 @@ type DeleteLanguageProgressOptions struct {
 }
 
 func (s *Services) DeleteLanguageProgress(ctx context.Context, opts DeleteLanguageProgressOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, languageProgressLocation, "DeleteLanguageProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languageProgressLocation, "DeleteLanguageProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
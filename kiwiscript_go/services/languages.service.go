@@ -28,6 +28,9 @@ import (
 const languagesLocation string = "languages"
 
 func (s *Services) FindLanguageBySlug(ctx context.Context, slug string) (*db.Language, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "FindLanguageBySlug")
+	defer span.End()
+
 	language, err := s.database.FindLanguageBySlug(ctx, slug)
 
 	if err != nil {
@@ -47,6 +50,9 @@ func (s *Services) FindLanguageWithProgressBySlug(
 	ctx context.Context,
 	opts FindLanguageWithProgressBySlugOptions,
 ) (*db.FindLanguageBySlugWithLanguageProgressRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "FindLanguageWithProgressBySlug")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "FindLanguageWithProgressBySlug").With(
 		"userId", opts.UserID,
 		"slug", opts.LanguageSlug,
@@ -69,6 +75,9 @@ func (s *Services) FindLanguageWithProgressBySlug(
 }
 
 func (s *Services) FindLanguageByID(ctx context.Context, id int32) (db.Language, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "FindLanguageByID")
+	defer span.End()
+
 	language, err := s.database.FindLanguageById(ctx, id)
 
 	if err != nil {
@@ -86,6 +95,9 @@ type CreateLanguageOptions struct {
 }
 
 func (s *Services) CreateLanguage(ctx context.Context, opts CreateLanguageOptions) (*db.Language, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "CreateLanguage")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "CreateLanguage").With(
 		"userId", opts.UserID,
 		"name", opts.Name,
@@ -108,6 +120,7 @@ func (s *Services) CreateLanguage(ctx context.Context, opts CreateLanguageOption
 		return nil, exceptions.FromDBError(err)
 	}
 
+	s.invalidateCatalog(ctx, log, opts.RequestID)
 	return &language, nil
 }
 
@@ -119,6 +132,9 @@ type UpdateLanguageOptions struct {
 }
 
 func (s *Services) UpdateLanguage(ctx context.Context, opts UpdateLanguageOptions) (*db.Language, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "UpdateLanguage")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "UpdateLanguage").With(
 		"slug", opts.Slug,
 		"name", opts.Name,
@@ -137,7 +153,21 @@ func (s *Services) UpdateLanguage(ctx context.Context, opts UpdateLanguageOption
 		return nil, exceptions.NewValidationError("language already exists")
 	}
 
-	updateLanguage, err := s.database.UpdateLanguage(ctx, db.UpdateLanguageParams{
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	var updateLanguage db.Language
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.Slug, updateLanguage.Slug)
+		}
+	}()
+
+	updateLanguage, err = qrs.UpdateLanguage(ctx, db.UpdateLanguageParams{
 		ID:   language.ID,
 		Name: opts.Name,
 		Icon: opts.Icon,
@@ -148,15 +178,72 @@ func (s *Services) UpdateLanguage(ctx context.Context, opts UpdateLanguageOption
 		return nil, exceptions.FromDBError(err)
 	}
 
+	// The old slug keeps redirecting to the language
+	if err = qrs.CreateLanguageSlugHistory(ctx, db.CreateLanguageSlugHistoryParams{
+		LanguageID: language.ID,
+		OldSlug:    language.Slug,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to create language slug history", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
 	return &updateLanguage, nil
 }
 
+type UpdateLanguageRequiresReviewOptions struct {
+	RequestID      string
+	Slug           string
+	RequiresReview bool
+}
+
+func (s *Services) UpdateLanguageRequiresReview(
+	ctx context.Context,
+	opts UpdateLanguageRequiresReviewOptions,
+) (*db.Language, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "UpdateLanguageRequiresReview")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, languagesLocation, "UpdateLanguageRequiresReview").With(
+		"slug", opts.Slug,
+		"requiresReview", opts.RequiresReview,
+	)
+	log.InfoContext(ctx, "Updating language requires review...")
+
+	language, serviceErr := s.FindLanguageBySlug(ctx, opts.Slug)
+	if serviceErr != nil {
+		log.InfoContext(ctx, "language not found", "slug", opts.Slug)
+		return nil, serviceErr
+	}
+	if language.RequiresEditorialReview == opts.RequiresReview {
+		log.InfoContext(ctx, "Language requires review is already set")
+		return language, nil
+	}
+
+	updatedLanguage, err := s.database.UpdateLanguageRequiresEditorialReview(
+		ctx,
+		db.UpdateLanguageRequiresEditorialReviewParams{
+			ID:                      language.ID,
+			RequiresEditorialReview: opts.RequiresReview,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update language requires review", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.Slug)
+	return &updatedLanguage, nil
+}
+
 type DeleteLanguageOptions struct {
 	RequestID string
 	Slug      string
 }
 
 func (s *Services) DeleteLanguage(ctx context.Context, opts DeleteLanguageOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, languagesLocation, "DeleteLanguage")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "DeleteLanguage").With(
 		"slug", opts.Slug,
 	)
@@ -184,6 +271,7 @@ func (s *Services) DeleteLanguage(ctx context.Context, opts DeleteLanguageOption
 		return exceptions.FromDBError(err)
 	}
 
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.Slug)
 	return nil
 }
 
@@ -198,6 +286,9 @@ func (s *Services) FindPaginatedLanguages(
 	ctx context.Context,
 	opts FindPaginatedLanguagesOptions,
 ) ([]db.Language, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "FindPaginatedLanguages")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "GetLanguages").With(
 		"search", opts.Search,
 		"offset", opts.Offset,
@@ -265,6 +356,9 @@ func (s *Services) FindPaginatedLanguagesWithProgress(
 	ctx context.Context,
 	opts FindPaginatedLanguagesWithProgressOptions,
 ) ([]db.FindPaginatedLanguagesWithLanguageProgressRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "FindPaginatedLanguagesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "FindPaginatedLanguagesWithProgress").With(
 		"userId", opts.UserID,
 		"offset", opts.Offset,
@@ -311,6 +405,9 @@ func (s *Services) FindFilteredPaginatedLanguagesWithProgress(
 	ctx context.Context,
 	opts FindFilteredPaginatedLanguagesWithProgressOptions,
 ) ([]db.FindFilteredPaginatedLanguagesWithLanguageProgressRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "FindFilteredPaginatedLanguagesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "FindFilteredPaginatedLanguagesWithProgress").With(
 		"userId", opts.UserID,
 		"search", opts.Search,
@@ -352,6 +449,9 @@ func (s *Services) FindPaginatedViewedLanguagesWithProgress(
 	ctx context.Context,
 	opts FindPaginatedLanguagesWithProgressOptions,
 ) ([]db.FindPaginatedLanguagesWithInnerProgressRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, languagesLocation, "FindPaginatedViewedLanguagesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, languagesLocation, "FindPaginatedViewedLanguagesWithProgress").With(
 		"userId", opts.UserID,
 		"offset", opts.Offset,
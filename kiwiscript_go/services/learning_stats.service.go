@@ -0,0 +1,379 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const learningStatsLocation string = "learning_stats"
+
+const (
+	XpSourceLesson  string = "lesson"
+	XpSourceSection string = "section"
+	XpSourceSeries  string = "series"
+
+	lessonCompletedXp  int32 = 10
+	sectionCompletedXp int32 = 50
+	seriesCompletedXp  int32 = 200
+)
+
+func (s *Services) findOrCreateUserLearningStats(
+	ctx context.Context,
+	log *slog.Logger,
+	userID int32,
+) (*db.UserLearningStat, *exceptions.ServiceError) {
+	stats, err := s.database.FindUserLearningStatsByUserID(ctx, userID)
+	if err == nil {
+		return &stats, nil
+	}
+
+	serviceErr := exceptions.FromDBError(err)
+	if serviceErr.Code != exceptions.CodeNotFound {
+		log.ErrorContext(ctx, "Failed to find user learning stats", "error", err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "User learning stats not found, creating them...")
+	stats, err = s.database.CreateUserLearningStats(ctx, userID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create user learning stats", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &stats, nil
+}
+
+func learningStatsDate(timezone string, now time.Time) time.Time {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := now.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// currentLearningStreak returns the streak as seen today: a streak whose last
+// activity is older than yesterday, in the user's timezone, is already broken.
+func currentLearningStreak(stats *db.UserLearningStat, now time.Time) int32 {
+	if !stats.LastActivityDate.Valid {
+		return 0
+	}
+
+	yesterday := learningStatsDate(stats.Timezone, now).AddDate(0, 0, -1)
+	if stats.LastActivityDate.Time.Before(yesterday) {
+		return 0
+	}
+
+	return stats.CurrentStreak
+}
+
+type FindUserLearningStatsOptions struct {
+	RequestID string
+	UserID    int32
+}
+
+func (s *Services) FindUserLearningStats(
+	ctx context.Context,
+	opts FindUserLearningStatsOptions,
+) (*db.UserLearningStat, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, learningStatsLocation, "FindUserLearningStats")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, learningStatsLocation, "FindUserLearningStats").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Finding user learning stats...")
+
+	stats, serviceErr := s.findOrCreateUserLearningStats(ctx, log, opts.UserID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	stats.CurrentStreak = currentLearningStreak(stats, time.Now())
+	return stats, nil
+}
+
+type UpdateUserLearningStatsTimezoneOptions struct {
+	RequestID string
+	UserID    int32
+	Timezone  string
+}
+
+func (s *Services) UpdateUserLearningStatsTimezone(
+	ctx context.Context,
+	opts UpdateUserLearningStatsTimezoneOptions,
+) (*db.UserLearningStat, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, learningStatsLocation, "UpdateUserLearningStatsTimezone")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, learningStatsLocation, "UpdateUserLearningStatsTimezone").With(
+		"userId", opts.UserID,
+		"timezone", opts.Timezone,
+	)
+	log.InfoContext(ctx, "Updating user learning stats timezone...")
+
+	if _, err := time.LoadLocation(opts.Timezone); err != nil {
+		log.WarnContext(ctx, "Unknown timezone", "error", err)
+		return nil, exceptions.NewValidationError("Unknown timezone")
+	}
+
+	stats, serviceErr := s.findOrCreateUserLearningStats(ctx, log, opts.UserID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	updatedStats, err := s.database.UpdateUserLearningStatsTimezone(ctx, db.UpdateUserLearningStatsTimezoneParams{
+		Timezone: opts.Timezone,
+		ID:       stats.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update user learning stats timezone", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "User learning stats timezone updated successfully")
+	updatedStats.CurrentStreak = currentLearningStreak(&updatedStats, time.Now())
+	return &updatedStats, nil
+}
+
+func (s *Services) updateLearningStreak(
+	ctx context.Context,
+	log *slog.Logger,
+	stats *db.UserLearningStat,
+) (*db.UserLearningStat, *exceptions.ServiceError) {
+	today := learningStatsDate(stats.Timezone, time.Now())
+	streak := int32(1)
+
+	if stats.LastActivityDate.Valid {
+		lastActivity := stats.LastActivityDate.Time
+		if !lastActivity.Before(today) {
+			log.DebugContext(ctx, "Learning streak already updated today")
+			return stats, nil
+		}
+		if lastActivity.AddDate(0, 0, 1).Equal(today) {
+			streak = stats.CurrentStreak + 1
+		}
+	}
+
+	updatedStats, err := s.database.UpdateUserLearningStatsStreak(ctx, db.UpdateUserLearningStatsStreakParams{
+		CurrentStreak:    streak,
+		LastActivityDate: pgtype.Date{Time: today, Valid: true},
+		ID:               stats.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update learning streak", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &updatedStats, nil
+}
+
+func (s *Services) awardLearningXp(
+	ctx context.Context,
+	log *slog.Logger,
+	stats *db.UserLearningStat,
+	events []db.CreateXpEventParams,
+) (*db.UserLearningStat, *exceptions.ServiceError) {
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	var serviceErr *exceptions.ServiceError
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	var points int32
+	for _, e := range events {
+		rows, err := qrs.CreateXpEvent(ctx, e)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to create xp event", "error", err, "source", e.Source)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, serviceErr
+		}
+		if rows > 0 {
+			points += e.Points
+		}
+	}
+	if points == 0 {
+		log.DebugContext(ctx, "No new xp awarded")
+		return stats, nil
+	}
+
+	updatedStats, err := qrs.AddUserLearningStatsXp(ctx, db.AddUserLearningStatsXpParams{
+		Points: points,
+		ID:     stats.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to add user learning stats xp", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Xp awarded successfully", "points", points)
+	return &updatedStats, nil
+}
+
+func (s *Services) completedLearningXpEvents(
+	ctx context.Context,
+	log *slog.Logger,
+	lessonProgress *db.LessonProgress,
+) []db.CreateXpEventParams {
+	events := []db.CreateXpEventParams{
+		{
+			UserID:       lessonProgress.UserID,
+			Source:       XpSourceLesson,
+			Reference:    strconv.Itoa(int(lessonProgress.LessonID)),
+			Points:       lessonCompletedXp,
+			LanguageSlug: lessonProgress.LanguageSlug,
+			SeriesSlug:   lessonProgress.SeriesSlug,
+		},
+	}
+
+	sectionProgress, err := s.database.FindSectionProgressByID(ctx, lessonProgress.SectionProgressID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find section progress", "error", err)
+		return events
+	}
+	if !sectionProgress.CompletedAt.Valid {
+		return events
+	}
+
+	events = append(events, db.CreateXpEventParams{
+		UserID:       lessonProgress.UserID,
+		Source:       XpSourceSection,
+		Reference:    strconv.Itoa(int(lessonProgress.SectionID)),
+		Points:       sectionCompletedXp,
+		LanguageSlug: lessonProgress.LanguageSlug,
+		SeriesSlug:   lessonProgress.SeriesSlug,
+	})
+
+	seriesProgress, err := s.database.FindSeriesProgressBySlugAndUserID(ctx, db.FindSeriesProgressBySlugAndUserIDParams{
+		LanguageSlug: lessonProgress.LanguageSlug,
+		SeriesSlug:   lessonProgress.SeriesSlug,
+		UserID:       lessonProgress.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series progress", "error", err)
+		return events
+	}
+	if !seriesProgress.CompletedAt.Valid {
+		return events
+	}
+
+	return append(events, db.CreateXpEventParams{
+		UserID:       lessonProgress.UserID,
+		Source:       XpSourceSeries,
+		Reference:    lessonProgress.SeriesSlug,
+		Points:       seriesCompletedXp,
+		LanguageSlug: lessonProgress.LanguageSlug,
+		SeriesSlug:   lessonProgress.SeriesSlug,
+	})
+}
+
+type recordLearningActivityOptions struct {
+	RequestID      string
+	LessonProgress *db.LessonProgress
+}
+
+// recordLearningActivity updates the streak, awards the xp for everything the
+// lesson progress completed and unlocks any achievement the user now qualifies
+// for. Xp events are unique per user, source and reference so calling it more
+// than once for the same progress is harmless. Failures are only logged as they
+// must never fail the progress request that triggered them.
+func (s *Services) recordLearningActivity(ctx context.Context, opts recordLearningActivityOptions) {
+	log := s.buildLogger(opts.RequestID, learningStatsLocation, "recordLearningActivity").With(
+		"userId", opts.LessonProgress.UserID,
+		"lessonId", opts.LessonProgress.LessonID,
+	)
+	log.InfoContext(ctx, "Recording learning activity...")
+
+	stats, serviceErr := s.findOrCreateUserLearningStats(ctx, log, opts.LessonProgress.UserID)
+	if serviceErr != nil {
+		return
+	}
+
+	stats, serviceErr = s.updateLearningStreak(ctx, log, stats)
+	if serviceErr != nil {
+		return
+	}
+
+	if opts.LessonProgress.CompletedAt.Valid {
+		events := s.completedLearningXpEvents(ctx, log, opts.LessonProgress)
+		stats, serviceErr = s.awardLearningXp(ctx, log, stats, events)
+		if serviceErr != nil {
+			return
+		}
+	}
+
+	s.unlockAchievements(ctx, log, stats)
+}
+
+type FindPaginatedXpEventsOptions struct {
+	RequestID string
+	UserID    int32
+	Offset    int32
+	Limit     int32
+}
+
+func (s *Services) FindPaginatedXpEvents(
+	ctx context.Context,
+	opts FindPaginatedXpEventsOptions,
+) ([]db.XpEvent, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, learningStatsLocation, "FindPaginatedXpEvents")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, learningStatsLocation, "FindPaginatedXpEvents").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Finding paginated xp events...")
+
+	count, err := s.database.CountXpEventsByUserID(ctx, opts.UserID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count xp events", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No xp events found", "count", count)
+		return make([]db.XpEvent, 0), 0, nil
+	}
+
+	events, err := s.database.FindPaginatedXpEventsByUserID(ctx, db.FindPaginatedXpEventsByUserIDParams{
+		UserID: opts.UserID,
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated xp events", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	return events, count, nil
+}
@@ -37,6 +37,9 @@ func (s *Services) FindLessonArticleByLessonID(
 	ctx context.Context,
 	opts FindLessonArticleByLessonIDOptions,
 ) (*db.LessonArticle, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonArticlesLocation, "FindLessonArticleByLessonID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonArticlesLocation, "FindLessonArticleByLessonID").With(
 		"lessonId", opts.LessonID,
 	)
@@ -67,6 +70,9 @@ type CreateLessonArticleOptions struct {
 }
 
 func (s *Services) CreateLessonArticle(ctx context.Context, opts CreateLessonArticleOptions) (*db.LessonArticle, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonArticlesLocation, "CreateLessonArticle")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonArticlesLocation, "CreateLessonArticle").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -105,6 +111,9 @@ func (s *Services) CreateLessonArticle(ctx context.Context, opts CreateLessonArt
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	readTime := CalculateReadingTime(opts.Content)
@@ -164,18 +173,23 @@ type UpdateLessonArticleOptions struct {
 	SectionID    int32
 	LessonID     int32
 	Content      string
+	Version      int32
 }
 
 func (s *Services) UpdateLessonArticle(
 	ctx context.Context,
 	opts UpdateLessonArticleOptions,
 ) (*db.LessonArticle, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonArticlesLocation, "UpdateLessonArticle")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonArticlesLocation, "UpdateLessonArticle").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
 		"seriesPartId", opts.SectionID,
 		"lessonId", opts.LessonID,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Updating lesson article...")
 
@@ -198,6 +212,9 @@ func (s *Services) UpdateLessonArticle(
 	if serviceErr != nil {
 		return nil, serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, lessonArticle.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
 
 	oldReadTime := lessonArticle.ReadTimeSeconds
 	readTime := CalculateReadingTime(opts.Content)
@@ -210,16 +227,20 @@ func (s *Services) UpdateLessonArticle(
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	*lessonArticle, err = qrs.UpdateLessonArticle(ctx, db.UpdateLessonArticleParams{
 		ID:              lessonArticle.ID,
 		Content:         opts.Content,
 		ReadTimeSeconds: readTime,
+		Version:         lessonArticle.Version,
 	})
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to update lesson article", "error", err)
-		serviceErr = exceptions.FromDBError(err)
+		serviceErr = versionedUpdateError(err)
 		return nil, serviceErr
 	}
 
@@ -266,15 +287,20 @@ type DeleteLessonArticleOptions struct {
 	SeriesSlug   string
 	SectionID    int32
 	LessonID     int32
+	Version      int32
 }
 
 func (s *Services) DeleteLessonArticle(ctx context.Context, opts DeleteLessonArticleOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, lessonArticlesLocation, "DeleteLessonArticle")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonArticlesLocation, "DeleteLessonArticle").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
 		"seriesPartId", opts.SectionID,
 		"lessonId", opts.LessonID,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Deleting lesson article...")
 
@@ -296,6 +322,9 @@ func (s *Services) DeleteLessonArticle(ctx context.Context, opts DeleteLessonArt
 	if serviceErr != nil {
 		return serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, lessonArticle.Version); serviceErr != nil {
+		return serviceErr
+	}
 
 	if lesson.IsPublished {
 		log.WarnContext(ctx, "Cannot delete article from published lesson")
@@ -310,13 +339,25 @@ func (s *Services) DeleteLessonArticle(ctx context.Context, opts DeleteLessonArt
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
-	if err := qrs.DeleteLessonArticle(ctx, lessonArticle.ID); err != nil {
+	deleted, err := qrs.DeleteLessonArticleByIDAndVersion(ctx, db.DeleteLessonArticleByIDAndVersionParams{
+		ID:      lessonArticle.ID,
+		Version: lessonArticle.Version,
+	})
+	if err != nil {
 		log.ErrorContext(ctx, "Failed to delete lesson article", "error", err)
 		serviceErr = exceptions.FromDBError(err)
 		return serviceErr
 	}
+	if deleted == 0 {
+		log.WarnContext(ctx, "Lesson article changed while being deleted")
+		serviceErr = exceptions.NewPreconditionFailedError()
+		return serviceErr
+	}
 
 	lessonParams := db.UpdateLessonReadTimeSecondsParams{
 		ID:              lesson.ID,
@@ -333,6 +374,7 @@ func (s *Services) DeleteLessonArticle(ctx context.Context, opts DeleteLessonArt
 
 type FindLessonArticleOptions struct {
 	RequestID    string
+	UserID       int32
 	LanguageSlug string
 	SeriesSlug   string
 	SectionID    int32
@@ -344,6 +386,9 @@ func (s *Services) FindLessonArticle(
 	ctx context.Context,
 	opts FindLessonArticleOptions,
 ) (*db.LessonArticle, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonArticlesLocation, "FindLessonArticle")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonArticlesLocation, "FindLessonArticle").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -374,6 +419,17 @@ func (s *Services) FindLessonArticle(
 		return nil, exceptions.NewNotFoundError()
 	}
 
+	if opts.IsPublished {
+		if serviceErr := s.assertLessonContentAccess(ctx, log, assertLessonContentAccessOptions{
+			UserID:       opts.UserID,
+			LanguageSlug: opts.LanguageSlug,
+			SeriesSlug:   opts.SeriesSlug,
+			IsPreview:    lesson.IsPreview,
+		}); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
 	log.InfoContext(ctx, "Found lesson article")
 	return lessonArticle, nil
 }
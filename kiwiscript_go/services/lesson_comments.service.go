@@ -0,0 +1,1003 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const lessonCommentsLocation string = "lesson_comments"
+
+type CreateLessonCommentOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	ParentID     int32
+	Body         string
+}
+
+func (s *Services) CreateLessonComment(
+	ctx context.Context,
+	opts CreateLessonCommentOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "CreateLessonComment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "CreateLessonComment").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"parentId", opts.ParentID,
+	)
+	log.InfoContext(ctx, "Creating lesson comment...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	params := db.CreateLessonCommentParams{
+		Body:         opts.Body,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+		AuthorID:     opts.UserID,
+	}
+	if opts.ParentID == 0 {
+		comment, err := s.database.CreateLessonComment(ctx, params)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to create lesson comment", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+
+		log.InfoContext(ctx, "Lesson comment created successfully")
+		return s.findLessonCommentWithAuthor(ctx, opts.RequestID, comment.ID)
+	}
+
+	parent, err := s.database.FindLessonCommentByIDAndLessonID(ctx, db.FindLessonCommentByIDAndLessonIDParams{
+		ID:       opts.ParentID,
+		LessonID: lesson.ID,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Parent lesson comment not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if parent.IsHidden {
+		log.WarnContext(ctx, "Parent lesson comment is hidden")
+		return nil, exceptions.NewNotFoundError()
+	}
+	if parent.ParentID.Valid {
+		log.WarnContext(ctx, "Parent lesson comment is a reply")
+		return nil, exceptions.NewValidationError("Replies can only be added to top level comments")
+	}
+	if parent.IsLocked {
+		log.WarnContext(ctx, "Lesson comment thread is locked")
+		return nil, exceptions.NewConflictError("Comment thread is locked")
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	params.ParentID = pgtype.Int4{Int32: parent.ID, Valid: true}
+	reply, err := qrs.CreateLessonComment(ctx, params)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create lesson comment reply", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+	if err := qrs.IncrementLessonCommentRepliesCount(ctx, parent.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to increment lesson comment replies count", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	replyWithAuthor, err := qrs.FindLessonCommentByIDWithAuthor(ctx, reply.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson comment reply with author", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Lesson comment reply created successfully")
+	return replyWithAuthor.ToLessonCommentModel(), nil
+}
+
+func (s *Services) findLessonCommentWithAuthor(
+	ctx context.Context,
+	requestID string,
+	commentID int32,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	log := s.buildLogger(requestID, lessonCommentsLocation, "findLessonCommentWithAuthor").With(
+		"commentId", commentID,
+	)
+	log.InfoContext(ctx, "Finding lesson comment with author...")
+
+	comment, err := s.database.FindLessonCommentByIDWithAuthor(ctx, commentID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson comment with author", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return comment.ToLessonCommentModel(), nil
+}
+
+type FindLessonCommentOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+	IsStaff      bool
+}
+
+func (s *Services) FindLessonComment(
+	ctx context.Context,
+	opts FindLessonCommentOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "FindLessonComment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "FindLessonComment").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+	)
+	log.InfoContext(ctx, "Finding lesson comment...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	comment, err := s.database.FindLessonCommentByIDWithAuthor(ctx, opts.CommentID)
+	if err != nil {
+		log.WarnContext(ctx, "Lesson comment not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if comment.LessonID != lesson.ID {
+		log.WarnContext(ctx, "Lesson comment does not belong to the lesson")
+		return nil, exceptions.NewNotFoundError()
+	}
+	if comment.IsHidden && !opts.IsStaff {
+		log.WarnContext(ctx, "Lesson comment is hidden")
+		return nil, exceptions.NewNotFoundError()
+	}
+
+	log.InfoContext(ctx, "Lesson comment found")
+	return comment.ToLessonCommentModel(), nil
+}
+
+type FindPaginatedLessonCommentsOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	IsStaff      bool
+	Offset       int32
+	Limit        int32
+}
+
+func (s *Services) FindPaginatedLessonComments(
+	ctx context.Context,
+	opts FindPaginatedLessonCommentsOptions,
+) ([]db.LessonCommentModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "FindPaginatedLessonComments")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "FindPaginatedLessonComments").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"isStaff", opts.IsStaff,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated lesson comments...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	if opts.IsStaff {
+		count, err := s.database.CountAllLessonCommentsByLessonID(ctx, lesson.ID)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count lesson comments", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+		if count == 0 {
+			log.DebugContext(ctx, "No lesson comments found", "count", count)
+			return make([]db.LessonCommentModel, 0), 0, nil
+		}
+
+		comments, err := s.database.FindAllPaginatedLessonCommentsWithAuthor(
+			ctx,
+			db.FindAllPaginatedLessonCommentsWithAuthorParams{
+				LessonID: lesson.ID,
+				Limit:    opts.Limit,
+				Offset:   opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find paginated lesson comments", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		commentModels := make([]db.LessonCommentModel, 0, len(comments))
+		for _, c := range comments {
+			commentModels = append(commentModels, *c.ToLessonCommentModel())
+		}
+
+		return commentModels, count, nil
+	}
+
+	count, err := s.database.CountLessonCommentsByLessonID(ctx, lesson.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count lesson comments", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No lesson comments found", "count", count)
+		return make([]db.LessonCommentModel, 0), 0, nil
+	}
+
+	comments, err := s.database.FindPaginatedLessonCommentsWithAuthor(
+		ctx,
+		db.FindPaginatedLessonCommentsWithAuthorParams{
+			LessonID: lesson.ID,
+			Limit:    opts.Limit,
+			Offset:   opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated lesson comments", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	commentModels := make([]db.LessonCommentModel, 0, len(comments))
+	for _, c := range comments {
+		commentModels = append(commentModels, *c.ToLessonCommentModel())
+	}
+
+	return commentModels, count, nil
+}
+
+type FindPaginatedLessonCommentRepliesOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+	IsStaff      bool
+	Offset       int32
+	Limit        int32
+}
+
+func (s *Services) FindPaginatedLessonCommentReplies(
+	ctx context.Context,
+	opts FindPaginatedLessonCommentRepliesOptions,
+) ([]db.LessonCommentModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "FindPaginatedLessonCommentReplies")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "FindPaginatedLessonCommentReplies").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+		"isStaff", opts.IsStaff,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated lesson comment replies...")
+
+	comment, serviceErr := s.FindLessonComment(ctx, FindLessonCommentOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+		IsStaff:      opts.IsStaff,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	parentID := pgtype.Int4{Int32: comment.ID, Valid: true}
+	if opts.IsStaff {
+		count, err := s.database.CountAllLessonCommentRepliesByParentID(ctx, parentID)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count lesson comment replies", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+		if count == 0 {
+			log.DebugContext(ctx, "No lesson comment replies found", "count", count)
+			return make([]db.LessonCommentModel, 0), 0, nil
+		}
+
+		replies, err := s.database.FindAllPaginatedLessonCommentRepliesWithAuthor(
+			ctx,
+			db.FindAllPaginatedLessonCommentRepliesWithAuthorParams{
+				ParentID: parentID,
+				Limit:    opts.Limit,
+				Offset:   opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find paginated lesson comment replies", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		replyModels := make([]db.LessonCommentModel, 0, len(replies))
+		for _, r := range replies {
+			replyModels = append(replyModels, *r.ToLessonCommentModel())
+		}
+
+		return replyModels, count, nil
+	}
+
+	count, err := s.database.CountLessonCommentRepliesByParentID(ctx, parentID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count lesson comment replies", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No lesson comment replies found", "count", count)
+		return make([]db.LessonCommentModel, 0), 0, nil
+	}
+
+	replies, err := s.database.FindPaginatedLessonCommentRepliesWithAuthor(
+		ctx,
+		db.FindPaginatedLessonCommentRepliesWithAuthorParams{
+			ParentID: parentID,
+			Limit:    opts.Limit,
+			Offset:   opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated lesson comment replies", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	replyModels := make([]db.LessonCommentModel, 0, len(replies))
+	for _, r := range replies {
+		replyModels = append(replyModels, *r.ToLessonCommentModel())
+	}
+
+	return replyModels, count, nil
+}
+
+type findLessonCommentBySlugsAndIDsOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+}
+
+func (s *Services) findLessonCommentBySlugsAndIDs(
+	ctx context.Context,
+	opts findLessonCommentBySlugsAndIDsOptions,
+) (*db.LessonComment, *exceptions.ServiceError) {
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "findLessonCommentBySlugsAndIDs").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+	)
+	log.InfoContext(ctx, "Finding lesson comment by slugs and ids...")
+
+	comment, err := s.database.FindLessonCommentByIDAndLessonID(ctx, db.FindLessonCommentByIDAndLessonIDParams{
+		ID:       opts.CommentID,
+		LessonID: opts.LessonID,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Lesson comment not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if comment.LanguageSlug != opts.LanguageSlug ||
+		comment.SeriesSlug != opts.SeriesSlug ||
+		comment.SectionID != opts.SectionID {
+		log.WarnContext(ctx, "Lesson comment does not belong to the lesson")
+		return nil, exceptions.NewNotFoundError()
+	}
+
+	return &comment, nil
+}
+
+type UpdateLessonCommentOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+	Body         string
+}
+
+func (s *Services) UpdateLessonComment(
+	ctx context.Context,
+	opts UpdateLessonCommentOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "UpdateLessonComment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "UpdateLessonComment").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+	)
+	log.InfoContext(ctx, "Updating lesson comment...")
+
+	comment, serviceErr := s.findLessonCommentBySlugsAndIDs(ctx, findLessonCommentBySlugsAndIDsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if comment.AuthorID != opts.UserID {
+		log.WarnContext(ctx, "User is not the author of the comment")
+		return nil, exceptions.NewForbiddenError()
+	}
+
+	// Threads are locked through their top level comment
+	isLocked := comment.IsLocked
+	if comment.ParentID.Valid {
+		parent, err := s.database.FindLessonCommentByIDAndLessonID(ctx, db.FindLessonCommentByIDAndLessonIDParams{
+			ID:       comment.ParentID.Int32,
+			LessonID: comment.LessonID,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find parent lesson comment", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+
+		isLocked = parent.IsLocked
+	}
+	if isLocked {
+		log.WarnContext(ctx, "Lesson comment thread is locked")
+		return nil, exceptions.NewForbiddenError()
+	}
+
+	if _, err := s.database.UpdateLessonCommentBody(ctx, db.UpdateLessonCommentBodyParams{
+		ID:   comment.ID,
+		Body: opts.Body,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson comment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson comment updated successfully")
+	return s.findLessonCommentWithAuthor(ctx, opts.RequestID, comment.ID)
+}
+
+type DeleteLessonCommentOptions struct {
+	RequestID    string
+	UserID       int32
+	IsStaff      bool
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+}
+
+func (s *Services) DeleteLessonComment(ctx context.Context, opts DeleteLessonCommentOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "DeleteLessonComment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "DeleteLessonComment").With(
+		"userId", opts.UserID,
+		"isStaff", opts.IsStaff,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+	)
+	log.InfoContext(ctx, "Deleting lesson comment...")
+
+	comment, serviceErr := s.findLessonCommentBySlugsAndIDs(ctx, findLessonCommentBySlugsAndIDsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if comment.AuthorID != opts.UserID && !opts.IsStaff {
+		log.WarnContext(ctx, "User is not allowed to delete the comment")
+		return exceptions.NewForbiddenError()
+	}
+
+	// Top level comments take their replies with them through the cascade
+	if !comment.ParentID.Valid {
+		if _, err := s.database.DeleteLessonCommentByID(ctx, comment.ID); err != nil {
+			log.ErrorContext(ctx, "Failed to delete lesson comment", "error", err)
+			return exceptions.FromDBError(err)
+		}
+
+		log.InfoContext(ctx, "Lesson comment deleted successfully")
+		return nil
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	deleted, err := qrs.DeleteLessonCommentByID(ctx, comment.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to delete lesson comment reply", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return serviceErr
+	}
+	if deleted == 0 {
+		// A concurrent delete already removed the reply and updated the parent counters
+		log.WarnContext(ctx, "Lesson comment reply already deleted")
+		serviceErr = exceptions.NewNotFoundError()
+		return serviceErr
+	}
+	if err := qrs.DecrementLessonCommentRepliesCount(ctx, comment.ParentID.Int32); err != nil {
+		log.ErrorContext(ctx, "Failed to decrement lesson comment replies count", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return serviceErr
+	}
+	if comment.IsAnswer {
+		if err := qrs.RefreshLessonCommentIsAnswered(ctx, comment.ParentID.Int32); err != nil {
+			log.ErrorContext(ctx, "Failed to refresh lesson comment is answered", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return serviceErr
+		}
+	}
+
+	log.InfoContext(ctx, "Lesson comment reply deleted successfully")
+	return nil
+}
+
+type UpvoteLessonCommentOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+}
+
+func (s *Services) UpvoteLessonComment(
+	ctx context.Context,
+	opts UpvoteLessonCommentOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "UpvoteLessonComment")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "UpvoteLessonComment").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+	)
+	log.InfoContext(ctx, "Upvoting lesson comment...")
+
+	comment, serviceErr := s.findLessonCommentBySlugsAndIDs(ctx, findLessonCommentBySlugsAndIDsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if comment.IsHidden {
+		log.WarnContext(ctx, "Lesson comment is hidden")
+		return nil, exceptions.NewNotFoundError()
+	}
+
+	if _, err := s.database.FindLessonCommentUpvoteByCommentIDAndUserID(
+		ctx,
+		db.FindLessonCommentUpvoteByCommentIDAndUserIDParams{
+			CommentID: comment.ID,
+			UserID:    opts.UserID,
+		},
+	); err == nil {
+		log.WarnContext(ctx, "User has already upvoted the comment")
+		return nil, exceptions.NewConflictError("Comment already upvoted")
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	if err := qrs.CreateLessonCommentUpvote(ctx, db.CreateLessonCommentUpvoteParams{
+		CommentID: comment.ID,
+		UserID:    opts.UserID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to create lesson comment upvote", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+	if err := qrs.IncrementLessonCommentUpvotesCount(ctx, comment.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to increment lesson comment upvotes count", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	commentWithAuthor, err := qrs.FindLessonCommentByIDWithAuthor(ctx, comment.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson comment with author", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Lesson comment upvoted successfully")
+	return commentWithAuthor.ToLessonCommentModel(), nil
+}
+
+func (s *Services) RemoveLessonCommentUpvote(
+	ctx context.Context,
+	opts UpvoteLessonCommentOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "RemoveLessonCommentUpvote")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "RemoveLessonCommentUpvote").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+	)
+	log.InfoContext(ctx, "Removing lesson comment upvote...")
+
+	comment, serviceErr := s.findLessonCommentBySlugsAndIDs(ctx, findLessonCommentBySlugsAndIDsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	upvote, err := s.database.FindLessonCommentUpvoteByCommentIDAndUserID(
+		ctx,
+		db.FindLessonCommentUpvoteByCommentIDAndUserIDParams{
+			CommentID: comment.ID,
+			UserID:    opts.UserID,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Lesson comment upvote not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	deleted, err := qrs.DeleteLessonCommentUpvoteByID(ctx, upvote.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to delete lesson comment upvote", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+	if deleted == 0 {
+		// A concurrent removal already decremented the upvotes count
+		log.WarnContext(ctx, "Lesson comment upvote already removed")
+		serviceErr = exceptions.NewNotFoundError()
+		return nil, serviceErr
+	}
+	if err := qrs.DecrementLessonCommentUpvotesCount(ctx, comment.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to decrement lesson comment upvotes count", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	commentWithAuthor, err := qrs.FindLessonCommentByIDWithAuthor(ctx, comment.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson comment with author", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Lesson comment upvote removed successfully")
+	return commentWithAuthor.ToLessonCommentModel(), nil
+}
+
+type UpdateLessonCommentIsAnswerOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+	IsAnswer     bool
+}
+
+// UpdateLessonCommentIsAnswer lets the series author mark a reply as the answer to its question,
+// the parent comment is flagged as answered while at least one of its replies is an answer.
+func (s *Services) UpdateLessonCommentIsAnswer(
+	ctx context.Context,
+	opts UpdateLessonCommentIsAnswerOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "UpdateLessonCommentIsAnswer")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "UpdateLessonCommentIsAnswer").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+		"isAnswer", opts.IsAnswer,
+	)
+	log.InfoContext(ctx, "Updating lesson comment is answer...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.AuthorID != opts.UserID {
+		log.WarnContext(ctx, "User is not the author of the series")
+		return nil, exceptions.NewForbiddenError()
+	}
+
+	comment, serviceErr := s.findLessonCommentBySlugsAndIDs(ctx, findLessonCommentBySlugsAndIDsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if !comment.ParentID.Valid {
+		log.WarnContext(ctx, "Top level comments cannot be answers")
+		return nil, exceptions.NewValidationError("Only replies can be marked as answers")
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	if _, err := qrs.UpdateLessonCommentIsAnswer(ctx, db.UpdateLessonCommentIsAnswerParams{
+		ID:       comment.ID,
+		IsAnswer: opts.IsAnswer,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson comment is answer", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+	if err := qrs.RefreshLessonCommentIsAnswered(ctx, comment.ParentID.Int32); err != nil {
+		log.ErrorContext(ctx, "Failed to refresh lesson comment is answered", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	commentWithAuthor, err := qrs.FindLessonCommentByIDWithAuthor(ctx, comment.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson comment with author", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Lesson comment is answer updated successfully")
+	return commentWithAuthor.ToLessonCommentModel(), nil
+}
+
+type UpdateLessonCommentIsHiddenOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+	IsHidden     bool
+}
+
+func (s *Services) UpdateLessonCommentIsHidden(
+	ctx context.Context,
+	opts UpdateLessonCommentIsHiddenOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "UpdateLessonCommentIsHidden")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "UpdateLessonCommentIsHidden").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+		"isHidden", opts.IsHidden,
+	)
+	log.InfoContext(ctx, "Updating lesson comment is hidden...")
+
+	comment, serviceErr := s.findLessonCommentBySlugsAndIDs(ctx, findLessonCommentBySlugsAndIDsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if _, err := s.database.UpdateLessonCommentIsHidden(ctx, db.UpdateLessonCommentIsHiddenParams{
+		ID:       comment.ID,
+		IsHidden: opts.IsHidden,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson comment is hidden", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson comment is hidden updated successfully")
+	return s.findLessonCommentWithAuthor(ctx, opts.RequestID, comment.ID)
+}
+
+type UpdateLessonCommentIsLockedOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	CommentID    int32
+	IsLocked     bool
+}
+
+func (s *Services) UpdateLessonCommentIsLocked(
+	ctx context.Context,
+	opts UpdateLessonCommentIsLockedOptions,
+) (*db.LessonCommentModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonCommentsLocation, "UpdateLessonCommentIsLocked")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonCommentsLocation, "UpdateLessonCommentIsLocked").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"commentId", opts.CommentID,
+		"isLocked", opts.IsLocked,
+	)
+	log.InfoContext(ctx, "Updating lesson comment is locked...")
+
+	comment, serviceErr := s.findLessonCommentBySlugsAndIDs(ctx, findLessonCommentBySlugsAndIDsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		CommentID:    opts.CommentID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if comment.ParentID.Valid {
+		log.WarnContext(ctx, "Replies cannot be locked")
+		return nil, exceptions.NewValidationError("Only top level comments can be locked")
+	}
+
+	if _, err := s.database.UpdateLessonCommentIsLocked(ctx, db.UpdateLessonCommentIsLockedParams{
+		ID:       comment.ID,
+		IsLocked: opts.IsLocked,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson comment is locked", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson comment is locked updated successfully")
+	return s.findLessonCommentWithAuthor(ctx, opts.RequestID, comment.ID)
+}
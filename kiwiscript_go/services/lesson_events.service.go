@@ -0,0 +1,294 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"time"
+)
+
+const lessonEventsLocation string = "lesson_events"
+
+const (
+	LessonEventOpen         string = "lesson_open"
+	LessonEventScroll       string = "scroll"
+	LessonEventVideoPlay    string = "video_play"
+	LessonEventVideoPause   string = "video_pause"
+	LessonEventVideoSeek    string = "video_seek"
+	LessonEventFileDownload string = "file_download"
+
+	// Clients buffer events while offline, older ones are rejected so the
+	// rollups only ever have to recompute the last couple of days.
+	lessonEventsMaxAge     time.Duration = 24 * time.Hour
+	lessonEventsMaxSkew    time.Duration = 5 * time.Minute
+	lessonEventsRollupDays int           = 2
+)
+
+// lessonEngagementLockKey identifies the postgres advisory lock that makes
+// sure a single app instance rolls up and prunes the lesson events at a time.
+const lessonEngagementLockKey int64 = 4_105_119_243
+
+type LessonEvent struct {
+	ClientEventID        uuid.UUID
+	Type                 string
+	ScrollDepth          int16
+	VideoPositionSeconds int32
+	FileID               uuid.UUID
+	OccurredAt           time.Time
+}
+
+type LessonEventsReceipt struct {
+	Received int
+	Recorded int
+}
+
+type CreateLessonEventsOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	Events       []LessonEvent
+}
+
+func (s *Services) CreateLessonEvents(
+	ctx context.Context,
+	opts CreateLessonEventsOptions,
+) (*LessonEventsReceipt, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonEventsLocation, "CreateLessonEvents")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonEventsLocation, "CreateLessonEvents").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"events", len(opts.Events),
+	)
+	log.InfoContext(ctx, "Creating lesson events...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	lessonProgress, serviceErr := s.FindLessonProgressBySlugsAndIDs(ctx, FindLessonProgressOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		log.WarnContext(ctx, "Lesson events sent for a lesson without progress")
+		return nil, serviceErr
+	}
+
+	if serviceErr := s.validateLessonEvents(ctx, lesson, opts.Events); serviceErr != nil {
+		log.WarnContext(ctx, "Invalid lesson events", "error", serviceErr)
+		return nil, serviceErr
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	var recorded int64
+	var openedAt time.Time
+	for _, event := range opts.Events {
+		fileID := pgtype.UUID{Bytes: event.FileID, Valid: event.FileID != uuid.Nil}
+		var count int64
+		count, err = qrs.CreateLessonEvent(ctx, db.CreateLessonEventParams{
+			ClientEventID:        event.ClientEventID,
+			UserID:               opts.UserID,
+			LessonID:             lesson.ID,
+			EventType:            event.Type,
+			ScrollDepth:          event.ScrollDepth,
+			VideoPositionSeconds: event.VideoPositionSeconds,
+			FileID:               fileID,
+			OccurredAt:           pgtype.Timestamp{Time: event.OccurredAt.UTC(), Valid: true},
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to create lesson event", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, serviceErr
+		}
+
+		recorded += count
+		if count > 0 && event.Type == LessonEventOpen && event.OccurredAt.After(openedAt) {
+			openedAt = event.OccurredAt
+		}
+	}
+
+	if !openedAt.IsZero() {
+		err = qrs.UpdateLessonProgressViewedAtFromEvents(ctx, db.UpdateLessonProgressViewedAtFromEventsParams{
+			ViewedAt: pgtype.Timestamp{Time: openedAt.UTC(), Valid: true},
+			ID:       lessonProgress.ID,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to update lesson progress viewed at", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, serviceErr
+		}
+	}
+
+	log.InfoContext(ctx, "Lesson events created successfully", "recorded", recorded)
+	return &LessonEventsReceipt{
+		Received: len(opts.Events),
+		Recorded: int(recorded),
+	}, nil
+}
+
+func (s *Services) validateLessonEvents(
+	ctx context.Context,
+	lesson *db.Lesson,
+	events []LessonEvent,
+) *exceptions.ServiceError {
+	now := time.Now()
+	var fileIDs map[uuid.UUID]bool
+
+	for _, event := range events {
+		if event.OccurredAt.Before(now.Add(-lessonEventsMaxAge)) || event.OccurredAt.After(now.Add(lessonEventsMaxSkew)) {
+			return exceptions.NewValidationError("Lesson events must have occurred within the last 24 hours")
+		}
+
+		switch event.Type {
+		case LessonEventVideoPlay, LessonEventVideoPause, LessonEventVideoSeek:
+			if event.VideoPositionSeconds > lesson.WatchTimeSeconds {
+				return exceptions.NewValidationError("Video position exceeds the lesson video duration")
+			}
+		case LessonEventFileDownload:
+			if event.FileID == uuid.Nil {
+				return exceptions.NewValidationError("File download events require a file ID")
+			}
+
+			if fileIDs == nil {
+				files, err := s.database.FindLessonFilesByLessonID(ctx, lesson.ID)
+				if err != nil {
+					return exceptions.FromDBError(err)
+				}
+
+				fileIDs = make(map[uuid.UUID]bool, len(files))
+				for _, file := range files {
+					fileIDs[file.ID] = true
+				}
+			}
+			if !fileIDs[event.FileID] {
+				return exceptions.NewValidationError("File does not belong to the lesson")
+			}
+		}
+	}
+
+	return nil
+}
+
+// RollupLessonEngagement recomputes the daily engagement of the last couple of
+// days, syncs the time on task of the lesson progress and drops the monthly
+// event partitions older than the retention period.
+func (s *Services) RollupLessonEngagement(ctx context.Context, requestID string, retentionMonths int) {
+	ctx, span := s.startSpan(ctx, lessonEventsLocation, "RollupLessonEngagement")
+	defer span.End()
+
+	log := s.buildLogger(requestID, lessonEventsLocation, "RollupLessonEngagement").With(
+		"retentionMonths", retentionMonths,
+	)
+	log.DebugContext(ctx, "Rolling up lesson engagement...")
+
+	acquired, err := s.database.WithAdvisoryLock(ctx, lessonEngagementLockKey, func(ctx context.Context) {
+		now := time.Now().UTC()
+		month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		// Next month's partition is created ahead so ingestion never hits a gap
+		for _, partition := range [2]time.Time{month, month.AddDate(0, 1, 0)} {
+			if err := s.database.CreateLessonEventsPartition(ctx, analyticsDate(partition)); err != nil {
+				log.ErrorContext(ctx, "Failed to create lesson events partition", "month", partition, "error", err)
+				return
+			}
+		}
+
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		fromDay := analyticsDate(today.AddDate(0, 0, -lessonEventsRollupDays))
+		rollups, err := s.database.UpsertLessonEngagementDailyFrom(ctx, fromDay)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to roll up lesson engagement", "error", err)
+			return
+		}
+
+		progress, err := s.database.UpdateLessonProgressTimeOnTaskFrom(ctx, fromDay)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to update lesson progress time on task", "error", err)
+			return
+		}
+
+		dropped, err := s.database.DropLessonEventsPartitionsBefore(
+			ctx,
+			analyticsDate(month.AddDate(0, -retentionMonths, 0)),
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to drop expired lesson events partitions", "error", err)
+			return
+		}
+
+		log.InfoContext(ctx, "Lesson engagement rolled up",
+			"rollups", rollups,
+			"lessonProgress", progress,
+			"droppedPartitions", dropped,
+		)
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to take the lesson engagement lock", "error", err)
+		return
+	}
+	if !acquired {
+		log.DebugContext(ctx, "Lesson engagement is being rolled up by another instance")
+	}
+}
+
+// RunLessonEngagementWorker rolls up the lesson engagement until the context is cancelled.
+func (s *Services) RunLessonEngagementWorker(ctx context.Context, interval time.Duration, retentionMonths int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RollupLessonEngagement(ctx, uuid.NewString(), retentionMonths)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	objStg "github.com/kiwiscript/kiwiscript_go/providers/object_storage"
+	"time"
+)
+
+const lessonFileDownloadsLocation string = "lesson_file_downloads"
+
+// Download URLs are handed out right before the browser follows them, so they
+// only need to outlive a redirect and are never cached.
+const lessonFileDownloadExpiration time.Duration = 5 * time.Minute
+
+type LessonFileDownload struct {
+	File      *db.LessonFile
+	URL       string
+	ExpiresAt time.Time
+	Downloads int32
+}
+
+type CreateLessonFileDownloadOptions struct {
+	RequestID    string
+	UserID       int32
+	IsStaff      bool
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	File         uuid.UUID
+}
+
+func (s *Services) CreateLessonFileDownload(
+	ctx context.Context,
+	opts CreateLessonFileDownloadOptions,
+) (*LessonFileDownload, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonFileDownloadsLocation, "CreateLessonFileDownload")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonFileDownloadsLocation, "CreateLessonFileDownload").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"file", opts.File.String(),
+	)
+	log.InfoContext(ctx, "Creating lesson file download...")
+
+	lessonFile, serviceErr := s.FindLessonFile(ctx, FindLessonFileOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		File:         opts.File,
+		IsPublished:  !opts.IsStaff,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	expiresAt := time.Now().Add(lessonFileDownloadExpiration)
+	url, err := s.objStg.GetFileDownloadUrl(ctx, objStg.GetFileDownloadURLOptions{
+		RequestID: opts.RequestID,
+		UserID:    lessonFile.AuthorID,
+		FileID:    lessonFile.ID,
+		FileExt:   lessonFile.Ext,
+		FileName:  lessonFile.Name,
+		ExpiresIn: lessonFileDownloadExpiration,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to get file download URL", "error", err)
+		return nil, exceptions.NewServerError()
+	}
+
+	download := LessonFileDownload{
+		File:      lessonFile,
+		URL:       url,
+		ExpiresAt: expiresAt,
+	}
+
+	// Staff previews would skew the counts of the learners
+	if opts.IsStaff {
+		return &download, nil
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	lessonFile.DownloadCount, err = qrs.IncrementLessonFileDownloadCount(ctx, lessonFile.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to increment lesson file download count", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	if opts.UserID != 0 {
+		var userDownload db.LessonFileDownload
+		userDownload, err = qrs.UpsertLessonFileDownload(ctx, db.UpsertLessonFileDownloadParams{
+			FileID: lessonFile.ID,
+			UserID: opts.UserID,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to record lesson file download", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, serviceErr
+		}
+
+		download.Downloads = userDownload.Downloads
+	}
+
+	log.InfoContext(ctx, "Lesson file download created successfully", "downloadCount", lessonFile.DownloadCount)
+	return &download, nil
+}
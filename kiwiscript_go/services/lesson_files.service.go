@@ -43,6 +43,9 @@ func (s *Services) UploadLessonFile(
 	ctx context.Context,
 	opts UploadLessonFileOptions,
 ) (*db.LessonFile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonFilesLocation, "UploadLessonFile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonFilesLocation, "UploadLessonFile").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -109,6 +112,9 @@ func (s *Services) DeleteLessonFile(
 	ctx context.Context,
 	opts DeleteLessonFileOptions,
 ) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, lessonFilesLocation, "DeleteLessonFile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonFilesLocation, "DeleteLessonFile").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -165,6 +171,7 @@ func (s *Services) DeleteLessonFile(
 
 type FindLessonFileOptions struct {
 	RequestID    string
+	UserID       int32
 	LanguageSlug string
 	SeriesSlug   string
 	SectionID    int32
@@ -177,6 +184,9 @@ func (s *Services) FindLessonFile(
 	ctx context.Context,
 	opts FindLessonFileOptions,
 ) (*db.LessonFile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonFilesLocation, "FindLessonFile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonFilesLocation, "FindLessonFile").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -201,6 +211,17 @@ func (s *Services) FindLessonFile(
 		return nil, exceptions.NewForbiddenError()
 	}
 
+	if opts.IsPublished {
+		if serviceErr := s.assertLessonContentAccess(ctx, log, assertLessonContentAccessOptions{
+			UserID:       opts.UserID,
+			LanguageSlug: opts.LanguageSlug,
+			SeriesSlug:   opts.SeriesSlug,
+			IsPreview:    lesson.IsPreview,
+		}); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
 	lessonFile, err := s.database.FindLessonFileByIDAndLessonID(ctx, db.FindLessonFileByIDAndLessonIDParams{
 		ID:       opts.File,
 		LessonID: opts.LessonID,
@@ -215,6 +236,7 @@ func (s *Services) FindLessonFile(
 
 type FindLessonFilesOptions struct {
 	RequestID    string
+	UserID       int32
 	LanguageSlug string
 	SeriesSlug   string
 	SectionID    int32
@@ -226,6 +248,9 @@ func (s *Services) FindLessonFiles(
 	ctx context.Context,
 	opts FindLessonFilesOptions,
 ) ([]db.LessonFile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonFilesLocation, "FindLessonFiles")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonFilesLocation, "FindLessonFiles").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -251,6 +276,17 @@ func (s *Services) FindLessonFiles(
 		return nil, exceptions.NewForbiddenError()
 	}
 
+	if opts.IsPublished {
+		if serviceErr := s.assertLessonContentAccess(ctx, log, assertLessonContentAccessOptions{
+			UserID:       opts.UserID,
+			LanguageSlug: opts.LanguageSlug,
+			SeriesSlug:   opts.SeriesSlug,
+			IsPreview:    lesson.IsPreview,
+		}); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
 	lessonFiles, err := s.database.FindLessonFilesByLessonID(ctx, opts.LessonID)
 	if err != nil {
 		log.ErrorContext(ctx, "Error finding lesson files", "error", err)
@@ -262,6 +298,9 @@ func (s *Services) FindLessonFiles(
 }
 
 func (s *Services) FindLessonFilesWithNoCheck(ctx context.Context, lessonID int32) ([]db.LessonFile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonFilesLocation, "FindLessonFilesWithNoCheck")
+	defer span.End()
+
 	log := s.log.WithGroup("services_lessonFiles_FindLessonFilesWithNoCheck").With("lessonId", lessonID)
 	log.InfoContext(ctx, "Finding lesson files...")
 
@@ -290,6 +329,9 @@ func (s *Services) UpdateLessonFile(
 	ctx context.Context,
 	opts UpdateLessonFileOptions,
 ) (*db.LessonFile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonFilesLocation, "UpdateLessonFile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonFilesLocation, "UpdateLessonFile").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
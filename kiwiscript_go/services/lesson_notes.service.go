@@ -0,0 +1,471 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const lessonNotesLocation string = "lesson_notes"
+
+type CreateLessonNoteOptions struct {
+	RequestID     string
+	UserID        int32
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionID     int32
+	LessonID      int32
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+}
+
+func (s *Services) CreateLessonNote(
+	ctx context.Context,
+	opts CreateLessonNoteOptions,
+) (*db.LessonNoteModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonNotesLocation, "CreateLessonNote")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonNotesLocation, "CreateLessonNote").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+	)
+	log.InfoContext(ctx, "Creating lesson note...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if opts.AnchorSeconds > 0 && opts.AnchorSeconds > lesson.WatchTimeSeconds {
+		log.WarnContext(ctx, "Lesson note anchor is past the end of the lesson video",
+			"anchorSeconds", opts.AnchorSeconds,
+			"watchTimeSeconds", lesson.WatchTimeSeconds,
+		)
+		return nil, exceptions.NewValidationError("Anchor seconds exceed the lesson video duration")
+	}
+
+	note, err := s.database.CreateLessonNote(ctx, db.CreateLessonNoteParams{
+		Body:          opts.Body,
+		AnchorHeading: opts.AnchorHeading,
+		AnchorSeconds: opts.AnchorSeconds,
+		LanguageSlug:  lesson.LanguageSlug,
+		SeriesSlug:    lesson.SeriesSlug,
+		SectionID:     lesson.SectionID,
+		LessonID:      lesson.ID,
+		UserID:        opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create lesson note", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson note created successfully")
+	return note.ToLessonNoteModel(), nil
+}
+
+type FindLessonNoteOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	NoteID       int32
+}
+
+func (s *Services) findLessonNote(
+	ctx context.Context,
+	opts FindLessonNoteOptions,
+) (*db.LessonNote, *db.Lesson, *exceptions.ServiceError) {
+	log := s.buildLogger(opts.RequestID, lessonNotesLocation, "findLessonNote").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"noteId", opts.NoteID,
+	)
+	log.InfoContext(ctx, "Finding lesson note...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, nil, serviceErr
+	}
+
+	note, err := s.database.FindLessonNoteByIDAndUserID(ctx, db.FindLessonNoteByIDAndUserIDParams{
+		ID:     opts.NoteID,
+		UserID: opts.UserID,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Lesson note not found", "error", err)
+		return nil, nil, exceptions.FromDBError(err)
+	}
+	if note.LessonID != lesson.ID {
+		log.WarnContext(ctx, "Lesson note does not belong to the lesson")
+		return nil, nil, exceptions.NewNotFoundError()
+	}
+
+	return &note, lesson, nil
+}
+
+func (s *Services) FindLessonNote(
+	ctx context.Context,
+	opts FindLessonNoteOptions,
+) (*db.LessonNoteModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonNotesLocation, "FindLessonNote")
+	defer span.End()
+
+	note, _, serviceErr := s.findLessonNote(ctx, opts)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	return note.ToLessonNoteModel(), nil
+}
+
+type FindPaginatedLessonNotesOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	Offset       int32
+	Limit        int32
+}
+
+func (s *Services) FindPaginatedLessonNotes(
+	ctx context.Context,
+	opts FindPaginatedLessonNotesOptions,
+) ([]db.LessonNoteModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonNotesLocation, "FindPaginatedLessonNotes")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonNotesLocation, "FindPaginatedLessonNotes").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+	)
+	log.InfoContext(ctx, "Finding paginated lesson notes...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, FindLessonOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	count, err := s.database.CountLessonNotesByUserIDAndLessonID(ctx, db.CountLessonNotesByUserIDAndLessonIDParams{
+		UserID:   opts.UserID,
+		LessonID: lesson.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count lesson notes", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No lesson notes found", "count", count)
+		return make([]db.LessonNoteModel, 0), 0, nil
+	}
+
+	notes, err := s.database.FindPaginatedLessonNotesByUserIDAndLessonID(
+		ctx,
+		db.FindPaginatedLessonNotesByUserIDAndLessonIDParams{
+			UserID:   opts.UserID,
+			LessonID: lesson.ID,
+			Limit:    opts.Limit,
+			Offset:   opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated lesson notes", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	noteModels := make([]db.LessonNoteModel, 0, len(notes))
+	for _, n := range notes {
+		noteModels = append(noteModels, *n.ToLessonNoteModel())
+	}
+
+	return noteModels, count, nil
+}
+
+type FindPaginatedSeriesLessonNotesOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	Offset       int32
+	Limit        int32
+}
+
+func (s *Services) FindPaginatedSeriesLessonNotes(
+	ctx context.Context,
+	opts FindPaginatedSeriesLessonNotesOptions,
+) ([]db.LessonNoteModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonNotesLocation, "FindPaginatedSeriesLessonNotes")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonNotesLocation, "FindPaginatedSeriesLessonNotes").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding paginated series lesson notes...")
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	count, err := s.database.CountLessonNotesByUserIDAndSeriesSlug(ctx, db.CountLessonNotesByUserIDAndSeriesSlugParams{
+		UserID:     opts.UserID,
+		SeriesSlug: series.Slug,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count series lesson notes", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No series lesson notes found", "count", count)
+		return make([]db.LessonNoteModel, 0), 0, nil
+	}
+
+	notes, err := s.database.FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlug(
+		ctx,
+		db.FindPaginatedLessonNotesWithLessonByUserIDAndSeriesSlugParams{
+			UserID:     opts.UserID,
+			SeriesSlug: series.Slug,
+			Limit:      opts.Limit,
+			Offset:     opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated series lesson notes", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	noteModels := make([]db.LessonNoteModel, 0, len(notes))
+	for _, n := range notes {
+		noteModels = append(noteModels, *n.ToLessonNoteModel())
+	}
+
+	return noteModels, count, nil
+}
+
+type ExportSeriesLessonNotesOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+func formatNoteAnchorSeconds(seconds int32) string {
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+func (s *Services) ExportSeriesLessonNotes(
+	ctx context.Context,
+	opts ExportSeriesLessonNotesOptions,
+) (string, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonNotesLocation, "ExportSeriesLessonNotes")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonNotesLocation, "ExportSeriesLessonNotes").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Exporting series lesson notes...")
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return "", serviceErr
+	}
+
+	notes, err := s.database.FindAllLessonNotesWithLessonByUserIDAndSeriesSlug(
+		ctx,
+		db.FindAllLessonNotesWithLessonByUserIDAndSeriesSlugParams{
+			UserID:     opts.UserID,
+			SeriesSlug: series.Slug,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series lesson notes", "error", err)
+		return "", exceptions.FromDBError(err)
+	}
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# %s notes\n", series.Title))
+
+	var sectionID, lessonID int32
+	for _, n := range notes {
+		if n.SectionID != sectionID {
+			sectionID = n.SectionID
+			builder.WriteString(fmt.Sprintf("\n## %s\n", n.SectionTitle))
+		}
+		if n.LessonID != lessonID {
+			lessonID = n.LessonID
+			builder.WriteString(fmt.Sprintf("\n### %s\n", n.LessonTitle))
+		}
+
+		builder.WriteString("\n")
+		if n.AnchorHeading != "" {
+			builder.WriteString(fmt.Sprintf("> %s\n", n.AnchorHeading))
+		}
+		if n.AnchorSeconds > 0 {
+			builder.WriteString(fmt.Sprintf("> %s\n", formatNoteAnchorSeconds(n.AnchorSeconds)))
+		}
+		if n.AnchorHeading != "" || n.AnchorSeconds > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(strings.TrimSpace(n.Body))
+		builder.WriteString("\n")
+	}
+
+	log.InfoContext(ctx, "Series lesson notes exported successfully", "count", len(notes))
+	return builder.String(), nil
+}
+
+type UpdateLessonNoteOptions struct {
+	RequestID     string
+	UserID        int32
+	LanguageSlug  string
+	SeriesSlug    string
+	SectionID     int32
+	LessonID      int32
+	NoteID        int32
+	Body          string
+	AnchorHeading string
+	AnchorSeconds int32
+}
+
+func (s *Services) UpdateLessonNote(
+	ctx context.Context,
+	opts UpdateLessonNoteOptions,
+) (*db.LessonNoteModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonNotesLocation, "UpdateLessonNote")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonNotesLocation, "UpdateLessonNote").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"noteId", opts.NoteID,
+	)
+	log.InfoContext(ctx, "Updating lesson note...")
+
+	note, lesson, serviceErr := s.findLessonNote(ctx, FindLessonNoteOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+		NoteID:       opts.NoteID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if opts.AnchorSeconds > 0 && opts.AnchorSeconds > lesson.WatchTimeSeconds {
+		log.WarnContext(ctx, "Lesson note anchor is past the end of the lesson video",
+			"anchorSeconds", opts.AnchorSeconds,
+			"watchTimeSeconds", lesson.WatchTimeSeconds,
+		)
+		return nil, exceptions.NewValidationError("Anchor seconds exceed the lesson video duration")
+	}
+
+	updatedNote, err := s.database.UpdateLessonNote(ctx, db.UpdateLessonNoteParams{
+		ID:            note.ID,
+		Body:          opts.Body,
+		AnchorHeading: opts.AnchorHeading,
+		AnchorSeconds: opts.AnchorSeconds,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson note", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson note updated successfully")
+	return updatedNote.ToLessonNoteModel(), nil
+}
+
+func (s *Services) DeleteLessonNote(ctx context.Context, opts FindLessonNoteOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, lessonNotesLocation, "DeleteLessonNote")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonNotesLocation, "DeleteLessonNote").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"noteId", opts.NoteID,
+	)
+	log.InfoContext(ctx, "Deleting lesson note...")
+
+	note, _, serviceErr := s.findLessonNote(ctx, opts)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if err := s.database.DeleteLessonNoteByID(ctx, note.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete lesson note", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson note deleted successfully")
+	return nil
+}
@@ -20,9 +20,13 @@ package services
 import (
 	"context"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
 	"log/slog"
+	"time"
 )
 
 const lessonProgressLocation string = "lesson_progress"
@@ -40,6 +44,9 @@ func (s *Services) FindLessonProgressBySlugsAndIDs(
 	ctx context.Context,
 	opts FindLessonProgressOptions,
 ) (*db.LessonProgress, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonProgressLocation, "FindLessonProgressBySlugsAndIDs")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonProgressLocation, "FindLessonProgressBySlugsAndIDs").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -115,6 +122,9 @@ func (s *Services) CreateOrUpdateLessonProgress(
 	ctx context.Context,
 	opts CreateOrUpdateLessonProgressOptions,
 ) (*db.Lesson, *db.LessonProgress, bool, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonProgressLocation, "CreateOrUpdateLessonProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonProgressLocation, "CreateOrUpdateLessonProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -169,6 +179,10 @@ func (s *Services) CreateOrUpdateLessonProgress(
 			return nil, nil, false, serviceErr
 		}
 
+		s.recordLearningActivity(ctx, recordLearningActivityOptions{
+			RequestID:      opts.RequestID,
+			LessonProgress: lessonProgress,
+		})
 		return lesson, lessonProgress, true, nil
 	}
 
@@ -177,6 +191,10 @@ func (s *Services) CreateOrUpdateLessonProgress(
 		return nil, nil, false, exceptions.FromDBError(err)
 	}
 
+	s.recordLearningActivity(ctx, recordLearningActivityOptions{
+		RequestID:      opts.RequestID,
+		LessonProgress: lessonProgress,
+	})
 	return lesson, lessonProgress, false, nil
 }
 
@@ -193,7 +211,28 @@ func (s *Services) CompleteLessonProgress(
 	ctx context.Context,
 	opts CompleteLessonProgressOptions,
 ) (*db.Lesson, *db.LessonProgress, *db.Certificate, *exceptions.ServiceError) {
-	log := s.buildLogger(opts.RequestID, lessonProgressLocation, "CompleteLessonProgress").With(
+	ctx, span := s.startSpan(ctx, lessonProgressLocation, "CompleteLessonProgress")
+	defer span.End()
+
+	lesson, lessonProgress, certificate, serviceErr := s.completeLessonProgress(ctx, opts)
+	if serviceErr != nil {
+		return nil, nil, nil, serviceErr
+	}
+
+	// Only recorded once the completion transaction has been committed, so the
+	// section and series progress it reads are up-to-date
+	s.recordLearningActivity(ctx, recordLearningActivityOptions{
+		RequestID:      opts.RequestID,
+		LessonProgress: lessonProgress,
+	})
+	return lesson, lessonProgress, certificate, nil
+}
+
+func (s *Services) completeLessonProgress(
+	ctx context.Context,
+	opts CompleteLessonProgressOptions,
+) (*db.Lesson, *db.LessonProgress, *db.Certificate, *exceptions.ServiceError) {
+	log := s.buildLogger(opts.RequestID, lessonProgressLocation, "completeLessonProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -223,6 +262,11 @@ func (s *Services) CompleteLessonProgress(
 		return lesson, lessonProgress, nil, nil
 	}
 
+	version, sectionLessonsCount, serviceErr := s.findLessonProgressSeriesVersion(ctx, log, lessonProgress)
+	if serviceErr != nil {
+		return nil, nil, nil, serviceErr
+	}
+
 	qrs, txn, err := s.database.BeginTx(ctx)
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
@@ -240,7 +284,18 @@ func (s *Services) CompleteLessonProgress(
 		return nil, nil, nil, serviceErr
 	}
 
-	sectionProgress, err := qrs.IncrementSectionProgressCompletedLessons(ctx, lessonProgress.SectionProgressID)
+	var sectionProgress db.SectionProgress
+	if version != nil {
+		sectionProgress, err = qrs.IncrementSectionProgressCompletedLessonsInSeriesVersion(
+			ctx,
+			db.IncrementSectionProgressCompletedLessonsInSeriesVersionParams{
+				LessonsCount: sectionLessonsCount,
+				ID:           lessonProgress.SectionProgressID,
+			},
+		)
+	} else {
+		sectionProgress, err = qrs.IncrementSectionProgressCompletedLessons(ctx, lessonProgress.SectionProgressID)
+	}
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to increment section progress completed lessons", "error", err)
 		serviceErr = exceptions.FromDBError(err)
@@ -248,7 +303,18 @@ func (s *Services) CompleteLessonProgress(
 	}
 
 	if sectionProgress.CompletedAt.Valid {
-		seriesProgress, err := qrs.IncrementSeriesProgressCompletedSections(ctx, sectionProgress.SeriesProgressID)
+		var seriesProgress db.SeriesProgress
+		if version != nil {
+			seriesProgress, err = qrs.IncrementSeriesProgressCompletedSectionsInSeriesVersion(
+				ctx,
+				db.IncrementSeriesProgressCompletedSectionsInSeriesVersionParams{
+					SectionsCount: version.SectionsCount,
+					ID:            sectionProgress.SeriesProgressID,
+				},
+			)
+		} else {
+			seriesProgress, err = qrs.IncrementSeriesProgressCompletedSections(ctx, sectionProgress.SeriesProgressID)
+		}
 		if err != nil {
 			log.ErrorContext(ctx, "Failed to increment series progress completed sections", "error", err)
 			serviceErr = exceptions.FromDBError(err)
@@ -277,7 +343,7 @@ func (s *Services) CompleteLessonProgress(
 				},
 			)
 			if err != nil {
-				certificate, err = qrs.CreateCertificate(ctx, db.CreateCertificateParams{
+				certificateParams := db.CreateCertificateParams{
 					ID:               uuid.New(),
 					UserID:           opts.UserID,
 					LanguageSlug:     opts.LanguageSlug,
@@ -286,12 +352,39 @@ func (s *Services) CompleteLessonProgress(
 					Lessons:          series.LessonsCount,
 					WatchTimeSeconds: series.WatchTimeSeconds,
 					ReadTimeSeconds:  series.ReadTimeSeconds,
-				})
+				}
+				if version != nil {
+					// The certificate reflects the version the learner completed
+					certificateParams.SeriesTitle = version.Title
+					certificateParams.Lessons = version.LessonsCount
+					certificateParams.WatchTimeSeconds = version.WatchTimeSeconds
+					certificateParams.ReadTimeSeconds = version.ReadTimeSeconds
+					certificateParams.SeriesVersion = pgtype.Int2{Int16: version.Version, Valid: true}
+				}
+
+				certificate, err = qrs.CreateCertificate(ctx, certificateParams)
 				if err != nil {
 					log.ErrorContext(ctx, "Failed to create certificate")
 					serviceErr = exceptions.FromDBError(err)
 					return nil, nil, nil, serviceErr
 				}
+
+				serviceErr = s.enqueueWebhookEvent(ctx, qrs, enqueueWebhookEventOptions{
+					RequestID: opts.RequestID,
+					Event:     webhooks.EventCertificateIssued,
+					Data: webhookCertificateIssuedData{
+						CertificateID: certificate.ID.String(),
+						UserID:        certificate.UserID,
+						SeriesTitle:   certificate.SeriesTitle,
+						SeriesSlug:    certificate.SeriesSlug,
+						LanguageSlug:  certificate.LanguageSlug,
+						Lessons:       certificate.Lessons,
+						CompletedAt:   certificate.CompletedAt.Time.Format(time.RFC3339),
+					},
+				})
+				if serviceErr != nil {
+					return nil, nil, nil, serviceErr
+				}
 			}
 
 			return lesson, lessonProgress, &certificate, nil
@@ -309,6 +402,55 @@ func (s *Services) CompleteLessonProgress(
 	return lesson, lessonProgress, nil, nil
 }
 
+// findLessonProgressSeriesVersion returns the series version the lesson
+// progress is pinned to with the number of lessons of its section in that
+// version, lessons outside of the pinned version can't be completed.
+func (s *Services) findLessonProgressSeriesVersion(
+	ctx context.Context,
+	log *slog.Logger,
+	lessonProgress *db.LessonProgress,
+) (*db.SeriesVersion, int16, *exceptions.ServiceError) {
+	seriesProgress, err := s.database.FindSeriesProgressByID(ctx, lessonProgress.SeriesProgressID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series progress", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	version, serviceErr := s.findPinnedSeriesVersion(ctx, log, &seriesProgress)
+	if serviceErr != nil || version == nil {
+		return nil, 0, serviceErr
+	}
+
+	versionLesson, err := s.database.FindSeriesVersionLessonByLessonID(ctx, db.FindSeriesVersionLessonByLessonIDParams{
+		SeriesVersionID: version.ID,
+		LessonID:        lessonProgress.LessonID,
+	})
+	if err != nil && err != pgx.ErrNoRows {
+		log.ErrorContext(ctx, "Failed to find series version lesson", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if err == pgx.ErrNoRows || versionLesson.SectionID != lessonProgress.SectionID {
+		log.WarnContext(ctx, "Lesson is not part of the pinned series version", "version", version.Version)
+		return nil, 0, exceptions.NewValidationError(
+			"Lesson is not part of your series version, migrate to the latest version to complete it",
+		)
+	}
+
+	lessonsCount, err := s.database.CountSeriesVersionLessonsBySectionID(
+		ctx,
+		db.CountSeriesVersionLessonsBySectionIDParams{
+			SeriesVersionID: version.ID,
+			SectionID:       lessonProgress.SectionID,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count series version section lessons", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	return version, int16(lessonsCount), nil
+}
+
 type DeleteLessonProgressOptions struct {
 	RequestID    string
 	UserID       int32
@@ -322,6 +464,9 @@ func (s *Services) DeleteLessonProgress(
 	ctx context.Context,
 	opts DeleteLessonProgressOptions,
 ) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, lessonProgressLocation, "DeleteLessonProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonProgressLocation, "DeleteLessonProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
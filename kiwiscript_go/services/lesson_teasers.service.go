@@ -0,0 +1,108 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const (
+	lessonTeasersLocation string = "lesson_teasers"
+
+	maxOutlineHeadings int = 20
+)
+
+type LessonTeaser struct {
+	Lesson  *db.Lesson
+	Outline []string
+}
+
+// lessonOutline lists the markdown headings of an article up to the third
+// level, skipping the ones inside fenced code blocks.
+func lessonOutline(content string) []string {
+	outline := make([]string, 0)
+	inCodeBlock := false
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			continue
+		}
+
+		for _, prefix := range []string{"# ", "## ", "### "} {
+			if strings.HasPrefix(trimmed, prefix) {
+				if heading := strings.TrimSpace(strings.TrimLeft(trimmed, "#")); heading != "" {
+					outline = append(outline, heading)
+				}
+				break
+			}
+		}
+		if len(outline) == maxOutlineHeadings {
+			break
+		}
+	}
+
+	return outline
+}
+
+// FindLessonTeaser returns what an anonymous visitor can see of a published
+// lesson they cannot open: its title, duration and article outline.
+func (s *Services) FindLessonTeaser(ctx context.Context, opts FindLessonOptions) (*LessonTeaser, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonTeasersLocation, "FindLessonTeaser")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonTeasersLocation, "FindLessonTeaser").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+	)
+	log.InfoContext(ctx, "Finding lesson teaser...")
+
+	lesson, serviceErr := s.FindPublishedLessonBySlugsAndIDs(ctx, opts)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	outline := make([]string, 0)
+	article, serviceErr := s.FindLessonArticleByLessonID(ctx, FindLessonArticleByLessonIDOptions{
+		RequestID: opts.RequestID,
+		LessonID:  lesson.ID,
+	})
+	if serviceErr != nil {
+		if serviceErr.Code != exceptions.CodeNotFound {
+			return nil, serviceErr
+		}
+	} else {
+		outline = lessonOutline(article.Content)
+	}
+
+	log.InfoContext(ctx, "Lesson teaser found", "headings", len(outline))
+	return &LessonTeaser{
+		Lesson:  lesson,
+		Outline: outline,
+	}, nil
+}
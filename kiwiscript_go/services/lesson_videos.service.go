@@ -35,6 +35,9 @@ func (s *Services) FindLessonVideoByLessonID(
 	ctx context.Context,
 	opts FindLessonVideoByLessonIDOptions,
 ) (*db.LessonVideo, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonVideosLocation, "FindLessonVideoByLessonID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonVideosLocation, "FindLessonVideoByLessonID").With(
 		"lessonId", opts.LessonID,
 	)
@@ -63,6 +66,9 @@ func (s *Services) CreateLessonVideo(
 	ctx context.Context,
 	opts CreateLessonVideoOptions,
 ) (*db.LessonVideo, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonVideosLocation, "CreateLessonVideo")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonVideosLocation, "CreateLessonVideo").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -99,6 +105,9 @@ func (s *Services) CreateLessonVideo(
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	lessonVideo, err := qrs.CreateLessonVideo(ctx, db.CreateLessonVideoParams{
@@ -163,6 +172,9 @@ func (s *Services) UpdateLessonVideo(
 	ctx context.Context,
 	opts UpdateLessonVideoOptions,
 ) (*db.LessonVideo, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonVideosLocation, "UpdateLessonVideo")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonVideosLocation, "UpdateLessonVideo").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -203,6 +215,9 @@ func (s *Services) UpdateLessonVideo(
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	*lessonVideo, err = qrs.UpdateLessonVideo(ctx, db.UpdateLessonVideoParams{
@@ -265,6 +280,9 @@ func (s *Services) DeleteLessonVideo(
 	ctx context.Context,
 	opts DeleteLessonVideoOptions,
 ) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, lessonVideosLocation, "DeleteLessonVideo")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonVideosLocation, "DeleteLessonVideo").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -306,6 +324,9 @@ func (s *Services) DeleteLessonVideo(
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	if err := qrs.DeleteLessonVideo(ctx, lessonVideo.ID); err != nil {
@@ -329,6 +350,7 @@ func (s *Services) DeleteLessonVideo(
 
 type FindLessonVideoOptions struct {
 	RequestID    string
+	UserID       int32
 	LanguageSlug string
 	SeriesSlug   string
 	SectionID    int32
@@ -340,6 +362,9 @@ func (s *Services) FindLessonVideo(
 	ctx context.Context,
 	opts FindLessonVideoOptions,
 ) (*db.LessonVideo, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonVideosLocation, "FindLessonVideo")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonVideosLocation, "FindLessonVideo").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -370,5 +395,16 @@ func (s *Services) FindLessonVideo(
 		return nil, exceptions.NewNotFoundError()
 	}
 
+	if opts.IsPublished {
+		if serviceErr := s.assertLessonContentAccess(ctx, log, assertLessonContentAccessOptions{
+			UserID:       opts.UserID,
+			LanguageSlug: opts.LanguageSlug,
+			SeriesSlug:   opts.SeriesSlug,
+			IsPreview:    lesson.IsPreview,
+		}); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
 	return lessonVideo, nil
 }
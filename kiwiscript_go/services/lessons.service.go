@@ -23,6 +23,7 @@ import (
 	"log/slog"
 
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/utils"
 )
 
 const lessonsLocation string = "lessons"
@@ -37,6 +38,9 @@ type CreateLessonOptions struct {
 }
 
 func (s *Services) CreateLesson(ctx context.Context, opts CreateLessonOptions) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "CreateLesson")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "CreateLesson").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -68,6 +72,7 @@ func (s *Services) CreateLesson(ctx context.Context, opts CreateLessonOptions) (
 		return nil, exceptions.FromDBError(err)
 	}
 
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
 	log.InfoContext(ctx, "Lesson created successfully")
 	return &lesson, nil
 }
@@ -81,6 +86,9 @@ type FindLessonOptions struct {
 }
 
 func (s *Services) FindLessonBySlugsAndIDs(ctx context.Context, opts FindLessonOptions) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindLessonBySlugsAndIDs")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindLessonBySlugsAndIDs").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -110,6 +118,9 @@ func (s *Services) FindPublishedLessonBySlugsAndIDs(
 	ctx context.Context,
 	opts FindLessonOptions,
 ) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPublishedLessonBySlugsAndIDs")
+	defer span.End()
+
 	log := s.
 		log.
 		WithGroup("services.lessons.FindPublishedLessonBySlugsAndIDs").
@@ -156,6 +167,9 @@ func (s *Services) FindPaginatedLessons(
 	ctx context.Context,
 	opts FindPaginatedLessonsOptions,
 ) ([]db.Lesson, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPaginatedLessons")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindPaginatedLessons").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -244,6 +258,9 @@ func (s *Services) FindPaginatedPublishedLessonsWithProgress(
 	ctx context.Context,
 	opts FindPaginatedPublishedLessonsWithProgressOptions,
 ) ([]db.FindPaginatedPublishedLessonsBySlugsAndSectionIDWithProgressRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPaginatedPublishedLessonsWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindPaginatedPublishedLessonsWithProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -292,6 +309,9 @@ func (s *Services) FindPaginatedPublishedLessons(
 	ctx context.Context,
 	opts FindPaginatedLessonsOptions,
 ) ([]db.Lesson, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPaginatedPublishedLessons")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindPaginatedPublishedLessons").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -334,10 +354,323 @@ func (s *Services) FindPaginatedPublishedLessons(
 	return lessons, count, nil
 }
 
+type FindLessonsByCursorOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Cursor       *utils.Cursor
+	Limit        int32
+	WithCount    bool
+}
+
+func lessonCursorKey(l *db.Lesson, backward bool) *utils.Cursor {
+	return utils.NewPositionCursor(l.Position, l.ID, backward)
+}
+
+func lessonToLessonModel(l *db.Lesson) *db.LessonModel {
+	return l.ToLessonModel()
+}
+
+func (s *Services) FindLessonsByCursor(
+	ctx context.Context,
+	opts FindLessonsByCursorOptions,
+) (CursorPage[db.LessonModel], *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindLessonsByCursor")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindLessonsByCursor").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding lessons by cursor...")
+
+	secOpts := FindSectionBySlugsAndIDOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+	}
+	if _, serviceErr := s.FindSectionBySlugsAndID(ctx, secOpts); serviceErr != nil {
+		return CursorPage[db.LessonModel]{}, serviceErr
+	}
+
+	var count *int64
+	if opts.WithCount {
+		total, err := s.database.CountLessonsBySectionID(ctx, opts.SectionID)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count lessons", "error", err)
+			return CursorPage[db.LessonModel]{}, exceptions.FromDBError(err)
+		}
+		count = &total
+	}
+
+	var lessons []db.Lesson
+	var err error
+	if opts.Cursor != nil && opts.Cursor.Backward {
+		lessons, err = s.database.FindLessonsBySlugsAndSectionIDBeforeCursor(
+			ctx,
+			db.FindLessonsBySlugsAndSectionIDBeforeCursorParams{
+				LanguageSlug: opts.LanguageSlug,
+				SeriesSlug:   opts.SeriesSlug,
+				SectionID:    opts.SectionID,
+				Position:     opts.Cursor.Position,
+				ID:           opts.Cursor.ID,
+				Limit:        opts.Limit + 1,
+			},
+		)
+	} else {
+		var position int16
+		var id int32
+		if opts.Cursor != nil {
+			position, id = opts.Cursor.Position, opts.Cursor.ID
+		}
+
+		lessons, err = s.database.FindLessonsBySlugsAndSectionIDAfterCursor(
+			ctx,
+			db.FindLessonsBySlugsAndSectionIDAfterCursorParams{
+				LanguageSlug: opts.LanguageSlug,
+				SeriesSlug:   opts.SeriesSlug,
+				SectionID:    opts.SectionID,
+				Position:     position,
+				ID:           id,
+				Limit:        opts.Limit + 1,
+			},
+		)
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lessons by cursor", "error", err)
+		return CursorPage[db.LessonModel]{}, exceptions.FromDBError(err)
+	}
+
+	page := newCursorPage(lessons, opts.Limit, opts.Cursor, lessonCursorKey, lessonToLessonModel)
+	page.Count = count
+	log.InfoContext(ctx, "Lessons found successfully")
+	return page, nil
+}
+
+func (s *Services) findPublishedLessonsCursorCount(
+	ctx context.Context,
+	log *slog.Logger,
+	requestID,
+	languageSlug,
+	seriesSlug string,
+	sectionID int32,
+	withCount bool,
+) (*int64, *exceptions.ServiceError) {
+	if withCount {
+		count, serviceErr := s.findPublishedLessonsCount(ctx, log, requestID, languageSlug, seriesSlug, sectionID)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+
+		return &count, nil
+	}
+
+	secOpts := FindSectionBySlugsAndIDOptions{
+		RequestID:    requestID,
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		SectionID:    sectionID,
+	}
+	if _, serviceErr := s.FindPublishedSectionBySlugsAndID(ctx, secOpts); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	return nil, nil
+}
+
+func (s *Services) FindPublishedLessonsByCursor(
+	ctx context.Context,
+	opts FindLessonsByCursorOptions,
+) (CursorPage[db.LessonModel], *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPublishedLessonsByCursor")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindPublishedLessonsByCursor").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding published lessons by cursor...")
+
+	count, serviceErr := s.findPublishedLessonsCursorCount(
+		ctx,
+		log,
+		opts.RequestID,
+		opts.LanguageSlug,
+		opts.SeriesSlug,
+		opts.SectionID,
+		opts.WithCount,
+	)
+	if serviceErr != nil {
+		return CursorPage[db.LessonModel]{}, serviceErr
+	}
+
+	var lessons []db.Lesson
+	var err error
+	if opts.Cursor != nil && opts.Cursor.Backward {
+		lessons, err = s.database.FindPublishedLessonsBySlugsAndSectionIDBeforeCursor(
+			ctx,
+			db.FindPublishedLessonsBySlugsAndSectionIDBeforeCursorParams{
+				LanguageSlug: opts.LanguageSlug,
+				SeriesSlug:   opts.SeriesSlug,
+				SectionID:    opts.SectionID,
+				Position:     opts.Cursor.Position,
+				ID:           opts.Cursor.ID,
+				Limit:        opts.Limit + 1,
+			},
+		)
+	} else {
+		var position int16
+		var id int32
+		if opts.Cursor != nil {
+			position, id = opts.Cursor.Position, opts.Cursor.ID
+		}
+
+		lessons, err = s.database.FindPublishedLessonsBySlugsAndSectionIDAfterCursor(
+			ctx,
+			db.FindPublishedLessonsBySlugsAndSectionIDAfterCursorParams{
+				LanguageSlug: opts.LanguageSlug,
+				SeriesSlug:   opts.SeriesSlug,
+				SectionID:    opts.SectionID,
+				Position:     position,
+				ID:           id,
+				Limit:        opts.Limit + 1,
+			},
+		)
+	}
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find published lessons by cursor", "error", err)
+		return CursorPage[db.LessonModel]{}, exceptions.FromDBError(err)
+	}
+
+	page := newCursorPage(lessons, opts.Limit, opts.Cursor, lessonCursorKey, lessonToLessonModel)
+	page.Count = count
+	return page, nil
+}
+
+type FindPublishedLessonsWithProgressByCursorOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	Cursor       *utils.Cursor
+	Limit        int32
+	WithCount    bool
+}
+
+func (s *Services) FindPublishedLessonsWithProgressByCursor(
+	ctx context.Context,
+	opts FindPublishedLessonsWithProgressByCursorOptions,
+) (CursorPage[db.LessonModel], *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPublishedLessonsWithProgressByCursor")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindPublishedLessonsWithProgressByCursor").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding published lessons with progress by cursor...")
+
+	count, serviceErr := s.findPublishedLessonsCursorCount(
+		ctx,
+		log,
+		opts.RequestID,
+		opts.LanguageSlug,
+		opts.SeriesSlug,
+		opts.SectionID,
+		opts.WithCount,
+	)
+	if serviceErr != nil {
+		return CursorPage[db.LessonModel]{}, serviceErr
+	}
+
+	if opts.Cursor != nil && opts.Cursor.Backward {
+		lessons, err := s.database.FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursor(
+			ctx,
+			db.FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorParams{
+				UserID:       opts.UserID,
+				LanguageSlug: opts.LanguageSlug,
+				SeriesSlug:   opts.SeriesSlug,
+				SectionID:    opts.SectionID,
+				Position:     opts.Cursor.Position,
+				ID:           opts.Cursor.ID,
+				Limit:        opts.Limit + 1,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find published lessons with progress by cursor", "error", err)
+			return CursorPage[db.LessonModel]{}, exceptions.FromDBError(err)
+		}
+
+		page := newCursorPage(
+			lessons,
+			opts.Limit,
+			opts.Cursor,
+			func(l *db.FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorRow, backward bool) *utils.Cursor {
+				return utils.NewPositionCursor(l.Position, l.ID, backward)
+			},
+			func(l *db.FindPublishedLessonsBySlugsAndSectionIDWithProgressBeforeCursorRow) *db.LessonModel {
+				return l.ToLessonModel()
+			},
+		)
+		page.Count = count
+		return page, nil
+	}
+
+	var position int16
+	var id int32
+	if opts.Cursor != nil {
+		position, id = opts.Cursor.Position, opts.Cursor.ID
+	}
+
+	lessons, err := s.database.FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursor(
+		ctx,
+		db.FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorParams{
+			UserID:       opts.UserID,
+			LanguageSlug: opts.LanguageSlug,
+			SeriesSlug:   opts.SeriesSlug,
+			SectionID:    opts.SectionID,
+			Position:     position,
+			ID:           id,
+			Limit:        opts.Limit + 1,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find published lessons with progress by cursor", "error", err)
+		return CursorPage[db.LessonModel]{}, exceptions.FromDBError(err)
+	}
+
+	page := newCursorPage(
+		lessons,
+		opts.Limit,
+		opts.Cursor,
+		func(l *db.FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorRow, backward bool) *utils.Cursor {
+			return utils.NewPositionCursor(l.Position, l.ID, backward)
+		},
+		func(l *db.FindPublishedLessonsBySlugsAndSectionIDWithProgressAfterCursorRow) *db.LessonModel {
+			return l.ToLessonModel()
+		},
+	)
+	page.Count = count
+	return page, nil
+}
+
 func (s *Services) FindLessonWithArticleAndVideo(
 	ctx context.Context,
 	opts FindLessonOptions,
 ) (*db.FindLessonBySlugsAndIDsWithArticleAndVideoRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindLessonWithArticleAndVideo")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindLessonWithArticleAndVideo").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -367,6 +700,9 @@ func (s *Services) FindPublishedLessonWithArticleAndVideo(
 	ctx context.Context,
 	opts FindLessonOptions,
 ) (*db.FindLessonBySlugsAndIDsWithArticleAndVideoRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPublishedLessonWithArticleAndVideo")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindPublishedLessonWithArticleAndVideo").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -393,6 +729,13 @@ func (s *Services) FindPublishedLessonWithArticleAndVideo(
 		log.WarnContext(ctx, "Lesson is not published")
 		return nil, exceptions.NewNotFoundError()
 	}
+	if serviceErr := s.assertLessonContentAccess(ctx, log, assertLessonContentAccessOptions{
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		IsPreview:    lesson.IsPreview,
+	}); serviceErr != nil {
+		return nil, serviceErr
+	}
 
 	return lesson, nil
 }
@@ -410,6 +753,9 @@ func (s *Services) FindPublishedLessonWithProgressArticleAndVideo(
 	ctx context.Context,
 	opts FindLessonWithProgressOptions,
 ) (*db.FindPublishedLessonBySlugsAndIDsWithProgressArticleAndVideoRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindPublishedLessonWithProgressArticleAndVideo")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindPublishedLessonWithProgressArticleAndVideo").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -433,6 +779,14 @@ func (s *Services) FindPublishedLessonWithProgressArticleAndVideo(
 		log.WarnContext(ctx, "Lesson not found", "error", err)
 		return nil, exceptions.FromDBError(err)
 	}
+	if serviceErr := s.assertLessonContentAccess(ctx, log, assertLessonContentAccessOptions{
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		IsPreview:    lesson.IsPreview,
+	}); serviceErr != nil {
+		return nil, serviceErr
+	}
 
 	return &lesson, nil
 }
@@ -447,6 +801,9 @@ type AssertLessonOwnershipOptions struct {
 }
 
 func (s *Services) AssertLessonOwnership(ctx context.Context, opts AssertLessonOwnershipOptions) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "AssertLessonOwnership")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "AssertLessonOwnership").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -483,9 +840,13 @@ type UpdateLessonOptions struct {
 	LessonID     int32
 	Title        string
 	Position     int16
+	Version      int32
 }
 
 func (s *Services) UpdateLesson(ctx context.Context, opts UpdateLessonOptions) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "UpdateLesson")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "UpdateLesson").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -494,6 +855,7 @@ func (s *Services) UpdateLesson(ctx context.Context, opts UpdateLessonOptions) (
 		"lessonId", opts.LessonID,
 		"title", opts.Title,
 		"position", opts.Position,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Updating lessons...")
 
@@ -507,18 +869,23 @@ func (s *Services) UpdateLesson(ctx context.Context, opts UpdateLessonOptions) (
 	if serviceErr != nil {
 		return nil, serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, lesson.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
 
 	if opts.Position == 0 || lesson.Position == opts.Position {
 		var err error
 		*lesson, err = s.database.UpdateLesson(ctx, db.UpdateLessonParams{
-			ID:    lesson.ID,
-			Title: opts.Title,
+			ID:      lesson.ID,
+			Title:   opts.Title,
+			Version: lesson.Version,
 		})
 		if err != nil {
 			log.ErrorContext(ctx, "Failed to update lesson", "error", err)
-			return nil, exceptions.FromDBError(err)
+			return nil, versionedUpdateError(err)
 		}
 
+		s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
 		log.InfoContext(ctx, "Lesson updated successfully")
 		return lesson, nil
 	}
@@ -531,6 +898,9 @@ func (s *Services) UpdateLesson(ctx context.Context, opts UpdateLessonOptions) (
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	oldPosition := lesson.Position
@@ -538,10 +908,11 @@ func (s *Services) UpdateLesson(ctx context.Context, opts UpdateLessonOptions) (
 		ID:       lesson.ID,
 		Title:    opts.Title,
 		Position: opts.Position,
+		Version:  lesson.Version,
 	})
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to update lesson with position", "error", err)
-		serviceErr = exceptions.FromDBError(err)
+		serviceErr = versionedUpdateError(err)
 		return nil, serviceErr
 	}
 
@@ -580,22 +951,37 @@ type DeleteLessonOptions struct {
 	SeriesSlug   string
 	SectionID    int32
 	LessonID     int32
+	Version      int32
 }
 
 func (s *Services) DeleteLesson(ctx context.Context, opts DeleteLessonOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "DeleteLesson")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "DeleteLesson").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
 		"sectionId", opts.SectionID,
 		"lessonId", opts.LessonID,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Deleting lessons...")
 
-	lesson, serviceErr := s.AssertLessonOwnership(ctx, AssertLessonOwnershipOptions(opts))
+	lesson, serviceErr := s.AssertLessonOwnership(ctx, AssertLessonOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
 	if serviceErr != nil {
 		return serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, lesson.Version); serviceErr != nil {
+		return serviceErr
+	}
 
 	count, err := s.database.CountLessonProgressByLessonID(ctx, lesson.ID)
 	if err != nil {
@@ -616,13 +1002,25 @@ func (s *Services) DeleteLesson(ctx context.Context, opts DeleteLessonOptions) *
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
-	if err := qrs.DeleteLessonByID(ctx, lesson.ID); err != nil {
+	deleted, err := qrs.DeleteLessonByIDAndVersion(ctx, db.DeleteLessonByIDAndVersionParams{
+		ID:      lesson.ID,
+		Version: lesson.Version,
+	})
+	if err != nil {
 		log.ErrorContext(ctx, "Failed to delete lesson", "error", err)
 		serviceErr = exceptions.FromDBError(err)
 		return serviceErr
 	}
+	if deleted == 0 {
+		log.WarnContext(ctx, "Lesson changed while being deleted")
+		serviceErr = exceptions.NewPreconditionFailedError()
+		return serviceErr
+	}
 
 	params := db.DecrementLessonPositionParams{
 		SectionID:  opts.SectionID,
@@ -647,12 +1045,16 @@ type UpdateLessonIsPublishedOptions struct {
 	SectionID    int32
 	LessonID     int32
 	IsPublished  bool
+	Version      int32
 }
 
 func (s *Services) UpdateLessonIsPublished(
 	ctx context.Context,
 	opts UpdateLessonIsPublishedOptions,
 ) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "UpdateLessonIsPublished")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "UpdateLessonIsPublished").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -660,6 +1062,7 @@ func (s *Services) UpdateLessonIsPublished(
 		"sectionId", opts.SectionID,
 		"lessonId", opts.LessonID,
 		"isPublished", opts.IsPublished,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Updating lesson article is published...")
 
@@ -673,6 +1076,9 @@ func (s *Services) UpdateLessonIsPublished(
 	if serviceErr != nil {
 		return nil, serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, lesson.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
 
 	if lesson.IsPublished == opts.IsPublished {
 		log.InfoContext(ctx, "Lesson article is already published")
@@ -704,15 +1110,19 @@ func (s *Services) UpdateLessonIsPublished(
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
-	*lesson, err = qrs.UpdateLessonIsPublished(ctx, db.UpdateLessonIsPublishedParams{
+	*lesson, err = qrs.UpdateLessonIsPublishedByIDAndVersion(ctx, db.UpdateLessonIsPublishedByIDAndVersionParams{
 		ID:          lesson.ID,
 		IsPublished: opts.IsPublished,
+		Version:     lesson.Version,
 	})
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to update lesson article is published", "error", err)
-		serviceErr = exceptions.FromDBError(err)
+		serviceErr = versionedUpdateError(err)
 		return nil, serviceErr
 	}
 
@@ -765,6 +1175,328 @@ func (s *Services) UpdateLessonIsPublished(
 	return lesson, nil
 }
 
+type UpdateLessonIsPreviewOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	IsPreview    bool
+	Version      int32
+}
+
+func (s *Services) UpdateLessonIsPreview(
+	ctx context.Context,
+	opts UpdateLessonIsPreviewOptions,
+) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "UpdateLessonIsPreview")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonsLocation, "UpdateLessonIsPreview").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"isPreview", opts.IsPreview,
+		"version", opts.Version,
+	)
+	log.InfoContext(ctx, "Updating lesson is preview...")
+
+	lesson, serviceErr := s.AssertLessonOwnership(ctx, AssertLessonOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, lesson.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if lesson.IsPreview == opts.IsPreview {
+		log.InfoContext(ctx, "Lesson preview is already up to date")
+		return lesson, nil
+	}
+
+	updatedLesson, err := s.database.UpdateLessonIsPreview(ctx, db.UpdateLessonIsPreviewParams{
+		IsPreview: opts.IsPreview,
+		ID:        lesson.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson is preview", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+	log.InfoContext(ctx, "Lesson is preview updated")
+	return &updatedLesson, nil
+}
+
+type ReorderLessonsOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonIDs    []int32
+	Version      int32
+}
+
+// lessonsOrderVersion is the version of the order of a section's lessons, every
+// edit or reorder bumps the version of at least one lesson so the sum changes.
+func lessonsOrderVersion(lessons []db.Lesson) int32 {
+	var version int32
+	for _, lesson := range lessons {
+		version += lesson.Version
+	}
+
+	return version
+}
+
+// ReorderLessons sets the order of a section's lessons in a single transaction,
+// ids of lessons from other sections of the same series move them into this one.
+func (s *Services) ReorderLessons(
+	ctx context.Context,
+	opts ReorderLessonsOptions,
+) ([]db.Lesson, int32, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "ReorderLessons")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonsLocation, "ReorderLessons").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonIds", opts.LessonIDs,
+		"version", opts.Version,
+	)
+	log.InfoContext(ctx, "Reordering lessons...")
+
+	section, serviceErr := s.AssertSectionOwnership(ctx, AssertSectionOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	sectionLessons, err := qrs.FindLessonsBySectionIDForUpdate(ctx, section.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find section lessons", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, 0, serviceErr
+	}
+	if serviceErr = assertVersion(ctx, log, opts.Version, lessonsOrderVersion(sectionLessons)); serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	lessons, err := qrs.FindLessonsBySeriesSlugAndIDsForUpdate(ctx, db.FindLessonsBySeriesSlugAndIDsForUpdateParams{
+		SeriesSlug: section.SeriesSlug,
+		Ids:        opts.LessonIDs,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lessons", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, 0, serviceErr
+	}
+	if len(lessons) != len(opts.LessonIDs) {
+		log.WarnContext(ctx, "Some lessons do not belong to the series", "foundCount", len(lessons))
+		serviceErr = exceptions.NewValidationError("Lesson IDs must belong to the series")
+		return nil, 0, serviceErr
+	}
+
+	currentIDs := make([]int32, 0, len(lessons))
+	movedLessons := make([]db.Lesson, 0)
+	for _, lesson := range lessons {
+		if lesson.SectionID == section.ID {
+			currentIDs = append(currentIDs, lesson.ID)
+			continue
+		}
+
+		movedLessons = append(movedLessons, lesson)
+	}
+	if len(currentIDs) != len(sectionLessons) {
+		log.WarnContext(ctx, "Lesson IDs are missing lessons of the section", "sectionLessonsCount", len(sectionLessons))
+		serviceErr = exceptions.NewValidationError("Lesson IDs must contain every lesson of the section exactly once")
+		return nil, 0, serviceErr
+	}
+
+	movedIDs := make([]int32, 0, len(movedLessons))
+	for _, lesson := range movedLessons {
+		count, err := qrs.CountLessonProgressByLessonID(ctx, lesson.ID)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count lesson progress", "error", err, "lessonId", lesson.ID)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, 0, serviceErr
+		}
+		if count > 0 {
+			log.WarnContext(ctx, "Cannot move lesson with progress", "lessonId", lesson.ID)
+			serviceErr = exceptions.NewConflictError("Lesson has students")
+			return nil, 0, serviceErr
+		}
+
+		movedIDs = append(movedIDs, lesson.ID)
+	}
+
+	if err := qrs.ReorderLessons(ctx, db.ReorderLessonsParams{
+		SectionID:  section.ID,
+		Ids:        opts.LessonIDs,
+		SeriesSlug: section.SeriesSlug,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reorder lessons", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, 0, serviceErr
+	}
+
+	if len(movedLessons) > 0 {
+		if serviceErr = s.moveLessonsToSection(ctx, log, qrs, section.ID, movedIDs, movedLessons); serviceErr != nil {
+			return nil, 0, serviceErr
+		}
+	}
+
+	lessons, err = qrs.FindLessonsBySectionID(ctx, section.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find reordered lessons", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, 0, serviceErr
+	}
+
+	log.InfoContext(ctx, "Lessons reordered", "movedCount", len(movedLessons))
+	return lessons, lessonsOrderVersion(lessons), nil
+}
+
+type FindLessonsOrderOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+}
+
+// FindLessonsOrder returns every lesson of a section in order with the version
+// that a reorder has to send back in its If-Match header.
+func (s *Services) FindLessonsOrder(
+	ctx context.Context,
+	opts FindLessonsOrderOptions,
+) ([]db.Lesson, int32, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindLessonsOrder")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindLessonsOrder").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+	)
+	log.InfoContext(ctx, "Finding lessons order...")
+
+	section, serviceErr := s.FindSectionBySlugsAndID(ctx, FindSectionBySlugsAndIDOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	lessons, err := s.database.FindLessonsBySectionID(ctx, section.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find section lessons", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Lessons order found")
+	return lessons, lessonsOrderVersion(lessons), nil
+}
+
+// moveLessonsToSection fixes everything that still points at the old sections
+// of lessons already moved by ReorderLessons, the series totals do not change
+// since the lessons stay in the same series.
+func (s *Services) moveLessonsToSection(
+	ctx context.Context,
+	log *slog.Logger,
+	qrs *db.Queries,
+	sectionID int32,
+	movedIDs []int32,
+	movedLessons []db.Lesson,
+) *exceptions.ServiceError {
+	commentsParams := db.UpdateLessonCommentsSectionIDParams{SectionID: sectionID, LessonIds: movedIDs}
+	if err := qrs.UpdateLessonCommentsSectionID(ctx, commentsParams); err != nil {
+		log.ErrorContext(ctx, "Failed to move lesson comments", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	notesParams := db.UpdateLessonNotesSectionIDParams{SectionID: sectionID, LessonIds: movedIDs}
+	if err := qrs.UpdateLessonNotesSectionID(ctx, notesParams); err != nil {
+		log.ErrorContext(ctx, "Failed to move lesson notes", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	bookmarksParams := db.UpdateLessonBookmarksSectionIDParams{SectionID: sectionID, LessonIds: movedIDs}
+	if err := qrs.UpdateLessonBookmarksSectionID(ctx, bookmarksParams); err != nil {
+		log.ErrorContext(ctx, "Failed to move lesson bookmarks", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	sourceSectionIDs := make(map[int32]bool)
+	for _, lesson := range movedLessons {
+		sourceSectionIDs[lesson.SectionID] = true
+		if !lesson.IsPublished {
+			continue
+		}
+
+		decParams := db.DecrementSectionLessonsCountParams{
+			ID:               lesson.SectionID,
+			WatchTimeSeconds: lesson.WatchTimeSeconds,
+			ReadTimeSeconds:  lesson.ReadTimeSeconds,
+		}
+		if err := qrs.DecrementSectionLessonsCount(ctx, decParams); err != nil {
+			log.ErrorContext(ctx, "Failed to decrement series part lessons count", "error", err)
+			return exceptions.FromDBError(err)
+		}
+
+		incParams := db.IncrementSectionLessonsCountParams{
+			ID:               sectionID,
+			WatchTimeSeconds: lesson.WatchTimeSeconds,
+			ReadTimeSeconds:  lesson.ReadTimeSeconds,
+		}
+		if err := qrs.IncrementSectionLessonsCount(ctx, incParams); err != nil {
+			log.ErrorContext(ctx, "Failed to increment series part lessons count", "error", err)
+			return exceptions.FromDBError(err)
+		}
+	}
+
+	for sourceSectionID := range sourceSectionIDs {
+		if err := qrs.CompactLessonPositions(ctx, sourceSectionID); err != nil {
+			log.ErrorContext(ctx, "Failed to compact lesson positions", "error", err, "sectionId", sourceSectionID)
+			return exceptions.FromDBError(err)
+		}
+	}
+
+	return nil
+}
+
 type FindCurrentLessonOptions struct {
 	RequestID    string
 	UserID       int32
@@ -777,6 +1509,9 @@ func (s *Services) FindCurrentLesson(
 	ctx context.Context,
 	opts FindCurrentLessonOptions,
 ) (*db.FindCurrentLessonRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, lessonsLocation, "FindCurrentLesson")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, lessonsLocation, "FindCurrentLesson").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
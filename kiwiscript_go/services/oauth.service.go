@@ -37,6 +37,9 @@ type GetAuthorizationURLOptions struct {
 }
 
 func (s *Services) GetAuthorizationURL(ctx context.Context, opts GetAuthorizationURLOptions) (string, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, oauthLocation, "GetAuthorizationURL")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, oauthLocation, "GetAuthorizationURL")
 	log.InfoContext(ctx, "Getting authorization url")
 
@@ -77,6 +80,9 @@ type GetOAuthTokenOptions struct {
 }
 
 func (s *Services) GetOAuthToken(ctx context.Context, opts GetOAuthTokenOptions) (string, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, oauthLocation, "GetOAuthToken")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, oauthLocation, "GetOAuthToken")
 	log.InfoContext(ctx, "Getting oauth token")
 
@@ -181,6 +187,9 @@ type ExtOAuthSignInOptions struct {
 }
 
 func (s *Services) ExtOAuthSignIn(ctx context.Context, opts ExtOAuthSignInOptions) (*OAuthResponse, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, oauthLocation, "ExtOAuthSignIn")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, oauthLocation, "ExtOAuthSignIn")
 	log.InfoContext(ctx, "Generating internal code and state...")
 
@@ -265,6 +274,9 @@ func (s *Services) ExtOAuthSignIn(ctx context.Context, opts ExtOAuthSignInOption
 }
 
 func (s *Services) ProcessOAuthHeader(ctx context.Context, authHeader string) (*tokens.OAuthUserClaims, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, oauthLocation, "ProcessOAuthHeader")
+	defer span.End()
+
 	log := s.log.WithGroup("services.oauth.ProcessOAuthHeader")
 	log.InfoContext(ctx, "Processing OAuth authentication header...")
 
@@ -302,6 +314,9 @@ type IntOAuthSignInOptions struct {
 }
 
 func (s *Services) OAuthToken(ctx context.Context, opts IntOAuthSignInOptions) (*AuthResponse, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, oauthLocation, "OAuthToken")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, oauthLocation, "OAuthToken").With(
 		"tokenUserId", opts.UserID,
 		"tokenUserVersion", opts.UserVersion,
@@ -344,5 +359,10 @@ func (s *Services) OAuthToken(ctx context.Context, opts IntOAuthSignInOptions) (
 		return nil, exceptions.NewUnauthorizedError()
 	}
 
+	user, serviceErr = s.restoreDeletedUser(ctx, log, user)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	return s.generateAuthResponse(ctx, log, "User OAuth signed in successfully", user)
 }
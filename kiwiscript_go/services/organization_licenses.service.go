@@ -0,0 +1,181 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const organizationLicensesLocation string = "organization_licenses"
+
+type UpsertOrganizationLicenseOptions struct {
+	RequestID      string
+	UserID         int32
+	OrganizationID int32
+	LanguageSlug   string
+	SeriesSlug     string
+	Seats          int32
+}
+
+// UpsertOrganizationLicense grants the organization seats of a paid series,
+// licenses are granted by platform admins once the seats are paid for.
+func (s *Services) UpsertOrganizationLicense(
+	ctx context.Context,
+	opts UpsertOrganizationLicenseOptions,
+) (*db.OrganizationLicense, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, organizationLicensesLocation, "UpsertOrganizationLicense")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationLicensesLocation, "UpsertOrganizationLicense").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"seats", opts.Seats,
+	)
+	log.InfoContext(ctx, "Upserting organization license...")
+
+	organization, err := s.database.FindOrganizationByID(ctx, opts.OrganizationID)
+	if err != nil {
+		log.WarnContext(ctx, "Organization not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.Price == 0 {
+		log.WarnContext(ctx, "Free series do not need a license")
+		return nil, exceptions.NewValidationError("Free series do not need a license")
+	}
+
+	license, err := s.database.UpsertOrganizationLicense(ctx, db.UpsertOrganizationLicenseParams{
+		OrganizationID: organization.ID,
+		LanguageSlug:   series.LanguageSlug,
+		SeriesSlug:     series.Slug,
+		Seats:          opts.Seats,
+		GrantedByID:    pgtype.Int4{Int32: opts.UserID, Valid: true},
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to upsert organization license", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Organization license upserted", "licenseId", license.ID)
+	return &license, nil
+}
+
+type DeleteOrganizationLicenseOptions struct {
+	RequestID      string
+	UserID         int32
+	OrganizationID int32
+	SeriesSlug     string
+}
+
+// DeleteOrganizationLicense revokes the license, the cohorts keep the series
+// assigned but their members lose access to its paid content.
+func (s *Services) DeleteOrganizationLicense(
+	ctx context.Context,
+	opts DeleteOrganizationLicenseOptions,
+) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, organizationLicensesLocation, "DeleteOrganizationLicense")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationLicensesLocation, "DeleteOrganizationLicense").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Deleting organization license...")
+
+	license, err := s.database.FindOrganizationLicenseByOrganizationIDAndSeriesSlug(
+		ctx,
+		db.FindOrganizationLicenseByOrganizationIDAndSeriesSlugParams{
+			OrganizationID: opts.OrganizationID,
+			SeriesSlug:     opts.SeriesSlug,
+		},
+	)
+	if err != nil {
+		log.WarnContext(ctx, "Organization license not found", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	if err := s.database.DeleteOrganizationLicense(ctx, license.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete organization license", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Organization license deleted")
+	return nil
+}
+
+// assertOrganizationLicenseSeats checks the organization holds a license for
+// the paid series with enough seats for every member of the cohort.
+func (s *Services) assertOrganizationLicenseSeats(
+	ctx context.Context,
+	log *slog.Logger,
+	cohort *db.Cohort,
+	seriesSlug string,
+) *exceptions.ServiceError {
+	license, err := s.database.FindOrganizationLicenseByOrganizationIDAndSeriesSlug(
+		ctx,
+		db.FindOrganizationLicenseByOrganizationIDAndSeriesSlugParams{
+			OrganizationID: cohort.OrganizationID,
+			SeriesSlug:     seriesSlug,
+		},
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			log.WarnContext(ctx, "Organization has no license for the paid series")
+			return exceptions.NewPaymentRequiredError()
+		}
+
+		log.ErrorContext(ctx, "Failed to find organization license", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	seats, err := s.database.CountOrganizationLicenseSeatsWithCohort(
+		ctx,
+		db.CountOrganizationLicenseSeatsWithCohortParams{
+			CohortID:       cohort.ID,
+			OrganizationID: cohort.OrganizationID,
+			SeriesSlug:     seriesSlug,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count organization license seats", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	if seats > int64(license.Seats) {
+		log.WarnContext(ctx, "Organization license has no free seats", "seats", license.Seats, "usedSeats", seats)
+		return exceptions.NewConflictError("Organization license has no free seats for the series")
+	}
+
+	return nil
+}
@@ -0,0 +1,521 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/email"
+	"github.com/kiwiscript/kiwiscript_go/utils"
+)
+
+const (
+	organizationsLocation string = "organizations"
+
+	organizationInvitationTTL time.Duration = 7 * 24 * time.Hour
+)
+
+type assertOrganizationAccessOptions struct {
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	RequireAdmin   bool
+}
+
+// assertOrganizationAccess finds the organization and checks the user is one of
+// its members, or one of its admins when required. Platform admins manage
+// every organization.
+func (s *Services) assertOrganizationAccess(
+	ctx context.Context,
+	log *slog.Logger,
+	opts assertOrganizationAccessOptions,
+) (*db.Organization, *exceptions.ServiceError) {
+	organization, err := s.database.FindOrganizationByID(ctx, opts.OrganizationID)
+	if err != nil {
+		log.WarnContext(ctx, "Organization not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if opts.IsAdmin {
+		return &organization, nil
+	}
+
+	member, err := s.database.FindOrganizationMember(ctx, db.FindOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		UserID:         opts.UserID,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			log.WarnContext(ctx, "User is not a member of the organization")
+			return nil, exceptions.NewForbiddenError()
+		}
+
+		log.ErrorContext(ctx, "Failed to find organization member", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if opts.RequireAdmin && member.Role != db.OrganizationRoleAdmin {
+		log.WarnContext(ctx, "User is not an admin of the organization")
+		return nil, exceptions.NewForbiddenError()
+	}
+
+	return &organization, nil
+}
+
+type CreateOrganizationOptions struct {
+	RequestID string
+	UserID    int32
+	Name      string
+}
+
+func (s *Services) CreateOrganization(
+	ctx context.Context,
+	opts CreateOrganizationOptions,
+) (*db.Organization, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, organizationsLocation, "CreateOrganization")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationsLocation, "CreateOrganization").With(
+		"userId", opts.UserID,
+		"name", opts.Name,
+	)
+	log.InfoContext(ctx, "Creating organization...")
+
+	var serviceErr *exceptions.ServiceError
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	organization, err := qrs.CreateOrganization(ctx, db.CreateOrganizationParams{
+		Name:    opts.Name,
+		OwnerID: opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create organization", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	// The owner is the first admin of the organization
+	if _, err = qrs.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		UserID:         opts.UserID,
+		Role:           db.OrganizationRoleAdmin,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to create organization owner member", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Organization created", "organizationId", organization.ID)
+	return &organization, nil
+}
+
+type FindPaginatedOrganizationsOptions struct {
+	RequestID string
+	UserID    int32
+	Offset    int32
+	Limit     int32
+}
+
+func (s *Services) FindPaginatedOrganizations(
+	ctx context.Context,
+	opts FindPaginatedOrganizationsOptions,
+) ([]db.Organization, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, organizationsLocation, "FindPaginatedOrganizations")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationsLocation, "FindPaginatedOrganizations").With(
+		"userId", opts.UserID,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated organizations...")
+
+	count, err := s.database.CountOrganizationsByUserID(ctx, opts.UserID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count organizations", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	if count == 0 {
+		log.DebugContext(ctx, "No organizations found", "count", count)
+		return make([]db.Organization, 0), 0, nil
+	}
+
+	organizations, err := s.database.FindPaginatedOrganizationsByUserID(
+		ctx,
+		db.FindPaginatedOrganizationsByUserIDParams{
+			UserID: opts.UserID,
+			Offset: opts.Offset,
+			Limit:  opts.Limit,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find organizations", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Organizations found", "count", count)
+	return organizations, count, nil
+}
+
+type FindOrganizationOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+}
+
+func (s *Services) FindOrganization(
+	ctx context.Context,
+	opts FindOrganizationOptions,
+) (*db.Organization, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, organizationsLocation, "FindOrganization")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationsLocation, "FindOrganization").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+	)
+	log.InfoContext(ctx, "Finding organization...")
+
+	organization, serviceErr := s.assertOrganizationAccess(ctx, log, assertOrganizationAccessOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Organization found")
+	return organization, nil
+}
+
+type FindPaginatedOrganizationMembersOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	Offset         int32
+	Limit          int32
+}
+
+func (s *Services) FindPaginatedOrganizationMembers(
+	ctx context.Context,
+	opts FindPaginatedOrganizationMembersOptions,
+) ([]db.FindPaginatedOrganizationMembersWithUserRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, organizationsLocation, "FindPaginatedOrganizationMembers")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationsLocation, "FindPaginatedOrganizationMembers").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated organization members...")
+
+	organization, serviceErr := s.assertOrganizationAccess(ctx, log, assertOrganizationAccessOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		RequireAdmin:   true,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	count, err := s.database.CountOrganizationMembers(ctx, organization.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count organization members", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	members, err := s.database.FindPaginatedOrganizationMembersWithUser(
+		ctx,
+		db.FindPaginatedOrganizationMembersWithUserParams{
+			OrganizationID: organization.ID,
+			Offset:         opts.Offset,
+			Limit:          opts.Limit,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find organization members", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Organization members found", "count", count)
+	return members, count, nil
+}
+
+type DeleteOrganizationMemberOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	MemberID       int32
+}
+
+func (s *Services) DeleteOrganizationMember(
+	ctx context.Context,
+	opts DeleteOrganizationMemberOptions,
+) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, organizationsLocation, "DeleteOrganizationMember")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationsLocation, "DeleteOrganizationMember").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"memberId", opts.MemberID,
+	)
+	log.InfoContext(ctx, "Deleting organization member...")
+
+	organization, serviceErr := s.assertOrganizationAccess(ctx, log, assertOrganizationAccessOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		RequireAdmin:   true,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if organization.OwnerID == opts.MemberID {
+		log.WarnContext(ctx, "Organization owner cannot be removed")
+		return exceptions.NewValidationError("The organization owner cannot be removed")
+	}
+
+	if _, err := s.database.FindOrganizationMember(ctx, db.FindOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		UserID:         opts.MemberID,
+	}); err != nil {
+		log.WarnContext(ctx, "Organization member not found", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	if err = qrs.DeleteCohortMembersByOrganizationIDAndUserID(
+		ctx,
+		db.DeleteCohortMembersByOrganizationIDAndUserIDParams{
+			OrganizationID: organization.ID,
+			UserID:         opts.MemberID,
+		},
+	); err != nil {
+		log.ErrorContext(ctx, "Failed to delete cohort members", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	if err = qrs.DeleteOrganizationMember(ctx, db.DeleteOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		UserID:         opts.MemberID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to delete organization member", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Organization member deleted")
+	return nil
+}
+
+type CreateOrganizationInvitationOptions struct {
+	RequestID      string
+	UserID         int32
+	IsAdmin        bool
+	OrganizationID int32
+	Email          string
+	Role           string
+}
+
+func (s *Services) CreateOrganizationInvitation(
+	ctx context.Context,
+	opts CreateOrganizationInvitationOptions,
+) (*db.OrganizationInvitation, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, organizationsLocation, "CreateOrganizationInvitation")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationsLocation, "CreateOrganizationInvitation").With(
+		"userId", opts.UserID,
+		"organizationId", opts.OrganizationID,
+		"role", opts.Role,
+	)
+	log.InfoContext(ctx, "Creating organization invitation...")
+
+	organization, serviceErr := s.assertOrganizationAccess(ctx, log, assertOrganizationAccessOptions{
+		UserID:         opts.UserID,
+		IsAdmin:        opts.IsAdmin,
+		OrganizationID: opts.OrganizationID,
+		RequireAdmin:   true,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	invitedEmail := utils.Lowered(opts.Email)
+	if invitedUser, err := s.database.FindUserByEmail(ctx, invitedEmail); err == nil {
+		if _, err := s.database.FindOrganizationMember(ctx, db.FindOrganizationMemberParams{
+			OrganizationID: organization.ID,
+			UserID:         invitedUser.ID,
+		}); err == nil {
+			log.InfoContext(ctx, "User is already a member of the organization")
+			return nil, exceptions.NewConflictError("User is already a member of the organization")
+		}
+	}
+
+	inviter, serviceErr := s.FindUserByID(ctx, FindUserByIDOptions{
+		RequestID: opts.RequestID,
+		ID:        opts.UserID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	expiresAt := time.Now().Add(organizationInvitationTTL)
+	invitation, err := s.database.CreateOrganizationInvitation(ctx, db.CreateOrganizationInvitationParams{
+		OrganizationID: organization.ID,
+		Email:          invitedEmail,
+		Role:           opts.Role,
+		Token:          uuid.New(),
+		InvitedByID:    pgtype.Int4{Int32: inviter.ID, Valid: true},
+		ExpiresAt:      pgtype.Timestamp{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create organization invitation", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	go func() {
+		if err := s.mail.SendOrganizationInvitationEmail(ctx, email.OrganizationInvitationEmailOptions{
+			RequestID:        opts.RequestID,
+			Email:            invitation.Email,
+			InviterName:      fmt.Sprintf("%s %s", inviter.FirstName, inviter.LastName),
+			OrganizationName: organization.Name,
+			InvitationToken:  invitation.Token.String(),
+			ExpiresAt:        expiresAt.UTC().Format(time.RFC1123),
+		}); err != nil {
+			log.WarnContext(ctx, "Failed to send organization invitation email", "error", err)
+		}
+	}()
+
+	log.InfoContext(ctx, "Organization invitation created")
+	return &invitation, nil
+}
+
+type AcceptOrganizationInvitationOptions struct {
+	RequestID string
+	UserID    int32
+	Token     uuid.UUID
+}
+
+func (s *Services) AcceptOrganizationInvitation(
+	ctx context.Context,
+	opts AcceptOrganizationInvitationOptions,
+) (*db.Organization, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, organizationsLocation, "AcceptOrganizationInvitation")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, organizationsLocation, "AcceptOrganizationInvitation").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Accepting organization invitation...")
+
+	invitation, err := s.database.FindOrganizationInvitationByToken(ctx, opts.Token)
+	if err != nil {
+		log.WarnContext(ctx, "Organization invitation not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if invitation.ExpiresAt.Time.Before(time.Now()) {
+		log.WarnContext(ctx, "Organization invitation has expired")
+		return nil, exceptions.NewValidationError("Invitation has expired")
+	}
+
+	user, serviceErr := s.FindUserByID(ctx, FindUserByIDOptions{
+		RequestID: opts.RequestID,
+		ID:        opts.UserID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if utils.Lowered(user.Email) != invitation.Email {
+		log.WarnContext(ctx, "Invitation was sent to another email")
+		return nil, exceptions.NewForbiddenError()
+	}
+
+	organization, err := s.database.FindOrganizationByID(ctx, invitation.OrganizationID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find organization", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	if _, memberErr := qrs.FindOrganizationMember(ctx, db.FindOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		UserID:         user.ID,
+	}); memberErr != nil {
+		if memberErr != pgx.ErrNoRows {
+			log.ErrorContext(ctx, "Failed to find organization member", "error", memberErr)
+			serviceErr = exceptions.FromDBError(memberErr)
+			return nil, serviceErr
+		}
+
+		if _, err = qrs.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+			OrganizationID: organization.ID,
+			UserID:         user.ID,
+			Role:           invitation.Role,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to create organization member", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+	}
+
+	if err = qrs.DeleteOrganizationInvitation(ctx, invitation.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete organization invitation", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Organization invitation accepted", "organizationId", organization.ID)
+	return &organization, nil
+}
@@ -0,0 +1,384 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/payments"
+)
+
+const (
+	paymentsLocation string = "payments"
+
+	// couponProvider records checkouts fully covered by a coupon, they never
+	// reach the payment provider.
+	couponProvider string = "coupon"
+)
+
+type Checkout struct {
+	SessionID  string
+	URL        string
+	Amount     int32
+	Currency   string
+	Enrollment *db.Enrollment
+}
+
+type CreateCheckoutOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	CouponCode   string
+}
+
+// CreateCheckout prices a paid series, applying the coupon if any, and opens a
+// checkout session with the payment provider. A coupon covering the whole price
+// enrolls the user straight away.
+func (s *Services) CreateCheckout(ctx context.Context, opts CreateCheckoutOptions) (*Checkout, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, paymentsLocation, "CreateCheckout")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, paymentsLocation, "CreateCheckout").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"couponCode", opts.CouponCode,
+	)
+	log.InfoContext(ctx, "Creating checkout...")
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.Price == 0 {
+		log.WarnContext(ctx, "Series is free")
+		return nil, exceptions.NewValidationError("Series is free, enroll instead")
+	}
+	if series.AuthorID == opts.UserID {
+		log.WarnContext(ctx, "Authors cannot buy their own series")
+		return nil, exceptions.NewValidationError("Cannot buy your own series")
+	}
+
+	hasAccess, err := s.database.HasSeriesAccess(ctx, db.HasSeriesAccessParams{
+		UserID:     opts.UserID,
+		SeriesSlug: series.Slug,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to check series access", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if hasAccess {
+		log.WarnContext(ctx, "User already has access to the series")
+		return nil, exceptions.NewConflictError("Already enrolled in the series")
+	}
+
+	amount := series.Price
+	var coupon *db.Coupon
+	if opts.CouponCode != "" {
+		found, err := s.database.FindCouponByCode(ctx, strings.ToUpper(opts.CouponCode))
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				log.WarnContext(ctx, "Coupon not found")
+				return nil, exceptions.NewValidationError("Invalid coupon code")
+			}
+
+			log.ErrorContext(ctx, "Failed to find coupon", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+		if !found.IsRedeemable(series.Slug, time.Now()) {
+			log.WarnContext(ctx, "Coupon cannot be redeemed", "couponId", found.ID)
+			return nil, exceptions.NewValidationError("Invalid coupon code")
+		}
+
+		coupon = &found
+		amount = series.Price * int32(100-found.PercentOff) / 100
+	}
+
+	var couponID pgtype.Int4
+	if coupon != nil {
+		couponID = pgtype.Int4{Int32: coupon.ID, Valid: true}
+	}
+
+	if amount == 0 {
+		return s.completeCouponCheckout(ctx, opts.RequestID, opts.UserID, series, coupon)
+	}
+
+	user, serviceErr := s.FindUserByID(ctx, FindUserByIDOptions{
+		RequestID: opts.RequestID,
+		ID:        opts.UserID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	session, err := s.payments.CreateCheckoutSession(ctx, payments.CreateCheckoutSessionOptions{
+		RequestID:         opts.RequestID,
+		ClientReferenceID: fmt.Sprintf("user_%d_%s", opts.UserID, uuid.NewString()),
+		CustomerEmail:     user.Email,
+		LanguageSlug:      series.LanguageSlug,
+		SeriesSlug:        series.Slug,
+		ProductName:       series.Title,
+		Amount:            amount,
+		Currency:          series.Currency,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create checkout session", "error", err)
+		return nil, exceptions.NewServerError()
+	}
+
+	if _, err := s.database.CreatePayment(ctx, db.CreatePaymentParams{
+		UserID:            pgtype.Int4{Int32: opts.UserID, Valid: true},
+		LanguageSlug:      series.LanguageSlug,
+		SeriesSlug:        series.Slug,
+		Provider:          s.payments.Name(),
+		ProviderSessionID: session.ID,
+		Amount:            amount,
+		Currency:          series.Currency,
+		CouponID:          couponID,
+		Status:            db.PaymentStatusPending,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to create payment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Checkout created", "sessionId", session.ID, "amount", amount)
+	return &Checkout{
+		SessionID: session.ID,
+		URL:       session.URL,
+		Amount:    amount,
+		Currency:  series.Currency,
+	}, nil
+}
+
+func (s *Services) completeCouponCheckout(
+	ctx context.Context,
+	requestID string,
+	userID int32,
+	series *db.Series,
+	coupon *db.Coupon,
+) (*Checkout, *exceptions.ServiceError) {
+	log := s.buildLogger(requestID, paymentsLocation, "completeCouponCheckout").With(
+		"userId", userID,
+		"seriesSlug", series.Slug,
+		"couponId", coupon.ID,
+	)
+	log.InfoContext(ctx, "Completing checkout covered by coupon...")
+
+	var serviceErr *exceptions.ServiceError
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	rows, err := qrs.IncrementCouponRedemptionsCount(ctx, coupon.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to increment coupon redemptions", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if rows == 0 {
+		log.WarnContext(ctx, "Coupon has no redemptions left")
+		serviceErr = exceptions.NewValidationError("Invalid coupon code")
+		return nil, serviceErr
+	}
+
+	sessionID := couponProvider + "_" + uuid.NewString()
+	payment, err := qrs.CreatePayment(ctx, db.CreatePaymentParams{
+		UserID:            pgtype.Int4{Int32: userID, Valid: true},
+		LanguageSlug:      series.LanguageSlug,
+		SeriesSlug:        series.Slug,
+		Provider:          couponProvider,
+		ProviderSessionID: sessionID,
+		Amount:            0,
+		Currency:          series.Currency,
+		CouponID:          pgtype.Int4{Int32: coupon.ID, Valid: true},
+		Status:            db.PaymentStatusCompleted,
+		CompletedAt:       pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create payment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	enrollment, err := qrs.UpsertPaidEnrollment(ctx, db.UpsertPaidEnrollmentParams{
+		UserID:       userID,
+		LanguageSlug: series.LanguageSlug,
+		SeriesSlug:   series.Slug,
+		PaymentID:    pgtype.Int4{Int32: payment.ID, Valid: true},
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create enrollment", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Checkout completed with coupon", "enrollmentId", enrollment.ID)
+	return &Checkout{
+		SessionID:  sessionID,
+		Amount:     0,
+		Currency:   series.Currency,
+		Enrollment: &enrollment,
+	}, nil
+}
+
+type HandlePaymentWebhookOptions struct {
+	RequestID string
+	Payload   []byte
+	Signature string
+}
+
+// HandlePaymentWebhook applies the provider's checkout events, completions are
+// idempotent so redelivered events don't redeem coupons twice.
+func (s *Services) HandlePaymentWebhook(ctx context.Context, opts HandlePaymentWebhookOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, paymentsLocation, "HandlePaymentWebhook")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, paymentsLocation, "HandlePaymentWebhook")
+	log.InfoContext(ctx, "Handling payment webhook...")
+
+	event, err := s.payments.ParseWebhookEvent(opts.Payload, opts.Signature)
+	if err != nil {
+		if errors.Is(err, payments.ErrInvalidSignature) {
+			log.WarnContext(ctx, "Invalid webhook signature")
+			return exceptions.NewValidationError("Invalid signature")
+		}
+		if errors.Is(err, payments.ErrMissingWebhookSecret) {
+			log.ErrorContext(ctx, "Payments webhook secret is not configured")
+			return exceptions.NewServerError()
+		}
+
+		log.WarnContext(ctx, "Failed to parse webhook event", "error", err)
+		return exceptions.NewValidationError("Invalid payload")
+	}
+
+	log = log.With("eventId", event.ID, "eventType", event.Type, "sessionId", event.SessionID)
+	switch event.Type {
+	case payments.EventCheckoutCompleted:
+		if event.PaymentStatus != payments.PaymentStatusPaid {
+			log.InfoContext(ctx, "Checkout completed without payment, ignoring", "paymentStatus", event.PaymentStatus)
+			return nil
+		}
+
+		return s.completePayment(ctx, opts.RequestID, event.SessionID)
+	case payments.EventCheckoutExpired:
+		payment, err := s.database.FindPaymentByProviderSessionID(ctx, event.SessionID)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				log.WarnContext(ctx, "Payment not found, ignoring")
+				return nil
+			}
+
+			log.ErrorContext(ctx, "Failed to find payment", "error", err)
+			return exceptions.FromDBError(err)
+		}
+		if err := s.database.ExpirePayment(ctx, payment.ID); err != nil {
+			log.ErrorContext(ctx, "Failed to expire payment", "error", err)
+			return exceptions.FromDBError(err)
+		}
+
+		log.InfoContext(ctx, "Payment expired", "paymentId", payment.ID)
+		return nil
+	default:
+		log.InfoContext(ctx, "Unhandled event type, ignoring")
+		return nil
+	}
+}
+
+func (s *Services) completePayment(ctx context.Context, requestID, sessionID string) *exceptions.ServiceError {
+	log := s.buildLogger(requestID, paymentsLocation, "completePayment").With("sessionId", sessionID)
+	log.InfoContext(ctx, "Completing payment...")
+
+	payment, err := s.database.FindPaymentByProviderSessionID(ctx, sessionID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			log.WarnContext(ctx, "Payment not found, ignoring")
+			return nil
+		}
+
+		log.ErrorContext(ctx, "Failed to find payment", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	var serviceErr *exceptions.ServiceError
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	rows, err := qrs.CompletePayment(ctx, payment.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to complete payment", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	if rows == 0 {
+		log.InfoContext(ctx, "Payment already processed", "paymentId", payment.ID, "status", payment.Status)
+		return nil
+	}
+
+	// The coupon was checked at checkout and the discounted price is already
+	// paid, a coupon that ran out meanwhile simply stops counting
+	if payment.CouponID.Valid {
+		if _, err = qrs.IncrementCouponRedemptionsCount(ctx, payment.CouponID.Int32); err != nil {
+			log.ErrorContext(ctx, "Failed to increment coupon redemptions", "error", err)
+			return exceptions.FromDBError(err)
+		}
+	}
+
+	// Payments outlive their purged users for bookkeeping, there is no one
+	// left to enroll
+	if !payment.UserID.Valid {
+		log.WarnContext(ctx, "Payment user was purged, skipping enrollment", "paymentId", payment.ID)
+		return nil
+	}
+
+	enrollment, err := qrs.UpsertPaidEnrollment(ctx, db.UpsertPaidEnrollmentParams{
+		UserID:       payment.UserID.Int32,
+		LanguageSlug: payment.LanguageSlug,
+		SeriesSlug:   payment.SeriesSlug,
+		PaymentID:    pgtype.Int4{Int32: payment.ID, Valid: true},
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create enrollment", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Payment completed", "paymentId", payment.ID, "enrollmentId", enrollment.ID)
+	return nil
+}
@@ -0,0 +1,471 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const scheduledReleasesLocation string = "scheduled_releases"
+
+const scheduledReleasesBatchSize int32 = 25
+
+// scheduledReleasesLockKey identifies the postgres advisory lock that makes
+// sure a single app instance performs the scheduled transitions at a time.
+const scheduledReleasesLockKey int64 = 4_105_119_241
+
+func scheduleTimestamp(t time.Time) pgtype.Timestamp {
+	if t.IsZero() {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: t.UTC(), Valid: true}
+}
+
+func isScheduleDue(ts pgtype.Timestamp) bool {
+	return ts.Valid && !ts.Time.After(time.Now().UTC())
+}
+
+// assertSchedule checks that the schedule is in the future and that it
+// transitions the content away from its current state, a zero time means
+// that side of the schedule is unset.
+func assertSchedule(
+	ctx context.Context,
+	log *slog.Logger,
+	isPublished bool,
+	publishAt,
+	unpublishAt time.Time,
+) *exceptions.ServiceError {
+	now := time.Now()
+
+	if !publishAt.IsZero() {
+		if !publishAt.After(now) {
+			log.WarnContext(ctx, "Publish date is not in the future", "publishAt", publishAt)
+			return exceptions.NewValidationError("Publish date must be in the future")
+		}
+		if isPublished {
+			log.WarnContext(ctx, "Content is already published")
+			return exceptions.NewValidationError("Cannot schedule the publishing of published content")
+		}
+	}
+	if !unpublishAt.IsZero() {
+		if !unpublishAt.After(now) {
+			log.WarnContext(ctx, "Unpublish date is not in the future", "unpublishAt", unpublishAt)
+			return exceptions.NewValidationError("Unpublish date must be in the future")
+		}
+		if !isPublished && publishAt.IsZero() {
+			log.WarnContext(ctx, "Content is not published nor scheduled to be")
+			return exceptions.NewValidationError("Cannot schedule the unpublishing of unpublished content")
+		}
+		if !publishAt.IsZero() && !unpublishAt.After(publishAt) {
+			log.WarnContext(ctx, "Unpublish date is not after the publish date")
+			return exceptions.NewValidationError("Unpublish date must be after the publish date")
+		}
+	}
+
+	return nil
+}
+
+type UpdateSeriesScheduleOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	PublishAt    time.Time
+	UnpublishAt  time.Time
+}
+
+func (s *Services) UpdateSeriesSchedule(
+	ctx context.Context,
+	opts UpdateSeriesScheduleOptions,
+) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, scheduledReleasesLocation, "UpdateSeriesSchedule")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, scheduledReleasesLocation, "UpdateSeriesSchedule").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"publishAt", opts.PublishAt,
+		"unpublishAt", opts.UnpublishAt,
+	)
+	log.InfoContext(ctx, "Updating series schedule...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.AuthorID != opts.UserID {
+		log.WarnContext(ctx, "User is not the author of the series")
+		return nil, exceptions.NewForbiddenError()
+	}
+	if serviceErr := assertSchedule(ctx, log, series.IsPublished, opts.PublishAt, opts.UnpublishAt); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	updatedSeries, err := s.database.UpdateSeriesSchedule(ctx, db.UpdateSeriesScheduleParams{
+		ID:          series.ID,
+		PublishAt:   scheduleTimestamp(opts.PublishAt),
+		UnpublishAt: scheduleTimestamp(opts.UnpublishAt),
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update series schedule", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series schedule updated")
+	return &updatedSeries, nil
+}
+
+type UpdateSectionScheduleOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	PublishAt    time.Time
+	UnpublishAt  time.Time
+	Version      int32
+}
+
+func (s *Services) UpdateSectionSchedule(
+	ctx context.Context,
+	opts UpdateSectionScheduleOptions,
+) (*db.Section, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, scheduledReleasesLocation, "UpdateSectionSchedule")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, scheduledReleasesLocation, "UpdateSectionSchedule").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"publishAt", opts.PublishAt,
+		"unpublishAt", opts.UnpublishAt,
+		"version", opts.Version,
+	)
+	log.InfoContext(ctx, "Updating section schedule...")
+
+	section, serviceErr := s.AssertSectionOwnership(ctx, AssertSectionOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, section.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
+	if serviceErr := assertSchedule(ctx, log, section.IsPublished, opts.PublishAt, opts.UnpublishAt); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	updatedSection, err := s.database.UpdateSectionSchedule(ctx, db.UpdateSectionScheduleParams{
+		ID:          section.ID,
+		PublishAt:   scheduleTimestamp(opts.PublishAt),
+		UnpublishAt: scheduleTimestamp(opts.UnpublishAt),
+		Version:     section.Version,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update section schedule", "error", err)
+		return nil, versionedUpdateError(err)
+	}
+
+	log.InfoContext(ctx, "Section schedule updated")
+	return &updatedSection, nil
+}
+
+type UpdateLessonScheduleOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionID    int32
+	LessonID     int32
+	PublishAt    time.Time
+	UnpublishAt  time.Time
+	Version      int32
+}
+
+func (s *Services) UpdateLessonSchedule(
+	ctx context.Context,
+	opts UpdateLessonScheduleOptions,
+) (*db.Lesson, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, scheduledReleasesLocation, "UpdateLessonSchedule")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, scheduledReleasesLocation, "UpdateLessonSchedule").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionId", opts.SectionID,
+		"lessonId", opts.LessonID,
+		"publishAt", opts.PublishAt,
+		"unpublishAt", opts.UnpublishAt,
+		"version", opts.Version,
+	)
+	log.InfoContext(ctx, "Updating lesson schedule...")
+
+	lesson, serviceErr := s.AssertLessonOwnership(ctx, AssertLessonOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+		LessonID:     opts.LessonID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, lesson.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
+	if serviceErr := assertSchedule(ctx, log, lesson.IsPublished, opts.PublishAt, opts.UnpublishAt); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	updatedLesson, err := s.database.UpdateLessonSchedule(ctx, db.UpdateLessonScheduleParams{
+		ID:          lesson.ID,
+		PublishAt:   scheduleTimestamp(opts.PublishAt),
+		UnpublishAt: scheduleTimestamp(opts.UnpublishAt),
+		Version:     lesson.Version,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update lesson schedule", "error", err)
+		return nil, versionedUpdateError(err)
+	}
+
+	log.InfoContext(ctx, "Lesson schedule updated")
+	return &updatedLesson, nil
+}
+
+type FindPaginatedScheduledReleasesOptions struct {
+	RequestID string
+	Offset    int32
+	Limit     int32
+}
+
+func (s *Services) FindPaginatedScheduledReleases(
+	ctx context.Context,
+	opts FindPaginatedScheduledReleasesOptions,
+) ([]db.FindPaginatedScheduledReleasesRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, scheduledReleasesLocation, "FindPaginatedScheduledReleases")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, scheduledReleasesLocation, "FindPaginatedScheduledReleases").With(
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated scheduled releases...")
+
+	count, err := s.database.CountScheduledReleases(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count scheduled releases", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	if count == 0 {
+		log.DebugContext(ctx, "No scheduled releases found", "count", count)
+		return make([]db.FindPaginatedScheduledReleasesRow, 0), 0, nil
+	}
+
+	releases, err := s.database.FindPaginatedScheduledReleases(ctx, db.FindPaginatedScheduledReleasesParams{
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find scheduled releases", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Scheduled releases found", "count", count)
+	return releases, count, nil
+}
+
+// completeScheduledTransition clears a due schedule once its transition ran,
+// server errors keep it so the transition is retried on the next run while any
+// other error means it can never be applied and it is dropped.
+func completeScheduledTransition(
+	ctx context.Context,
+	log *slog.Logger,
+	serviceErr *exceptions.ServiceError,
+	clearSchedule func(ctx context.Context, id int32) error,
+	id int32,
+) {
+	if serviceErr != nil {
+		if serviceErr.Code == exceptions.CodeServerError {
+			log.ErrorContext(ctx, "Failed to perform scheduled transition, retrying later", "error", serviceErr)
+			return
+		}
+		log.WarnContext(ctx, "Dropping scheduled transition that cannot be performed", "error", serviceErr)
+	}
+
+	if err := clearSchedule(ctx, id); err != nil {
+		log.ErrorContext(ctx, "Failed to clear schedule", "error", err)
+	}
+}
+
+func (s *Services) performScheduledLessonTransitions(ctx context.Context, requestID string) {
+	log := s.buildLogger(requestID, scheduledReleasesLocation, "performScheduledLessonTransitions")
+
+	lessons, err := s.database.FindLessonsDueForScheduling(ctx, scheduledReleasesBatchSize)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lessons due for scheduling", "error", err)
+		return
+	}
+
+	for _, lesson := range lessons {
+		opts := UpdateLessonIsPublishedOptions{
+			RequestID:    requestID,
+			UserID:       lesson.AuthorID,
+			LanguageSlug: lesson.LanguageSlug,
+			SeriesSlug:   lesson.SeriesSlug,
+			SectionID:    lesson.SectionID,
+			LessonID:     lesson.ID,
+			Version:      AnyVersion,
+		}
+		lessonLog := log.With("lessonId", lesson.ID)
+
+		if isScheduleDue(lesson.PublishAt) {
+			opts.IsPublished = true
+			_, serviceErr := s.UpdateLessonIsPublished(ctx, opts)
+			completeScheduledTransition(ctx, lessonLog, serviceErr, s.database.ClearLessonPublishAt, lesson.ID)
+		}
+		if isScheduleDue(lesson.UnpublishAt) {
+			opts.IsPublished = false
+			_, serviceErr := s.UpdateLessonIsPublished(ctx, opts)
+			completeScheduledTransition(ctx, lessonLog, serviceErr, s.database.ClearLessonUnpublishAt, lesson.ID)
+		}
+	}
+}
+
+func (s *Services) performScheduledSectionTransitions(ctx context.Context, requestID string) {
+	log := s.buildLogger(requestID, scheduledReleasesLocation, "performScheduledSectionTransitions")
+
+	sections, err := s.database.FindSectionsDueForScheduling(ctx, scheduledReleasesBatchSize)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find sections due for scheduling", "error", err)
+		return
+	}
+
+	for _, section := range sections {
+		opts := UpdateSectionIsPublishedOptions{
+			RequestID:    requestID,
+			UserID:       section.AuthorID,
+			LanguageSlug: section.LanguageSlug,
+			SeriesSlug:   section.SeriesSlug,
+			SectionID:    section.ID,
+			Version:      AnyVersion,
+		}
+		sectionLog := log.With("sectionId", section.ID)
+
+		if isScheduleDue(section.PublishAt) {
+			opts.IsPublished = true
+			_, serviceErr := s.UpdateSectionIsPublished(ctx, opts)
+			completeScheduledTransition(ctx, sectionLog, serviceErr, s.database.ClearSectionPublishAt, section.ID)
+		}
+		if isScheduleDue(section.UnpublishAt) {
+			opts.IsPublished = false
+			_, serviceErr := s.UpdateSectionIsPublished(ctx, opts)
+			completeScheduledTransition(ctx, sectionLog, serviceErr, s.database.ClearSectionUnpublishAt, section.ID)
+		}
+	}
+}
+
+func (s *Services) performScheduledSeriesTransitions(ctx context.Context, requestID string) {
+	log := s.buildLogger(requestID, scheduledReleasesLocation, "performScheduledSeriesTransitions")
+
+	seriesList, err := s.database.FindSeriesDueForScheduling(ctx, scheduledReleasesBatchSize)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series due for scheduling", "error", err)
+		return
+	}
+
+	for _, series := range seriesList {
+		opts := UpdateSeriesIsPublishedOptions{
+			RequestID:    requestID,
+			UserID:       series.AuthorID,
+			LanguageSlug: series.LanguageSlug,
+			SeriesSlug:   series.Slug,
+		}
+		seriesLog := log.With("seriesId", series.ID)
+
+		if isScheduleDue(series.PublishAt) {
+			opts.IsPublished = true
+			_, serviceErr := s.UpdateSeriesIsPublished(ctx, opts)
+			completeScheduledTransition(ctx, seriesLog, serviceErr, s.database.ClearSeriesPublishAt, series.ID)
+		}
+		if isScheduleDue(series.UnpublishAt) {
+			opts.IsPublished = false
+			_, serviceErr := s.UpdateSeriesIsPublished(ctx, opts)
+			completeScheduledTransition(ctx, seriesLog, serviceErr, s.database.ClearSeriesUnpublishAt, series.ID)
+		}
+	}
+}
+
+// PerformScheduledTransitions publishes and unpublishes the content whose
+// schedule is due. Lessons go first so sections and series are published with
+// their content and their counters are up to date.
+func (s *Services) PerformScheduledTransitions(ctx context.Context, requestID string) {
+	ctx, span := s.startSpan(ctx, scheduledReleasesLocation, "PerformScheduledTransitions")
+	defer span.End()
+
+	log := s.buildLogger(requestID, scheduledReleasesLocation, "PerformScheduledTransitions")
+	log.DebugContext(ctx, "Performing scheduled transitions...")
+
+	acquired, err := s.database.WithAdvisoryLock(ctx, scheduledReleasesLockKey, func(ctx context.Context) {
+		s.performScheduledLessonTransitions(ctx, requestID)
+		s.performScheduledSectionTransitions(ctx, requestID)
+		s.performScheduledSeriesTransitions(ctx, requestID)
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to take the scheduled releases lock", "error", err)
+		return
+	}
+	if !acquired {
+		log.DebugContext(ctx, "Scheduled transitions are being performed by another instance")
+	}
+}
+
+// RunScheduledReleasesWorker performs the scheduled transitions until the context is cancelled.
+func (s *Services) RunScheduledReleasesWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.PerformScheduledTransitions(ctx, uuid.NewString())
+		}
+	}
+}
@@ -37,6 +37,9 @@ func (s *Services) FindSectionProgressBySlugsAndID(
 	ctx context.Context,
 	opts FindSectionProgressBySlugsAndIDOptions,
 ) (*db.SectionProgress, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionProgressLocation, "FindSectionProgressBySlugsAndID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionProgressLocation, "FindSectionProgressBySlugsAndID").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -113,6 +116,9 @@ func (s *Services) CreateOrUpdateSectionProgress(
 	ctx context.Context,
 	opts CreateOrUpdateSectionProgressOptions,
 ) (*db.Section, *db.SectionProgress, bool, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionProgressLocation, "CreateOrUpdateSectionProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionProgressLocation, "CreateOrUpdateSectionProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -188,6 +194,9 @@ func (s *Services) DeleteSectionProgress(
 	ctx context.Context,
 	opts DeleteSectionProgressOptions,
 ) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, sectionProgressLocation, "DeleteSectionProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionProgressLocation, "DeleteSectionProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -37,6 +37,9 @@ type CreateSectionOptions struct {
 }
 
 func (s *Services) CreateSection(ctx context.Context, opts CreateSectionOptions) (*db.Section, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "CreateSection")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "CreateSection").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -67,6 +70,7 @@ func (s *Services) CreateSection(ctx context.Context, opts CreateSectionOptions)
 		return nil, exceptions.FromDBError(err)
 	}
 
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
 	log.InfoContext(ctx, "Series part created", "id", section.ID)
 	return &section, nil
 }
@@ -82,6 +86,9 @@ func (s *Services) FindSectionBySlugsAndID(
 	ctx context.Context,
 	opts FindSectionBySlugsAndIDOptions,
 ) (*db.Section, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindSectionBySlugsAndID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "FindSectionBySlugsAndID").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -106,6 +113,9 @@ func (s *Services) FindPublishedSectionBySlugsAndID(
 	ctx context.Context,
 	opts FindSectionBySlugsAndIDOptions,
 ) (*db.Section, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindPublishedSectionBySlugsAndID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "FindPublishedSectionBySlugsAndID").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -146,6 +156,9 @@ func (s *Services) FindPublishedSectionBySlugsAndIDWithProgress(
 	ctx context.Context,
 	opts FindPublishedSectionBySlugsAndIDWithProgressOptions,
 ) (*db.FindPublishedSectionBySlugsAndIDWithProgressRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindPublishedSectionBySlugsAndIDWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "FindPublishedSectionBySlugsAndIDWithProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -192,6 +205,9 @@ func (s *Services) FindPaginatedSectionsBySlugs(
 	ctx context.Context,
 	opts FindPaginatedSectionsBySlugsOptions,
 ) ([]db.Section, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindPaginatedSectionsBySlugs")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "FindPaginatedSectionsBySlugs").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -259,6 +275,9 @@ func (s *Services) FindPaginatedPublishedSectionsBySlugs(
 	ctx context.Context,
 	opts FindPaginatedSectionsBySlugsOptions,
 ) ([]db.Section, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindPaginatedPublishedSectionsBySlugs")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "FindPaginatedPublishedSectionsBySlugs").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -303,6 +322,9 @@ func (s *Services) FindPaginatedPublishedSectionsBySlugsWithProgress(
 	ctx context.Context,
 	opts FindSectionBySlugsAndIDWithProgressOptions,
 ) ([]db.FindPaginatedPublishedSectionsBySlugsWithProgressRow, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindPaginatedPublishedSectionsBySlugsWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(
 		opts.RequestID,
 		sectionsLocation,
@@ -347,6 +369,9 @@ type AssertSectionOwnershipOptions struct {
 }
 
 func (s *Services) AssertSectionOwnership(ctx context.Context, opts AssertSectionOwnershipOptions) (*db.Section, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "AssertSectionOwnership")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "AssertSectionOwnership").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -381,14 +406,19 @@ type UpdateSectionOptions struct {
 	Title        string
 	Description  string
 	Position     int16
+	Version      int32
 }
 
 func (s *Services) UpdateSection(ctx context.Context, opts UpdateSectionOptions) (*db.Section, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "UpdateSection")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "UpdateSection").With(
 		"seriesSlug", opts.SeriesSlug,
 		"sectionId", opts.SectionID,
 		"title", opts.Title,
 		"position", opts.Position,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Updating section...")
 
@@ -402,19 +432,24 @@ func (s *Services) UpdateSection(ctx context.Context, opts UpdateSectionOptions)
 	if serviceErr != nil {
 		return nil, serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, section.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
 
 	if opts.Position == 0 || opts.Position == section.Position {
 		section, err := s.database.UpdateSection(ctx, db.UpdateSectionParams{
 			ID:          section.ID,
 			Title:       opts.Title,
 			Description: opts.Description,
+			Version:     section.Version,
 		})
 
 		if err != nil {
 			log.ErrorContext(ctx, "Failed to update series part", "error", err)
-			return nil, exceptions.FromDBError(err)
+			return nil, versionedUpdateError(err)
 		}
 
+		s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
 		log.InfoContext(ctx, "Series part updated", "id", section.ID)
 		return &section, nil
 	}
@@ -437,6 +472,9 @@ func (s *Services) UpdateSection(ctx context.Context, opts UpdateSectionOptions)
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	oldPosition := section.Position
@@ -469,10 +507,11 @@ func (s *Services) UpdateSection(ctx context.Context, opts UpdateSectionOptions)
 		Title:       opts.Title,
 		Description: opts.Description,
 		Position:    opts.Position,
+		Version:     section.Version,
 	})
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to update series part", "error", err)
-		serviceErr = exceptions.FromDBError(err)
+		serviceErr = versionedUpdateError(err)
 		return nil, serviceErr
 	}
 
@@ -487,14 +526,19 @@ type UpdateSectionIsPublishedOptions struct {
 	SeriesSlug   string
 	SectionID    int32
 	IsPublished  bool
+	Version      int32
 }
 
 func (s *Services) UpdateSectionIsPublished(ctx context.Context, opts UpdateSectionIsPublishedOptions) (*db.Section, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "UpdateSectionIsPublished")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "UpdateSectionIsPublished").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
 		"sectionId", opts.SectionID,
 		"isPublished", opts.IsPublished,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Updating series part is published...")
 
@@ -508,6 +552,9 @@ func (s *Services) UpdateSectionIsPublished(ctx context.Context, opts UpdateSect
 	if serviceErr != nil {
 		return nil, serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, section.Version); serviceErr != nil {
+		return nil, serviceErr
+	}
 
 	if section.IsPublished == opts.IsPublished {
 		log.InfoContext(ctx, "Series part is already published", "is_published", opts.IsPublished)
@@ -544,15 +591,19 @@ func (s *Services) UpdateSectionIsPublished(ctx context.Context, opts UpdateSect
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
-	*section, err = qrs.UpdateSectionIsPublished(ctx, db.UpdateSectionIsPublishedParams{
+	*section, err = qrs.UpdateSectionIsPublishedByIDAndVersion(ctx, db.UpdateSectionIsPublishedByIDAndVersionParams{
 		ID:          section.ID,
 		IsPublished: opts.IsPublished,
+		Version:     section.Version,
 	})
 	if err != nil {
 		log.ErrorContext(ctx, "Failed to update series part is published", "error", err)
-		serviceErr = exceptions.FromDBError(err)
+		serviceErr = versionedUpdateError(err)
 		return nil, serviceErr
 	}
 	if opts.IsPublished {
@@ -591,20 +642,34 @@ type DeleteSectionOptions struct {
 	LanguageSlug string
 	SeriesSlug   string
 	SectionID    int32
+	Version      int32
 }
 
 func (s *Services) DeleteSection(ctx context.Context, opts DeleteSectionOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "DeleteSection")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "DeleteSection").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
 		"sectionId", opts.SectionID,
+		"version", opts.Version,
 	)
 	log.InfoContext(ctx, "Deleting series part...")
 
-	section, serviceErr := s.AssertSectionOwnership(ctx, AssertSectionOwnershipOptions(opts))
+	section, serviceErr := s.AssertSectionOwnership(ctx, AssertSectionOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		SectionID:    opts.SectionID,
+	})
 	if serviceErr != nil {
 		return serviceErr
 	}
+	if serviceErr := assertVersion(ctx, log, opts.Version, section.Version); serviceErr != nil {
+		return serviceErr
+	}
 
 	if section.IsPublished {
 		progressCount, err := s.database.CountSectionProgress(ctx, section.ID)
@@ -627,13 +692,25 @@ func (s *Services) DeleteSection(ctx context.Context, opts DeleteSectionOptions)
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
-	if err := qrs.DeleteSectionById(ctx, opts.SectionID); err != nil {
+	deleted, err := qrs.DeleteSectionByIDAndVersion(ctx, db.DeleteSectionByIDAndVersionParams{
+		ID:      section.ID,
+		Version: section.Version,
+	})
+	if err != nil {
 		log.ErrorContext(ctx, "Failed to delete series part", "error", err)
 		serviceErr = exceptions.FromDBError(err)
 		return serviceErr
 	}
+	if deleted == 0 {
+		log.WarnContext(ctx, "Section changed while being deleted")
+		serviceErr = exceptions.NewPreconditionFailedError()
+		return serviceErr
+	}
 
 	posParams := db.DecrementSectionPositionParams{
 		SeriesSlug: opts.SeriesSlug,
@@ -662,6 +739,144 @@ func (s *Services) DeleteSection(ctx context.Context, opts DeleteSectionOptions)
 	return nil
 }
 
+type ReorderSectionsOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	SectionIDs   []int32
+	Version      int32
+}
+
+// sectionsOrderVersion is the version of the order of a series' parts, every
+// edit or reorder bumps the version of at least one part so the sum changes.
+func sectionsOrderVersion(sections []db.Section) int32 {
+	var version int32
+	for _, section := range sections {
+		version += section.Version
+	}
+
+	return version
+}
+
+func (s *Services) ReorderSections(
+	ctx context.Context,
+	opts ReorderSectionsOptions,
+) ([]db.Section, int32, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "ReorderSections")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, sectionsLocation, "ReorderSections").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"sectionIds", opts.SectionIDs,
+		"version", opts.Version,
+	)
+	log.InfoContext(ctx, "Reordering series parts...")
+
+	series, serviceErr := s.AssertSeriesOwnership(ctx, AssertSeriesOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	sections, err := qrs.FindSectionsBySeriesSlugForUpdate(ctx, series.Slug)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series parts", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, 0, serviceErr
+	}
+	if serviceErr = assertVersion(ctx, log, opts.Version, sectionsOrderVersion(sections)); serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	currentIDs := make([]int32, 0, len(sections))
+	for _, section := range sections {
+		currentIDs = append(currentIDs, section.ID)
+	}
+	if !isPermutation(opts.SectionIDs, currentIDs) {
+		log.WarnContext(ctx, "Section IDs are not a permutation of the series parts", "currentIds", currentIDs)
+		serviceErr = exceptions.NewValidationError("Section IDs must contain every section of the series exactly once")
+		return nil, 0, serviceErr
+	}
+
+	if err := qrs.ReorderSections(ctx, db.ReorderSectionsParams{
+		Ids:        opts.SectionIDs,
+		SeriesSlug: series.Slug,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reorder series parts", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, 0, serviceErr
+	}
+
+	sections, err = qrs.FindSectionsBySeriesSlug(ctx, series.Slug)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find reordered series parts", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, 0, serviceErr
+	}
+
+	log.InfoContext(ctx, "Series parts reordered")
+	return sections, sectionsOrderVersion(sections), nil
+}
+
+type FindSectionsOrderOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+// FindSectionsOrder returns every part of a series in order with the version
+// that a reorder has to send back in its If-Match header.
+func (s *Services) FindSectionsOrder(
+	ctx context.Context,
+	opts FindSectionsOrderOptions,
+) ([]db.Section, int32, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindSectionsOrder")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, sectionsLocation, "FindSectionsOrder").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding series parts order...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	sections, err := s.database.FindSectionsBySeriesSlug(ctx, series.Slug)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series parts", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series parts order found")
+	return sections, sectionsOrderVersion(sections), nil
+}
+
 type FindCurrentSectionOptions struct {
 	RequestID    string
 	UserID       int32
@@ -673,6 +888,9 @@ func (s *Services) FindCurrentSection(
 	ctx context.Context,
 	opts FindCurrentSectionOptions,
 ) (*db.FindCurrentSectionRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, sectionsLocation, "FindCurrentSection")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, sectionsLocation, "FindCurrentSection").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
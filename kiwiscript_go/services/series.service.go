@@ -19,10 +19,14 @@ package services
 
 import (
 	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
 	"github.com/kiwiscript/kiwiscript_go/utils"
 	"log/slog"
+	"strings"
 )
 
 const seriesLocation string = "series"
@@ -34,6 +38,9 @@ type FindSeriesBySlugsOptions struct {
 }
 
 func (s *Services) FindSeriesBySlugs(ctx context.Context, opts FindSeriesBySlugsOptions) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindSeriesBySlugs")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindSeriesBySlugs").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -57,6 +64,9 @@ func (s *Services) FindPublishedSeriesBySlugs(
 	ctx context.Context,
 	opts FindSeriesBySlugsOptions,
 ) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPublishedSeriesBySlugs")
+	defer span.End()
+
 	log := s.
 		log.
 		WithGroup("service.series.FindSeriesBySlug").
@@ -79,6 +89,9 @@ func (s *Services) FindPublishedSeriesBySlugsWithAuthor(
 	ctx context.Context,
 	opts FindSeriesBySlugsOptions,
 ) (*db.FindPublishedSeriesBySlugsWithAuthorRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPublishedSeriesBySlugsWithAuthor")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindPublishedSeriesBySlugsWithAuthor").With(
 		"languageSlug", opts.LanguageSlug,
 		"seriesSlug", opts.SeriesSlug,
@@ -109,6 +122,9 @@ func (s *Services) FindPublishedSeriesBySlugsWithProgress(
 	ctx context.Context,
 	opts FindSeriesBySlugsWithProgressOptions,
 ) (*db.FindPublishedSeriesBySlugWithAuthorAndProgressRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPublishedSeriesBySlugsWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindPublishedSeriesBySlugsWithProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -142,6 +158,9 @@ type CreateSeriesOptions struct {
 }
 
 func (s *Services) CreateSeries(ctx context.Context, options CreateSeriesOptions) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "CreateSeries")
+	defer span.End()
+
 	log := s.buildLogger(options.RequestID, seriesLocation, "CreateSeries").With(
 		"userId", options.UserID,
 		"languageSlug", options.LanguageSlug,
@@ -176,6 +195,7 @@ func (s *Services) CreateSeries(ctx context.Context, options CreateSeriesOptions
 		return nil, exceptions.FromDBError(err)
 	}
 
+	s.invalidateCatalog(ctx, log, options.RequestID, options.LanguageSlug)
 	return &series, nil
 }
 
@@ -185,6 +205,7 @@ type FindPaginatedSeriesOptions struct {
 	Offset       int32
 	Limit        int32
 	SortBySlug   bool
+	SortByRating bool
 }
 
 func (s *Services) findPublishedCount(
@@ -210,11 +231,15 @@ func (s *Services) FindPaginatedPublishedSeries(
 	ctx context.Context,
 	opts FindPaginatedSeriesOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPaginatedPublishedSeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindPaginatedPublishedSeries").With(
 		"languageSlug", opts.LanguageSlug,
 		"offset", opts.Offset,
 		"limit", opts.Limit,
 		"sortBySlug", opts.SortBySlug,
+		"sortByRating", opts.SortByRating,
 	)
 	log.InfoContext(ctx, "Getting published series...")
 
@@ -228,6 +253,27 @@ func (s *Services) FindPaginatedPublishedSeries(
 		return seriesDTOs, 0, nil
 	}
 
+	if opts.SortByRating {
+		series, err := s.database.FindPaginatedPublishedSeriesWithAuthorSortByRating(
+			ctx,
+			db.FindPaginatedPublishedSeriesWithAuthorSortByRatingParams{
+				LanguageSlug: opts.LanguageSlug,
+				Limit:        opts.Limit,
+				Offset:       opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Error getting series", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		for _, row := range series {
+			seriesDTOs = append(seriesDTOs, *row.ToSeriesModel())
+		}
+
+		return seriesDTOs, count, nil
+	}
+
 	if opts.SortBySlug {
 		series, err := s.database.FindPaginatedPublishedSeriesWithAuthorSortBySlug(
 			ctx,
@@ -276,18 +322,23 @@ type FindPaginatedSeriesWithProgressOptions struct {
 	Offset       int32
 	Limit        int32
 	SortBySlug   bool
+	SortByRating bool
 }
 
 func (s *Services) FindPaginatedPublishedSeriesWithProgress(
 	ctx context.Context,
 	opts FindPaginatedSeriesWithProgressOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPaginatedPublishedSeriesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindPaginatedPublishedSeriesWithProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
 		"offset", opts.Offset,
 		"limit", opts.Limit,
 		"sortBySlug", opts.SortBySlug,
+		"sortByRating", opts.SortByRating,
 	)
 	log.InfoContext(ctx, "Getting published series with progress...")
 
@@ -301,6 +352,28 @@ func (s *Services) FindPaginatedPublishedSeriesWithProgress(
 		return seriesDTOs, 0, nil
 	}
 
+	if opts.SortByRating {
+		series, err := s.database.FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRating(
+			ctx,
+			db.FindPaginatedPublishedSeriesWithAuthorAndProgressSortByRatingParams{
+				UserID:       opts.UserID,
+				LanguageSlug: opts.LanguageSlug,
+				Limit:        opts.Limit,
+				Offset:       opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Error getting series", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		for _, row := range series {
+			seriesDTOs = append(seriesDTOs, *row.ToSeriesModel())
+		}
+
+		return seriesDTOs, count, nil
+	}
+
 	if opts.SortBySlug {
 		series, err := s.database.FindPaginatedPublishedSeriesWithAuthorAndProgressSortBySlug(
 			ctx,
@@ -348,11 +421,15 @@ func (s *Services) FindPaginatedSeries(
 	ctx context.Context,
 	opts FindPaginatedSeriesOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPaginatedSeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindPaginatedSeries").With(
 		"languageSlug", opts.LanguageSlug,
 		"offset", opts.Offset,
 		"limit", opts.Limit,
 		"sortBySlug", opts.SortBySlug,
+		"sortByRating", opts.SortByRating,
 	)
 	log.InfoContext(ctx, "Finding paginated series...")
 
@@ -372,6 +449,24 @@ func (s *Services) FindPaginatedSeries(
 		return seriesDTOs, 0, nil
 	}
 
+	if opts.SortByRating {
+		series, err := s.database.FindPaginatedSeriesWithAuthorSortByRating(ctx, db.FindPaginatedSeriesWithAuthorSortByRatingParams{
+			LanguageSlug: opts.LanguageSlug,
+			Limit:        opts.Limit,
+			Offset:       opts.Offset,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Error getting series", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		for _, row := range series {
+			seriesDTOs = append(seriesDTOs, *row.ToSeriesModel())
+		}
+
+		return seriesDTOs, count, nil
+	}
+
 	if opts.SortBySlug {
 		series, err := s.database.FindPaginatedSeriesWithAuthorSortBySlug(ctx, db.FindPaginatedSeriesWithAuthorSortBySlugParams{
 			LanguageSlug: opts.LanguageSlug,
@@ -414,6 +509,7 @@ type FindFilteredSeriesOptions struct {
 	Offset       int32
 	Limit        int32
 	SortBySlug   bool
+	SortByRating bool
 }
 
 func (s *Services) findFilteredPublishedCount(
@@ -443,12 +539,16 @@ func (s *Services) FindFilteredPublishedSeries(
 	ctx context.Context,
 	opts FindFilteredSeriesOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindFilteredPublishedSeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindFilteredPublishedSeries").With(
 		"search", opts.Search,
 		"languageSlug", opts.LanguageSlug,
 		"offset", opts.Offset,
 		"limit", opts.Limit,
 		"sortBySlug", opts.SortBySlug,
+		"sortByRating", opts.SortByRating,
 	)
 	log.InfoContext(ctx, "Find filtered published series...")
 
@@ -463,6 +563,28 @@ func (s *Services) FindFilteredPublishedSeries(
 		return seriesDTOs, 0, nil
 	}
 
+	if opts.SortByRating {
+		series, err := s.database.FindFilteredPublishedSeriesWithAuthorSortByRating(
+			ctx,
+			db.FindFilteredPublishedSeriesWithAuthorSortByRatingParams{
+				LanguageSlug: opts.LanguageSlug,
+				Title:        dbSearch,
+				Limit:        opts.Limit,
+				Offset:       opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Error getting series", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		for _, row := range series {
+			seriesDTOs = append(seriesDTOs, *row.ToSeriesModel())
+		}
+
+		return seriesDTOs, count, nil
+	}
+
 	if opts.SortBySlug {
 		series, err := s.database.FindFilteredPublishedSeriesWithAuthorSortBySlug(
 			ctx,
@@ -514,12 +636,16 @@ type FindFilteredPublishedSeriesWithProgressOptions struct {
 	Offset       int32
 	Limit        int32
 	SortBySlug   bool
+	SortByRating bool
 }
 
 func (s *Services) FindFilteredPublishedSeriesWithProgress(
 	ctx context.Context,
 	opts FindFilteredPublishedSeriesWithProgressOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindFilteredPublishedSeriesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindFilteredPublishedSeriesWithProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -527,6 +653,7 @@ func (s *Services) FindFilteredPublishedSeriesWithProgress(
 		"offset", opts.Offset,
 		"limit", opts.Limit,
 		"sortBySlug", opts.SortBySlug,
+		"sortByRating", opts.SortByRating,
 	)
 	log.InfoContext(ctx, "Finding filtered published series...")
 
@@ -541,6 +668,29 @@ func (s *Services) FindFilteredPublishedSeriesWithProgress(
 		return seriesDTOs, 0, nil
 	}
 
+	if opts.SortByRating {
+		series, err := s.database.FindFilteredPublishedSeriesWithAuthorAndProgressSortByRating(
+			ctx,
+			db.FindFilteredPublishedSeriesWithAuthorAndProgressSortByRatingParams{
+				UserID:       opts.UserID,
+				LanguageSlug: opts.LanguageSlug,
+				Title:        dbSearch,
+				Limit:        opts.Limit,
+				Offset:       opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Error getting series", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		for _, row := range series {
+			seriesDTOs = append(seriesDTOs, *row.ToSeriesModel())
+		}
+
+		return seriesDTOs, count, nil
+	}
+
 	if opts.SortBySlug {
 		series, err := s.database.FindFilteredPublishedSeriesWithAuthorAndProgressSortBySlug(
 			ctx,
@@ -590,12 +740,16 @@ func (s *Services) FindFilteredSeries(
 	ctx context.Context,
 	opts FindFilteredSeriesOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindFilteredSeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindFilteredSeries").With(
 		"search", opts.Search,
 		"languageSlug", opts.LanguageSlug,
 		"offset", opts.Offset,
 		"limit", opts.Limit,
 		"sortBySlug", opts.SortBySlug,
+		"sortByRating", opts.SortByRating,
 	)
 	log.InfoContext(ctx, "Finding filtered series...")
 
@@ -619,6 +773,28 @@ func (s *Services) FindFilteredSeries(
 		return seriesDTOs, 0, nil
 	}
 
+	if opts.SortByRating {
+		series, err := s.database.FindFilteredSeriesWithAuthorSortByRating(
+			ctx,
+			db.FindFilteredSeriesWithAuthorSortByRatingParams{
+				LanguageSlug: opts.LanguageSlug,
+				Title:        dbSearch,
+				Limit:        opts.Limit,
+				Offset:       opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Error getting series", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		for _, row := range series {
+			seriesDTOs = append(seriesDTOs, *row.ToSeriesModel())
+		}
+
+		return seriesDTOs, count, nil
+	}
+
 	if opts.SortBySlug {
 		series, err := s.database.FindFilteredSeriesWithAuthorSortBySlug(
 			ctx,
@@ -670,6 +846,9 @@ type AssertSeriesOwnershipOptions struct {
 }
 
 func (s *Services) AssertSeriesOwnership(ctx context.Context, opts AssertSeriesOwnershipOptions) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "AssertSeriesOwnership")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "AssertSeriesOwnership").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -704,6 +883,9 @@ type UpdateSeriesOptions struct {
 }
 
 func (s *Services) UpdateSeries(ctx context.Context, opts UpdateSeriesOptions) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "UpdateSeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "UpdateSeries").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -721,8 +903,21 @@ func (s *Services) UpdateSeries(ctx context.Context, opts UpdateSeriesOptions) (
 		return nil, serviceErr
 	}
 
-	var err error
-	*series, err = s.database.UpdateSeries(ctx, db.UpdateSeriesParams{
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	oldSlug := series.Slug
+	*series, err = qrs.UpdateSeries(ctx, db.UpdateSeriesParams{
 		ID:          series.ID,
 		Title:       opts.Title,
 		Slug:        utils.Slugify(opts.Title),
@@ -733,10 +928,123 @@ func (s *Services) UpdateSeries(ctx context.Context, opts UpdateSeriesOptions) (
 		return nil, exceptions.FromDBError(err)
 	}
 
+	// The old slug keeps redirecting to the series
+	if series.Slug != oldSlug {
+		if err = qrs.CreateSeriesSlugHistory(ctx, db.CreateSeriesSlugHistoryParams{
+			SeriesID: series.ID,
+			OldSlug:  oldSlug,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to create series slug history", "error", err)
+			return nil, exceptions.FromDBError(err)
+		}
+	}
+
 	log.InfoContext(ctx, "Series updated")
 	return series, nil
 }
 
+type UpdateSeriesPriceOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	Price        int32
+	Currency     string
+}
+
+// UpdateSeriesPrice turns a series into a paid one, or back into a free one,
+// users already enrolled keep their access either way.
+func (s *Services) UpdateSeriesPrice(ctx context.Context, opts UpdateSeriesPriceOptions) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "UpdateSeriesPrice")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesLocation, "UpdateSeriesPrice").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"price", opts.Price,
+		"currency", opts.Currency,
+	)
+	log.InfoContext(ctx, "Updating series price...")
+
+	series, serviceErr := s.AssertSeriesOwnership(ctx, AssertSeriesOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	updatedSeries, err := s.database.UpdateSeriesPrice(ctx, db.UpdateSeriesPriceParams{
+		Price:    opts.Price,
+		Currency: strings.ToLower(opts.Currency),
+		ID:       series.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update series price", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+	log.InfoContext(ctx, "Series price updated")
+	return &updatedSeries, nil
+}
+
+type UpdateSeriesIsMembersOnlyOptions struct {
+	RequestID     string
+	UserID        int32
+	LanguageSlug  string
+	SeriesSlug    string
+	IsMembersOnly bool
+}
+
+// UpdateSeriesIsMembersOnly restricts the non preview lessons of a series to
+// signed in users, anonymous visitors only get their teasers.
+func (s *Services) UpdateSeriesIsMembersOnly(
+	ctx context.Context,
+	opts UpdateSeriesIsMembersOnlyOptions,
+) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "UpdateSeriesIsMembersOnly")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesLocation, "UpdateSeriesIsMembersOnly").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"isMembersOnly", opts.IsMembersOnly,
+	)
+	log.InfoContext(ctx, "Updating series is members only...")
+
+	series, serviceErr := s.AssertSeriesOwnership(ctx, AssertSeriesOwnershipOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.IsMembersOnly == opts.IsMembersOnly {
+		log.InfoContext(ctx, "Series is members only unchanged")
+		return series, nil
+	}
+
+	updatedSeries, err := s.database.UpdateSeriesIsMembersOnly(ctx, db.UpdateSeriesIsMembersOnlyParams{
+		IsMembersOnly: opts.IsMembersOnly,
+		ID:            series.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update series is members only", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+	log.InfoContext(ctx, "Series is members only updated")
+	return &updatedSeries, nil
+}
+
 type DeleteSeriesOptions struct {
 	RequestID    string
 	UserID       int32
@@ -745,6 +1053,9 @@ type DeleteSeriesOptions struct {
 }
 
 func (s *Services) DeleteSeries(ctx context.Context, opts DeleteSeriesOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, seriesLocation, "DeleteSeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "DeleteSeries").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -775,6 +1086,7 @@ func (s *Services) DeleteSeries(ctx context.Context, opts DeleteSeriesOptions) *
 		return exceptions.FromDBError(err)
 	}
 
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
 	log.InfoContext(ctx, "Series deleted")
 	return nil
 }
@@ -791,6 +1103,9 @@ func (s *Services) UpdateSeriesIsPublished(
 	ctx context.Context,
 	opts UpdateSeriesIsPublishedOptions,
 ) (*db.Series, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "UpdateSeriesIsPublished")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "UpdateSeriesIsPublished").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -820,6 +1135,17 @@ func (s *Services) UpdateSeriesIsPublished(
 		log.WarnContext(ctx, "Series has no sections")
 		return nil, exceptions.NewValidationError("Series must have sections to be published")
 	}
+	if opts.IsPublished && series.EditorialStatus != EditorialStatusApproved {
+		language, serviceErr := s.FindLanguageBySlug(ctx, series.LanguageSlug)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+
+		if language.RequiresEditorialReview {
+			log.WarnContext(ctx, "Series has not been approved", "editorialStatus", series.EditorialStatus)
+			return nil, exceptions.NewValidationError("Series must be approved before being published")
+		}
+	}
 	if series.IsPublished && !opts.IsPublished {
 		progressCount, err := s.database.CountSeriesProgressBySeriesSlug(ctx, series.Slug)
 		if err != nil {
@@ -841,15 +1167,20 @@ func (s *Services) UpdateSeriesIsPublished(
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
-	*series, err = qrs.UpdateSeriesIsPublished(ctx, db.UpdateSeriesIsPublishedParams{
+	// The flag is only flipped from the state that was checked above, a
+	// concurrent publish makes this a stale write
+	*series, err = qrs.ToggleSeriesIsPublished(ctx, db.ToggleSeriesIsPublishedParams{
 		ID:          series.ID,
-		IsPublished: opts.IsPublished,
+		IsPublished: series.IsPublished,
 	})
 	if err != nil {
 		log.ErrorContext(ctx, "Error publishing series", "error", err)
-		serviceErr = exceptions.FromDBError(err)
+		serviceErr = versionedUpdateError(err)
 		return nil, serviceErr
 	}
 
@@ -859,6 +1190,34 @@ func (s *Services) UpdateSeriesIsPublished(
 			serviceErr = exceptions.FromDBError(err)
 			return nil, serviceErr
 		}
+
+		// The first publication is the first version learners get pinned to
+		if _, versionErr := qrs.FindLatestSeriesVersionBySeriesSlug(ctx, series.Slug); versionErr != nil {
+			if versionErr != pgx.ErrNoRows {
+				log.ErrorContext(ctx, "Error finding latest series version", "error", versionErr)
+				serviceErr = exceptions.FromDBError(versionErr)
+				return nil, serviceErr
+			}
+
+			if _, serviceErr = s.createSeriesVersion(ctx, log, qrs, series, opts.UserID); serviceErr != nil {
+				return nil, serviceErr
+			}
+		}
+
+		serviceErr = s.enqueueWebhookEvent(ctx, qrs, enqueueWebhookEventOptions{
+			RequestID: opts.RequestID,
+			Event:     webhooks.EventSeriesPublished,
+			Data: webhookSeriesPublishedData{
+				SeriesID:     series.ID,
+				Title:        series.Title,
+				Slug:         series.Slug,
+				LanguageSlug: series.LanguageSlug,
+				AuthorID:     series.AuthorID,
+			},
+		})
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
 	} else {
 		if err := qrs.DecrementLanguageSeriesCount(ctx, opts.LanguageSlug); err != nil {
 			log.ErrorContext(ctx, "Error decrementing language series count", "error", err)
@@ -883,6 +1242,9 @@ func (s *Services) FindPaginatedViewedSeriesWithProgress(
 	ctx context.Context,
 	opts FindPaginatedViewedSeriesWithProgressOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPaginatedViewedSeriesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindPaginatedViewedSeriesWithProgress").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -927,6 +1289,112 @@ func (s *Services) FindPaginatedViewedSeriesWithProgress(
 	return seriesModels, count, nil
 }
 
+type FindViewedSeriesWithProgressByCursorOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	Cursor       *utils.Cursor
+	Limit        int32
+	WithCount    bool
+}
+
+func (s *Services) FindViewedSeriesWithProgressByCursor(
+	ctx context.Context,
+	opts FindViewedSeriesWithProgressByCursorOptions,
+) (CursorPage[db.SeriesModel], *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindViewedSeriesWithProgressByCursor")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesLocation, "FindViewedSeriesWithProgressByCursor").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding viewed series with progress by cursor...")
+
+	var count *int64
+	if opts.WithCount {
+		total, err := s.database.CountPublishedSeriesWithInnerProgress(ctx, db.CountPublishedSeriesWithInnerProgressParams{
+			UserID:       opts.UserID,
+			LanguageSlug: opts.LanguageSlug,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count viewed series", "error", err)
+			return CursorPage[db.SeriesModel]{}, exceptions.FromDBError(err)
+		}
+		count = &total
+	}
+
+	if opts.Cursor != nil && opts.Cursor.Backward {
+		series, err := s.database.FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursor(
+			ctx,
+			db.FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorParams{
+				UserID:       opts.UserID,
+				LanguageSlug: opts.LanguageSlug,
+				ViewedAt:     pgtype.Timestamp{Time: opts.Cursor.GetTime(), Valid: true},
+				ID:           opts.Cursor.ID,
+				Limit:        opts.Limit + 1,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find viewed series by cursor", "error", err)
+			return CursorPage[db.SeriesModel]{}, exceptions.FromDBError(err)
+		}
+
+		page := newCursorPage(
+			series,
+			opts.Limit,
+			opts.Cursor,
+			func(ss *db.FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorRow, backward bool) *utils.Cursor {
+				return utils.NewTimeCursor(ss.SeriesProgressViewedAt.Time, ss.SeriesProgressID, backward)
+			},
+			func(ss *db.FindPublishedSeriesWithAuthorAndInnerProgressBeforeCursorRow) *db.SeriesModel {
+				return ss.ToSeriesModel()
+			},
+		)
+		page.Count = count
+		return page, nil
+	}
+
+	// The list is sorted by most recently viewed, so the first page starts
+	// below infinity instead of the zero value.
+	viewedAt := pgtype.Timestamp{InfinityModifier: pgtype.Infinity, Valid: true}
+	var id int32
+	if opts.Cursor != nil {
+		viewedAt = pgtype.Timestamp{Time: opts.Cursor.GetTime(), Valid: true}
+		id = opts.Cursor.ID
+	}
+
+	series, err := s.database.FindPublishedSeriesWithAuthorAndInnerProgressAfterCursor(
+		ctx,
+		db.FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorParams{
+			UserID:       opts.UserID,
+			LanguageSlug: opts.LanguageSlug,
+			ViewedAt:     viewedAt,
+			ID:           id,
+			Limit:        opts.Limit + 1,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find viewed series by cursor", "error", err)
+		return CursorPage[db.SeriesModel]{}, exceptions.FromDBError(err)
+	}
+
+	page := newCursorPage(
+		series,
+		opts.Limit,
+		opts.Cursor,
+		func(ss *db.FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorRow, backward bool) *utils.Cursor {
+			return utils.NewTimeCursor(ss.SeriesProgressViewedAt.Time, ss.SeriesProgressID, backward)
+		},
+		func(ss *db.FindPublishedSeriesWithAuthorAndInnerProgressAfterCursorRow) *db.SeriesModel {
+			return ss.ToSeriesModel()
+		},
+	)
+	page.Count = count
+	return page, nil
+}
+
 func (s *Services) findAllPublishedSeriesCount(ctx context.Context, log *slog.Logger) (int64, *exceptions.ServiceError) {
 	count, err := s.database.CountAllPublishedSeries(ctx)
 	if err != nil {
@@ -961,6 +1429,9 @@ func (s *Services) FindPaginatedDiscoverySeries(
 	ctx context.Context,
 	opts FindPaginatedDiscoverySeriesOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPaginatedDiscoverySeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindPaginatedDiscoverySeries").With(
 		"offset", opts.Offset,
 		"limit", opts.Limit,
@@ -1008,6 +1479,9 @@ func (s *Services) FindFilteredDiscoverySeries(
 	ctx context.Context,
 	opts FindFilteredDiscoverySeriesOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindFilteredDiscoverySeries")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesLocation, "FindFilteredDiscoverySeries").With(
 		"search", opts.Search,
 		"offset", opts.Offset,
@@ -1058,6 +1532,9 @@ func (s *Services) FindPaginatedDiscoverySeriesWithProgress(
 	ctx context.Context,
 	opts FindPaginatedDiscoverySeriesWithProgressOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindPaginatedDiscoverySeriesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(
 		opts.RequestID,
 		seriesLocation,
@@ -1108,6 +1585,9 @@ func (s *Services) FindFilteredDiscoverySeriesWithProgress(
 	ctx context.Context,
 	opts FindFilteredDiscoverySeriesWithProgressOptions,
 ) ([]db.SeriesModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesLocation, "FindFilteredDiscoverySeriesWithProgress")
+	defer span.End()
+
 	log := s.buildLogger(
 		opts.RequestID,
 		seriesLocation,
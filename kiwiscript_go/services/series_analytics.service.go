@@ -0,0 +1,473 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const seriesAnalyticsLocation string = "series_analytics"
+
+const (
+	AnalyticsIntervalDay   string = "day"
+	AnalyticsIntervalWeek  string = "week"
+	AnalyticsIntervalMonth string = "month"
+
+	defaultAnalyticsRangeDays int = 30
+	maxAnalyticsRangeDays     int = 366
+	analyticsHighlightsLimit  int = 5
+
+	seriesAnalyticsRefreshName string = "series_analytics"
+)
+
+// seriesAnalyticsLockKey identifies the postgres advisory lock that makes sure
+// a single app instance refreshes the analytics views at a time.
+const seriesAnalyticsLockKey int64 = 4_105_119_242
+
+type AnalyticsRange struct {
+	From time.Time
+	To   time.Time
+}
+
+func analyticsDate(t time.Time) pgtype.Date {
+	return pgtype.Date{Time: t, Valid: true}
+}
+
+// newAnalyticsRange defaults to the last thirty days up to today, both ends
+// are inclusive and truncated to UTC dates.
+func newAnalyticsRange(from, to time.Time) (AnalyticsRange, *exceptions.ServiceError) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	to = to.UTC().Truncate(24 * time.Hour)
+	if from.IsZero() {
+		from = to.AddDate(0, 0, 1-defaultAnalyticsRangeDays)
+	}
+	from = from.UTC().Truncate(24 * time.Hour)
+
+	if from.After(to) {
+		return AnalyticsRange{}, exceptions.NewValidationError("From date must not be after the to date")
+	}
+	if to.Sub(from) >= time.Duration(maxAnalyticsRangeDays)*24*time.Hour {
+		return AnalyticsRange{}, exceptions.NewValidationError("Date range must not exceed 366 days")
+	}
+
+	return AnalyticsRange{From: from, To: to}, nil
+}
+
+func analyticsBucketStart(day time.Time, interval string) time.Time {
+	switch interval {
+	case AnalyticsIntervalWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case AnalyticsIntervalMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func nextAnalyticsBucket(start time.Time, interval string) time.Time {
+	switch interval {
+	case AnalyticsIntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case AnalyticsIntervalMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+func (s *Services) assertSeriesAnalyticsAccess(
+	ctx context.Context,
+	requestID string,
+	userID int32,
+	languageSlug,
+	seriesSlug string,
+) *exceptions.ServiceError {
+	_, serviceErr := s.AssertSeriesOwnership(ctx, AssertSeriesOwnershipOptions{
+		RequestID:    requestID,
+		UserID:       userID,
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+	})
+	return serviceErr
+}
+
+func (s *Services) findLessonFunnelStats(
+	ctx context.Context,
+	languageSlug,
+	seriesSlug string,
+	dateRange AnalyticsRange,
+) ([]db.LessonFunnelStatsModel, error) {
+	rows, err := s.database.FindLessonFunnelStatsBySlugs(ctx, db.FindLessonFunnelStatsBySlugsParams{
+		LanguageSlug: languageSlug,
+		SeriesSlug:   seriesSlug,
+		FromDay:      analyticsDate(dateRange.From),
+		ToDay:        analyticsDate(dateRange.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lessons := make([]db.LessonFunnelStatsModel, 0, len(rows))
+	for _, row := range rows {
+		lessons = append(lessons, row.ToLessonFunnelStatsModel())
+	}
+
+	return lessons, nil
+}
+
+type FindSeriesAnalyticsOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	From         time.Time
+	To           time.Time
+}
+
+// FindSeriesAnalytics sums the materialized daily stats of the range, only the
+// median time to complete is computed from the progress table itself.
+func (s *Services) FindSeriesAnalytics(
+	ctx context.Context,
+	opts FindSeriesAnalyticsOptions,
+) (*db.SeriesAnalyticsModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesAnalyticsLocation, "FindSeriesAnalytics")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesAnalyticsLocation, "FindSeriesAnalytics").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding series analytics...")
+
+	dateRange, serviceErr := newAnalyticsRange(opts.From, opts.To)
+	if serviceErr != nil {
+		log.WarnContext(ctx, "Invalid analytics range", "from", opts.From, "to", opts.To)
+		return nil, serviceErr
+	}
+	if serviceErr := s.assertSeriesAnalyticsAccess(
+		ctx,
+		opts.RequestID,
+		opts.UserID,
+		opts.LanguageSlug,
+		opts.SeriesSlug,
+	); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	totals, err := s.database.SumSeriesDailyStatsBySlugs(ctx, db.SumSeriesDailyStatsBySlugsParams{
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		FromDay:      analyticsDate(dateRange.From),
+		ToDay:        analyticsDate(dateRange.To),
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to sum series daily stats", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	medianSeconds, err := s.database.FindSeriesMedianCompletionSeconds(
+		ctx,
+		db.FindSeriesMedianCompletionSecondsParams{
+			LanguageSlug: opts.LanguageSlug,
+			SeriesSlug:   opts.SeriesSlug,
+			FromDay:      analyticsDate(dateRange.From),
+			ToDay:        analyticsDate(dateRange.To),
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find median completion time", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	lessons, err := s.findLessonFunnelStats(ctx, opts.LanguageSlug, opts.SeriesSlug, dateRange)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson funnel stats", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	topViewed := make([]db.LessonFunnelStatsModel, 0, len(lessons))
+	dropOffs := make([]db.LessonFunnelStatsModel, 0, len(lessons))
+	for _, lesson := range lessons {
+		if lesson.Learners > 0 || lesson.Opens > 0 {
+			topViewed = append(topViewed, lesson)
+		}
+		if lesson.DropOffs() > 0 {
+			dropOffs = append(dropOffs, lesson)
+		}
+	}
+	sort.SliceStable(topViewed, func(i, j int) bool {
+		if topViewed[i].Opens != topViewed[j].Opens {
+			return topViewed[i].Opens > topViewed[j].Opens
+		}
+		return topViewed[i].Learners > topViewed[j].Learners
+	})
+	sort.SliceStable(dropOffs, func(i, j int) bool {
+		return dropOffs[i].DropOffs() > dropOffs[j].DropOffs()
+	})
+
+	var refreshedAt time.Time
+	refresh, err := s.database.FindAnalyticsRefreshByName(ctx, seriesAnalyticsRefreshName)
+	if err == nil {
+		refreshedAt = refresh.RefreshedAt.Time
+	} else if serviceErr := exceptions.FromDBError(err); serviceErr.Code != exceptions.CodeNotFound {
+		log.ErrorContext(ctx, "Failed to find analytics refresh", "error", err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Found series analytics")
+	return &db.SeriesAnalyticsModel{
+		From:                    dateRange.From,
+		To:                      dateRange.To,
+		Learners:                totals.Learners,
+		Enrollments:             totals.Enrollments,
+		Completions:             totals.Completions,
+		Certificates:            totals.Certificates,
+		MedianCompletionSeconds: medianSeconds,
+		TopViewedLessons:        topViewed[:min(len(topViewed), analyticsHighlightsLimit)],
+		DropOffLessons:          dropOffs[:min(len(dropOffs), analyticsHighlightsLimit)],
+		RefreshedAt:             refreshedAt,
+	}, nil
+}
+
+type FindSeriesAnalyticsTimelineOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	From         time.Time
+	To           time.Time
+	Interval     string
+}
+
+// FindSeriesAnalyticsTimeline groups the daily stats in day, week or month
+// buckets, buckets without activity are returned with zeroed counters.
+func (s *Services) FindSeriesAnalyticsTimeline(
+	ctx context.Context,
+	opts FindSeriesAnalyticsTimelineOptions,
+) (*db.SeriesAnalyticsTimelineModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesAnalyticsLocation, "FindSeriesAnalyticsTimeline")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesAnalyticsLocation, "FindSeriesAnalyticsTimeline").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"interval", opts.Interval,
+	)
+	log.InfoContext(ctx, "Finding series analytics timeline...")
+
+	dateRange, serviceErr := newAnalyticsRange(opts.From, opts.To)
+	if serviceErr != nil {
+		log.WarnContext(ctx, "Invalid analytics range", "from", opts.From, "to", opts.To)
+		return nil, serviceErr
+	}
+	if serviceErr := s.assertSeriesAnalyticsAccess(
+		ctx,
+		opts.RequestID,
+		opts.UserID,
+		opts.LanguageSlug,
+		opts.SeriesSlug,
+	); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	stats, err := s.database.FindSeriesDailyStatsBySlugs(ctx, db.FindSeriesDailyStatsBySlugsParams{
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		FromDay:      analyticsDate(dateRange.From),
+		ToDay:        analyticsDate(dateRange.To),
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series daily stats", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	buckets := make([]db.SeriesAnalyticsBucketModel, 0)
+	indexes := make(map[time.Time]int)
+	for start := analyticsBucketStart(dateRange.From, opts.Interval); !start.After(dateRange.To); {
+		indexes[start] = len(buckets)
+		buckets = append(buckets, db.SeriesAnalyticsBucketModel{Start: start})
+		start = nextAnalyticsBucket(start, opts.Interval)
+	}
+	for _, stat := range stats {
+		bucket := &buckets[indexes[analyticsBucketStart(stat.Day.Time.UTC(), opts.Interval)]]
+		bucket.Learners += stat.Learners
+		bucket.Enrollments += stat.Enrollments
+		bucket.Completions += stat.Completions
+		bucket.Certificates += stat.Certificates
+	}
+
+	log.InfoContext(ctx, "Found series analytics timeline", "buckets", len(buckets))
+	return &db.SeriesAnalyticsTimelineModel{
+		From:     dateRange.From,
+		To:       dateRange.To,
+		Interval: opts.Interval,
+		Buckets:  buckets,
+	}, nil
+}
+
+type FindSeriesAnalyticsFunnelOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	From         time.Time
+	To           time.Time
+}
+
+// FindSeriesAnalyticsFunnel returns the published sections in order, each
+// with its published lessons, and how many learners started and completed them.
+func (s *Services) FindSeriesAnalyticsFunnel(
+	ctx context.Context,
+	opts FindSeriesAnalyticsFunnelOptions,
+) (*db.SeriesAnalyticsFunnelModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesAnalyticsLocation, "FindSeriesAnalyticsFunnel")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesAnalyticsLocation, "FindSeriesAnalyticsFunnel").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding series analytics funnel...")
+
+	dateRange, serviceErr := newAnalyticsRange(opts.From, opts.To)
+	if serviceErr != nil {
+		log.WarnContext(ctx, "Invalid analytics range", "from", opts.From, "to", opts.To)
+		return nil, serviceErr
+	}
+	if serviceErr := s.assertSeriesAnalyticsAccess(
+		ctx,
+		opts.RequestID,
+		opts.UserID,
+		opts.LanguageSlug,
+		opts.SeriesSlug,
+	); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	sectionRows, err := s.database.FindSectionFunnelStatsBySlugs(ctx, db.FindSectionFunnelStatsBySlugsParams{
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		FromDay:      analyticsDate(dateRange.From),
+		ToDay:        analyticsDate(dateRange.To),
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find section funnel stats", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	lessons, err := s.findLessonFunnelStats(ctx, opts.LanguageSlug, opts.SeriesSlug, dateRange)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find lesson funnel stats", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	sections := make([]db.SectionFunnelStatsModel, 0, len(sectionRows))
+	indexes := make(map[int32]int, len(sectionRows))
+	for _, row := range sectionRows {
+		indexes[row.ID] = len(sections)
+		sections = append(sections, db.SectionFunnelStatsModel{
+			ID:          row.ID,
+			Title:       row.Title,
+			Position:    row.Position,
+			Learners:    row.Learners,
+			Completions: row.Completions,
+			Lessons:     make([]db.LessonFunnelStatsModel, 0),
+		})
+	}
+	for _, lesson := range lessons {
+		if i, ok := indexes[lesson.SectionID]; ok {
+			sections[i].Lessons = append(sections[i].Lessons, lesson)
+		}
+	}
+
+	log.InfoContext(ctx, "Found series analytics funnel", "sections", len(sections))
+	return &db.SeriesAnalyticsFunnelModel{
+		From:     dateRange.From,
+		To:       dateRange.To,
+		Sections: sections,
+	}, nil
+}
+
+// RefreshSeriesAnalytics recomputes the materialized daily stats, the views
+// are refreshed concurrently so the dashboards keep being served meanwhile.
+func (s *Services) RefreshSeriesAnalytics(ctx context.Context, requestID string) {
+	ctx, span := s.startSpan(ctx, seriesAnalyticsLocation, "RefreshSeriesAnalytics")
+	defer span.End()
+
+	log := s.buildLogger(requestID, seriesAnalyticsLocation, "RefreshSeriesAnalytics")
+	log.DebugContext(ctx, "Refreshing series analytics...")
+
+	acquired, err := s.database.WithAdvisoryLock(ctx, seriesAnalyticsLockKey, func(ctx context.Context) {
+		refreshes := []struct {
+			name string
+			fn   func(context.Context) error
+		}{
+			{"series_daily_stats", s.database.RefreshSeriesDailyStats},
+			{"section_daily_stats", s.database.RefreshSectionDailyStats},
+			{"lesson_daily_stats", s.database.RefreshLessonDailyStats},
+		}
+		for _, refresh := range refreshes {
+			if err := refresh.fn(ctx); err != nil {
+				log.ErrorContext(ctx, "Failed to refresh materialized view", "view", refresh.name, "error", err)
+				return
+			}
+		}
+
+		if err := s.database.UpsertAnalyticsRefresh(ctx, seriesAnalyticsRefreshName); err != nil {
+			log.ErrorContext(ctx, "Failed to record analytics refresh", "error", err)
+			return
+		}
+
+		log.InfoContext(ctx, "Series analytics refreshed")
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to take the series analytics lock", "error", err)
+		return
+	}
+	if !acquired {
+		log.DebugContext(ctx, "Series analytics are being refreshed by another instance")
+	}
+}
+
+// RunSeriesAnalyticsWorker refreshes the series analytics until the context is cancelled.
+func (s *Services) RunSeriesAnalyticsWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RefreshSeriesAnalytics(ctx, uuid.NewString())
+		}
+	}
+}
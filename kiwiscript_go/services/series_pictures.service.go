@@ -36,6 +36,9 @@ func (s *Services) FindSeriesPictureBySeriesID(
 	ctx context.Context,
 	opts FindSeriesPictureBySeriesIDOptions,
 ) (*db.SeriesPicture, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesPicturesLocation, "FindSeriesPictureBySeriesID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesPicturesLocation, "FindSeriesPictureBySeriesID").With(
 		"seriesId", opts.SeriesID,
 	)
@@ -62,6 +65,9 @@ func (s *Services) UploadSeriesPicture(
 	ctx context.Context,
 	opts UploadSeriesPictureOptions,
 ) (*db.SeriesPicture, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesPicturesLocation, "UploadSeriesPicture")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesPicturesLocation, "UploadSeriesPicture").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -114,6 +120,7 @@ func (s *Services) UploadSeriesPicture(
 		return nil, exceptions.FromDBError(err)
 	}
 
+	s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
 	return &seriesPicture, nil
 }
 
@@ -128,6 +135,9 @@ func (s *Services) DeleteSeriesPicture(
 	ctx context.Context,
 	opts DeletePictureOptions,
 ) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, seriesPicturesLocation, "DeleteSeriesPicture")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesPicturesLocation, "DeleteSeriesPicture").With(
 		"userId", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -161,6 +171,9 @@ func (s *Services) DeleteSeriesPicture(
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
 	}()
 
 	if err := qrs.DeleteSeriesPicture(ctx, seriesPicture.ID); err != nil {
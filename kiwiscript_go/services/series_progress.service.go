@@ -19,6 +19,7 @@ package services
 
 import (
 	"context"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
@@ -37,6 +38,9 @@ func (s *Services) FindSeriesProgress(
 	ctx context.Context,
 	opts FindSeriesProgressOptions,
 ) (*db.SeriesProgress, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesProgressLocation, "FindSeriesProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesProgressLocation, "FindSeriesProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -78,11 +82,23 @@ func (s *Services) createSeriesProgress(
 	)
 	log.InfoContext(ctx, "Creating series progress...")
 
+	// New learners are pinned to the latest released version of the series
+	version, serviceErr := s.findLatestSeriesVersion(ctx, log, opts.SeriesSlug)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	var seriesVersionID pgtype.Int4
+	if version != nil {
+		seriesVersionID = pgtype.Int4{Int32: version.ID, Valid: true}
+	}
+
 	seriesProgress, err := s.database.CreateSeriesProgress(ctx, db.CreateSeriesProgressParams{
 		UserID:             opts.UserID,
 		LanguageSlug:       opts.LanguageSlug,
 		SeriesSlug:         opts.SeriesSlug,
 		LanguageProgressID: opts.LanguageProgressID,
+		SeriesVersionID:    seriesVersionID,
 	})
 	if err != nil {
 		log.ErrorContext(ctx, "Error creating series progress", "error", err)
@@ -103,6 +119,9 @@ func (s *Services) CreateOrUpdateSeriesProgress(
 	ctx context.Context,
 	opts CreateOrUpdateSeriesProgressOptions,
 ) (*db.FindPublishedSeriesBySlugsWithAuthorRow, *db.SeriesProgress, bool, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesProgressLocation, "CreateOrUpdateSeriesProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesProgressLocation, "CreateOrUpdateSeriesProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
@@ -166,6 +185,9 @@ type DeleteSeriesProgressOptions struct {
 }
 
 func (s *Services) DeleteSeriesProgress(ctx context.Context, opts DeleteSeriesProgressOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, seriesProgressLocation, "DeleteSeriesProgress")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, seriesProgressLocation, "DeleteSeriesProgress").With(
 		"userID", opts.UserID,
 		"languageSlug", opts.LanguageSlug,
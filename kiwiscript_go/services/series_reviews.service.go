@@ -0,0 +1,639 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const seriesReviewsLocation string = "series_reviews"
+
+type CreateSeriesReviewOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	Rating       int16
+	Review       string
+}
+
+func (s *Services) CreateSeriesReview(
+	ctx context.Context,
+	opts CreateSeriesReviewOptions,
+) (*db.SeriesReviewModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesReviewsLocation, "CreateSeriesReview")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "CreateSeriesReview").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"rating", opts.Rating,
+	)
+	log.InfoContext(ctx, "Creating series review...")
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if _, serviceErr := s.FindSeriesProgress(ctx, FindSeriesProgressOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: series.LanguageSlug,
+		SeriesSlug:   series.Slug,
+	}); serviceErr != nil {
+		if serviceErr.Code == exceptions.CodeNotFound {
+			log.WarnContext(ctx, "User has not started the series")
+			return nil, exceptions.NewForbiddenError()
+		}
+		return nil, serviceErr
+	}
+
+	if _, err := s.database.FindSeriesReviewByUserIDAndSeriesSlug(ctx, db.FindSeriesReviewByUserIDAndSeriesSlugParams{
+		UserID:     opts.UserID,
+		SeriesSlug: series.Slug,
+	}); err == nil {
+		log.WarnContext(ctx, "User has already reviewed the series")
+		return nil, exceptions.NewConflictError("Series already reviewed")
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	review, err := qrs.CreateSeriesReview(ctx, db.CreateSeriesReviewParams{
+		Rating:       opts.Rating,
+		Review:       opts.Review,
+		LanguageSlug: series.LanguageSlug,
+		SeriesSlug:   series.Slug,
+		UserID:       opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create series review", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	if err := qrs.AddSeriesReview(ctx, db.AddSeriesReviewParams{
+		Slug:        series.Slug,
+		RatingTotal: int32(review.Rating),
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to add series review to the rating", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	reviewWithAuthor, err := qrs.FindSeriesReviewByIDWithAuthor(ctx, review.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series review with author", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Series review created successfully")
+	return reviewWithAuthor.ToSeriesReviewModel(), nil
+}
+
+type FindSeriesReviewOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewID     int32
+	IsStaff      bool
+}
+
+func (s *Services) FindSeriesReview(
+	ctx context.Context,
+	opts FindSeriesReviewOptions,
+) (*db.SeriesReviewModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesReviewsLocation, "FindSeriesReview")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "FindSeriesReview").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewId", opts.ReviewID,
+	)
+	log.InfoContext(ctx, "Finding series review...")
+
+	review, err := s.database.FindSeriesReviewByIDWithAuthor(ctx, opts.ReviewID)
+	if err != nil {
+		log.WarnContext(ctx, "Series review not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if review.LanguageSlug != opts.LanguageSlug || review.SeriesSlug != opts.SeriesSlug {
+		log.WarnContext(ctx, "Series review does not belong to the series")
+		return nil, exceptions.NewNotFoundError()
+	}
+	if review.IsHidden && !opts.IsStaff {
+		log.WarnContext(ctx, "Series review is hidden")
+		return nil, exceptions.NewNotFoundError()
+	}
+
+	log.InfoContext(ctx, "Series review found")
+	return review.ToSeriesReviewModel(), nil
+}
+
+type FindPaginatedSeriesReviewsOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	IsStaff      bool
+	Offset       int32
+	Limit        int32
+}
+
+func (s *Services) FindPaginatedSeriesReviews(
+	ctx context.Context,
+	opts FindPaginatedSeriesReviewsOptions,
+) ([]db.SeriesReviewModel, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesReviewsLocation, "FindPaginatedSeriesReviews")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "FindPaginatedSeriesReviews").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"isStaff", opts.IsStaff,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated series reviews...")
+
+	series, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	if opts.IsStaff {
+		count, err := s.database.CountAllSeriesReviewsBySeriesSlug(ctx, series.Slug)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to count series reviews", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+		if count == 0 {
+			log.DebugContext(ctx, "No series reviews found", "count", count)
+			return make([]db.SeriesReviewModel, 0), 0, nil
+		}
+
+		reviews, err := s.database.FindAllPaginatedSeriesReviewsWithAuthor(
+			ctx,
+			db.FindAllPaginatedSeriesReviewsWithAuthorParams{
+				SeriesSlug: series.Slug,
+				Limit:      opts.Limit,
+				Offset:     opts.Offset,
+			},
+		)
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to find paginated series reviews", "error", err)
+			return nil, 0, exceptions.FromDBError(err)
+		}
+
+		reviewModels := make([]db.SeriesReviewModel, 0, len(reviews))
+		for _, r := range reviews {
+			reviewModels = append(reviewModels, *r.ToSeriesReviewModel())
+		}
+
+		return reviewModels, count, nil
+	}
+
+	count, err := s.database.CountSeriesReviewsBySeriesSlug(ctx, series.Slug)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count series reviews", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+	if count == 0 {
+		log.DebugContext(ctx, "No series reviews found", "count", count)
+		return make([]db.SeriesReviewModel, 0), 0, nil
+	}
+
+	reviews, err := s.database.FindPaginatedSeriesReviewsWithAuthor(
+		ctx,
+		db.FindPaginatedSeriesReviewsWithAuthorParams{
+			SeriesSlug: series.Slug,
+			Limit:      opts.Limit,
+			Offset:     opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated series reviews", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	reviewModels := make([]db.SeriesReviewModel, 0, len(reviews))
+	for _, r := range reviews {
+		reviewModels = append(reviewModels, *r.ToSeriesReviewModel())
+	}
+
+	return reviewModels, count, nil
+}
+
+type findSeriesReviewBySlugsOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewID     int32
+}
+
+func (s *Services) findSeriesReviewBySlugs(
+	ctx context.Context,
+	opts findSeriesReviewBySlugsOptions,
+) (*db.SeriesReview, *exceptions.ServiceError) {
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "findSeriesReviewBySlugs").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewId", opts.ReviewID,
+	)
+	log.InfoContext(ctx, "Finding series review by slugs...")
+
+	review, err := s.database.FindSeriesReviewByIDAndSeriesSlug(ctx, db.FindSeriesReviewByIDAndSeriesSlugParams{
+		ID:         opts.ReviewID,
+		SeriesSlug: opts.SeriesSlug,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Series review not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	if review.LanguageSlug != opts.LanguageSlug {
+		log.WarnContext(ctx, "Series review language does not match")
+		return nil, exceptions.NewNotFoundError()
+	}
+
+	return &review, nil
+}
+
+func (s *Services) findSeriesReviewWithAuthor(
+	ctx context.Context,
+	requestID string,
+	reviewID int32,
+) (*db.SeriesReviewModel, *exceptions.ServiceError) {
+	log := s.buildLogger(requestID, seriesReviewsLocation, "findSeriesReviewWithAuthor").With(
+		"reviewId", reviewID,
+	)
+	log.InfoContext(ctx, "Finding series review with author...")
+
+	review, err := s.database.FindSeriesReviewByIDWithAuthor(ctx, reviewID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series review with author", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return review.ToSeriesReviewModel(), nil
+}
+
+type UpdateSeriesReviewOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewID     int32
+	Rating       int16
+	Review       string
+}
+
+func (s *Services) UpdateSeriesReview(
+	ctx context.Context,
+	opts UpdateSeriesReviewOptions,
+) (*db.SeriesReviewModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesReviewsLocation, "UpdateSeriesReview")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "UpdateSeriesReview").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewId", opts.ReviewID,
+		"rating", opts.Rating,
+	)
+	log.InfoContext(ctx, "Updating series review...")
+
+	review, serviceErr := s.findSeriesReviewBySlugs(ctx, findSeriesReviewBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		ReviewID:     opts.ReviewID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if review.UserID != opts.UserID {
+		log.WarnContext(ctx, "User is not the author of the review")
+		return nil, exceptions.NewForbiddenError()
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	var ratingChanged bool
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil && ratingChanged {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	// The rating delta is taken from the locked row so concurrent changes are applied once
+	lockedReview, err := qrs.FindSeriesReviewByIDForUpdate(ctx, review.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to lock series review", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	if _, err := qrs.UpdateSeriesReview(ctx, db.UpdateSeriesReviewParams{
+		ID:     lockedReview.ID,
+		Rating: opts.Rating,
+		Review: opts.Review,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to update series review", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+	if !lockedReview.IsHidden && lockedReview.Rating != opts.Rating {
+		if err := qrs.AddSeriesRatingTotal(ctx, db.AddSeriesRatingTotalParams{
+			Slug:        lockedReview.SeriesSlug,
+			RatingTotal: int32(opts.Rating - lockedReview.Rating),
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to update series rating", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, serviceErr
+		}
+
+		ratingChanged = true
+	}
+
+	reviewWithAuthor, err := qrs.FindSeriesReviewByIDWithAuthor(ctx, lockedReview.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series review with author", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Series review updated successfully")
+	return reviewWithAuthor.ToSeriesReviewModel(), nil
+}
+
+type DeleteSeriesReviewOptions struct {
+	RequestID    string
+	UserID       int32
+	IsStaff      bool
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewID     int32
+}
+
+func (s *Services) DeleteSeriesReview(ctx context.Context, opts DeleteSeriesReviewOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, seriesReviewsLocation, "DeleteSeriesReview")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "DeleteSeriesReview").With(
+		"userId", opts.UserID,
+		"isStaff", opts.IsStaff,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewId", opts.ReviewID,
+	)
+	log.InfoContext(ctx, "Deleting series review...")
+
+	review, serviceErr := s.findSeriesReviewBySlugs(ctx, findSeriesReviewBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		ReviewID:     opts.ReviewID,
+	})
+	if serviceErr != nil {
+		return serviceErr
+	}
+	if review.UserID != opts.UserID && !opts.IsStaff {
+		log.WarnContext(ctx, "User is not allowed to delete the review")
+		return exceptions.NewForbiddenError()
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	var ratingChanged bool
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil && ratingChanged {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	// A concurrent delete holds the lock, once released the review is no longer found
+	lockedReview, err := qrs.FindSeriesReviewByIDForUpdate(ctx, review.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to lock series review", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return serviceErr
+	}
+
+	if err := qrs.DeleteSeriesReviewByID(ctx, lockedReview.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete series review", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return serviceErr
+	}
+	if !lockedReview.IsHidden {
+		if err := qrs.RemoveSeriesReview(ctx, db.RemoveSeriesReviewParams{
+			Slug:        lockedReview.SeriesSlug,
+			RatingTotal: int32(lockedReview.Rating),
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to remove series review from the rating", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return serviceErr
+		}
+
+		ratingChanged = true
+	}
+
+	log.InfoContext(ctx, "Series review deleted successfully")
+	return nil
+}
+
+type ReplySeriesReviewOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewID     int32
+	Reply        string
+}
+
+func (s *Services) ReplySeriesReview(
+	ctx context.Context,
+	opts ReplySeriesReviewOptions,
+) (*db.SeriesReviewModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesReviewsLocation, "ReplySeriesReview")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "ReplySeriesReview").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewId", opts.ReviewID,
+	)
+	log.InfoContext(ctx, "Replying to series review...")
+
+	review, serviceErr := s.findSeriesReviewBySlugs(ctx, findSeriesReviewBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		ReviewID:     opts.ReviewID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if _, err := s.database.UpdateSeriesReviewReply(ctx, db.UpdateSeriesReviewReplyParams{
+		ID:            review.ID,
+		Reply:         opts.Reply,
+		ReplyAuthorID: pgtype.Int4{Int32: opts.UserID, Valid: true},
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to reply to series review", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series review replied successfully")
+	return s.findSeriesReviewWithAuthor(ctx, opts.RequestID, review.ID)
+}
+
+type UpdateSeriesReviewIsHiddenOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	ReviewID     int32
+	IsHidden     bool
+}
+
+// UpdateSeriesReviewIsHidden hides or shows a review, hidden reviews do not count towards the series rating.
+func (s *Services) UpdateSeriesReviewIsHidden(
+	ctx context.Context,
+	opts UpdateSeriesReviewIsHiddenOptions,
+) (*db.SeriesReviewModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesReviewsLocation, "UpdateSeriesReviewIsHidden")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesReviewsLocation, "UpdateSeriesReviewIsHidden").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"reviewId", opts.ReviewID,
+		"isHidden", opts.IsHidden,
+	)
+	log.InfoContext(ctx, "Updating series review is hidden...")
+
+	review, serviceErr := s.findSeriesReviewBySlugs(ctx, findSeriesReviewBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+		ReviewID:     opts.ReviewID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	var ratingChanged bool
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil && ratingChanged {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	// Concurrent toggles are serialized on the row so the rating is only adjusted once
+	lockedReview, err := qrs.FindSeriesReviewByIDForUpdate(ctx, review.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to lock series review", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	if lockedReview.IsHidden != opts.IsHidden {
+		if _, err := qrs.UpdateSeriesReviewIsHidden(ctx, db.UpdateSeriesReviewIsHiddenParams{
+			ID:       lockedReview.ID,
+			IsHidden: opts.IsHidden,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to update series review is hidden", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, serviceErr
+		}
+
+		if opts.IsHidden {
+			err = qrs.RemoveSeriesReview(ctx, db.RemoveSeriesReviewParams{
+				Slug:        lockedReview.SeriesSlug,
+				RatingTotal: int32(lockedReview.Rating),
+			})
+		} else {
+			err = qrs.AddSeriesReview(ctx, db.AddSeriesReviewParams{
+				Slug:        lockedReview.SeriesSlug,
+				RatingTotal: int32(lockedReview.Rating),
+			})
+		}
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to update series rating", "error", err)
+			serviceErr = exceptions.FromDBError(err)
+			return nil, serviceErr
+		}
+
+		ratingChanged = true
+	} else {
+		log.InfoContext(ctx, "Series review is hidden already set")
+	}
+
+	reviewWithAuthor, err := qrs.FindSeriesReviewByIDWithAuthor(ctx, lockedReview.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series review with author", "error", err)
+		serviceErr = exceptions.FromDBError(err)
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Series review is hidden updated successfully")
+	return reviewWithAuthor.ToSeriesReviewModel(), nil
+}
@@ -0,0 +1,432 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const seriesVersionsLocation string = "series_versions"
+
+// createSeriesVersion snapshots the published sections and lessons of the
+// series as its next version.
+func (s *Services) createSeriesVersion(
+	ctx context.Context,
+	log *slog.Logger,
+	qrs *db.Queries,
+	series *db.Series,
+	authorID int32,
+) (*db.SeriesVersion, *exceptions.ServiceError) {
+	version, err := qrs.CreateSeriesVersion(ctx, db.CreateSeriesVersionParams{
+		AuthorID: authorID,
+		SeriesID: series.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create series version", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	if err := qrs.CreateSeriesVersionLessons(ctx, db.CreateSeriesVersionLessonsParams{
+		SeriesVersionID: version.ID,
+		SeriesSlug:      series.Slug,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to create series version lessons", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series version created", "version", version.Version)
+	return &version, nil
+}
+
+func (s *Services) findLatestSeriesVersion(
+	ctx context.Context,
+	log *slog.Logger,
+	seriesSlug string,
+) (*db.SeriesVersion, *exceptions.ServiceError) {
+	version, err := s.database.FindLatestSeriesVersionBySeriesSlug(ctx, seriesSlug)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			log.DebugContext(ctx, "Series has no versions")
+			return nil, nil
+		}
+
+		log.ErrorContext(ctx, "Failed to find latest series version", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &version, nil
+}
+
+// findPinnedSeriesVersion returns the version the series progress is pinned
+// to, or nil when the progress follows the live series.
+func (s *Services) findPinnedSeriesVersion(
+	ctx context.Context,
+	log *slog.Logger,
+	seriesProgress *db.SeriesProgress,
+) (*db.SeriesVersion, *exceptions.ServiceError) {
+	if !seriesProgress.SeriesVersionID.Valid {
+		return nil, nil
+	}
+
+	version, err := s.database.FindSeriesVersionByID(ctx, seriesProgress.SeriesVersionID.Int32)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find pinned series version", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &version, nil
+}
+
+type PublishSeriesVersionOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+func (s *Services) PublishSeriesVersion(
+	ctx context.Context,
+	opts PublishSeriesVersionOptions,
+) (*db.SeriesVersion, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesVersionsLocation, "PublishSeriesVersion")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesVersionsLocation, "PublishSeriesVersion").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Publishing series version...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if series.AuthorID != opts.UserID {
+		log.WarnContext(ctx, "User is not the author of the series")
+		return nil, exceptions.NewForbiddenError()
+	}
+	if !series.IsPublished {
+		log.WarnContext(ctx, "Series is not published")
+		return nil, exceptions.NewValidationError("Series must be published to release a new version")
+	}
+
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+		if serviceErr == nil && err == nil {
+			s.invalidateCatalog(ctx, log, opts.RequestID, opts.LanguageSlug)
+		}
+	}()
+
+	version, serviceErr := s.createSeriesVersion(ctx, log, qrs, series, opts.UserID)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	return version, nil
+}
+
+type FindPaginatedSeriesVersionsOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+	Offset       int32
+	Limit        int32
+}
+
+func (s *Services) FindPaginatedSeriesVersions(
+	ctx context.Context,
+	opts FindPaginatedSeriesVersionsOptions,
+) ([]db.SeriesVersion, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesVersionsLocation, "FindPaginatedSeriesVersions")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesVersionsLocation, "FindPaginatedSeriesVersions").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated series versions...")
+
+	series, serviceErr := s.FindSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	count, err := s.database.CountSeriesVersionsBySeriesSlug(ctx, series.Slug)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count series versions", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	if count == 0 {
+		log.DebugContext(ctx, "No series versions found", "count", count)
+		return make([]db.SeriesVersion, 0), 0, nil
+	}
+
+	versions, err := s.database.FindPaginatedSeriesVersionsBySeriesSlug(
+		ctx,
+		db.FindPaginatedSeriesVersionsBySeriesSlugParams{
+			SeriesSlug: series.Slug,
+			Offset:     opts.Offset,
+			Limit:      opts.Limit,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find series versions", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Series versions found", "count", count)
+	return versions, count, nil
+}
+
+type FindSeriesProgressVersionOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+func (s *Services) FindSeriesProgressVersion(
+	ctx context.Context,
+	opts FindSeriesProgressVersionOptions,
+) (*db.SeriesProgressVersionModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesVersionsLocation, "FindSeriesProgressVersion")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesVersionsLocation, "FindSeriesProgressVersion").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding series progress version...")
+
+	seriesProgress, serviceErr := s.FindSeriesProgress(ctx, FindSeriesProgressOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	pinned, serviceErr := s.findPinnedSeriesVersion(ctx, log, seriesProgress)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	latest, serviceErr := s.findLatestSeriesVersion(ctx, log, seriesProgress.SeriesSlug)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Series progress version found")
+	return seriesProgress.ToSeriesProgressVersionModel(pinned, latest), nil
+}
+
+type MigrateSeriesProgressVersionOptions struct {
+	RequestID    string
+	UserID       int32
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+// MigrateSeriesProgressVersion moves a learner to the latest version of the
+// series. Progress follows the lessons: lessons dropped from the version lose
+// their progress, lessons moved to another section take it with them and the
+// section and series counters are recomputed against the new version.
+func (s *Services) MigrateSeriesProgressVersion(
+	ctx context.Context,
+	opts MigrateSeriesProgressVersionOptions,
+) (*db.SeriesProgressVersionModel, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, seriesVersionsLocation, "MigrateSeriesProgressVersion")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, seriesVersionsLocation, "MigrateSeriesProgressVersion").With(
+		"userId", opts.UserID,
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Migrating series progress version...")
+
+	if _, serviceErr := s.FindPublishedSeriesBySlugs(ctx, FindSeriesBySlugsOptions{
+		RequestID:    opts.RequestID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	}); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	seriesProgress, serviceErr := s.FindSeriesProgress(ctx, FindSeriesProgressOptions{
+		RequestID:    opts.RequestID,
+		UserID:       opts.UserID,
+		LanguageSlug: opts.LanguageSlug,
+		SeriesSlug:   opts.SeriesSlug,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	latest, serviceErr := s.findLatestSeriesVersion(ctx, log, seriesProgress.SeriesSlug)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if latest == nil {
+		log.WarnContext(ctx, "Series has no versions to migrate to")
+		return nil, exceptions.NewNotFoundError()
+	}
+	if seriesProgress.SeriesVersionID.Valid && seriesProgress.SeriesVersionID.Int32 == latest.ID {
+		log.InfoContext(ctx, "Series progress already on the latest version")
+		return nil, exceptions.NewConflictError("Series progress is already on the latest version")
+	}
+
+	if serviceErr := s.migrateSeriesProgress(ctx, log, seriesProgress, latest); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	log.InfoContext(ctx, "Series progress migrated", "version", latest.Version)
+	return seriesProgress.ToSeriesProgressVersionModel(latest, latest), nil
+}
+
+func (s *Services) migrateSeriesProgress(
+	ctx context.Context,
+	log *slog.Logger,
+	seriesProgress *db.SeriesProgress,
+	version *db.SeriesVersion,
+) (serviceErr *exceptions.ServiceError) {
+	qrs, txn, err := s.database.BeginTx(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	defer func() {
+		log.DebugContext(ctx, "Finalizing transaction")
+		s.database.FinalizeTx(ctx, txn, err, serviceErr)
+	}()
+
+	if err = qrs.DeleteLessonProgressNotInSeriesVersion(ctx, db.DeleteLessonProgressNotInSeriesVersionParams{
+		SeriesProgressID: seriesProgress.ID,
+		SeriesVersionID:  version.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to delete lesson progress outside of the version", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	movedLessons, err := qrs.FindLessonProgressMovedInSeriesVersion(ctx, db.FindLessonProgressMovedInSeriesVersionParams{
+		SeriesVersionID:  version.ID,
+		SeriesProgressID: seriesProgress.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find moved lesson progress", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	sectionProgressIDs := make(map[int32]int32)
+	for _, moved := range movedLessons {
+		sectionProgressID, ok := sectionProgressIDs[moved.SectionID]
+		if !ok {
+			var sectionProgress db.SectionProgress
+			sectionProgress, err = qrs.FindSectionProgressBySlugsAndUserID(
+				ctx,
+				db.FindSectionProgressBySlugsAndUserIDParams{
+					LanguageSlug: seriesProgress.LanguageSlug,
+					SeriesSlug:   seriesProgress.SeriesSlug,
+					SectionID:    moved.SectionID,
+					UserID:       seriesProgress.UserID,
+				},
+			)
+			if err == pgx.ErrNoRows {
+				sectionProgress, err = qrs.CreateSectionProgress(ctx, db.CreateSectionProgressParams{
+					LanguageSlug:       seriesProgress.LanguageSlug,
+					SeriesSlug:         seriesProgress.SeriesSlug,
+					SectionID:          moved.SectionID,
+					LanguageProgressID: seriesProgress.LanguageProgressID,
+					SeriesProgressID:   seriesProgress.ID,
+					UserID:             seriesProgress.UserID,
+				})
+			}
+			if err != nil {
+				log.ErrorContext(ctx, "Failed to find or create section progress", "error", err)
+				return exceptions.FromDBError(err)
+			}
+
+			sectionProgressID = sectionProgress.ID
+			sectionProgressIDs[moved.SectionID] = sectionProgressID
+		}
+
+		if err = qrs.UpdateLessonProgressSection(ctx, db.UpdateLessonProgressSectionParams{
+			SectionID:         moved.SectionID,
+			SectionProgressID: sectionProgressID,
+			ID:                moved.ID,
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to move lesson progress", "error", err)
+			return exceptions.FromDBError(err)
+		}
+	}
+
+	if err = qrs.DeleteSectionProgressNotInSeriesVersion(ctx, db.DeleteSectionProgressNotInSeriesVersionParams{
+		SeriesProgressID: seriesProgress.ID,
+		SeriesVersionID:  version.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to delete section progress outside of the version", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	if err = qrs.RecountSectionProgressInSeriesVersion(ctx, db.RecountSectionProgressInSeriesVersionParams{
+		SeriesProgressID: seriesProgress.ID,
+		SeriesVersionID:  version.ID,
+	}); err != nil {
+		log.ErrorContext(ctx, "Failed to recount section progress", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	*seriesProgress, err = qrs.UpdateSeriesProgressSeriesVersion(ctx, db.UpdateSeriesProgressSeriesVersionParams{
+		SeriesVersionID: pgtype.Int4{Int32: version.ID, Valid: true},
+		SectionsCount:   version.SectionsCount,
+		ID:              seriesProgress.ID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update series progress version", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	return nil
+}
@@ -19,13 +19,16 @@ package services
 
 import (
 	"log/slog"
+	"sync/atomic"
 
 	cc "github.com/kiwiscript/kiwiscript_go/providers/cache"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
 	"github.com/kiwiscript/kiwiscript_go/providers/email"
 	"github.com/kiwiscript/kiwiscript_go/providers/oauth"
 	objstg "github.com/kiwiscript/kiwiscript_go/providers/object_storage"
+	"github.com/kiwiscript/kiwiscript_go/providers/payments"
 	"github.com/kiwiscript/kiwiscript_go/providers/tokens"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
 )
 
 type Services struct {
@@ -36,6 +39,9 @@ type Services struct {
 	jwt            *tokens.Tokens
 	objStg         *objstg.ObjectStorage
 	oauthProviders *oauth.Providers
+	webhooks       *webhooks.Webhooks
+	payments       payments.Provider
+	shuttingDown   atomic.Bool
 }
 
 func NewServices(
@@ -46,6 +52,8 @@ func NewServices(
 	mail *email.Mail,
 	jwt *tokens.Tokens,
 	oauthProv *oauth.Providers,
+	webhooksProv *webhooks.Webhooks,
+	paymentsProv payments.Provider,
 ) *Services {
 	return &Services{
 		database:       database,
@@ -55,5 +63,7 @@ func NewServices(
 		jwt:            jwt,
 		log:            log,
 		oauthProviders: oauthProv,
+		webhooks:       webhooksProv,
+		payments:       paymentsProv,
 	}
 }
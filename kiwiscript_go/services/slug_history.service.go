@@ -0,0 +1,75 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+const slugHistoryLocation string = "slug_history"
+
+type FindCanonicalSlugsOptions struct {
+	RequestID    string
+	LanguageSlug string
+	SeriesSlug   string
+}
+
+// FindCanonicalSlugs resolves old language and series slugs to the current
+// ones, slugs that are live again are never redirected. It returns not found
+// when none of the slugs has been renamed.
+func (s *Services) FindCanonicalSlugs(
+	ctx context.Context,
+	opts FindCanonicalSlugsOptions,
+) (string, string, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, slugHistoryLocation, "FindCanonicalSlugs")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, slugHistoryLocation, "FindCanonicalSlugs").With(
+		"languageSlug", opts.LanguageSlug,
+		"seriesSlug", opts.SeriesSlug,
+	)
+	log.InfoContext(ctx, "Finding canonical slugs...")
+
+	if opts.SeriesSlug != "" {
+		slugs, err := s.database.FindSeriesSlugsByOldSlugs(ctx, db.FindSeriesSlugsByOldSlugsParams{
+			SeriesSlug:   opts.SeriesSlug,
+			LanguageSlug: opts.LanguageSlug,
+		})
+		if err == nil {
+			log.InfoContext(ctx, "Series slug renamed", "canonicalSeriesSlug", slugs.Slug)
+			return slugs.LanguageSlug, slugs.Slug, nil
+		}
+		if err != pgx.ErrNoRows {
+			log.ErrorContext(ctx, "Failed to find series slugs by old slugs", "error", err)
+			return "", "", exceptions.FromDBError(err)
+		}
+	}
+
+	languageSlug, err := s.database.FindLanguageSlugByOldSlug(ctx, opts.LanguageSlug)
+	if err != nil {
+		log.DebugContext(ctx, "No renamed slugs found", "error", err)
+		return "", "", exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Language slug renamed", "canonicalLanguageSlug", languageSlug)
+	return languageSlug, opts.SeriesSlug, nil
+}
@@ -0,0 +1,351 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/email"
+	objstg "github.com/kiwiscript/kiwiscript_go/providers/object_storage"
+)
+
+const userDataExportsLocation string = "user_data_exports"
+
+const (
+	UserDataExportStatusPending    string = "pending"
+	UserDataExportStatusProcessing string = "processing"
+	UserDataExportStatusCompleted  string = "completed"
+	UserDataExportStatusFailed     string = "failed"
+	UserDataExportStatusExpired    string = "expired"
+
+	userDataExportTTL        time.Duration = 48 * time.Hour
+	userDataExportsBatchSize int32         = 5
+)
+
+type RequestUserDataExportOptions struct {
+	RequestID string
+	UserID    int32
+}
+
+func (s *Services) RequestUserDataExport(
+	ctx context.Context,
+	opts RequestUserDataExportOptions,
+) (*db.UserDataExport, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, userDataExportsLocation, "RequestUserDataExport")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, userDataExportsLocation, "RequestUserDataExport").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Requesting user data export...")
+
+	latest, err := s.database.FindLatestUserDataExportByUserID(ctx, opts.UserID)
+	if err != nil {
+		if serviceErr := exceptions.FromDBError(err); serviceErr.Code != exceptions.CodeNotFound {
+			log.ErrorContext(ctx, "Failed to find latest user data export", "error", err)
+			return nil, serviceErr
+		}
+	} else if latest.Status == UserDataExportStatusPending || latest.Status == UserDataExportStatusProcessing {
+		log.InfoContext(ctx, "User data export already in progress", "exportId", latest.ID.String())
+		return nil, exceptions.NewConflictError("Data export already in progress")
+	}
+
+	export, err := s.database.CreateUserDataExport(ctx, db.CreateUserDataExportParams{
+		ID:     uuid.New(),
+		UserID: opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create user data export", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "User data export requested", "exportId", export.ID.String())
+	return &export, nil
+}
+
+type FindLatestUserDataExportOptions struct {
+	RequestID string
+	UserID    int32
+}
+
+func (s *Services) FindLatestUserDataExport(
+	ctx context.Context,
+	opts FindLatestUserDataExportOptions,
+) (*db.UserDataExport, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, userDataExportsLocation, "FindLatestUserDataExport")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, userDataExportsLocation, "FindLatestUserDataExport").With(
+		"userId", opts.UserID,
+	)
+	log.InfoContext(ctx, "Finding latest user data export...")
+
+	export, err := s.database.FindLatestUserDataExportByUserID(ctx, opts.UserID)
+	if err != nil {
+		log.WarnContext(ctx, "User data export not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &export, nil
+}
+
+func writeUserDataExportFile(zw *zip.Writer, name string, data interface{}) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+func mapUserDataExportSlice[T any, R any](items []T, mapper func(*T) R) []R {
+	mapped := make([]R, len(items))
+	for i := range items {
+		mapped[i] = mapper(&items[i])
+	}
+	return mapped
+}
+
+// buildUserDataExport assembles every table holding the user's personal data into
+// a zip archive with one JSON document per table.
+func (s *Services) buildUserDataExport(ctx context.Context, user *db.User) (*bytes.Buffer, error) {
+	files := map[string]interface{}{
+		"user.json": user.ToUserDataExport(),
+	}
+
+	profile, err := s.database.FindUserProfileByUserID(ctx, user.ID)
+	if err != nil {
+		if exceptions.FromDBError(err).Code != exceptions.CodeNotFound {
+			return nil, err
+		}
+		files["profile.json"] = nil
+	} else {
+		files["profile.json"] = profile.ToUserDataExport()
+	}
+
+	picture, err := s.database.FindUserPictureByUserID(ctx, user.ID)
+	if err != nil {
+		if exceptions.FromDBError(err).Code != exceptions.CodeNotFound {
+			return nil, err
+		}
+		files["picture.json"] = nil
+	} else {
+		files["picture.json"] = picture.ToUserDataExport()
+	}
+
+	authProviders, err := s.database.FindAuthProvidersByEmail(ctx, user.Email)
+	if err != nil {
+		return nil, err
+	}
+	files["auth_providers.json"] = mapUserDataExportSlice(authProviders, (*db.AuthProvider).ToUserDataExport)
+
+	languageProgress, err := s.database.FindAllLanguageProgressByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	files["language_progress.json"] = mapUserDataExportSlice(languageProgress, (*db.LanguageProgress).ToUserDataExport)
+
+	seriesProgress, err := s.database.FindAllSeriesProgressByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	files["series_progress.json"] = mapUserDataExportSlice(seriesProgress, (*db.SeriesProgress).ToUserDataExport)
+
+	sectionProgress, err := s.database.FindAllSectionProgressByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	files["section_progress.json"] = mapUserDataExportSlice(sectionProgress, (*db.SectionProgress).ToUserDataExport)
+
+	lessonProgress, err := s.database.FindAllLessonProgressByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	files["lesson_progress.json"] = mapUserDataExportSlice(lessonProgress, (*db.LessonProgress).ToUserDataExport)
+
+	certificates, err := s.database.FindAllCertificatesByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	files["certificates.json"] = mapUserDataExportSlice(certificates, (*db.Certificate).ToUserDataExport)
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for _, name := range []string{
+		"user.json",
+		"profile.json",
+		"picture.json",
+		"auth_providers.json",
+		"language_progress.json",
+		"series_progress.json",
+		"section_progress.json",
+		"lesson_progress.json",
+		"certificates.json",
+	} {
+		if err := writeUserDataExportFile(zw, name, files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+func (s *Services) processUserDataExport(ctx context.Context, requestID string, export *db.UserDataExport) {
+	log := s.buildLogger(requestID, userDataExportsLocation, "processUserDataExport").With(
+		"exportId", export.ID.String(),
+		"userId", export.UserID,
+	)
+	log.InfoContext(ctx, "Processing user data export...")
+
+	fail := func(msg string, err error) {
+		log.ErrorContext(ctx, msg, "error", err)
+		if err := s.database.FailUserDataExport(ctx, export.ID); err != nil {
+			log.ErrorContext(ctx, "Failed to mark user data export as failed", "error", err)
+		}
+	}
+
+	user, err := s.database.FindUserById(ctx, export.UserID)
+	if err != nil {
+		fail("Failed to find user", err)
+		return
+	}
+
+	buf, err := s.buildUserDataExport(ctx, &user)
+	if err != nil {
+		fail("Failed to build user data export", err)
+		return
+	}
+
+	if err := s.objStg.UploadDataExport(ctx, objstg.UploadDataExportOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+		ExportID:  export.ID,
+		Body:      buf,
+	}); err != nil {
+		fail("Failed to upload user data export", err)
+		return
+	}
+
+	downloadURL, err := s.objStg.GetDataExportUrl(ctx, objstg.GetDataExportURLOptions{
+		RequestID: requestID,
+		UserID:    user.ID,
+		ExportID:  export.ID,
+		ExpiresIn: userDataExportTTL,
+	})
+	if err != nil {
+		fail("Failed to get user data export URL", err)
+		return
+	}
+
+	expiresAt := time.Now().Add(userDataExportTTL)
+	if err := s.database.CompleteUserDataExport(ctx, db.CompleteUserDataExportParams{
+		ID:        export.ID,
+		ExpiresAt: pgtype.Timestamp{Time: expiresAt, Valid: true},
+	}); err != nil {
+		fail("Failed to complete user data export", err)
+		return
+	}
+
+	if err := s.mail.SendDataExportEmail(ctx, email.DataExportEmailOptions{
+		RequestID:   requestID,
+		Email:       user.Email,
+		FirstName:   user.FirstName,
+		LastName:    user.LastName,
+		DownloadURL: downloadURL,
+		ExpiresAt:   expiresAt.UTC().Format(time.RFC1123),
+	}); err != nil {
+		log.WarnContext(ctx, "Failed to send data export email", "error", err)
+		return
+	}
+
+	log.InfoContext(ctx, "User data export completed")
+}
+
+// ProcessPendingUserDataExports builds the archives of claimed pending exports.
+func (s *Services) ProcessPendingUserDataExports(ctx context.Context, requestID string) {
+	ctx, span := s.startSpan(ctx, userDataExportsLocation, "ProcessPendingUserDataExports")
+	defer span.End()
+
+	log := s.buildLogger(requestID, userDataExportsLocation, "ProcessPendingUserDataExports")
+	log.DebugContext(ctx, "Processing pending user data exports...")
+
+	exports, err := s.database.ClaimPendingUserDataExports(ctx, userDataExportsBatchSize)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to claim pending user data exports", "error", err)
+		return
+	}
+
+	for i := range exports {
+		s.processUserDataExport(ctx, requestID, &exports[i])
+	}
+}
+
+// ExpireUserDataExports deletes the archives of exports whose download window has passed.
+func (s *Services) ExpireUserDataExports(ctx context.Context, requestID string) {
+	ctx, span := s.startSpan(ctx, userDataExportsLocation, "ExpireUserDataExports")
+	defer span.End()
+
+	log := s.buildLogger(requestID, userDataExportsLocation, "ExpireUserDataExports")
+	log.DebugContext(ctx, "Expiring user data exports...")
+
+	exports, err := s.database.FindExpiredUserDataExports(ctx, userDataExportsBatchSize)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find expired user data exports", "error", err)
+		return
+	}
+
+	for _, export := range exports {
+		if err := s.objStg.DeleteDataExport(ctx, export.UserID, export.ID); err != nil {
+			log.ErrorContext(ctx, "Failed to delete user data export", "error", err, "exportId", export.ID.String())
+			continue
+		}
+		if err := s.database.ExpireUserDataExport(ctx, export.ID); err != nil {
+			log.ErrorContext(ctx, "Failed to expire user data export", "error", err, "exportId", export.ID.String())
+		}
+	}
+}
+
+// RunUserDataExportsWorker processes and expires data exports until the context is cancelled.
+func (s *Services) RunUserDataExportsWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			requestID := uuid.NewString()
+			s.ProcessPendingUserDataExports(ctx, requestID)
+			s.ExpireUserDataExports(ctx, requestID)
+		}
+	}
+}
@@ -34,6 +34,9 @@ func (s *Services) FindUserProfile(
 	ctx context.Context,
 	opts FindUserProfileOptions,
 ) (*db.UserProfile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, userProfilesLocation, "FindUserProfile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, userProfilesLocation, "FindUserProfile").With(
 		"userID", opts.UserID,
 	)
@@ -61,6 +64,9 @@ func (s *Services) CreateUserProfile(
 	ctx context.Context,
 	opts UserProfileOptions,
 ) (*db.UserProfile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, userProfilesLocation, "CreateUserProfile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, userProfilesLocation, "CreateUserProfile").With(
 		"userID", opts.UserID,
 		"github", opts.GitHub,
@@ -93,6 +99,9 @@ func (s *Services) UpdateUserProfile(
 	ctx context.Context,
 	opts UserProfileOptions,
 ) (*db.UserProfile, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, userProfilesLocation, "UpdateUserProfile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, userProfilesLocation, "UpdateUserProfile").With(
 		"userID", opts.UserID,
 		"github", opts.GitHub,
@@ -134,6 +143,9 @@ func (s *Services) DeleteUserProfile(
 	ctx context.Context,
 	opts DeleteUserProfileOptions,
 ) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, userProfilesLocation, "DeleteUserProfile")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, userProfilesLocation, "DeleteUserProfile").With(
 		"userId", opts.UserID,
 	)
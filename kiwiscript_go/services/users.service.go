@@ -22,7 +22,9 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
 	"github.com/kiwiscript/kiwiscript_go/utils"
+	"log/slog"
 	"strings"
 )
 
@@ -39,6 +41,9 @@ type CreateUserOptions struct {
 }
 
 func (s *Services) CreateUser(ctx context.Context, opts CreateUserOptions) (*db.User, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, usersLocation, "CreateUser")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "CreateUser").With(
 		"firstName", opts.FirstName,
 		"lastName", opts.LastName,
@@ -74,12 +79,12 @@ func (s *Services) CreateUser(ctx context.Context, opts CreateUserOptions) (*db.
 		log.ErrorContext(ctx, "Failed to begin transaction", "error", err)
 		return nil, exceptions.FromDBError(err)
 	}
+	var user db.User
 	defer func() {
 		log.DebugContext(ctx, "Finalizing transaction")
 		s.database.FinalizeTx(ctx, txn, err, serviceErr)
 	}()
 
-	var user db.User
 	if provider == utils.ProviderEmail {
 		user, err = qrs.CreateUserWithPassword(ctx, db.CreateUserWithPasswordParams{
 			FirstName: opts.FirstName,
@@ -112,6 +117,21 @@ func (s *Services) CreateUser(ctx context.Context, opts CreateUserOptions) (*db.
 		return nil, serviceErr
 	}
 
+	serviceErr = s.enqueueWebhookEvent(ctx, qrs, enqueueWebhookEventOptions{
+		RequestID: opts.RequestID,
+		Event:     webhooks.EventUserRegistered,
+		Data: webhookUserRegisteredData{
+			UserID:    user.ID,
+			Email:     user.Email,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Provider:  provider,
+		},
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
 	log.InfoContext(ctx, "Created user successfully")
 	return &user, nil
 }
@@ -122,6 +142,9 @@ type FindUserByEmailOptions struct {
 }
 
 func (s *Services) FindUserByEmail(ctx context.Context, opts FindUserByEmailOptions) (*db.User, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, usersLocation, "FindUserByEmail")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "FindUserByEmail")
 	log.InfoContext(ctx, "Finding user by email...")
 
@@ -141,6 +164,9 @@ type FindUserByIDOptions struct {
 }
 
 func (s *Services) FindUserByID(ctx context.Context, opts FindUserByIDOptions) (*db.User, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, usersLocation, "FindUserByID")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "FindUserByID").With("id", opts.ID)
 	log.InfoContext(ctx, "Finding user by id...")
 
@@ -160,6 +186,9 @@ type UpdateUserPasswordOptions struct {
 }
 
 func (s *Services) UpdateUserPassword(ctx context.Context, opts UpdateUserPasswordOptions) (*db.User, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, usersLocation, "UpdateUserPassword")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "UpdateUserPassword").With("id", opts.ID)
 	log.InfoContext(ctx, "Updating user password...")
 	var password pgtype.Text
@@ -186,6 +215,9 @@ type ConfirmUserOptions struct {
 }
 
 func (s *Services) ConfirmUser(ctx context.Context, opts ConfirmUserOptions) (*db.User, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, usersLocation, "ConfirmUser")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "ConfirmUser").With("id", opts.ID)
 	log.InfoContext(ctx, "Confirming user...")
 
@@ -208,6 +240,9 @@ type UpdateUserOptions struct {
 }
 
 func (s *Services) UpdateUser(ctx context.Context, opts UpdateUserOptions) (*db.User, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, usersLocation, "UpdateUser")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "UpdateUser").With(
 		"id", opts.ID,
 		"firstName", opts.FirstName,
@@ -247,6 +282,9 @@ type DeleteUserOptions struct {
 }
 
 func (s *Services) DeleteUser(ctx context.Context, opts DeleteUserOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, usersLocation, "DeleteUser")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "DeleteUser").With("id", opts.ID)
 	log.InfoContext(ctx, "Deleting user...")
 
@@ -265,19 +303,43 @@ func (s *Services) DeleteUser(ctx context.Context, opts DeleteUserOptions) *exce
 		}
 	}
 
-	if err := s.database.DeleteUserById(ctx, opts.ID); err != nil {
-		log.ErrorContext(ctx, "Failed to delete user", "error", err)
+	if err := s.database.SoftDeleteUserById(ctx, opts.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to soft delete user", "error", err)
 		return exceptions.FromDBError(err)
 	}
 
-	log.InfoContext(ctx, "Deleted user successfully")
+	log.InfoContext(ctx, "Soft deleted user successfully, pending purge")
 	return nil
 }
 
+// restoreDeletedUser cancels a pending account deletion when the user logs
+// back in during the grace period.
+func (s *Services) restoreDeletedUser(
+	ctx context.Context,
+	log *slog.Logger,
+	user *db.User,
+) (*db.User, *exceptions.ServiceError) {
+	if !user.DeletedAt.Valid {
+		return user, nil
+	}
+
+	restored, err := s.database.RestoreUserById(ctx, user.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to restore deleted user", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Restored deleted user", "userId", user.ID)
+	return &restored, nil
+}
+
 func (s *Services) FindStaffUserWithProfileAndPicture(
 	ctx context.Context,
 	opts FindUserByIDOptions,
 ) (*db.FindStaffUserByIdWithProfileAndPictureRow, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, usersLocation, "FindStaffUserWithProfileAndPicture")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, usersLocation, "FindStaffUserWithProfileAndPicture").With(
 		"id", opts.ID,
 	)
@@ -36,6 +36,9 @@ func (s *Services) FindUserPicture(
 	ctx context.Context,
 	opts FindUserPictureOptions,
 ) (*db.UserPicture, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, userPicturesLocation, "FindUserPicture")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, userPicturesLocation, "FindUserPicture").With(
 		"userId", opts.UserID,
 	)
@@ -60,6 +63,9 @@ func (s *Services) UploadUserPicture(
 	ctx context.Context,
 	opts UploadUserPictureOptions,
 ) (*db.UserPicture, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, userPicturesLocation, "UploadUserPicture")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, userPicturesLocation, "UploadUserPicture").With(
 		"userId", opts.UserID,
 	)
@@ -110,6 +116,9 @@ func (s *Services) DeleteUserPicture(
 	ctx context.Context,
 	opts DeleteUserPictureOptions,
 ) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, userPicturesLocation, "DeleteUserPicture")
+	defer span.End()
+
 	log := s.buildLogger(opts.RequestID, userPicturesLocation, "DeleteUserPicture").With(
 		"userId", opts.UserID,
 	)
@@ -0,0 +1,557 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
+)
+
+const webhooksLocation string = "webhooks"
+
+const (
+	WebhookDeliveryStatusPending string = "pending"
+	WebhookDeliveryStatusSuccess string = "success"
+	WebhookDeliveryStatusFailed  string = "failed"
+
+	webhookDeliveryMaxAttempts int16         = 6
+	webhookDeliveryBaseBackoff time.Duration = time.Minute
+	webhookDeliveriesBatchSize int32         = 50
+)
+
+type CreateWebhookOptions struct {
+	RequestID string
+	UserID    int32
+	URL       string
+	Secret    string
+	Events    []string
+}
+
+func (s *Services) CreateWebhook(ctx context.Context, opts CreateWebhookOptions) (*db.Webhook, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "CreateWebhook")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "CreateWebhook").With(
+		"userId", opts.UserID,
+		"url", opts.URL,
+		"events", opts.Events,
+	)
+	log.InfoContext(ctx, "Creating webhook...")
+
+	webhook, err := s.database.CreateWebhook(ctx, db.CreateWebhookParams{
+		Url:      opts.URL,
+		Secret:   opts.Secret,
+		Events:   opts.Events,
+		AuthorID: opts.UserID,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create webhook", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Webhook created successfully")
+	return &webhook, nil
+}
+
+type FindWebhookByIDOptions struct {
+	RequestID string
+	WebhookID int32
+}
+
+func (s *Services) FindWebhookByID(ctx context.Context, opts FindWebhookByIDOptions) (*db.Webhook, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "FindWebhookByID")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "FindWebhookByID").With(
+		"webhookId", opts.WebhookID,
+	)
+	log.InfoContext(ctx, "Finding webhook by id...")
+
+	webhook, err := s.database.FindWebhookByID(ctx, opts.WebhookID)
+	if err != nil {
+		log.WarnContext(ctx, "Webhook not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &webhook, nil
+}
+
+type FindPaginatedWebhooksOptions struct {
+	RequestID string
+	Offset    int32
+	Limit     int32
+}
+
+func (s *Services) FindPaginatedWebhooks(
+	ctx context.Context,
+	opts FindPaginatedWebhooksOptions,
+) ([]db.Webhook, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "FindPaginatedWebhooks")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "FindPaginatedWebhooks").With(
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated webhooks...")
+
+	count, err := s.database.CountWebhooks(ctx)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count webhooks", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	if count == 0 {
+		log.DebugContext(ctx, "No webhooks found", "count", count)
+		return make([]db.Webhook, 0), 0, nil
+	}
+
+	webhooksList, err := s.database.FindPaginatedWebhooks(ctx, db.FindPaginatedWebhooksParams{
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated webhooks", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	return webhooksList, count, nil
+}
+
+type UpdateWebhookOptions struct {
+	RequestID string
+	WebhookID int32
+	URL       string
+	Secret    string
+	Events    []string
+	IsActive  bool
+}
+
+func (s *Services) UpdateWebhook(ctx context.Context, opts UpdateWebhookOptions) (*db.Webhook, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "UpdateWebhook")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "UpdateWebhook").With(
+		"webhookId", opts.WebhookID,
+		"url", opts.URL,
+		"events", opts.Events,
+		"isActive", opts.IsActive,
+	)
+	log.InfoContext(ctx, "Updating webhook...")
+
+	webhook, serviceErr := s.FindWebhookByID(ctx, FindWebhookByIDOptions{
+		RequestID: opts.RequestID,
+		WebhookID: opts.WebhookID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	secret := webhook.Secret
+	if opts.Secret != "" {
+		secret = opts.Secret
+	}
+
+	updatedWebhook, err := s.database.UpdateWebhook(ctx, db.UpdateWebhookParams{
+		ID:       webhook.ID,
+		Url:      opts.URL,
+		Secret:   secret,
+		Events:   opts.Events,
+		IsActive: opts.IsActive,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update webhook", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Webhook updated successfully")
+	return &updatedWebhook, nil
+}
+
+type DeleteWebhookOptions struct {
+	RequestID string
+	WebhookID int32
+}
+
+func (s *Services) DeleteWebhook(ctx context.Context, opts DeleteWebhookOptions) *exceptions.ServiceError {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "DeleteWebhook")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "DeleteWebhook").With(
+		"webhookId", opts.WebhookID,
+	)
+	log.InfoContext(ctx, "Deleting webhook...")
+
+	webhook, serviceErr := s.FindWebhookByID(ctx, FindWebhookByIDOptions(opts))
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if err := s.database.DeleteWebhookByID(ctx, webhook.ID); err != nil {
+		log.ErrorContext(ctx, "Failed to delete webhook", "error", err)
+		return exceptions.FromDBError(err)
+	}
+
+	log.InfoContext(ctx, "Webhook deleted successfully")
+	return nil
+}
+
+type FindPaginatedWebhookDeliveriesOptions struct {
+	RequestID string
+	WebhookID int32
+	Offset    int32
+	Limit     int32
+}
+
+func (s *Services) FindPaginatedWebhookDeliveries(
+	ctx context.Context,
+	opts FindPaginatedWebhookDeliveriesOptions,
+) ([]db.WebhookDelivery, int64, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "FindPaginatedWebhookDeliveries")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "FindPaginatedWebhookDeliveries").With(
+		"webhookId", opts.WebhookID,
+		"offset", opts.Offset,
+		"limit", opts.Limit,
+	)
+	log.InfoContext(ctx, "Finding paginated webhook deliveries...")
+
+	webhook, serviceErr := s.FindWebhookByID(ctx, FindWebhookByIDOptions{
+		RequestID: opts.RequestID,
+		WebhookID: opts.WebhookID,
+	})
+	if serviceErr != nil {
+		return nil, 0, serviceErr
+	}
+
+	count, err := s.database.CountWebhookDeliveriesByWebhookID(ctx, webhook.ID)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to count webhook deliveries", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	if count == 0 {
+		log.DebugContext(ctx, "No webhook deliveries found", "count", count)
+		return make([]db.WebhookDelivery, 0), 0, nil
+	}
+
+	deliveries, err := s.database.FindPaginatedWebhookDeliveriesByWebhookID(
+		ctx,
+		db.FindPaginatedWebhookDeliveriesByWebhookIDParams{
+			WebhookID: webhook.ID,
+			Limit:     opts.Limit,
+			Offset:    opts.Offset,
+		},
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find paginated webhook deliveries", "error", err)
+		return nil, 0, exceptions.FromDBError(err)
+	}
+
+	return deliveries, count, nil
+}
+
+type FindWebhookDeliveryOptions struct {
+	RequestID  string
+	WebhookID  int32
+	DeliveryID uuid.UUID
+}
+
+func (s *Services) FindWebhookDelivery(
+	ctx context.Context,
+	opts FindWebhookDeliveryOptions,
+) (*db.WebhookDelivery, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "FindWebhookDelivery")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "FindWebhookDelivery").With(
+		"webhookId", opts.WebhookID,
+		"deliveryId", opts.DeliveryID,
+	)
+	log.InfoContext(ctx, "Finding webhook delivery...")
+
+	delivery, err := s.database.FindWebhookDeliveryByIDAndWebhookID(ctx, db.FindWebhookDeliveryByIDAndWebhookIDParams{
+		ID:        opts.DeliveryID,
+		WebhookID: opts.WebhookID,
+	})
+	if err != nil {
+		log.WarnContext(ctx, "Webhook delivery not found", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return &delivery, nil
+}
+
+type RedeliverWebhookDeliveryOptions struct {
+	RequestID  string
+	WebhookID  int32
+	DeliveryID uuid.UUID
+}
+
+// RedeliverWebhookDelivery creates a new delivery with the same event and payload and sends it right away,
+// the original delivery is kept untouched in the log.
+func (s *Services) RedeliverWebhookDelivery(
+	ctx context.Context,
+	opts RedeliverWebhookDeliveryOptions,
+) (*db.WebhookDelivery, *exceptions.ServiceError) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "RedeliverWebhookDelivery")
+	defer span.End()
+
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "RedeliverWebhookDelivery").With(
+		"webhookId", opts.WebhookID,
+		"deliveryId", opts.DeliveryID,
+	)
+	log.InfoContext(ctx, "Redelivering webhook delivery...")
+
+	webhook, serviceErr := s.FindWebhookByID(ctx, FindWebhookByIDOptions{
+		RequestID: opts.RequestID,
+		WebhookID: opts.WebhookID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	delivery, serviceErr := s.FindWebhookDelivery(ctx, FindWebhookDeliveryOptions{
+		RequestID:  opts.RequestID,
+		WebhookID:  webhook.ID,
+		DeliveryID: opts.DeliveryID,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	newDelivery, err := s.database.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		ID:        uuid.New(),
+		WebhookID: webhook.ID,
+		Event:     delivery.Event,
+		Payload:   delivery.Payload,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to create webhook delivery", "error", err)
+		return nil, exceptions.FromDBError(err)
+	}
+
+	return s.sendWebhookDelivery(ctx, opts.RequestID, &newDelivery, webhook.Url, webhook.Secret), nil
+}
+
+type webhookEventPayload struct {
+	ID        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt string      `json:"createdAt"`
+	Data      interface{} `json:"data"`
+}
+
+type webhookUserRegisteredData struct {
+	UserID    int32  `json:"userId"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Provider  string `json:"provider"`
+}
+
+type webhookSeriesPublishedData struct {
+	SeriesID     int32  `json:"seriesId"`
+	Title        string `json:"title"`
+	Slug         string `json:"slug"`
+	LanguageSlug string `json:"languageSlug"`
+	AuthorID     int32  `json:"authorId"`
+}
+
+type webhookCertificateIssuedData struct {
+	CertificateID string `json:"certificateId"`
+	UserID        int32  `json:"userId"`
+	SeriesTitle   string `json:"seriesTitle"`
+	SeriesSlug    string `json:"seriesSlug"`
+	LanguageSlug  string `json:"languageSlug"`
+	Lessons       int16  `json:"lessons"`
+	CompletedAt   string `json:"completedAt"`
+}
+
+type enqueueWebhookEventOptions struct {
+	RequestID string
+	Event     string
+	Data      interface{}
+}
+
+// enqueueWebhookEvent writes a pending delivery for every active webhook subscribed to the event,
+// it runs inside the transaction that produced the event so deliveries are only ever created for
+// committed changes and never lost in between, RunWebhookDeliveriesWorker then sends them.
+func (s *Services) enqueueWebhookEvent(
+	ctx context.Context,
+	qrs *db.Queries,
+	opts enqueueWebhookEventOptions,
+) *exceptions.ServiceError {
+	log := s.buildLogger(opts.RequestID, webhooksLocation, "enqueueWebhookEvent").With(
+		"event", opts.Event,
+	)
+	log.InfoContext(ctx, "Enqueuing webhook event...")
+
+	webhooksList, err := qrs.FindActiveWebhooksByEvent(ctx, opts.Event)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to find webhooks by event", "error", err)
+		return exceptions.FromDBError(err)
+	}
+	if len(webhooksList) == 0 {
+		log.DebugContext(ctx, "No webhooks subscribed to event")
+		return nil
+	}
+
+	eventID := uuid.New()
+	payload, err := json.Marshal(webhookEventPayload{
+		ID:        eventID.String(),
+		Event:     opts.Event,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		Data:      opts.Data,
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to marshal webhook payload", "error", err)
+		return exceptions.NewServerError()
+	}
+
+	for _, webhook := range webhooksList {
+		if err := qrs.CreateDueWebhookDelivery(ctx, db.CreateDueWebhookDeliveryParams{
+			ID:        uuid.New(),
+			WebhookID: webhook.ID,
+			Event:     opts.Event,
+			Payload:   string(payload),
+		}); err != nil {
+			log.ErrorContext(ctx, "Failed to create webhook delivery", "error", err, "webhookId", webhook.ID)
+			return exceptions.FromDBError(err)
+		}
+	}
+
+	return nil
+}
+
+func webhookDeliveryBackoff(attempts int16) time.Duration {
+	return webhookDeliveryBaseBackoff * time.Duration(1<<uint(attempts))
+}
+
+func (s *Services) sendWebhookDelivery(
+	ctx context.Context,
+	requestID string,
+	delivery *db.WebhookDelivery,
+	url,
+	secret string,
+) *db.WebhookDelivery {
+	log := s.buildLogger(requestID, webhooksLocation, "sendWebhookDelivery").With(
+		"webhookId", delivery.WebhookID,
+		"deliveryId", delivery.ID,
+		"event", delivery.Event,
+		"attempts", delivery.Attempts,
+	)
+	log.DebugContext(ctx, "Sending webhook delivery...")
+
+	result, err := s.webhooks.Deliver(ctx, webhooks.DeliverOptions{
+		RequestID:  requestID,
+		URL:        url,
+		Secret:     secret,
+		DeliveryID: delivery.ID.String(),
+		Event:      delivery.Event,
+		Payload:    []byte(delivery.Payload),
+	})
+	if err == nil && result.Status >= 200 && result.Status < 300 {
+		updated, err := s.database.UpdateWebhookDeliverySucceeded(ctx, db.UpdateWebhookDeliverySucceededParams{
+			ID:             delivery.ID,
+			ResponseStatus: int16(result.Status),
+			ResponseBody:   result.Body,
+		})
+		if err != nil {
+			log.ErrorContext(ctx, "Failed to update webhook delivery", "error", err)
+			return delivery
+		}
+
+		log.DebugContext(ctx, "Webhook delivery succeeded")
+		return &updated
+	}
+
+	params := db.UpdateWebhookDeliveryFailedParams{
+		ID:     delivery.ID,
+		Status: WebhookDeliveryStatusPending,
+	}
+	if err != nil {
+		params.ResponseBody = err.Error()
+	} else {
+		params.ResponseStatus = int16(result.Status)
+		params.ResponseBody = result.Body
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= webhookDeliveryMaxAttempts {
+		log.WarnContext(ctx, "Webhook delivery failed, no attempts left")
+		params.Status = WebhookDeliveryStatusFailed
+	} else {
+		log.WarnContext(ctx, "Webhook delivery failed, scheduling retry")
+		params.NextAttemptAt = pgtype.Timestamp{
+			Time:  time.Now().Add(webhookDeliveryBackoff(attempts)),
+			Valid: true,
+		}
+	}
+
+	updated, err := s.database.UpdateWebhookDeliveryFailed(ctx, params)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to update webhook delivery", "error", err)
+		return delivery
+	}
+
+	return &updated
+}
+
+// ProcessDueWebhookDeliveries claims and retries the pending deliveries whose backoff has elapsed,
+// claimed rows are leased so other instances running the worker never send them twice.
+func (s *Services) ProcessDueWebhookDeliveries(ctx context.Context, requestID string) {
+	ctx, span := s.startSpan(ctx, webhooksLocation, "ProcessDueWebhookDeliveries")
+	defer span.End()
+
+	log := s.buildLogger(requestID, webhooksLocation, "ProcessDueWebhookDeliveries")
+	log.DebugContext(ctx, "Processing due webhook deliveries...")
+
+	deliveries, err := s.database.ClaimDueWebhookDeliveriesWithWebhook(ctx, webhookDeliveriesBatchSize)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to claim due webhook deliveries", "error", err)
+		return
+	}
+
+	for _, d := range deliveries {
+		delivery := d.ToWebhookDelivery()
+		s.sendWebhookDelivery(ctx, requestID, delivery, d.WebhookUrl, d.WebhookSecret)
+	}
+}
+
+// RunWebhookDeliveriesWorker polls for due deliveries until the context is cancelled.
+func (s *Services) RunWebhookDeliveriesWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.ProcessDueWebhookDeliveries(ctx, uuid.NewString())
+		}
+	}
+}
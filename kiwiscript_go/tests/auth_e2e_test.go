@@ -41,6 +41,9 @@ func userCleanUp(t *testing.T) func() {
 		dbProv := GetTestDatabase(t)
 		cacheProv := GetTestCache(t)
 
+		if err := dbProv.DeleteAllOrganizations(context.Background()); err != nil {
+			t.Fatal("Failed to delete all organizations", err)
+		}
 		if err := dbProv.DeleteAllUsers(context.Background()); err != nil {
 			t.Fatal("Failed to delete all users", err)
 		}
@@ -406,6 +409,26 @@ func TestLoginConfirm(t *testing.T) {
 				assertOAuthResponse(t, resp)
 			},
 		},
+		{
+			Name: "Should return 200 OK and restore a soft deleted user",
+			ReqFn: func(t *testing.T) (dtos.ConfirmSignInBody, string) {
+				testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+				if err := GetTestDatabase(t).SoftDeleteUserById(context.Background(), testUser.ID); err != nil {
+					t.Fatal("Failed to soft delete user", err)
+				}
+				code := generateTestTwoFactorCode(t, testUser)
+				return dtos.ConfirmSignInBody{Email: testUser.Email, Code: code}, ""
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.ConfirmSignInBody, resp *http.Response) {
+				assertOAuthResponse(t, resp)
+				user, err := GetTestDatabase(t).FindUserByEmail(context.Background(), req.Email)
+				if err != nil {
+					t.Fatal("Failed to find user", err)
+				}
+				AssertEqual(t, user.DeletedAt.Valid, false)
+			},
+		},
 		{
 			Name: "Should return 401 UNAUTHORIZED if code is wrong",
 			ReqFn: func(t *testing.T) (dtos.ConfirmSignInBody, string) {
@@ -0,0 +1,131 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	cc "github.com/kiwiscript/kiwiscript_go/providers/cache"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func performCatalogCacheRequest(t *testing.T, path, ifNoneMatch, accessToken string) *http.Response {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Accept", "application/json")
+	if ifNoneMatch != "" {
+		req.Header.Set(fiber.HeaderIfNoneMatch, ifNoneMatch)
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := GetTestApp(t).Test(req, 2000)
+	if err != nil {
+		t.Fatal("Failed to perform request", err)
+	}
+
+	return resp
+}
+
+func TestCatalogHTTPCache(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testServices := GetTestServices(t)
+
+	opts := services.CreateLanguageOptions{
+		UserID: testUser.ID,
+		Name:   "Rust",
+		Icon:   strings.TrimSpace(languageIcons["Rust"]),
+	}
+	if _, serviceErr := testServices.CreateLanguage(context.Background(), opts); serviceErr != nil {
+		t.Fatal("Failed to create language", serviceErr)
+	}
+
+	var etag string
+	t.Run("Should return 200 OK with an ETag and a public Cache-Control on a miss", func(t *testing.T) {
+		resp := performCatalogCacheRequest(t, baseLanguagesPath, "", "")
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderCacheControl), "public, max-age=300")
+		AssertEqual(t, resp.Header.Get("X-Cache"), "MISS")
+		etag = resp.Header.Get(fiber.HeaderETag)
+		AssertStringContains(t, etag, `"`)
+	})
+
+	t.Run("Should return 200 OK from the response cache on a hit", func(t *testing.T) {
+		resp := performCatalogCacheRequest(t, baseLanguagesPath, "", "")
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get("X-Cache"), "HIT")
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), etag)
+	})
+
+	t.Run("Should return 304 NOT MODIFIED when the ETag matches", func(t *testing.T) {
+		resp := performCatalogCacheRequest(t, baseLanguagesPath, etag, "")
+		AssertTestStatusCode(t, resp, fiber.StatusNotModified)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), etag)
+	})
+
+	t.Run("Should return 200 OK with a new ETag after the language is updated", func(t *testing.T) {
+		updateOpts := services.UpdateLanguageOptions{
+			Slug: "rust",
+			Name: "Rust Lang",
+			Icon: strings.TrimSpace(languageIcons["Rust"]),
+		}
+		if _, serviceErr := testServices.UpdateLanguage(context.Background(), updateOpts); serviceErr != nil {
+			t.Fatal("Failed to update language", serviceErr)
+		}
+
+		resp := performCatalogCacheRequest(t, baseLanguagesPath, etag, "")
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get("X-Cache"), "MISS")
+		if resp.Header.Get(fiber.HeaderETag) == etag {
+			t.Fatal("ETag was not changed by the update")
+		}
+		etag = resp.Header.Get(fiber.HeaderETag)
+	})
+
+	t.Run("Should return 304 NOT MODIFIED after an invalidation that left the content unchanged", func(t *testing.T) {
+		if err := GetTestCache(t).BumpCatalogVersions(context.Background(), cc.BumpCatalogVersionsOptions{
+			RequestID: uuid.NewString(),
+			Scopes:    []string{services.CatalogLanguagesScope},
+		}); err != nil {
+			t.Fatal("Failed to bump catalog versions", err)
+		}
+
+		resp := performCatalogCacheRequest(t, baseLanguagesPath, etag, "")
+		AssertTestStatusCode(t, resp, fiber.StatusNotModified)
+		AssertEqual(t, resp.Header.Get("X-Cache"), "MISS")
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), etag)
+	})
+
+	t.Run("Should return 200 OK with a private Cache-Control when the user is authenticated", func(t *testing.T) {
+		accessToken, _ := GenerateTestAuthTokens(t, testUser)
+		resp := performCatalogCacheRequest(t, baseLanguagesPath, etag, accessToken)
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderCacheControl), "private, no-cache")
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), "")
+	})
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	"github.com/kiwiscript/kiwiscript_go/providers/oauth"
 	"io"
@@ -47,7 +48,9 @@ import (
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
 	"github.com/kiwiscript/kiwiscript_go/providers/email"
 	stg "github.com/kiwiscript/kiwiscript_go/providers/object_storage"
+	"github.com/kiwiscript/kiwiscript_go/providers/payments"
 	"github.com/kiwiscript/kiwiscript_go/providers/tokens"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
 	"github.com/kiwiscript/kiwiscript_go/services"
 	"github.com/kiwiscript/kiwiscript_go/utils"
 )
@@ -130,6 +133,17 @@ func initTestServicesAndApp(t *testing.T) {
 		_testConfig.OAuthProviders.Google.ClientSecret,
 		_testConfig.BackendDomain,
 	)
+	testPayments, err := payments.NewPayments(
+		log,
+		_testConfig.Payments.Provider,
+		_testConfig.Payments.SecretKey,
+		_testConfig.Payments.WebhookSecret,
+		_testConfig.FrontendDomain,
+	)
+	if err != nil {
+		log.ErrorContext(ctx, "Failed to build payments provider", "error", err)
+		t.Fatal("Failed to build payments provider", err)
+	}
 	_testServices = services.NewServices(
 		log,
 		_testDatabase,
@@ -138,8 +152,11 @@ func initTestServicesAndApp(t *testing.T) {
 		mailer,
 		_testTokens,
 		testOAuthProvider,
+		webhooks.NewWebhooks(log),
+		testPayments,
 	)
 	_testApp = app.CreateApp(
+		app.NewLifecycle(log, &_testConfig.Shutdown),
 		log,
 		storage,
 		dbConnPool,
@@ -148,6 +165,10 @@ func initTestServicesAndApp(t *testing.T) {
 		&_testConfig.Tokens,
 		&_testConfig.Limiter,
 		&_testConfig.OAuthProviders,
+		&_testConfig.AccountDeletion,
+		&_testConfig.Engagement,
+		&_testConfig.Telemetry,
+		&_testConfig.Payments,
 		_testConfig.ObjectStorage.Bucket,
 		_testConfig.BackendDomain,
 		_testConfig.FrontendDomain,
@@ -204,6 +225,20 @@ func GetTestCache(t *testing.T) *cc.Cache {
 	return _testCache
 }
 
+// InvalidateTestCatalog drops the cached catalog responses of fixtures that
+// were written straight to the database instead of through the services.
+func InvalidateTestCatalog(t *testing.T, languageSlugs ...string) {
+	scopes := []string{services.CatalogLanguagesScope}
+	for _, languageSlug := range languageSlugs {
+		scopes = append(scopes, services.CatalogLanguageScope(languageSlug))
+	}
+
+	opts := cc.BumpCatalogVersionsOptions{Scopes: scopes}
+	if err := GetTestCache(t).BumpCatalogVersions(context.Background(), opts); err != nil {
+		t.Fatal("Failed to invalidate catalog cache", err)
+	}
+}
+
 func CreateTestJSONRequestBody(t *testing.T, reqBody interface{}) *bytes.Reader {
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
@@ -214,6 +249,20 @@ func CreateTestJSONRequestBody(t *testing.T, reqBody interface{}) *bytes.Reader
 }
 
 func PerformTestRequest(t *testing.T, app *fiber.App, delayMs int, method, path, accessToken, contentType string, body io.Reader) *http.Response {
+	return PerformTestRequestWithHeaders(t, app, delayMs, method, path, accessToken, contentType, body, nil)
+}
+
+func PerformTestRequestWithHeaders(
+	t *testing.T,
+	app *fiber.App,
+	delayMs int,
+	method,
+	path,
+	accessToken,
+	contentType string,
+	body io.Reader,
+	headers map[string]string,
+) *http.Response {
 	req := httptest.NewRequest(method, path, body)
 	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", "application/json")
@@ -221,6 +270,9 @@ func PerformTestRequest(t *testing.T, app *fiber.App, delayMs int, method, path,
 	if accessToken != "" {
 		req.Header.Set("Authorization", "Bearer "+accessToken)
 	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 
 	resp, err := app.Test(req, 2000)
 	if err != nil {
@@ -418,6 +470,64 @@ type TestRequestCase[R any] struct {
 	Path      string
 	PathFn    func() string
 	Method    string
+	IfMatchFn func(t *testing.T) string
+}
+
+func (tc *TestRequestCase[R]) headers(t *testing.T) map[string]string {
+	if tc.IfMatchFn == nil {
+		return nil
+	}
+
+	return map[string]string{fiber.HeaderIfMatch: tc.IfMatchFn(t)}
+}
+
+// currentVersionIfMatch builds an If-Match header with the current version of
+// the row, missing rows fall back to the first version so the request still
+// reaches the service.
+func currentVersionIfMatch(t *testing.T, query string, arg interface{}) string {
+	var version int32
+	row := GetTestDatabase(t).RawQueryRow(context.Background(), query, []interface{}{arg})
+	if err := row.Scan(&version); err != nil {
+		return `"v1"`
+	}
+
+	return fmt.Sprintf(`"v%d"`, version)
+}
+
+func sectionIfMatchFn(sectionID *int32) func(t *testing.T) string {
+	return func(t *testing.T) string {
+		return currentVersionIfMatch(t, `SELECT "version" FROM "sections" WHERE "id" = $1`, *sectionID)
+	}
+}
+
+func lessonIfMatchFn(lessonID *int32) func(t *testing.T) string {
+	return func(t *testing.T) string {
+		return currentVersionIfMatch(t, `SELECT "version" FROM "lessons" WHERE "id" = $1`, *lessonID)
+	}
+}
+
+// sectionsOrderIfMatchFn and lessonsOrderIfMatchFn build the If-Match of a
+// reorder, the order version is the sum of the versions of its rows.
+func sectionsOrderIfMatchFn(seriesSlug string) func(t *testing.T) string {
+	return func(t *testing.T) string {
+		return currentVersionIfMatch(
+			t,
+			`SELECT SUM("version")::int FROM "sections" WHERE "series_slug" = $1`,
+			seriesSlug,
+		)
+	}
+}
+
+func lessonsOrderIfMatchFn(sectionID *int32) func(t *testing.T) string {
+	return func(t *testing.T) string {
+		return currentVersionIfMatch(t, `SELECT SUM("version")::int FROM "lessons" WHERE "section_id" = $1`, *sectionID)
+	}
+}
+
+func lessonArticleIfMatchFn(lessonID *int32) func(t *testing.T) string {
+	return func(t *testing.T) string {
+		return currentVersionIfMatch(t, `SELECT "version" FROM "lesson_articles" WHERE "lesson_id" = $1`, *lessonID)
+	}
 }
 
 func PerformTestRequestCase[R any](t *testing.T, method, path string, tc TestRequestCase[R]) {
@@ -427,7 +537,7 @@ func PerformTestRequestCase[R any](t *testing.T, method, path string, tc TestReq
 	fiberApp := GetTestApp(t)
 
 	// Act
-	resp := PerformTestRequest(t, fiberApp, tc.DelayMs, method, path, accessToken, "application/json", jsonBody)
+	resp := PerformTestRequestWithHeaders(t, fiberApp, tc.DelayMs, method, path, accessToken, "application/json", jsonBody, tc.headers(t))
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
 			t.Fatal(err)
@@ -463,7 +573,17 @@ func PerformTestRequestCaseWithPathFn[R any](t *testing.T, method string, tc Tes
 	fiberApp := GetTestApp(t)
 
 	// Act
-	resp := PerformTestRequest(t, fiberApp, tc.DelayMs, method, tc.PathFn(), accessToken, "application/json", jsonBody)
+	resp := PerformTestRequestWithHeaders(
+		t,
+		fiberApp,
+		tc.DelayMs,
+		method,
+		tc.PathFn(),
+		accessToken,
+		"application/json",
+		jsonBody,
+		tc.headers(t),
+	)
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
 			t.Fatal(err)
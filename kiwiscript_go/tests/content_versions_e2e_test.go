@@ -0,0 +1,203 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func performVersionedRequest(t *testing.T, method, path, ifMatch, accessToken string, body interface{}) *http.Response {
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal("Failed to marshal body", err)
+		}
+		reader = bytes.NewBuffer(jsonBody)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	if ifMatch != "" {
+		req.Header.Set(fiber.HeaderIfMatch, ifMatch)
+	}
+
+	resp, err := GetTestApp(t).Test(req, 2000)
+	if err != nil {
+		t.Fatal("Failed to perform request", err)
+	}
+
+	return resp
+}
+
+func TestContentVersions(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	var sectionID, lessonID int32
+	func() {
+		testDb := GetTestDatabase(t)
+		testServices := GetTestServices(t)
+		ctx := context.Background()
+
+		params := db.CreateLanguageParams{
+			Name:     "Rust",
+			Icon:     strings.TrimSpace(languageIcons["Rust"]),
+			AuthorID: testUser.ID,
+			Slug:     "rust",
+		}
+		if _, err := testDb.CreateLanguage(ctx, params); err != nil {
+			t.Fatal("Failed to create language", err)
+		}
+
+		if _, err := testDb.CreateSeries(ctx, db.CreateSeriesParams{
+			Title:        "Existing Series",
+			Slug:         "existing-series",
+			Description:  "Some description",
+			LanguageSlug: "rust",
+			AuthorID:     testUser.ID,
+		}); err != nil {
+			t.Fatal("Failed to create series", "error", err)
+		}
+
+		section, serviceErr := testServices.CreateSection(ctx, services.CreateSectionOptions{
+			UserID:       testUser.ID,
+			Title:        "Some Section",
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			Description:  "Some description",
+		})
+		if serviceErr != nil {
+			t.Fatal("Failed to create section", "serviceError", serviceErr)
+		}
+		sectionID = section.ID
+
+		lesson, serviceErr := testServices.CreateLesson(ctx, services.CreateLessonOptions{
+			UserID:       testUser.ID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			SectionID:    sectionID,
+			Title:        "Some Lesson",
+		})
+		if serviceErr != nil {
+			t.Fatal("Failed to create lesson", "serviceError", serviceErr)
+		}
+		lessonID = lesson.ID
+
+		if _, serviceErr := testServices.CreateLessonArticle(ctx, services.CreateLessonArticleOptions{
+			UserID:       testUser.ID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			SectionID:    sectionID,
+			LessonID:     lessonID,
+			Content:      "Sample article content",
+		}); serviceErr != nil {
+			t.Fatal("Failed to create lesson article", "serviceError", serviceErr)
+		}
+	}()
+
+	testUser.IsStaff = true
+	accessToken, _ := GenerateTestAuthTokens(t, testUser)
+	sectionPath := fmt.Sprintf("%s/rust/series/existing-series/sections/%d", baseLanguagesPath, sectionID)
+	lessonPath := fmt.Sprintf("%s/lessons/%d", sectionPath, lessonID)
+	articlePath := lessonPath + "/article"
+	sectionBody := dtos.UpdateSectionBody{Title: "Other Section", Description: "New description", Position: 1}
+
+	t.Run("Should return 428 PRECONDITION REQUIRED when the If-Match header is missing", func(t *testing.T) {
+		resp := performVersionedRequest(t, http.MethodPut, sectionPath, "", accessToken, sectionBody)
+		AssertTestStatusCode(t, resp, fiber.StatusPreconditionRequired)
+	})
+
+	t.Run("Should return 428 PRECONDITION REQUIRED when the If-Match header is a wildcard", func(t *testing.T) {
+		resp := performVersionedRequest(t, http.MethodPut, sectionPath, "*", accessToken, sectionBody)
+		AssertTestStatusCode(t, resp, fiber.StatusPreconditionRequired)
+	})
+
+	t.Run("Should return the section version as an ETag", func(t *testing.T) {
+		resp := performVersionedRequest(t, http.MethodGet, sectionPath, "", accessToken, nil)
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v1"`)
+	})
+
+	t.Run("Should return 200 OK and bump the ETag when the section version matches", func(t *testing.T) {
+		resp := performVersionedRequest(t, http.MethodPut, sectionPath, `"v1"`, accessToken, sectionBody)
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v2"`)
+	})
+
+	t.Run("Should return 412 PRECONDITION FAILED with the current section when the version is stale", func(t *testing.T) {
+		staleBody := dtos.UpdateSectionBody{Title: "Stale Section", Description: "Stale description", Position: 1}
+		resp := performVersionedRequest(t, http.MethodPut, sectionPath, `"v1"`, accessToken, staleBody)
+		AssertTestStatusCode(t, resp, fiber.StatusPreconditionFailed)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v2"`)
+		resBody := AssertTestResponseBody(t, resp, dtos.SectionResponse{})
+		AssertEqual(t, resBody.Title, sectionBody.Title)
+		AssertEqual(t, resBody.Description, sectionBody.Description)
+	})
+
+	t.Run("Should return 412 PRECONDITION FAILED when the If-Match header is malformed", func(t *testing.T) {
+		resp := performVersionedRequest(t, http.MethodPut, sectionPath, "v2", accessToken, sectionBody)
+		AssertTestStatusCode(t, resp, fiber.StatusPreconditionFailed)
+	})
+
+	t.Run("Should return 412 PRECONDITION FAILED with the current lesson when the version is stale", func(t *testing.T) {
+		lessonBody := dtos.UpdateLessonBody{Title: "Other Lesson", Position: 1}
+		resp := performVersionedRequest(t, http.MethodPut, lessonPath, `"v1"`, accessToken, lessonBody)
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v2"`)
+
+		staleBody := dtos.UpdateLessonBody{Title: "Stale Lesson", Position: 1}
+		resp = performVersionedRequest(t, http.MethodPut, lessonPath, `"v1"`, accessToken, staleBody)
+		AssertTestStatusCode(t, resp, fiber.StatusPreconditionFailed)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v2"`)
+		resBody := AssertTestResponseBody(t, resp, dtos.LessonResponse{})
+		AssertEqual(t, resBody.Title, lessonBody.Title)
+	})
+
+	t.Run("Should return 412 PRECONDITION FAILED when deleting a stale lesson article", func(t *testing.T) {
+		articleBody := dtos.LessonArticleBody{Content: "Updated article content"}
+		resp := performVersionedRequest(t, http.MethodPut, articlePath, `"v1"`, accessToken, articleBody)
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v2"`)
+
+		resp = performVersionedRequest(t, http.MethodDelete, articlePath, `"v1"`, accessToken, nil)
+		AssertTestStatusCode(t, resp, fiber.StatusPreconditionFailed)
+		resBody := AssertTestResponseBody(t, resp, dtos.LessonArticleResponse{})
+		AssertEqual(t, resBody.Content, articleBody.Content)
+
+		resp = performVersionedRequest(t, http.MethodDelete, articlePath, `"v2"`, accessToken, nil)
+		AssertTestStatusCode(t, resp, fiber.StatusNoContent)
+	})
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
@@ -0,0 +1,372 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func createEditorialContent(t *testing.T) (*db.User, *db.User, db.Section, db.Lesson) {
+	testDb := GetTestDatabase(t)
+	testServices := GetTestServices(t)
+	ctx := context.Background()
+
+	author := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	reviewer := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	for _, user := range []*db.User{author, reviewer} {
+		if err := testDb.UpdateUserIsStaff(ctx, db.UpdateUserIsStaffParams{IsStaff: true, ID: user.ID}); err != nil {
+			t.Fatal("Failed to update user is staff", "error", err)
+		}
+		user.IsStaff = true
+	}
+
+	section, lesson := createScheduledContent(t, author.ID)
+	if _, serviceErr := testServices.UpdateLanguageRequiresReview(ctx, services.UpdateLanguageRequiresReviewOptions{
+		RequestID:      uuid.NewString(),
+		Slug:           "rust",
+		RequiresReview: true,
+	}); serviceErr != nil {
+		t.Fatal("Failed to update language requires review", "serviceError", serviceErr)
+	}
+
+	return author, reviewer, section, lesson
+}
+
+func addEditorialReviewer(t *testing.T, authorID, reviewerID int32) {
+	testServices := GetTestServices(t)
+	if _, serviceErr := testServices.AddSeriesEditorialReviewer(
+		context.Background(),
+		services.AddSeriesEditorialReviewerOptions{
+			RequestID:    uuid.NewString(),
+			UserID:       authorID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			ReviewerID:   reviewerID,
+		},
+	); serviceErr != nil {
+		t.Fatal("Failed to add series reviewer", "serviceError", serviceErr)
+	}
+}
+
+func updateEditorialStatus(t *testing.T, userID int32, status string) {
+	testServices := GetTestServices(t)
+	if _, serviceErr := testServices.UpdateSeriesEditorialStatus(
+		context.Background(),
+		services.UpdateSeriesEditorialStatusOptions{
+			RequestID:    uuid.NewString(),
+			UserID:       userID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			Status:       status,
+		},
+	); serviceErr != nil {
+		t.Fatal("Failed to update series editorial status", "serviceError", serviceErr)
+	}
+}
+
+func TestUpdateSeriesEditorialStatus(t *testing.T) {
+	languagesCleanUp(t)()
+	author, reviewer, _, _ := createEditorialContent(t)
+
+	path := baseLanguagesPath + "/rust/series/existing-series/editorial"
+	testCases := []TestRequestCase[dtos.SeriesEditorialStatusBody]{
+		{
+			Name: "Should return 400 BAD REQUEST when submitting a series without reviewers",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusInReview}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Series must have reviewers to be submitted for review")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when the author submits the series for review",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				addEditorialReviewer(t, author.ID, reviewer.ID)
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusInReview}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesEditorialResponse{})
+				AssertEqual(t, resBody.Status, services.EditorialStatusInReview)
+				AssertEqual(t, resBody.RequiresReview, true)
+				AssertEqual(t, len(resBody.Embedded.Reviewers), 1)
+				AssertEqual(t, resBody.Embedded.Reviewers[0].User.ID, reviewer.ID)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the author tries to approve the series",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusApproved}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when requesting changes without a comment",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, reviewer)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusChangesRequested}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Change requests must have a comment")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when the reviewer requests changes",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, reviewer)
+				return dtos.SeriesEditorialStatusBody{
+					Status:  services.EditorialStatusChangesRequested,
+					Comment: "The second lesson needs more examples",
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesEditorialResponse{})
+				AssertEqual(t, resBody.Status, services.EditorialStatusChangesRequested)
+				AssertEqual(t, len(resBody.Embedded.ChangeRequests), 1)
+				AssertEqual(t, resBody.Embedded.ChangeRequests[0].Comment, req.Comment)
+				AssertEqual(t, resBody.Embedded.ChangeRequests[0].ResolvedAt, "")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK and resolve change requests when the author resubmits",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusInReview}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesEditorialResponse{})
+				AssertEqual(t, resBody.Status, services.EditorialStatusInReview)
+				AssertEqual(t, len(resBody.Embedded.ChangeRequests), 1)
+				AssertNotEmpty(t, resBody.Embedded.ChangeRequests[0].ResolvedAt)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when the reviewer approves the series",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, reviewer)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusApproved}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesEditorialResponse{})
+				AssertEqual(t, resBody.Status, services.EditorialStatusApproved)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the transition is not allowed",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusInReview}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Series cannot move from approved to in_review")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is neither the author nor a reviewer",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				outsider := confirmTestUser(t, CreateTestUser(t, nil).ID)
+				outsider.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, outsider)
+				return dtos.SeriesEditorialStatusBody{Status: services.EditorialStatusDraft}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the status is unknown",
+			ReqFn: func(t *testing.T) (dtos.SeriesEditorialStatusBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.SeriesEditorialStatusBody{Status: "published"}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.SeriesEditorialStatusBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{{
+					Param:   "status",
+					Message: exceptions.FieldErrMessageInvalid,
+				}})
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPatch, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestPublishSeriesRequiresEditorialApproval(t *testing.T) {
+	languagesCleanUp(t)()
+	author, reviewer, _, _ := createEditorialContent(t)
+
+	path := baseLanguagesPath + "/rust/series/existing-series/publish"
+	testCases := []TestRequestCase[dtos.UpdateIsPublishedBody]{
+		{
+			Name: "Should return 400 BAD REQUEST when the series has not been approved",
+			ReqFn: func(t *testing.T) (dtos.UpdateIsPublishedBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.UpdateIsPublishedBody{IsPublished: true}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.UpdateIsPublishedBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Series must be approved before being published")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when the series has been approved",
+			ReqFn: func(t *testing.T) (dtos.UpdateIsPublishedBody, string) {
+				addEditorialReviewer(t, author.ID, reviewer.ID)
+				updateEditorialStatus(t, author.ID, services.EditorialStatusInReview)
+				updateEditorialStatus(t, reviewer.ID, services.EditorialStatusApproved)
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.UpdateIsPublishedBody{IsPublished: true}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.UpdateIsPublishedBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesResponse{})
+				AssertEqual(t, resBody.IsPublished, true)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPatch, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestLessonEditorialComments(t *testing.T) {
+	languagesCleanUp(t)()
+	author, reviewer, section, lesson := createEditorialContent(t)
+	addEditorialReviewer(t, author.ID, reviewer.ID)
+
+	path := fmt.Sprintf(
+		"%s/rust/series/existing-series/sections/%d/lessons/%d/editorial-comments",
+		baseLanguagesPath,
+		section.ID,
+		lesson.ID,
+	)
+	var commentID int32
+	createCases := []TestRequestCase[dtos.LessonEditorialCommentBody]{
+		{
+			Name: "Should return 201 CREATED when a reviewer comments on a lesson",
+			ReqFn: func(t *testing.T) (dtos.LessonEditorialCommentBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, reviewer)
+				return dtos.LessonEditorialCommentBody{Body: "Explain ownership before borrowing"}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, req dtos.LessonEditorialCommentBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonEditorialCommentResponse{})
+				AssertEqual(t, resBody.Body, req.Body)
+				AssertEqual(t, resBody.IsResolved, false)
+				AssertEqual(t, resBody.Author.ID, reviewer.ID)
+				commentID = resBody.ID
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is neither the author nor a reviewer",
+			ReqFn: func(t *testing.T) (dtos.LessonEditorialCommentBody, string) {
+				outsider := confirmTestUser(t, CreateTestUser(t, nil).ID)
+				outsider.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, outsider)
+				return dtos.LessonEditorialCommentBody{Body: "Looks good to me"}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.LessonEditorialCommentBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range createCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	resolveCases := []TestRequestCase[dtos.LessonEditorialCommentIsResolvedBody]{
+		{
+			Name: "Should return 200 OK when the author resolves the comment",
+			ReqFn: func(t *testing.T) (dtos.LessonEditorialCommentIsResolvedBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.LessonEditorialCommentIsResolvedBody{IsResolved: true}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.LessonEditorialCommentIsResolvedBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonEditorialCommentResponse{})
+				AssertEqual(t, resBody.ID, commentID)
+				AssertEqual(t, resBody.IsResolved, true)
+			},
+		},
+	}
+
+	for _, tc := range resolveCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPatch, fmt.Sprintf("%s/%d/resolve", path, commentID), tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
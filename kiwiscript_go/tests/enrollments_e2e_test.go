@@ -0,0 +1,413 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/app"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/payments"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func updateVersionedSeriesPrice(t *testing.T, authorID, price int32) {
+	testServices := GetTestServices(t)
+	if _, serviceErr := testServices.UpdateSeriesPrice(context.Background(), services.UpdateSeriesPriceOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       authorID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "existing-series",
+		Price:        price,
+		Currency:     "eur",
+	}); serviceErr != nil {
+		t.Fatal("Failed to update series price", "serviceError", serviceErr)
+	}
+}
+
+func performWebhookRequest(t *testing.T, payload, signature string) *http.Response {
+	req := httptest.NewRequest(fiber.MethodPost, "/api/v1/payments/webhook", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(payments.HeaderSignature, signature)
+
+	resp, err := GetTestApp(t).Test(req, 2000)
+	if err != nil {
+		t.Fatal("Failed to perform request", err)
+	}
+
+	return resp
+}
+
+func TestCreateEnrollment(t *testing.T) {
+	languagesCleanUp(t)()
+	author := createVersionedContent(t)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+
+	path := baseLanguagesPath + "/rust/series/existing-series/enrollment"
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not logged in",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 402 PAYMENT REQUIRED when the series is paid",
+			ReqFn: func(t *testing.T) (string, string) {
+				updateVersionedSeriesPrice(t, author.ID, 1999)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusPaymentRequired,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				updateVersionedSeriesPrice(t, author.ID, 0)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 201 CREATED with a free enrollment",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.EnrollmentResponse{})
+				AssertNotEmpty(t, resBody.ID)
+				AssertEqual(t, resBody.Source, db.EnrollmentSourceFree)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 409 CONFLICT when the user is already enrolled",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Already enrolled in the series")
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+}
+
+func TestCreateCheckout(t *testing.T) {
+	languagesCleanUp(t)()
+	author := createVersionedContent(t)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+
+	path := baseLanguagesPath + "/rust/series/existing-series/checkout"
+	testCases := []TestRequestCase[dtos.CheckoutBody]{
+		{
+			Name: "Should return 400 BAD REQUEST when the series is free",
+			ReqFn: func(t *testing.T) (dtos.CheckoutBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.CheckoutBody{}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.CheckoutBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Series is free, enroll instead")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the coupon does not exist",
+			ReqFn: func(t *testing.T) (dtos.CheckoutBody, string) {
+				updateVersionedSeriesPrice(t, author.ID, 1999)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.CheckoutBody{CouponCode: "NOTACOUPON"}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.CheckoutBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Invalid coupon code")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 201 CREATED with the checkout session",
+			ReqFn: func(t *testing.T) (dtos.CheckoutBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.CheckoutBody{}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ dtos.CheckoutBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.CheckoutResponse{})
+				AssertNotEmpty(t, resBody.SessionID)
+				AssertNotEmpty(t, resBody.CheckoutURL)
+				AssertEqual(t, resBody.Amount, 1999)
+				AssertEqual(t, resBody.Currency, "eur")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the author buys their own series",
+			ReqFn: func(t *testing.T) (dtos.CheckoutBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return dtos.CheckoutBody{}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.CheckoutBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Cannot buy your own series")
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+}
+
+func TestHandlePaymentWebhook(t *testing.T) {
+	languagesCleanUp(t)()
+	author := createVersionedContent(t)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	updateVersionedSeriesPrice(t, author.ID, 1999)
+
+	checkout, serviceErr := GetTestServices(t).CreateCheckout(context.Background(), services.CreateCheckoutOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       testUser.ID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "existing-series",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create checkout", "serviceError", serviceErr)
+	}
+
+	payload := fmt.Sprintf(
+		`{"id":"evt_%s","type":"%s","data":{"object":{"id":"%s","payment_status":"paid","amount_total":1999}}}`,
+		uuid.NewString(),
+		payments.EventCheckoutCompleted,
+		checkout.SessionID,
+	)
+	secret := GetTestConfig(t).Payments.WebhookSecret
+
+	t.Run("Should return 400 BAD REQUEST when the signature is invalid", func(t *testing.T) {
+		resp := performWebhookRequest(t, payload, payments.Sign("not-the-secret", time.Now().Unix(), []byte(payload)))
+		AssertTestStatusCode(t, resp, fiber.StatusBadRequest)
+		AssertValidationErrorWithoutFieldsResponse(t, resp, "Invalid signature")
+	})
+
+	t.Run("Should return 204 NO CONTENT and enroll the user when the payment completes", func(t *testing.T) {
+		for range 2 {
+			resp := performWebhookRequest(t, payload, payments.Sign(secret, time.Now().Unix(), []byte(payload)))
+			AssertTestStatusCode(t, resp, fiber.StatusNoContent)
+		}
+
+		enrollment, err := GetTestDatabase(t).FindEnrollmentByUserIDAndSeriesSlug(
+			context.Background(),
+			db.FindEnrollmentByUserIDAndSeriesSlugParams{
+				UserID:     testUser.ID,
+				SeriesSlug: "existing-series",
+			},
+		)
+		if err != nil {
+			t.Fatal("Failed to find enrollment", "error", err)
+		}
+		AssertEqual(t, enrollment.Source, db.EnrollmentSourcePaid)
+	})
+
+	t.Cleanup(languagesCleanUp(t))
+}
+
+func TestOrganizationLicenses(t *testing.T) {
+	languagesCleanUp(t)()
+	owner, member, organization, cohort := createTestCohortWithSeries(t)
+	updateVersionedSeriesPrice(t, owner.ID, 1999)
+
+	secondMember := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	invitation := createTestOrganizationInvitation(t, organization, secondMember.Email)
+	if _, serviceErr := GetTestServices(t).AcceptOrganizationInvitation(
+		context.Background(),
+		services.AcceptOrganizationInvitationOptions{
+			RequestID: uuid.NewString(),
+			UserID:    secondMember.ID,
+			Token:     invitation.Token,
+		},
+	); serviceErr != nil {
+		t.Fatal("Failed to accept organization invitation", "serviceError", serviceErr)
+	}
+
+	organizationPath := fmt.Sprintf("%s/%d", baseOrganizationsPath, organization.ID)
+	licensePath := organizationPath + "/licenses/existing-series"
+	cohortPath := fmt.Sprintf("%s/cohorts/%d", organizationPath, cohort.ID)
+	enrollmentPath := baseLanguagesPath + "/rust/series/existing-series/enrollment"
+	testCases := []TestRequestCase[interface{}]{
+		{
+			Name: "Should return 403 FORBIDDEN when an organization admin grants a license",
+			ReqFn: func(t *testing.T) (interface{}, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return dtos.OrganizationLicenseBody{LanguageSlug: "rust", Seats: 1}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ interface{}, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path:   licensePath,
+			Method: http.MethodPut,
+		},
+		{
+			Name: "Should return 402 PAYMENT REQUIRED when a cohort member enrolls without a license",
+			ReqFn: func(t *testing.T) (interface{}, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, member)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusPaymentRequired,
+			AssertFn:  func(t *testing.T, _ interface{}, _ *http.Response) {},
+			Path:      enrollmentPath,
+			Method:    http.MethodPost,
+		},
+		{
+			Name: "Should return 402 PAYMENT REQUIRED when assigning a paid series without a license",
+			ReqFn: func(t *testing.T) (interface{}, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return dtos.CohortSeriesBody{LanguageSlug: "rust"}, accessToken
+			},
+			ExpStatus: fiber.StatusPaymentRequired,
+			AssertFn:  func(t *testing.T, _ interface{}, _ *http.Response) {},
+			Path:      cohortPath + "/series/existing-series",
+			Method:    http.MethodPut,
+		},
+		{
+			Name: "Should return 200 OK when a platform admin grants a license",
+			ReqFn: func(t *testing.T) (interface{}, string) {
+				admin := *owner
+				admin.IsAdmin = true
+				accessToken, _ := GenerateTestAuthTokens(t, &admin)
+				return dtos.OrganizationLicenseBody{LanguageSlug: "rust", Seats: 1}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ interface{}, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.OrganizationLicenseResponse{})
+				AssertEqual(t, resBody.SeriesSlug, "existing-series")
+				AssertEqual(t, resBody.Seats, 1)
+			},
+			Path:   licensePath,
+			Method: http.MethodPut,
+		},
+		{
+			Name: "Should return 200 OK when assigning a licensed paid series",
+			ReqFn: func(t *testing.T) (interface{}, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return dtos.CohortSeriesBody{LanguageSlug: "rust"}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ interface{}, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.CohortSeriesResponse{})
+				AssertEqual(t, resBody.SeriesSlug, "existing-series")
+			},
+			Path:   cohortPath + "/series/existing-series",
+			Method: http.MethodPut,
+		},
+		{
+			Name: "Should return 409 CONFLICT when the license has no free seats for a new cohort member",
+			ReqFn: func(t *testing.T) (interface{}, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return dtos.CohortMemberBody{UserID: secondMember.ID}, accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ interface{}, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Organization license has no free seats for the series existing-series")
+			},
+			Path:   cohortPath + "/members",
+			Method: http.MethodPost,
+		},
+		{
+			Name: "Should return 201 CREATED with an organization enrollment for licensed cohort members",
+			ReqFn: func(t *testing.T) (interface{}, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, member)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ interface{}, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.EnrollmentResponse{})
+				AssertEqual(t, resBody.Source, db.EnrollmentSourceOrganization)
+			},
+			Path:   enrollmentPath,
+			Method: http.MethodPost,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, tc.Method, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestNewPayments(t *testing.T) {
+	log := app.DefaultLogger()
+	testCases := []struct {
+		Name          string
+		Provider      string
+		SecretKey     string
+		WebhookSecret string
+		ExpErr        bool
+	}{
+		{Name: "Should build the fake provider", Provider: payments.ProviderFake, WebhookSecret: "whsec_test"},
+		{Name: "Should build the stripe provider", Provider: payments.ProviderStripe, SecretKey: "sk_test", WebhookSecret: "whsec_test"},
+		{Name: "Should fail without a webhook secret", Provider: payments.ProviderFake, ExpErr: true},
+		{Name: "Should fail without a stripe secret key", Provider: payments.ProviderStripe, WebhookSecret: "whsec_test", ExpErr: true},
+		{Name: "Should fail with an unknown provider", Provider: "paypal", WebhookSecret: "whsec_test", ExpErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			provider, err := payments.NewPayments(log, tc.Provider, tc.SecretKey, tc.WebhookSecret, "localhost:3000")
+			if tc.ExpErr {
+				AssertEqual(t, provider == nil, true)
+				AssertEqual(t, err != nil, true)
+				return
+			}
+
+			AssertEqual(t, err == nil, true)
+			AssertEqual(t, provider.Name(), tc.Provider)
+		})
+	}
+}
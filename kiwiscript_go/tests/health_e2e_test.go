@@ -20,6 +20,9 @@ package tests
 import (
 	"net/http/httptest"
 	"testing"
+
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/services"
 )
 
 func TestHealth(t *testing.T) {
@@ -42,3 +45,34 @@ func TestHealth(t *testing.T) {
 		}
 	}()
 }
+
+func TestHealthLive(t *testing.T) {
+	resp := PerformTestRequest(t, GetTestApp(t), 0, "GET", "/api/health/live", "", "", nil)
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	AssertTestStatusCode(t, resp, 200)
+	resBody := AssertTestResponseBody(t, resp, dtos.HealthResponse{})
+	AssertEqual(t, resBody.Status, services.HealthStatusUp)
+}
+
+func TestHealthReady(t *testing.T) {
+	resp := PerformTestRequest(t, GetTestApp(t), 0, "GET", "/api/health/ready", "", "", nil)
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	AssertTestStatusCode(t, resp, 200)
+	resBody := AssertTestResponseBody(t, resp, dtos.HealthResponse{})
+	AssertEqual(t, resBody.Status, services.HealthStatusUp)
+	AssertEqual(t, resBody.ShuttingDown, false)
+	AssertEqual(t, len(resBody.Dependencies), 4)
+	for _, dependency := range resBody.Dependencies {
+		AssertEqual(t, dependency.Status, services.HealthStatusUp)
+	}
+}
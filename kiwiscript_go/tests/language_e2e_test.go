@@ -281,6 +281,7 @@ func TestGetLanguages(t *testing.T) {
 				t.Fatal("Failed to create language", err)
 			}
 		}
+		InvalidateTestCatalog(t)
 	}()
 
 	testCases := []TestRequestCase[string]{
@@ -0,0 +1,219 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func completeLearningStatsTestLesson(t *testing.T, userID int32, lesson *db.Lesson) {
+	testServices := GetTestServices(t)
+	ctx := context.Background()
+	requestID := uuid.NewString()
+
+	langOpts := services.CreateOrUpdateLanguageProgressOptions{
+		RequestID:    requestID,
+		UserID:       userID,
+		LanguageSlug: lesson.LanguageSlug,
+	}
+	if _, _, _, serviceErr := testServices.CreateOrUpdateLanguageProgress(ctx, langOpts); serviceErr != nil {
+		t.Fatal("Failed to create language progress", "serviceErr", serviceErr)
+	}
+
+	serOpts := services.CreateOrUpdateSeriesProgressOptions{
+		RequestID:    requestID,
+		UserID:       userID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+	}
+	if _, _, _, serviceErr := testServices.CreateOrUpdateSeriesProgress(ctx, serOpts); serviceErr != nil {
+		t.Fatal("Failed to create series progress", "serviceErr", serviceErr)
+	}
+
+	secOpts := services.CreateOrUpdateSectionProgressOptions{
+		RequestID:    requestID,
+		UserID:       userID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+	}
+	if _, _, _, serviceErr := testServices.CreateOrUpdateSectionProgress(ctx, secOpts); serviceErr != nil {
+		t.Fatal("Failed to create section progress", "serviceErr", serviceErr)
+	}
+
+	lesOpts := services.CreateOrUpdateLessonProgressOptions{
+		RequestID:    requestID,
+		UserID:       userID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+	}
+	if _, _, _, serviceErr := testServices.CreateOrUpdateLessonProgress(ctx, lesOpts); serviceErr != nil {
+		t.Fatal("Failed to create lesson progress", "serviceErr", serviceErr)
+	}
+
+	if _, _, _, serviceErr := testServices.CompleteLessonProgress(ctx, services.CompleteLessonProgressOptions(lesOpts)); serviceErr != nil {
+		t.Fatal("Failed to complete lesson progress", "serviceErr", serviceErr)
+	}
+}
+
+func TestGetMyLearningStats(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	newUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	completeLearningStatsTestLesson(t, testUser.ID, lesson)
+
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 200 OK with the streak and xp after completing a lesson",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LearningStatsResponse{})
+				AssertEqual(t, resBody.Timezone, "UTC")
+				AssertEqual(t, resBody.CurrentStreak, 1)
+				AssertEqual(t, resBody.LongestStreak, 1)
+				AssertNotEmpty(t, resBody.LastActivityDate)
+				AssertGreaterThan(t, resBody.XpTotal, 9)
+			},
+			Path: userPath + paths.MePath + paths.StreakPath,
+		},
+		{
+			Name: "Should return 200 OK with empty stats for a user without activity",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, newUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LearningStatsResponse{})
+				AssertEqual(t, resBody.CurrentStreak, 0)
+				AssertEqual(t, resBody.XpTotal, 0)
+				AssertEqual(t, resBody.LastActivityDate, "")
+			},
+			Path: userPath + paths.MePath + paths.StreakPath,
+		},
+		{
+			Name: "Should return 200 OK with the first lesson achievement unlocked",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, make([]dtos.AchievementResponse, 0))
+				for _, a := range resBody {
+					if a.Slug == "first-lesson" {
+						AssertEqual(t, a.IsUnlocked, true)
+						AssertNotEmpty(t, a.UnlockedAt)
+						return
+					}
+				}
+				t.Fatal("First lesson achievement not found")
+			},
+			Path: userPath + paths.MePath + paths.AchievementsPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: userPath + paths.MePath + paths.StreakPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestUpdateMyLearningStatsTimezone(t *testing.T) {
+	userCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+
+	testCases := []TestRequestCase[dtos.LearningStatsTimezoneBody]{
+		{
+			Name: "Should return 200 OK when the timezone is updated",
+			ReqFn: func(t *testing.T) (dtos.LearningStatsTimezoneBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LearningStatsTimezoneBody{Timezone: "Europe/Lisbon"}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.LearningStatsTimezoneBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LearningStatsResponse{})
+				AssertEqual(t, resBody.Timezone, req.Timezone)
+			},
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the timezone is unknown",
+			ReqFn: func(t *testing.T) (dtos.LearningStatsTimezoneBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LearningStatsTimezoneBody{Timezone: "Mars/Olympus_Mons"}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LearningStatsTimezoneBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{
+					{Param: "timezone", Message: exceptions.FieldErrMessageInvalid},
+				})
+			},
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (dtos.LearningStatsTimezoneBody, string) {
+				return dtos.LearningStatsTimezoneBody{Timezone: "Europe/Lisbon"}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.LearningStatsTimezoneBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPut, userPath+paths.MePath+paths.StreakPath, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
@@ -373,6 +373,7 @@ func TestGetLessonArticle(t *testing.T) {
 				if err := testDb.DeleteLessonArticle(ctx, lessonArticle.ID); err != nil {
 					t.Fatal("Failed to delete lesson article", "error", err)
 				}
+				InvalidateTestCatalog(t, "rust")
 				return "", ""
 			},
 			ExpStatus: fiber.StatusNotFound,
@@ -572,6 +573,7 @@ func TestUpdateLessonArticle(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = lessonArticleIfMatchFn(&lessonID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCase(t, http.MethodPut, tc.Path, tc)
 		})
@@ -748,6 +750,7 @@ func TestDeleteLessonArticle(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = lessonArticleIfMatchFn(&lessonID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCase(t, http.MethodDelete, tc.Path, tc)
 		})
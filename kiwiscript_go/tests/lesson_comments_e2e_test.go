@@ -0,0 +1,500 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func createLessonCommentsTestLesson(t *testing.T, staffUser *db.User) *db.Lesson {
+	testDb := GetTestDatabase(t)
+	ctx := context.Background()
+
+	prms := db.CreateLanguageParams{
+		Name:     "Rust",
+		Icon:     strings.TrimSpace(languageIcons["Rust"]),
+		AuthorID: staffUser.ID,
+		Slug:     "rust",
+	}
+	if _, err := testDb.CreateLanguage(ctx, prms); err != nil {
+		t.Fatal("Failed to create language", err)
+	}
+
+	series, err := testDb.CreateSeries(ctx, db.CreateSeriesParams{
+		LanguageSlug: "rust",
+		Title:        "Rust Series",
+		Slug:         "rust-series",
+		AuthorID:     staffUser.ID,
+		Description:  "Some cool rust series",
+	})
+	if err != nil {
+		t.Fatal("Failed to create series", err)
+	}
+
+	section, err := testDb.CreateSection(ctx, db.CreateSectionParams{
+		Title:        "Rust Section",
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+		Description:  "Some section",
+		AuthorID:     staffUser.ID,
+	})
+	if err != nil {
+		t.Fatal("Failed to create section", err)
+	}
+
+	lesson, err := testDb.CreateLesson(ctx, db.CreateLessonParams{
+		Title:        "Cool rust lesson",
+		AuthorID:     staffUser.ID,
+		SectionID:    section.ID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+	})
+	if err != nil {
+		t.Fatal("Failed to create lesson", err)
+	}
+
+	isPubLesPrms := db.UpdateLessonIsPublishedParams{
+		IsPublished: true,
+		ID:          lesson.ID,
+	}
+	if _, err := testDb.UpdateLessonIsPublished(ctx, isPubLesPrms); err != nil {
+		t.Fatal("Failed to update lesson is published", err)
+	}
+
+	isPubSecPrms := db.UpdateSectionIsPublishedParams{
+		IsPublished: true,
+		ID:          section.ID,
+	}
+	if _, err := testDb.UpdateSectionIsPublished(ctx, isPubSecPrms); err != nil {
+		t.Fatal("Failed to update section is published", err)
+	}
+
+	isPubSerPrms := db.UpdateSeriesIsPublishedParams{
+		IsPublished: true,
+		ID:          series.ID,
+	}
+	if _, err := testDb.UpdateSeriesIsPublished(ctx, isPubSerPrms); err != nil {
+		t.Fatal("Failed to update series is published", err)
+	}
+
+	return &lesson
+}
+
+func createTestLessonComment(t *testing.T, lesson *db.Lesson, userID, parentID int32) *db.LessonCommentModel {
+	testServices := GetTestServices(t)
+	comment, serviceErr := testServices.CreateLessonComment(context.Background(), services.CreateLessonCommentOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       userID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+		ParentID:     parentID,
+		Body:         "How does the borrow checker work?",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create lesson comment", serviceErr)
+	}
+
+	return comment
+}
+
+func deleteTestLessonComment(t *testing.T, lesson *db.Lesson, commentID int32) {
+	testServices := GetTestServices(t)
+	serviceErr := testServices.DeleteLessonComment(context.Background(), services.DeleteLessonCommentOptions{
+		RequestID:    uuid.NewString(),
+		IsStaff:      true,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+		CommentID:    commentID,
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to delete lesson comment", serviceErr)
+	}
+}
+
+func lockTestLessonComment(t *testing.T, lesson *db.Lesson, commentID int32) {
+	testServices := GetTestServices(t)
+	_, serviceErr := testServices.UpdateLessonCommentIsLocked(
+		context.Background(),
+		services.UpdateLessonCommentIsLockedOptions{
+			RequestID:    uuid.NewString(),
+			LanguageSlug: lesson.LanguageSlug,
+			SeriesSlug:   lesson.SeriesSlug,
+			SectionID:    lesson.SectionID,
+			LessonID:     lesson.ID,
+			CommentID:    commentID,
+			IsLocked:     true,
+		},
+	)
+	if serviceErr != nil {
+		t.Fatal("Failed to lock lesson comment", serviceErr)
+	}
+}
+
+func TestCreateLessonComment(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	commentsPath := fmt.Sprintf(
+		"%s/rust/series/rust-series/sections/%d/lessons/%d/comments",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+
+	testCases := []TestRequestCase[dtos.LessonCommentBody]{
+		{
+			Name: "Should return 201 CREATED when a comment is created",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentBody{Body: "How does the borrow checker work?"}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, req dtos.LessonCommentBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonCommentResponse{})
+				AssertEqual(t, resBody.Body, req.Body)
+				AssertEqual(t, resBody.RepliesCount, 0)
+				AssertEqual(t, resBody.Embedded.Author.ID, testUser.ID)
+				AssertNotEmpty(t, resBody.Links.Replies.Href)
+				deleteTestLessonComment(t, lesson, resBody.ID)
+			},
+			Path: commentsPath,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the body is too short",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentBody{Body: "a"}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{
+					{Param: "body", Message: exceptions.StrFieldErrMessageMin},
+				})
+			},
+			Path: commentsPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				return dtos.LessonCommentBody{Body: "How does the borrow checker work?"}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: commentsPath,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the lesson does not exist",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentBody{Body: "How does the borrow checker work?"}, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: fmt.Sprintf(
+				"%s/rust/series/rust-series/sections/%d/lessons/%d/comments",
+				baseLanguagesPath,
+				lesson.SectionID,
+				987654,
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestCreateLessonCommentReply(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	var commentID int32
+	repliesPathFn := func() string {
+		return fmt.Sprintf(
+			"%s/rust/series/rust-series/sections/%d/lessons/%d/comments/%d/replies",
+			baseLanguagesPath,
+			lesson.SectionID,
+			lesson.ID,
+			commentID,
+		)
+	}
+
+	testCases := []TestRequestCase[dtos.LessonCommentBody]{
+		{
+			Name: "Should return 201 CREATED when a reply is created",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				commentID = createTestLessonComment(t, lesson, testUser.ID, 0).ID
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return dtos.LessonCommentBody{Body: "It tracks ownership at compile time"}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, req dtos.LessonCommentBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonCommentResponse{})
+				AssertEqual(t, resBody.Body, req.Body)
+				AssertNotEmpty(t, resBody.Links.Parent.Href)
+				deleteTestLessonComment(t, lesson, commentID)
+			},
+			PathFn: repliesPathFn,
+		},
+		{
+			Name: "Should return 409 CONFLICT when the thread is locked",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				commentID = createTestLessonComment(t, lesson, testUser.ID, 0).ID
+				lockTestLessonComment(t, lesson, commentID)
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return dtos.LessonCommentBody{Body: "It tracks ownership at compile time"}, accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Comment thread is locked")
+				deleteTestLessonComment(t, lesson, commentID)
+			},
+			PathFn: repliesPathFn,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when replying to a reply",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				parent := createTestLessonComment(t, lesson, testUser.ID, 0)
+				commentID = createTestLessonComment(t, lesson, staffUser.ID, parent.ID).ID
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentBody{Body: "Thanks for the answer"}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, exceptions.RequestError{})
+				AssertEqual(t, resBody.Message, "Replies can only be added to top level comments")
+			},
+			PathFn: repliesPathFn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodPost, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestUpdateLessonComment(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	var commentID int32
+	commentPathFn := func() string {
+		return fmt.Sprintf(
+			"%s/rust/series/rust-series/sections/%d/lessons/%d/comments/%d",
+			baseLanguagesPath,
+			lesson.SectionID,
+			lesson.ID,
+			commentID,
+		)
+	}
+
+	testCases := []TestRequestCase[dtos.LessonCommentBody]{
+		{
+			Name: "Should return 200 OK when the author updates the comment",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				commentID = createTestLessonComment(t, lesson, testUser.ID, 0).ID
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentBody{Body: "How does the borrow checker track lifetimes?"}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.LessonCommentBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonCommentResponse{})
+				AssertEqual(t, resBody.Body, req.Body)
+				deleteTestLessonComment(t, lesson, commentID)
+			},
+			PathFn: commentPathFn,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not the author",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				commentID = createTestLessonComment(t, lesson, testUser.ID, 0).ID
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return dtos.LessonCommentBody{Body: "How does the borrow checker track lifetimes?"}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+				deleteTestLessonComment(t, lesson, commentID)
+			},
+			PathFn: commentPathFn,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the thread is locked",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				commentID = createTestLessonComment(t, lesson, testUser.ID, 0).ID
+				lockTestLessonComment(t, lesson, commentID)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentBody{Body: "How does the borrow checker track lifetimes?"}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+				deleteTestLessonComment(t, lesson, commentID)
+			},
+			PathFn: commentPathFn,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the reply belongs to a locked thread",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentBody, string) {
+				parentID := createTestLessonComment(t, lesson, staffUser.ID, 0).ID
+				commentID = createTestLessonComment(t, lesson, testUser.ID, parentID).ID
+				lockTestLessonComment(t, lesson, parentID)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentBody{Body: "It tracks ownership at compile time"}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			PathFn: commentPathFn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodPut, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestUpdateLessonCommentIsAnswer(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherStaffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	staffUser.IsStaff = true
+	otherStaffUser.IsStaff = true
+
+	var commentID, replyID int32
+	beforeEach := func(t *testing.T) {
+		commentID = createTestLessonComment(t, lesson, testUser.ID, 0).ID
+		replyID = createTestLessonComment(t, lesson, otherStaffUser.ID, commentID).ID
+	}
+	afterEach := func(t *testing.T) {
+		deleteTestLessonComment(t, lesson, commentID)
+	}
+	answerPathFn := func() string {
+		return fmt.Sprintf(
+			"%s/rust/series/rust-series/sections/%d/lessons/%d/comments/%d/answer",
+			baseLanguagesPath,
+			lesson.SectionID,
+			lesson.ID,
+			replyID,
+		)
+	}
+
+	testCases := []TestRequestCase[dtos.LessonCommentIsAnswerBody]{
+		{
+			Name: "Should return 200 OK and mark the parent as answered",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentIsAnswerBody, string) {
+				beforeEach(t)
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return dtos.LessonCommentIsAnswerBody{IsAnswer: true}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentIsAnswerBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonCommentResponse{})
+				AssertEqual(t, resBody.IsAnswer, true)
+
+				parent, err := GetTestDatabase(t).FindLessonCommentByIDWithAuthor(context.Background(), commentID)
+				if err != nil {
+					t.Fatal("Failed to find parent comment", err)
+				}
+				AssertEqual(t, parent.IsAnswered, true)
+				afterEach(t)
+			},
+			PathFn: answerPathFn,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not the series author",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentIsAnswerBody, string) {
+				beforeEach(t)
+				accessToken, _ := GenerateTestAuthTokens(t, otherStaffUser)
+				return dtos.LessonCommentIsAnswerBody{IsAnswer: true}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentIsAnswerBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+				afterEach(t)
+			},
+			PathFn: answerPathFn,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not staff",
+			ReqFn: func(t *testing.T) (dtos.LessonCommentIsAnswerBody, string) {
+				beforeEach(t)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonCommentIsAnswerBody{IsAnswer: true}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.LessonCommentIsAnswerBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+				afterEach(t)
+			},
+			PathFn: answerPathFn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodPatch, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func TestCreateLessonEvents(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	completeLearningStatsTestLesson(t, testUser.ID, lesson)
+
+	eventsPath := fmt.Sprintf(
+		"%s/rust/series/rust-series/sections/%d/lessons/%d/events",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+	openedAt := time.Now().Add(-10 * time.Minute).UTC()
+	events := []dtos.LessonEventBody{
+		{
+			ID:         uuid.NewString(),
+			Type:       services.LessonEventOpen,
+			OccurredAt: openedAt.Format(time.RFC3339),
+		},
+		{
+			ID:          uuid.NewString(),
+			Type:        services.LessonEventScroll,
+			ScrollDepth: 60,
+			OccurredAt:  openedAt.Add(2 * time.Minute).Format(time.RFC3339),
+		},
+	}
+
+	testCases := []TestRequestCase[dtos.LessonEventsBody]{
+		{
+			Name: "Should return 202 ACCEPTED when the events are recorded",
+			ReqFn: func(t *testing.T) (dtos.LessonEventsBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonEventsBody{Events: events}, accessToken
+			},
+			ExpStatus: fiber.StatusAccepted,
+			AssertFn: func(t *testing.T, _ dtos.LessonEventsBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonEventsResponse{})
+				AssertEqual(t, resBody.Received, 2)
+				AssertEqual(t, resBody.Recorded, 2)
+				AssertNotEmpty(t, resBody.Links.Progress.Href)
+			},
+			Path: eventsPath,
+		},
+		{
+			Name: "Should return 202 ACCEPTED without recording events that were already sent",
+			ReqFn: func(t *testing.T) (dtos.LessonEventsBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonEventsBody{Events: events}, accessToken
+			},
+			ExpStatus: fiber.StatusAccepted,
+			AssertFn: func(t *testing.T, _ dtos.LessonEventsBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonEventsResponse{})
+				AssertEqual(t, resBody.Received, 2)
+				AssertEqual(t, resBody.Recorded, 0)
+			},
+			Path: eventsPath,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when an event is older than a day",
+			ReqFn: func(t *testing.T) (dtos.LessonEventsBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonEventsBody{Events: []dtos.LessonEventBody{{
+					ID:         uuid.NewString(),
+					Type:       services.LessonEventOpen,
+					OccurredAt: time.Now().Add(-48 * time.Hour).UTC().Format(time.RFC3339),
+				}}}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LessonEventsBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Lesson events must have occurred within the last 24 hours")
+			},
+			Path: eventsPath,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when a file download has no file",
+			ReqFn: func(t *testing.T) (dtos.LessonEventsBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonEventsBody{Events: []dtos.LessonEventBody{{
+					ID:         uuid.NewString(),
+					Type:       services.LessonEventFileDownload,
+					OccurredAt: time.Now().UTC().Format(time.RFC3339),
+				}}}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LessonEventsBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "File download events require a file ID")
+			},
+			Path: eventsPath,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the user has no progress on the lesson",
+			ReqFn: func(t *testing.T) (dtos.LessonEventsBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherUser)
+				return dtos.LessonEventsBody{Events: events}, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ dtos.LessonEventsBody, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: eventsPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (dtos.LessonEventsBody, string) {
+				return dtos.LessonEventsBody{Events: events}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.LessonEventsBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: eventsPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestRollupLessonEngagement(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	completeLearningStatsTestLesson(t, testUser.ID, lesson)
+
+	ctx := context.Background()
+	testServices := GetTestServices(t)
+	openedAt := time.Now().Add(-10 * time.Minute)
+	_, serviceErr := testServices.CreateLessonEvents(ctx, services.CreateLessonEventsOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       testUser.ID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+		Events: []services.LessonEvent{
+			{ClientEventID: uuid.New(), Type: services.LessonEventOpen, OccurredAt: openedAt},
+			{ClientEventID: uuid.New(), Type: services.LessonEventScroll, ScrollDepth: 40, OccurredAt: openedAt.Add(time.Minute)},
+			// Idle gaps are capped at five minutes
+			{ClientEventID: uuid.New(), Type: services.LessonEventScroll, ScrollDepth: 80, OccurredAt: openedAt.Add(9 * time.Minute)},
+		},
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create lesson events", serviceErr)
+	}
+
+	testServices.RollupLessonEngagement(ctx, uuid.NewString(), 12)
+
+	progress, err := GetTestDatabase(t).FindLessonProgressBySlugsIDsAndUserID(ctx, db.FindLessonProgressBySlugsIDsAndUserIDParams{
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+		UserID:       testUser.ID,
+	})
+	if err != nil {
+		t.Fatal("Failed to find lesson progress", err)
+	}
+	AssertEqual(t, progress.TimeOnTaskSeconds, int32(360))
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
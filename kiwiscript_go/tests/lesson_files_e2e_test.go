@@ -123,7 +123,8 @@ func TestUploadLessonFile(t *testing.T) {
 				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileResponse{})
 				AssertEqual(t, resBody.Name, "Some Rust Cheatsheet")
 				AssertEqual(t, resBody.Ext, "pdf")
-				AssertStringContains(t, resBody.URL, resBody.ID.String()+".pdf")
+				AssertEqual(t, resBody.URL, resBody.Links.Download.Href)
+				AssertStringContains(t, resBody.URL, resBody.ID.String()+"/download")
 				AssertStringContains(t, resBody.Links.Lesson.Href, fmt.Sprintf(
 					"%s/rust/series/existing-series/sections/%d/lessons/%d",
 					baseLanguagesPath,
@@ -695,7 +696,8 @@ func TestGetLessonFile(t *testing.T) {
 				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileResponse{})
 				AssertEqual(t, resBody.Name, "test-file.pdf")
 				AssertEqual(t, resBody.Ext, "pdf")
-				AssertStringContains(t, resBody.URL, fileID.String()+".pdf")
+				AssertEqual(t, resBody.URL, resBody.Links.Download.Href)
+				AssertStringContains(t, resBody.URL, fileID.String()+"/download")
 				AssertStringContains(t, resBody.Links.Lesson.Href, fmt.Sprintf(
 					"%s/rust/series/existing-series/sections/%d/lessons/%d",
 					baseLanguagesPath,
@@ -768,7 +770,8 @@ func TestGetLessonFile(t *testing.T) {
 				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileResponse{})
 				AssertEqual(t, resBody.Name, "test-file.pdf")
 				AssertEqual(t, resBody.Ext, "pdf")
-				AssertStringContains(t, resBody.URL, fileID.String()+".pdf")
+				AssertEqual(t, resBody.URL, resBody.Links.Download.Href)
+				AssertStringContains(t, resBody.URL, fileID.String()+"/download")
 				AssertStringContains(t, resBody.Links.Lesson.Href, fmt.Sprintf(
 					"%s/rust/series/existing-series/sections/%d/lessons/%d",
 					baseLanguagesPath,
@@ -1284,7 +1287,8 @@ func TestUpdateLessonFile(t *testing.T) {
 				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileResponse{})
 				AssertEqual(t, resBody.Name, req.Name)
 				AssertEqual(t, resBody.Ext, "pdf")
-				AssertStringContains(t, resBody.URL, fileID.String()+".pdf")
+				AssertEqual(t, resBody.URL, resBody.Links.Download.Href)
+				AssertStringContains(t, resBody.URL, fileID.String()+"/download")
 				AssertStringContains(t, resBody.Links.Lesson.Href, fmt.Sprintf(
 					"%s/rust/series/existing-series/sections/%d/lessons/%d",
 					baseLanguagesPath,
@@ -1457,3 +1461,121 @@ func TestUpdateLessonFile(t *testing.T) {
 	t.Cleanup(languagesCleanUp(t))
 	t.Cleanup(userCleanUp(t))
 }
+
+func TestDownloadLessonFile(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	staffUser.IsStaff = true
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	lessonFile, serviceErr := GetTestServices(t).UploadLessonFile(context.Background(), services.UploadLessonFileOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       staffUser.ID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+		Name:         "test-file.pdf",
+		FileHeader:   FileUploadMock(t),
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to upload lesson file", "error", serviceErr)
+	}
+
+	filesPath := fmt.Sprintf(
+		"%s/rust/series/rust-series/sections/%d/lessons/%d/files",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+	downloadPath := fmt.Sprintf("%s/%s/download", filesPath, lessonFile.ID.String())
+
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 200 OK with a short lived URL and count the download",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderCacheControl), "no-store")
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileDownloadResponse{})
+				AssertStringContains(t, resBody.URL, lessonFile.ID.String()+".pdf")
+				AssertNotEmpty(t, resBody.ExpiresAt)
+				AssertEqual(t, resBody.Downloads, 1)
+			},
+			Path: downloadPath,
+		},
+		{
+			Name: "Should return 200 OK and count the downloads of the same user",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileDownloadResponse{})
+				AssertEqual(t, resBody.Downloads, 2)
+			},
+			Path: downloadPath,
+		},
+		{
+			Name: "Should return 200 OK when an anonymous user downloads a free lesson file",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileDownloadResponse{})
+				AssertNotEmpty(t, resBody.URL)
+				AssertEqual(t, resBody.Downloads, 0)
+			},
+			Path: downloadPath,
+		},
+		{
+			Name: "Should return 200 OK without counting staff downloads",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonFileDownloadResponse{})
+				AssertEqual(t, resBody.Downloads, 0)
+			},
+			Path: downloadPath,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the file does not exist",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: fmt.Sprintf("%s/%s/download", filesPath, uuid.NewString()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	storedFile, err := GetTestDatabase(t).FindLessonFileByIDAndLessonID(
+		context.Background(),
+		db.FindLessonFileByIDAndLessonIDParams{ID: lessonFile.ID, LessonID: lesson.ID},
+	)
+	if err != nil {
+		t.Fatal("Failed to find lesson file", err)
+	}
+	AssertEqual(t, storedFile.DownloadCount, int32(3))
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
@@ -0,0 +1,763 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func createTestLessonNote(t *testing.T, lesson *db.Lesson, userID int32, body, anchorHeading string) *db.LessonNoteModel {
+	testServices := GetTestServices(t)
+	note, serviceErr := testServices.CreateLessonNote(context.Background(), services.CreateLessonNoteOptions{
+		RequestID:     uuid.NewString(),
+		UserID:        userID,
+		LanguageSlug:  lesson.LanguageSlug,
+		SeriesSlug:    lesson.SeriesSlug,
+		SectionID:     lesson.SectionID,
+		LessonID:      lesson.ID,
+		Body:          body,
+		AnchorHeading: anchorHeading,
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create lesson note", serviceErr)
+	}
+
+	return note
+}
+
+func TestCreateLessonNote(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	notesPath := fmt.Sprintf(
+		"%s/rust/series/rust-series/sections/%d/lessons/%d/notes",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+
+	testCases := []TestRequestCase[dtos.LessonNoteBody]{
+		{
+			Name: "Should return 201 CREATED when a note is created",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonNoteBody{
+					Body:          "Borrowed references cannot outlive their owner",
+					AnchorHeading: "Lifetimes",
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, req dtos.LessonNoteBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonNoteResponse{})
+				AssertEqual(t, resBody.Body, req.Body)
+				AssertEqual(t, resBody.AnchorHeading, req.AnchorHeading)
+				AssertEqual(t, resBody.AnchorSeconds, 0)
+				AssertNotEmpty(t, resBody.Links.Self.Href)
+			},
+			Path: notesPath,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the body is empty",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonNoteBody{Body: ""}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LessonNoteBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{
+					{Param: "body", Message: exceptions.FieldErrMessageRequired},
+				})
+			},
+			Path: notesPath,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the anchor is past the end of the video",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonNoteBody{
+					Body:          "Borrowed references cannot outlive their owner",
+					AnchorSeconds: 120,
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LessonNoteBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Anchor seconds exceed the lesson video duration")
+			},
+			Path: notesPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				return dtos.LessonNoteBody{Body: "Borrowed references cannot outlive their owner"}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.LessonNoteBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: notesPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestExportSeriesLessonNotes(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	createTestLessonNote(t, lesson, testUser.ID, "Borrowed references cannot outlive their owner", "Lifetimes")
+	createTestLessonNote(t, lesson, otherUser.ID, "Somebody else's note", "")
+
+	exportPath := fmt.Sprintf("%s/rust/series/rust-series/notes/export", baseLanguagesPath)
+
+	testCases := []TestRequestCase[any]{
+		{
+			Name: "Should return 200 OK with the user's notes as markdown",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertStringContains(t, resp.Header.Get(fiber.HeaderContentType), "text/markdown")
+				AssertStringContains(t, resp.Header.Get(fiber.HeaderContentDisposition), "rust-series-notes.md")
+
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatal("Failed to read response body", err)
+				}
+
+				markdown := string(body)
+				AssertStringContains(t, markdown, "# Rust Series notes")
+				AssertStringContains(t, markdown, "## Rust Section")
+				AssertStringContains(t, markdown, "### Cool rust lesson")
+				AssertStringContains(t, markdown, "> Lifetimes")
+				AssertStringContains(t, markdown, "Borrowed references cannot outlive their owner")
+				if strings.Contains(markdown, "Somebody else's note") {
+					t.Fatal("Export contains notes from another user")
+				}
+			},
+			Path: exportPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (any, string) {
+				return nil, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: exportPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestGetLessonNotes(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	createTestLessonNote(t, lesson, testUser.ID, "Borrowed references cannot outlive their owner", "Lifetimes")
+	createTestLessonNote(t, lesson, testUser.ID, "Moves invalidate the previous binding", "Ownership")
+	createTestLessonNote(t, lesson, otherUser.ID, "Somebody else's note", "")
+
+	notesPath := fmt.Sprintf(
+		"%s/rust/series/rust-series/sections/%d/lessons/%d/notes",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+
+	testCases := []TestRequestCase[any]{
+		{
+			Name: "Should return 200 OK with only the user's notes",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.LessonNoteResponse]{})
+				AssertEqual(t, resBody.Count, int64(2))
+				AssertEqual(t, len(resBody.Results), 2)
+				for _, note := range resBody.Results {
+					if note.Body == "Somebody else's note" {
+						t.Fatal("Notes list contains notes from another user")
+					}
+				}
+			},
+			Path: notesPath,
+		},
+		{
+			Name: "Should return 200 OK with an empty list when the user has no notes",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.LessonNoteResponse]{})
+				AssertEqual(t, resBody.Count, int64(0))
+				AssertEqual(t, len(resBody.Results), 0)
+			},
+			Path: notesPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (any, string) {
+				return nil, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: notesPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestUpdateLessonNote(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	var noteID int32
+	notePathFn := func() string {
+		return fmt.Sprintf(
+			"%s/rust/series/rust-series/sections/%d/lessons/%d/notes/%d",
+			baseLanguagesPath,
+			lesson.SectionID,
+			lesson.ID,
+			noteID,
+		)
+	}
+
+	testCases := []TestRequestCase[dtos.LessonNoteBody]{
+		{
+			Name: "Should return 200 OK when the owner updates the note",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				noteID = createTestLessonNote(t, lesson, testUser.ID, "Borrowed references", "").ID
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonNoteBody{
+					Body:          "Borrowed references cannot outlive their owner",
+					AnchorHeading: "Lifetimes",
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.LessonNoteBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonNoteResponse{})
+				AssertEqual(t, resBody.ID, noteID)
+				AssertEqual(t, resBody.Body, req.Body)
+				AssertEqual(t, resBody.AnchorHeading, req.AnchorHeading)
+			},
+			PathFn: notePathFn,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the body is empty",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				noteID = createTestLessonNote(t, lesson, testUser.ID, "Borrowed references", "").ID
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonNoteBody{Body: ""}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.LessonNoteBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{
+					{Param: "body", Message: exceptions.FieldErrMessageRequired},
+				})
+			},
+			PathFn: notePathFn,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the note belongs to another user",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				noteID = createTestLessonNote(t, lesson, otherUser.ID, "Somebody else's note", "").ID
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.LessonNoteBody{Body: "Borrowed references cannot outlive their owner"}, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ dtos.LessonNoteBody, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			PathFn: notePathFn,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (dtos.LessonNoteBody, string) {
+				noteID = createTestLessonNote(t, lesson, testUser.ID, "Borrowed references", "").ID
+				return dtos.LessonNoteBody{Body: "Borrowed references cannot outlive their owner"}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.LessonNoteBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			PathFn: notePathFn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodPut, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestDeleteLessonNote(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	var noteID int32
+	notePathFn := func() string {
+		return fmt.Sprintf(
+			"%s/rust/series/rust-series/sections/%d/lessons/%d/notes/%d",
+			baseLanguagesPath,
+			lesson.SectionID,
+			lesson.ID,
+			noteID,
+		)
+	}
+
+	testCases := []TestRequestCase[any]{
+		{
+			Name: "Should return 204 NO CONTENT when the owner deletes the note",
+			ReqFn: func(t *testing.T) (any, string) {
+				noteID = createTestLessonNote(t, lesson, testUser.ID, "Borrowed references", "").ID
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusNoContent,
+			AssertFn: func(t *testing.T, _ any, _ *http.Response) {
+				_, err := GetTestDatabase(t).FindLessonNoteByIDAndUserID(
+					context.Background(),
+					db.FindLessonNoteByIDAndUserIDParams{ID: noteID, UserID: testUser.ID},
+				)
+				if err == nil {
+					t.Fatal("Lesson note was not deleted")
+				}
+			},
+			PathFn: notePathFn,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the note belongs to another user",
+			ReqFn: func(t *testing.T) (any, string) {
+				noteID = createTestLessonNote(t, lesson, otherUser.ID, "Somebody else's note", "").ID
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			PathFn: notePathFn,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (any, string) {
+				noteID = createTestLessonNote(t, lesson, testUser.ID, "Borrowed references", "").ID
+				return nil, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			PathFn: notePathFn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodDelete, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func createTestSeriesBookmark(t *testing.T, lesson *db.Lesson, userID int32) *db.SeriesBookmarkModel {
+	testServices := GetTestServices(t)
+	bookmark, serviceErr := testServices.CreateSeriesBookmark(context.Background(), services.SeriesBookmarkOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       userID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create series bookmark", serviceErr)
+	}
+
+	return bookmark
+}
+
+func createTestLessonBookmark(t *testing.T, lesson *db.Lesson, userID int32) *db.LessonBookmarkModel {
+	testServices := GetTestServices(t)
+	bookmark, serviceErr := testServices.CreateLessonBookmark(context.Background(), services.LessonBookmarkOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       userID,
+		LanguageSlug: lesson.LanguageSlug,
+		SeriesSlug:   lesson.SeriesSlug,
+		SectionID:    lesson.SectionID,
+		LessonID:     lesson.ID,
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create lesson bookmark", serviceErr)
+	}
+
+	return bookmark
+}
+
+func TestCreateBookmarks(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+
+	seriesBookmarkPath := fmt.Sprintf("%s/rust/series/rust-series/bookmark", baseLanguagesPath)
+	lessonBookmarkPath := fmt.Sprintf(
+		"%s/rust/series/rust-series/sections/%d/lessons/%d/bookmark",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+
+	testCases := []TestRequestCase[any]{
+		{
+			Name: "Should return 201 CREATED when a series is bookmarked",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesBookmarkResponse{})
+				AssertEqual(t, resBody.SeriesTitle, "Rust Series")
+				AssertStringContains(t, resBody.Links.Self.Href, "/series/rust-series")
+			},
+			Path: seriesBookmarkPath,
+		},
+		{
+			Name: "Should return 409 CONFLICT when the series is already bookmarked",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Series already bookmarked")
+			},
+			Path: seriesBookmarkPath,
+		},
+		{
+			Name: "Should return 201 CREATED when another user bookmarks the same series",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertTestResponseBody(t, resp, dtos.SeriesBookmarkResponse{})
+			},
+			Path: seriesBookmarkPath,
+		},
+		{
+			Name: "Should return 201 CREATED when a lesson is bookmarked",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonBookmarkResponse{})
+				AssertEqual(t, resBody.LessonTitle, lesson.Title)
+				AssertStringContains(t, resBody.Links.Self.Href, fmt.Sprintf("/lessons/%d", lesson.ID))
+			},
+			Path: lessonBookmarkPath,
+		},
+		{
+			Name: "Should return 409 CONFLICT when the lesson is already bookmarked",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Lesson already bookmarked")
+			},
+			Path: lessonBookmarkPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (any, string) {
+				return nil, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: lessonBookmarkPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestGetMyBookmarks(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	createTestSeriesBookmark(t, lesson, testUser.ID)
+	createTestLessonBookmark(t, lesson, testUser.ID)
+
+	const myBookmarksPath = "/api/v1/users/me/bookmarks"
+
+	testCases := []TestRequestCase[any]{
+		{
+			Name: "Should return 200 OK with the user's series bookmarks",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.SeriesBookmarkResponse]{})
+				AssertEqual(t, resBody.Count, int64(1))
+				AssertEqual(t, len(resBody.Results), 1)
+				AssertEqual(t, resBody.Results[0].SeriesTitle, "Rust Series")
+			},
+			Path: myBookmarksPath + "/series",
+		},
+		{
+			Name: "Should return 200 OK with the user's lesson bookmarks",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.LessonBookmarkResponse]{})
+				AssertEqual(t, resBody.Count, int64(1))
+				AssertEqual(t, len(resBody.Results), 1)
+				AssertEqual(t, resBody.Results[0].LessonTitle, lesson.Title)
+			},
+			Path: myBookmarksPath + "/lessons",
+		},
+		{
+			Name: "Should return 200 OK with an empty list for a user without bookmarks",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.LessonBookmarkResponse]{})
+				AssertEqual(t, resBody.Count, int64(0))
+				AssertEqual(t, len(resBody.Results), 0)
+			},
+			Path: myBookmarksPath + "/lessons",
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (any, string) {
+				return nil, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: myBookmarksPath + "/series",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestDeleteBookmarks(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	lesson := createLessonCommentsTestLesson(t, staffUser)
+	createTestSeriesBookmark(t, lesson, otherUser.ID)
+	createTestLessonBookmark(t, lesson, otherUser.ID)
+
+	seriesBookmarkPath := fmt.Sprintf("%s/rust/series/rust-series/bookmark", baseLanguagesPath)
+	lessonBookmarkPath := fmt.Sprintf(
+		"%s/rust/series/rust-series/sections/%d/lessons/%d/bookmark",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+
+	testCases := []TestRequestCase[any]{
+		{
+			Name: "Should return 404 NOT FOUND when only another user bookmarked the series",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: seriesBookmarkPath,
+		},
+		{
+			Name: "Should return 204 NO CONTENT when the series bookmark is deleted",
+			ReqFn: func(t *testing.T) (any, string) {
+				createTestSeriesBookmark(t, lesson, testUser.ID)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusNoContent,
+			AssertFn: func(t *testing.T, _ any, _ *http.Response) {
+				_, err := GetTestDatabase(t).FindSeriesBookmarkByUserIDAndSeriesSlug(
+					context.Background(),
+					db.FindSeriesBookmarkByUserIDAndSeriesSlugParams{
+						UserID:     otherUser.ID,
+						SeriesSlug: lesson.SeriesSlug,
+					},
+				)
+				if err != nil {
+					t.Fatal("Deleted another user's series bookmark", err)
+				}
+			},
+			Path: seriesBookmarkPath,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when only another user bookmarked the lesson",
+			ReqFn: func(t *testing.T) (any, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: lessonBookmarkPath,
+		},
+		{
+			Name: "Should return 204 NO CONTENT when the lesson bookmark is deleted",
+			ReqFn: func(t *testing.T) (any, string) {
+				createTestLessonBookmark(t, lesson, testUser.ID)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return nil, accessToken
+			},
+			ExpStatus: fiber.StatusNoContent,
+			AssertFn: func(t *testing.T, _ any, _ *http.Response) {
+				_, err := GetTestDatabase(t).FindLessonBookmarkByUserIDAndLessonID(
+					context.Background(),
+					db.FindLessonBookmarkByUserIDAndLessonIDParams{
+						UserID:   otherUser.ID,
+						LessonID: lesson.ID,
+					},
+				)
+				if err != nil {
+					t.Fatal("Deleted another user's lesson bookmark", err)
+				}
+			},
+			Path: lessonBookmarkPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (any, string) {
+				return nil, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ any, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: lessonBookmarkPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodDelete, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
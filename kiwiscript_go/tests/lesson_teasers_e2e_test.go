@@ -0,0 +1,164 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const membersOnlyArticle string = "# Introduction\n\nSome text\n\n```\n# not a heading\n```\n\n## Ownership\n\nMore text"
+
+func createMembersOnlyContent(t *testing.T) (*db.User, db.Lesson) {
+	testDb := GetTestDatabase(t)
+	testServices := GetTestServices(t)
+	ctx := context.Background()
+
+	author := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	if err := testDb.UpdateUserIsStaff(ctx, db.UpdateUserIsStaffParams{IsStaff: true, ID: author.ID}); err != nil {
+		t.Fatal("Failed to update user is staff", "error", err)
+	}
+	author.IsStaff = true
+
+	section, lesson := createScheduledContent(t, author.ID)
+	if _, serviceErr := testServices.CreateLessonArticle(ctx, services.CreateLessonArticleOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       author.ID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "existing-series",
+		SectionID:    section.ID,
+		LessonID:     lesson.ID,
+		Content:      membersOnlyArticle,
+	}); serviceErr != nil {
+		t.Fatal("Failed to create lesson article", "serviceError", serviceErr)
+	}
+	if _, err := testDb.UpdateLessonIsPublished(ctx, db.UpdateLessonIsPublishedParams{
+		IsPublished: true,
+		ID:          lesson.ID,
+	}); err != nil {
+		t.Fatal("Failed to update lesson is published", "error", err)
+	}
+	if _, err := testDb.UpdateSectionIsPublished(ctx, db.UpdateSectionIsPublishedParams{
+		IsPublished: true,
+		ID:          section.ID,
+	}); err != nil {
+		t.Fatal("Failed to update section is published", "error", err)
+	}
+
+	updateVersionedSeriesIsPublished(t, author.ID, true)
+	if _, serviceErr := testServices.UpdateSeriesIsMembersOnly(ctx, services.UpdateSeriesIsMembersOnlyOptions{
+		RequestID:     uuid.NewString(),
+		UserID:        author.ID,
+		LanguageSlug:  "rust",
+		SeriesSlug:    "existing-series",
+		IsMembersOnly: true,
+	}); serviceErr != nil {
+		t.Fatal("Failed to update series is members only", "serviceError", serviceErr)
+	}
+
+	return author, lesson
+}
+
+func TestGetMembersOnlyLessonArticle(t *testing.T) {
+	languagesCleanUp(t)()
+	author, lesson := createMembersOnlyContent(t)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+
+	path := fmt.Sprintf(
+		"%s/rust/series/existing-series/sections/%d/lessons/%d/article",
+		baseLanguagesPath,
+		lesson.SectionID,
+		lesson.ID,
+	)
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 403 FORBIDDEN with the lesson teaser when the user is anonymous",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SignInRequiredResponse{})
+				AssertEqual(t, resBody.Code, exceptions.StatusSignInRequired)
+				AssertEqual(t, resBody.Teaser.ID, lesson.ID)
+				AssertEqual(t, resBody.Teaser.Title, lesson.Title)
+				AssertEqual(t, len(resBody.Teaser.Outline), 2)
+				AssertEqual(t, resBody.Teaser.Outline[0], "Introduction")
+				AssertEqual(t, resBody.Teaser.Outline[1], "Ownership")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when the user is signed in",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonArticleResponse{})
+				AssertEqual(t, resBody.Content, membersOnlyArticle)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when the user is anonymous and the lesson is a preview",
+			ReqFn: func(t *testing.T) (string, string) {
+				if _, serviceErr := GetTestServices(t).UpdateLessonIsPreview(
+					context.Background(),
+					services.UpdateLessonIsPreviewOptions{
+						RequestID:    uuid.NewString(),
+						UserID:       author.ID,
+						LanguageSlug: "rust",
+						SeriesSlug:   "existing-series",
+						SectionID:    lesson.SectionID,
+						LessonID:     lesson.ID,
+						IsPreview:    true,
+						Version:      services.AnyVersion,
+					},
+				); serviceErr != nil {
+					t.Fatal("Failed to update lesson is preview", "serviceError", serviceErr)
+				}
+				return "", ""
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.LessonArticleResponse{})
+				AssertEqual(t, resBody.Content, membersOnlyArticle)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/kiwiscript/kiwiscript_go/exceptions"
 	db "github.com/kiwiscript/kiwiscript_go/providers/database"
 	"github.com/kiwiscript/kiwiscript_go/services"
+	"github.com/kiwiscript/kiwiscript_go/utils"
 	"mime/multipart"
 	"net/http"
 	"strings"
@@ -350,6 +351,9 @@ func TestGetLesson(t *testing.T) {
 					),
 				)
 				AssertEqual(t, len(resBody.Embedded.Files), 2)
+				for _, file := range resBody.Embedded.Files {
+					AssertEqual(t, file.URL, file.Links.Self.Href+"/download")
+				}
 			},
 			Path: fmt.Sprintf(
 				"%s/rust/series/existing-series/sections/%d/lessons/%d",
@@ -797,6 +801,7 @@ func TestUpdateLesson(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = lessonIfMatchFn(&lessonID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCase(t, http.MethodPut, tc.Path, tc)
 		})
@@ -811,6 +816,7 @@ func TestGetLessons(t *testing.T) {
 	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
 
 	var sectionID int32
+	var fourthLessonCursor string
 	func() {
 		testDb := GetTestDatabase(t)
 		testServices := GetTestServices(t)
@@ -883,6 +889,12 @@ func TestGetLessons(t *testing.T) {
 				count++
 			}
 		}
+
+		lessons, err := testDb.FindLessonsBySectionID(context.Background(), sectionID)
+		if err != nil {
+			t.Fatal("Failed to get lessons", "error", err)
+		}
+		fourthLessonCursor = utils.NewPositionCursor(lessons[3].Position, lessons[3].ID, false).Encode()
 	}()
 
 	testCases := []TestRequestCase[string]{
@@ -958,6 +970,81 @@ func TestGetLessons(t *testing.T) {
 				baseLanguagesPath, sectionID,
 			),
 		},
+		{
+			Name: "Should return 200 OK with a cursor page when the cursor is empty and the user is staff",
+			ReqFn: func(t *testing.T) (string, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.CursorPaginatedResponse[dtos.LessonResponse]{})
+				AssertEqual(t, len(resBody.Results), 4)
+				AssertEqual(t, *resBody.Count, 10)
+				AssertGreaterThan(t, resBody.Results[1].Position, resBody.Results[0].Position)
+				AssertEqual(
+					t,
+					resBody.Links.Self.Href,
+					fmt.Sprintf(
+						"https://api.kiwiscript.com/api/v1/languages/rust/series/existing-series/sections/%d/lessons?withCount=true&limit=4&cursor=",
+						sectionID,
+					),
+				)
+				AssertEqual(
+					t,
+					resBody.Links.Next.Href,
+					fmt.Sprintf(
+						"https://api.kiwiscript.com/api/v1/languages/rust/series/existing-series/sections/%d/lessons?withCount=true&limit=4&cursor=%s",
+						sectionID, fourthLessonCursor,
+					),
+				)
+				AssertEqual(t, resBody.Links.Prev, nil)
+			},
+			Path: fmt.Sprintf(
+				"%s/rust/series/existing-series/sections/%d/lessons?cursor=&limit=4&withCount=true",
+				baseLanguagesPath, sectionID,
+			),
+		},
+		{
+			Name: "Should return 200 OK with the next cursor page without count and the user is staff",
+			ReqFn: func(t *testing.T) (string, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.CursorPaginatedResponse[dtos.LessonResponse]{})
+				AssertEqual(t, len(resBody.Results), 4)
+				AssertEqual(t, resBody.Count, nil)
+				AssertEqual(t, resBody.Results[0].Position, 5)
+				AssertStringContains(t, resBody.Links.Next.Href, "cursor=")
+				AssertStringContains(t, resBody.Links.Prev.Href, "cursor=")
+			},
+			Path: fmt.Sprintf(
+				"%s/rust/series/existing-series/sections/%d/lessons?cursor=%s&limit=4",
+				baseLanguagesPath, sectionID, fourthLessonCursor,
+			),
+		},
+		{
+			Name: "Should return 400 BAD REQUEST if the cursor is invalid",
+			ReqFn: func(t *testing.T) (string, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{
+					{Param: "cursor", Message: exceptions.StrFieldErrMessageCursor},
+				})
+			},
+			Path: fmt.Sprintf(
+				"%s/rust/series/existing-series/sections/%d/lessons?cursor=not-a-cursor",
+				baseLanguagesPath, sectionID,
+			),
+		},
 		{
 			Name: "Should return 200 OK with no lessons when the user is not staff and the lessons are not published",
 			ReqFn: func(t *testing.T) (string, string) {
@@ -1453,6 +1540,7 @@ func TestDeleteLesson(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = lessonIfMatchFn(&lessonID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCaseWithPathFn(t, http.MethodDelete, tc)
 		})
@@ -2132,6 +2220,7 @@ func TestPublishLesson(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = lessonIfMatchFn(&lessonID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCaseWithPathFn(t, http.MethodPatch, tc)
 		})
@@ -2140,3 +2229,211 @@ func TestPublishLesson(t *testing.T) {
 	t.Cleanup(languagesCleanUp(t))
 	t.Cleanup(userCleanUp(t))
 }
+
+func TestReorderLessons(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	sectionIDs := make([]int32, 0, 2)
+	lessonIDs := make([]int32, 0, 3)
+	var movedLessonID int32
+	func() {
+		testDb := GetTestDatabase(t)
+		testServices := GetTestServices(t)
+		ctx := context.Background()
+
+		params := db.CreateLanguageParams{
+			Name:     "Rust",
+			Icon:     strings.TrimSpace(languageIcons["Rust"]),
+			AuthorID: testUser.ID,
+			Slug:     "rust",
+		}
+		if _, err := testDb.CreateLanguage(ctx, params); err != nil {
+			t.Fatal("Failed to create language", err)
+		}
+
+		if _, err := testDb.CreateSeries(ctx, db.CreateSeriesParams{
+			Title:        "Existing Series",
+			Slug:         "existing-series",
+			Description:  "Some description",
+			LanguageSlug: "rust",
+			AuthorID:     testUser.ID,
+		}); err != nil {
+			t.Fatal("Failed to create series", "error", err)
+		}
+
+		for _, title := range []string{"First Section", "Second Section"} {
+			section, serviceErr := testServices.CreateSection(ctx, services.CreateSectionOptions{
+				UserID:       testUser.ID,
+				Title:        title,
+				LanguageSlug: "rust",
+				SeriesSlug:   "existing-series",
+				Description:  "Some description",
+			})
+			if serviceErr != nil {
+				t.Fatal("Failed to create section", "serviceError", serviceErr)
+			}
+			sectionIDs = append(sectionIDs, section.ID)
+		}
+
+		for _, title := range []string{"First Lesson", "Second Lesson", "Third Lesson"} {
+			lesson, serviceErr := testServices.CreateLesson(ctx, services.CreateLessonOptions{
+				UserID:       testUser.ID,
+				LanguageSlug: "rust",
+				SeriesSlug:   "existing-series",
+				SectionID:    sectionIDs[0],
+				Title:        title,
+			})
+			if serviceErr != nil {
+				t.Fatal("Failed to create lesson", "serviceError", serviceErr)
+			}
+			lessonIDs = append(lessonIDs, lesson.ID)
+		}
+
+		lesson, serviceErr := testServices.CreateLesson(ctx, services.CreateLessonOptions{
+			UserID:       testUser.ID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			SectionID:    sectionIDs[1],
+			Title:        "Moved Lesson",
+		})
+		if serviceErr != nil {
+			t.Fatal("Failed to create lesson", "serviceError", serviceErr)
+		}
+		movedLessonID = lesson.ID
+	}()
+
+	path := baseLanguagesPath + fmt.Sprintf("/rust/series/existing-series/sections/%d/lessons/order", sectionIDs[0])
+	testCases := []TestRequestCase[dtos.ReorderLessonsBody]{
+		{
+			Name: "Should return 200 OK when it reorders the lessons of the section",
+			ReqFn: func(t *testing.T) (dtos.ReorderLessonsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderLessonsBody{
+					LessonIDs: []int32{lessonIDs[2], lessonIDs[0], lessonIDs[1]},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.ReorderLessonsBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, []dtos.LessonResponse{})
+				AssertEqual(t, len(resBody), len(req.LessonIDs))
+				for i, lesson := range resBody {
+					AssertEqual(t, lesson.ID, req.LessonIDs[i])
+					AssertEqual(t, lesson.Position, int16(i+1))
+				}
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when a lesson of the section is missing",
+			ReqFn: func(t *testing.T) (dtos.ReorderLessonsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderLessonsBody{LessonIDs: []int32{lessonIDs[0], lessonIDs[1]}}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.ReorderLessonsBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(
+					t,
+					resp,
+					"Lesson IDs must contain every lesson of the section exactly once",
+				)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when a lesson does not belong to the series",
+			ReqFn: func(t *testing.T) (dtos.ReorderLessonsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderLessonsBody{
+					LessonIDs: []int32{lessonIDs[0], lessonIDs[1], lessonIDs[2], 987654},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.ReorderLessonsBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Lesson IDs must belong to the series")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 428 PRECONDITION REQUIRED when the If-Match header is missing",
+			ReqFn: func(t *testing.T) (dtos.ReorderLessonsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderLessonsBody{LessonIDs: lessonIDs}, accessToken
+			},
+			ExpStatus: fiber.StatusPreconditionRequired,
+			AssertFn:  func(t *testing.T, _ dtos.ReorderLessonsBody, _ *http.Response) {},
+			Path:      path,
+			IfMatchFn: func(t *testing.T) string { return "" },
+		},
+		{
+			Name: "Should return 412 PRECONDITION FAILED with the current order when the version is stale",
+			ReqFn: func(t *testing.T) (dtos.ReorderLessonsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderLessonsBody{LessonIDs: lessonIDs}, accessToken
+			},
+			ExpStatus: fiber.StatusPreconditionFailed,
+			AssertFn: func(t *testing.T, _ dtos.ReorderLessonsBody, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderETag), lessonsOrderIfMatchFn(&sectionIDs[0])(t))
+				resBody := AssertTestResponseBody(t, resp, []dtos.LessonResponse{})
+				AssertEqual(t, len(resBody), len(lessonIDs))
+			},
+			Path:      path,
+			IfMatchFn: func(t *testing.T) string { return `"v1"` },
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not staff",
+			ReqFn: func(t *testing.T) (dtos.ReorderLessonsBody, string) {
+				testUser.IsStaff = false
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderLessonsBody{LessonIDs: lessonIDs}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.ReorderLessonsBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when it moves a lesson from another section",
+			ReqFn: func(t *testing.T) (dtos.ReorderLessonsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderLessonsBody{
+					LessonIDs: []int32{lessonIDs[0], movedLessonID, lessonIDs[1], lessonIDs[2]},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.ReorderLessonsBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, []dtos.LessonResponse{})
+				AssertEqual(t, len(resBody), len(req.LessonIDs))
+				for i, lesson := range resBody {
+					AssertEqual(t, lesson.ID, req.LessonIDs[i])
+					AssertEqual(t, lesson.Position, int16(i+1))
+				}
+
+				lessons, err := GetTestDatabase(t).FindLessonsBySectionID(context.Background(), sectionIDs[1])
+				if err != nil {
+					t.Fatal("Failed to find lessons", "error", err)
+				}
+				AssertEqual(t, len(lessons), 0)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.IfMatchFn == nil {
+			tc.IfMatchFn = lessonsOrderIfMatchFn(&sectionIDs[0])
+		}
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPatch, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/controllers"
+	"github.com/kiwiscript/kiwiscript_go/openapi"
+	"github.com/kiwiscript/kiwiscript_go/routers"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	app := GetTestApp(t)
+	resp := PerformTestRequest(t, app, 0, fiber.MethodGet, "/api/openapi.json", "", "", nil)
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	AssertTestStatusCode(t, resp, fiber.StatusOK)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal("Failed to read response body", err)
+	}
+
+	var document openapi.Document
+	if err := json.Unmarshal(body, &document); err != nil {
+		t.Fatal("Failed to parse OpenAPI document", err)
+	}
+	AssertEqual(t, document.OpenAPI, openapi.Version)
+
+	// Every routed endpoint must be in the served document and vice versa
+	routed := 0
+	for _, route := range app.GetRoutes(true) {
+		if route.Method == fiber.MethodHead || !strings.HasPrefix(route.Path, "/api/") {
+			continue
+		}
+
+		path := strings.TrimPrefix(route.Path, "/api")
+		for _, param := range route.Params {
+			path = strings.Replace(path, ":"+param, "{"+param+"}", 1)
+		}
+
+		item, ok := document.Paths[path]
+		if !ok || item.Operation(route.Method) == nil {
+			t.Errorf("%s %s is routed but missing from the OpenAPI document", route.Method, path)
+			continue
+		}
+		routed++
+	}
+
+	documented := 0
+	for _, item := range document.Paths {
+		for _, method := range []string{fiber.MethodGet, fiber.MethodPost, fiber.MethodPut, fiber.MethodPatch, fiber.MethodDelete} {
+			if item.Operation(method) != nil {
+				documented++
+			}
+		}
+	}
+	AssertEqual(t, documented, routed)
+}
+
+func TestOpenAPIDrift(t *testing.T) {
+	rtr := routers.NewRouter(GetTestApp(t), &controllers.Controllers{})
+	_, drift := rtr.BuildOpenAPIDocument(GetTestConfig(t).BackendDomain)
+
+	for _, d := range drift {
+		t.Error(d)
+	}
+}
+
+func TestAPIDocs(t *testing.T) {
+	resp := PerformTestRequest(t, GetTestApp(t), 0, fiber.MethodGet, "/api/docs", "", "", nil)
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	AssertTestStatusCode(t, resp, fiber.StatusOK)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal("Failed to read response body", err)
+	}
+	AssertStringContains(t, string(body), "/api/openapi.json")
+}
@@ -0,0 +1,391 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const baseOrganizationsPath = "/api/v1/organizations"
+
+func createTestOrganization(t *testing.T, ownerID int32) *db.Organization {
+	testServices := GetTestServices(t)
+	organization, serviceErr := testServices.CreateOrganization(
+		context.Background(),
+		services.CreateOrganizationOptions{
+			RequestID: uuid.NewString(),
+			UserID:    ownerID,
+			Name:      "Acme Academy",
+		},
+	)
+	if serviceErr != nil {
+		t.Fatal("Failed to create organization", "serviceError", serviceErr)
+	}
+
+	return organization
+}
+
+func createTestOrganizationInvitation(
+	t *testing.T,
+	organization *db.Organization,
+	email string,
+) *db.OrganizationInvitation {
+	testServices := GetTestServices(t)
+	invitation, serviceErr := testServices.CreateOrganizationInvitation(
+		context.Background(),
+		services.CreateOrganizationInvitationOptions{
+			RequestID:      uuid.NewString(),
+			UserID:         organization.OwnerID,
+			OrganizationID: organization.ID,
+			Email:          email,
+			Role:           db.OrganizationRoleMember,
+		},
+	)
+	if serviceErr != nil {
+		t.Fatal("Failed to create organization invitation", "serviceError", serviceErr)
+	}
+
+	return invitation
+}
+
+func TestCreateOrganization(t *testing.T) {
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+
+	testCases := []TestRequestCase[dtos.OrganizationBody]{
+		{
+			Name: "Should return 201 CREATED with the user as owner",
+			ReqFn: func(t *testing.T) (dtos.OrganizationBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.OrganizationBody{Name: "Acme Academy"}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, req dtos.OrganizationBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.OrganizationResponse{})
+				AssertEqual(t, resBody.Name, req.Name)
+				AssertEqual(t, resBody.OwnerID, testUser.ID)
+				AssertNotEmpty(t, resBody.Links.Cohorts.Href)
+			},
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not logged in",
+			ReqFn: func(t *testing.T) (dtos.OrganizationBody, string) {
+				return dtos.OrganizationBody{Name: "Acme Academy"}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.OrganizationBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, baseOrganizationsPath, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestCreateOrganizationInvitation(t *testing.T) {
+	owner := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	organization := createTestOrganization(t, owner.ID)
+
+	path := fmt.Sprintf("%s/%d/invitations", baseOrganizationsPath, organization.ID)
+	testCases := []TestRequestCase[dtos.OrganizationInvitationBody]{
+		{
+			Name: "Should return 201 CREATED with the pending invitation",
+			ReqFn: func(t *testing.T) (dtos.OrganizationInvitationBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return dtos.OrganizationInvitationBody{
+					Email: "New.Member@Example.com",
+					Role:  db.OrganizationRoleMember,
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ dtos.OrganizationInvitationBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.OrganizationInvitationResponse{})
+				AssertEqual(t, resBody.Email, "new.member@example.com")
+				AssertEqual(t, resBody.Role, db.OrganizationRoleMember)
+				AssertNotEmpty(t, resBody.ExpiresAt)
+			},
+		},
+		{
+			Name: "Should return 201 CREATED refreshing the invitation when the email was already invited",
+			ReqFn: func(t *testing.T) (dtos.OrganizationInvitationBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return dtos.OrganizationInvitationBody{
+					Email: "new.member@example.com",
+					Role:  db.OrganizationRoleAdmin,
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ dtos.OrganizationInvitationBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.OrganizationInvitationResponse{})
+				AssertEqual(t, resBody.Email, "new.member@example.com")
+				AssertEqual(t, resBody.Role, db.OrganizationRoleAdmin)
+			},
+		},
+		{
+			Name: "Should return 409 CONFLICT when the user is already a member",
+			ReqFn: func(t *testing.T) (dtos.OrganizationInvitationBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return dtos.OrganizationInvitationBody{
+					Email: owner.Email,
+					Role:  db.OrganizationRoleAdmin,
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ dtos.OrganizationInvitationBody, resp *http.Response) {
+				AssertConflictResponse(t, resp, "User is already a member of the organization")
+			},
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not a member of the organization",
+			ReqFn: func(t *testing.T) (dtos.OrganizationInvitationBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherUser)
+				return dtos.OrganizationInvitationBody{
+					Email: otherUser.Email,
+					Role:  db.OrganizationRoleAdmin,
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.OrganizationInvitationBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, path, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestAcceptOrganizationInvitation(t *testing.T) {
+	owner := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	invitedUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	organization := createTestOrganization(t, owner.ID)
+	invitation := createTestOrganizationInvitation(t, organization, invitedUser.Email)
+
+	path := fmt.Sprintf("%s/invitations/%s/accept", baseOrganizationsPath, invitation.Token.String())
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 403 FORBIDDEN when the invitation was sent to another user",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK with the organization the user joined",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, invitedUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.OrganizationResponse{})
+				AssertEqual(t, resBody.ID, organization.ID)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the invitation was already accepted",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, invitedUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
+func createTestCohortWithSeries(t *testing.T) (*db.User, *db.User, *db.Organization, *db.Cohort) {
+	testServices := GetTestServices(t)
+	ctx := context.Background()
+
+	author := createVersionedContent(t)
+	member := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	organization := createTestOrganization(t, author.ID)
+	invitation := createTestOrganizationInvitation(t, organization, member.Email)
+
+	if _, serviceErr := testServices.AcceptOrganizationInvitation(ctx, services.AcceptOrganizationInvitationOptions{
+		RequestID: uuid.NewString(),
+		UserID:    member.ID,
+		Token:     invitation.Token,
+	}); serviceErr != nil {
+		t.Fatal("Failed to accept organization invitation", "serviceError", serviceErr)
+	}
+
+	cohort, serviceErr := testServices.CreateCohort(ctx, services.CreateCohortOptions{
+		RequestID:      uuid.NewString(),
+		UserID:         author.ID,
+		OrganizationID: organization.ID,
+		Name:           "Spring Cohort",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create cohort", "serviceError", serviceErr)
+	}
+
+	if serviceErr := testServices.AddCohortMember(ctx, services.AddCohortMemberOptions{
+		RequestID:      uuid.NewString(),
+		UserID:         author.ID,
+		OrganizationID: organization.ID,
+		CohortID:       cohort.ID,
+		MemberID:       member.ID,
+	}); serviceErr != nil {
+		t.Fatal("Failed to add cohort member", "serviceError", serviceErr)
+	}
+
+	if _, serviceErr := testServices.AssignCohortSeries(ctx, services.AssignCohortSeriesOptions{
+		RequestID:      uuid.NewString(),
+		UserID:         author.ID,
+		OrganizationID: organization.ID,
+		CohortID:       cohort.ID,
+		LanguageSlug:   "rust",
+		SeriesSlug:     "existing-series",
+	}); serviceErr != nil {
+		t.Fatal("Failed to assign cohort series", "serviceError", serviceErr)
+	}
+
+	return author, member, organization, cohort
+}
+
+func TestGetCohortProgress(t *testing.T) {
+	languagesCleanUp(t)()
+	owner, member, organization, cohort := createTestCohortWithSeries(t)
+
+	path := fmt.Sprintf("%s/%d/cohorts/%d/progress", baseOrganizationsPath, organization.ID, cohort.ID)
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 200 OK with the zeroed progress of members that did not start",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, make([]dtos.CohortProgressResponse, 0))
+				AssertEqual(t, len(resBody), 1)
+				AssertEqual(t, resBody[0].UserID, member.ID)
+				AssertEqual(t, resBody[0].SeriesSlug, "existing-series")
+				AssertEqual(t, resBody[0].CompletedLessons, 0)
+				AssertEqual(t, resBody[0].TotalLessons, 1)
+				AssertEqual(t, resBody[0].IsOverdue, false)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not an admin of the organization",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, member)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK with the progress as CSV",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertStringContains(t, resp.Header.Get(fiber.HeaderContentType), "text/csv")
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatal("Failed to read response body", "error", err)
+				}
+				AssertStringContains(t, string(body), "user_id,first_name,last_name,email")
+				AssertStringContains(t, string(body), member.Email)
+			},
+			Path: path + "/export",
+		},
+		{
+			Name: "Should return 200 OK with formula like names escaped in the CSV",
+			ReqFn: func(t *testing.T) (string, string) {
+				if _, err := GetTestDatabase(t).UpdateUser(context.Background(), db.UpdateUserParams{
+					FirstName: "=HYPERLINK(\"https://example.com\")",
+					LastName:  member.LastName,
+					Location:  member.Location,
+					ID:        member.ID,
+				}); err != nil {
+					t.Fatal("Failed to update user", "error", err)
+				}
+
+				accessToken, _ := GenerateTestAuthTokens(t, owner)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatal("Failed to read response body", "error", err)
+				}
+				AssertStringContains(t, string(body), `"'=HYPERLINK(""https://example.com"")"`)
+			},
+			Path: path + "/export",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
@@ -0,0 +1,510 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func createScheduledContent(t *testing.T, authorID int32) (db.Section, db.Lesson) {
+	testDb := GetTestDatabase(t)
+	testServices := GetTestServices(t)
+	ctx := context.Background()
+
+	params := db.CreateLanguageParams{
+		Name:     "Rust",
+		Icon:     strings.TrimSpace(languageIcons["Rust"]),
+		AuthorID: authorID,
+		Slug:     "rust",
+	}
+	if _, err := testDb.CreateLanguage(ctx, params); err != nil {
+		t.Fatal("Failed to create language", err)
+	}
+
+	if _, err := testDb.CreateSeries(ctx, db.CreateSeriesParams{
+		Title:        "Existing Series",
+		Slug:         "existing-series",
+		Description:  "Some description",
+		LanguageSlug: "rust",
+		AuthorID:     authorID,
+	}); err != nil {
+		t.Fatal("Failed to create series", "error", err)
+	}
+
+	section, serviceErr := testServices.CreateSection(ctx, services.CreateSectionOptions{
+		UserID:       authorID,
+		Title:        "Some Section",
+		LanguageSlug: "rust",
+		SeriesSlug:   "existing-series",
+		Description:  "Some description",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create section", "serviceError", serviceErr)
+	}
+
+	lesson, serviceErr := testServices.CreateLesson(ctx, services.CreateLessonOptions{
+		UserID:       authorID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "existing-series",
+		SectionID:    section.ID,
+		Title:        "Some lesson",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create lesson", "serviceError", serviceErr)
+	}
+
+	readTimePrms := db.UpdateLessonReadTimeSecondsParams{
+		ID:              lesson.ID,
+		ReadTimeSeconds: 300,
+	}
+	if err := testDb.UpdateLessonReadTimeSeconds(ctx, readTimePrms); err != nil {
+		t.Fatal("Failed to update lesson read time", "error", err)
+	}
+
+	return *section, *lesson
+}
+
+func TestUpdateSeriesSchedule(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	createScheduledContent(t, testUser.ID)
+
+	path := baseLanguagesPath + "/rust/series/existing-series/schedule"
+	publishAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	testCases := []TestRequestCase[dtos.UpdateScheduleBody]{
+		{
+			Name: "Should return 200 OK when the series is scheduled",
+			ReqFn: func(t *testing.T) (dtos.UpdateScheduleBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.UpdateScheduleBody{
+					PublishAt:   publishAt.Format(time.RFC3339),
+					UnpublishAt: publishAt.Add(24 * time.Hour).Format(time.RFC3339),
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.UpdateScheduleBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.ScheduleResponse{})
+				AssertEqual(t, resBody.PublishAt, req.PublishAt)
+				AssertEqual(t, resBody.UnpublishAt, req.UnpublishAt)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK when the schedule is cleared",
+			ReqFn: func(t *testing.T) (dtos.UpdateScheduleBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.UpdateScheduleBody{}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.UpdateScheduleBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.ScheduleResponse{})
+				AssertEqual(t, resBody.PublishAt, "")
+				AssertEqual(t, resBody.UnpublishAt, "")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the publish date is in the past",
+			ReqFn: func(t *testing.T) (dtos.UpdateScheduleBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.UpdateScheduleBody{
+					PublishAt: time.Now().Add(-time.Hour).Format(time.RFC3339),
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Publish date must be in the future")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the unpublish date is before the publish date",
+			ReqFn: func(t *testing.T) (dtos.UpdateScheduleBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.UpdateScheduleBody{
+					PublishAt:   publishAt.Format(time.RFC3339),
+					UnpublishAt: publishAt.Add(-time.Hour).Format(time.RFC3339),
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Unpublish date must be after the publish date")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the date is not RFC 3339",
+			ReqFn: func(t *testing.T) (dtos.UpdateScheduleBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.UpdateScheduleBody{PublishAt: "tomorrow"}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{{
+					Param:   "publishAt",
+					Message: exceptions.FieldErrMessageInvalid,
+				}})
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not staff",
+			ReqFn: func(t *testing.T) (dtos.UpdateScheduleBody, string) {
+				testUser.IsStaff = false
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.UpdateScheduleBody{PublishAt: publishAt.Format(time.RFC3339)}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPut, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestUpdateSectionAndLessonSchedule(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	section, lesson := createScheduledContent(t, testUser.ID)
+
+	sectionPath := fmt.Sprintf("%s/rust/series/existing-series/sections/%d/schedule", baseLanguagesPath, section.ID)
+	lessonPath := fmt.Sprintf(
+		"%s/rust/series/existing-series/sections/%d/lessons/%d/schedule",
+		baseLanguagesPath, section.ID, lesson.ID,
+	)
+	publishAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	newScheduleReqFn := func(t *testing.T) (dtos.UpdateScheduleBody, string) {
+		testUser.IsStaff = true
+		accessToken, _ := GenerateTestAuthTokens(t, testUser)
+		return dtos.UpdateScheduleBody{PublishAt: publishAt.Format(time.RFC3339)}, accessToken
+	}
+	testCases := []TestRequestCase[dtos.UpdateScheduleBody]{
+		{
+			Name:      "Should return 200 OK and bump the ETag when the section is scheduled",
+			ReqFn:     newScheduleReqFn,
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v2"`)
+				resBody := AssertTestResponseBody(t, resp, dtos.ScheduleResponse{})
+				AssertEqual(t, resBody.PublishAt, req.PublishAt)
+			},
+			Path:      sectionPath,
+			IfMatchFn: func(t *testing.T) string { return `"v1"` },
+		},
+		{
+			Name:      "Should return 412 PRECONDITION FAILED when the section version is stale",
+			ReqFn:     newScheduleReqFn,
+			ExpStatus: fiber.StatusPreconditionFailed,
+			AssertFn: func(t *testing.T, _ dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderETag), `"v2"`)
+				resBody := AssertTestResponseBody(t, resp, dtos.ScheduleResponse{})
+				AssertEqual(t, resBody.PublishAt, publishAt.Format(time.RFC3339))
+			},
+			Path:      sectionPath,
+			IfMatchFn: func(t *testing.T) string { return `"v1"` },
+		},
+		{
+			Name:      "Should return 428 PRECONDITION REQUIRED when the section If-Match header is missing",
+			ReqFn:     newScheduleReqFn,
+			ExpStatus: fiber.StatusPreconditionRequired,
+			AssertFn:  func(t *testing.T, _ dtos.UpdateScheduleBody, _ *http.Response) {},
+			Path:      sectionPath,
+		},
+		{
+			Name:      "Should return 200 OK and bump the ETag when the lesson is scheduled",
+			ReqFn:     newScheduleReqFn,
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderETag), fmt.Sprintf(`"v%d"`, lesson.Version+1))
+				resBody := AssertTestResponseBody(t, resp, dtos.ScheduleResponse{})
+				AssertEqual(t, resBody.PublishAt, req.PublishAt)
+			},
+			Path:      lessonPath,
+			IfMatchFn: lessonIfMatchFn(&lesson.ID),
+		},
+		{
+			Name:      "Should return 412 PRECONDITION FAILED when the lesson version is stale",
+			ReqFn:     newScheduleReqFn,
+			ExpStatus: fiber.StatusPreconditionFailed,
+			AssertFn: func(t *testing.T, _ dtos.UpdateScheduleBody, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderETag), fmt.Sprintf(`"v%d"`, lesson.Version+1))
+			},
+			Path:      lessonPath,
+			IfMatchFn: func(t *testing.T) string { return fmt.Sprintf(`"v%d"`, lesson.Version) },
+		},
+		{
+			Name:      "Should return 428 PRECONDITION REQUIRED when the lesson If-Match header is missing",
+			ReqFn:     newScheduleReqFn,
+			ExpStatus: fiber.StatusPreconditionRequired,
+			AssertFn:  func(t *testing.T, _ dtos.UpdateScheduleBody, _ *http.Response) {},
+			Path:      lessonPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPut, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestGetScheduledReleases(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	section, lesson := createScheduledContent(t, testUser.ID)
+
+	lessonPublishAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	if _, serviceErr := GetTestServices(t).UpdateLessonSchedule(
+		context.Background(),
+		services.UpdateLessonScheduleOptions{
+			RequestID:    uuid.NewString(),
+			UserID:       testUser.ID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			SectionID:    section.ID,
+			LessonID:     lesson.ID,
+			PublishAt:    lessonPublishAt,
+		},
+	); serviceErr != nil {
+		t.Fatal("Failed to schedule lesson", "serviceError", serviceErr)
+	}
+	if _, serviceErr := GetTestServices(t).UpdateSectionSchedule(
+		context.Background(),
+		services.UpdateSectionScheduleOptions{
+			RequestID:    uuid.NewString(),
+			UserID:       testUser.ID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			SectionID:    section.ID,
+			PublishAt:    lessonPublishAt.Add(time.Hour),
+		},
+	); serviceErr != nil {
+		t.Fatal("Failed to schedule section", "serviceError", serviceErr)
+	}
+
+	path := "/api/v1/scheduled-releases"
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 200 OK with the releases ordered by date",
+			ReqFn: func(t *testing.T) (string, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.ScheduledReleaseResponse]{})
+				AssertEqual(t, resBody.Count, int64(2))
+				AssertEqual(t, len(resBody.Results), 2)
+				AssertEqual(t, resBody.Results[0].Kind, db.ScheduledReleaseKindLesson)
+				AssertEqual(t, resBody.Results[0].Action, "publish")
+				AssertEqual(t, resBody.Results[0].ID, lesson.ID)
+				AssertEqual(t, resBody.Results[0].SectionID, section.ID)
+				AssertEqual(t, resBody.Results[0].ScheduledAt, lessonPublishAt.Format(time.RFC3339))
+				AssertEqual(t, resBody.Results[1].Kind, db.ScheduledReleaseKindSection)
+				AssertEqual(t, resBody.Results[1].ID, section.ID)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not staff",
+			ReqFn: func(t *testing.T) (string, string) {
+				testUser.IsStaff = false
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestPerformScheduledTransitions(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	section, lesson := createScheduledContent(t, testUser.ID)
+	testDb := GetTestDatabase(t)
+	ctx := context.Background()
+
+	// The schedules are written straight to the database as the services only
+	// accept dates in the future.
+	due := pgtype.Timestamp{Time: time.Now().Add(-time.Minute).UTC(), Valid: true}
+	if _, err := testDb.UpdateLessonSchedule(ctx, db.UpdateLessonScheduleParams{
+		ID:        lesson.ID,
+		PublishAt: due,
+		Version:   lesson.Version,
+	}); err != nil {
+		t.Fatal("Failed to schedule lesson", "error", err)
+	}
+	if _, err := testDb.UpdateSectionSchedule(ctx, db.UpdateSectionScheduleParams{
+		ID:        section.ID,
+		PublishAt: due,
+		Version:   section.Version,
+	}); err != nil {
+		t.Fatal("Failed to schedule section", "error", err)
+	}
+	series, err := testDb.FindSeriesBySlugAndLanguageSlug(ctx, db.FindSeriesBySlugAndLanguageSlugParams{
+		Slug:         "existing-series",
+		LanguageSlug: "rust",
+	})
+	if err != nil {
+		t.Fatal("Failed to find series", "error", err)
+	}
+	if _, err := testDb.UpdateSeriesSchedule(ctx, db.UpdateSeriesScheduleParams{
+		ID:        series.ID,
+		PublishAt: due,
+	}); err != nil {
+		t.Fatal("Failed to schedule series", "error", err)
+	}
+
+	GetTestServices(t).PerformScheduledTransitions(ctx, uuid.NewString())
+
+	updatedSection, err := testDb.FindSectionById(ctx, section.ID)
+	if err != nil {
+		t.Fatal("Failed to find section", "error", err)
+	}
+	AssertEqual(t, updatedSection.IsPublished, true)
+	AssertEqual(t, updatedSection.PublishAt.Valid, false)
+	AssertEqual(t, updatedSection.LessonsCount, int16(1))
+	AssertEqual(t, updatedSection.ReadTimeSeconds, int32(300))
+
+	updatedSeries, err := testDb.FindSeriesById(ctx, series.ID)
+	if err != nil {
+		t.Fatal("Failed to find series", "error", err)
+	}
+	AssertEqual(t, updatedSeries.IsPublished, true)
+	AssertEqual(t, updatedSeries.PublishAt.Valid, false)
+	AssertEqual(t, updatedSeries.SectionsCount, int16(1))
+
+	language, err := testDb.FindLanguageBySlug(ctx, "rust")
+	if err != nil {
+		t.Fatal("Failed to find language", "error", err)
+	}
+	AssertEqual(t, language.SeriesCount, int16(1))
+
+	count, err := testDb.CountScheduledReleases(ctx)
+	if err != nil {
+		t.Fatal("Failed to count scheduled releases", "error", err)
+	}
+	AssertEqual(t, count, int64(0))
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestPerformScheduledTransitionsInvalidatesCatalog(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	section, lesson := createScheduledContent(t, testUser.ID)
+	testDb := GetTestDatabase(t)
+	ctx := context.Background()
+	path := baseLanguagesPath + "/rust/series"
+
+	resp := performCatalogCacheRequest(t, path, "", "")
+	AssertTestStatusCode(t, resp, fiber.StatusOK)
+	resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.SeriesResponse]{})
+	AssertEqual(t, len(resBody.Results), 0)
+	etag := resp.Header.Get(fiber.HeaderETag)
+
+	resp = performCatalogCacheRequest(t, path, etag, "")
+	AssertTestStatusCode(t, resp, fiber.StatusNotModified)
+
+	due := pgtype.Timestamp{Time: time.Now().Add(-time.Minute).UTC(), Valid: true}
+	if _, err := testDb.UpdateLessonSchedule(ctx, db.UpdateLessonScheduleParams{
+		ID:        lesson.ID,
+		PublishAt: due,
+		Version:   lesson.Version,
+	}); err != nil {
+		t.Fatal("Failed to schedule lesson", "error", err)
+	}
+	if _, err := testDb.UpdateSectionSchedule(ctx, db.UpdateSectionScheduleParams{
+		ID:        section.ID,
+		PublishAt: due,
+		Version:   section.Version,
+	}); err != nil {
+		t.Fatal("Failed to schedule section", "error", err)
+	}
+	series, err := testDb.FindSeriesBySlugAndLanguageSlug(ctx, db.FindSeriesBySlugAndLanguageSlugParams{
+		Slug:         section.SeriesSlug,
+		LanguageSlug: section.LanguageSlug,
+	})
+	if err != nil {
+		t.Fatal("Failed to find series", "error", err)
+	}
+	if _, err := testDb.UpdateSeriesSchedule(ctx, db.UpdateSeriesScheduleParams{
+		ID:        series.ID,
+		PublishAt: due,
+	}); err != nil {
+		t.Fatal("Failed to schedule series", "error", err)
+	}
+
+	GetTestServices(t).PerformScheduledTransitions(ctx, uuid.NewString())
+
+	resp = performCatalogCacheRequest(t, path, etag, "")
+	AssertTestStatusCode(t, resp, fiber.StatusOK)
+	AssertEqual(t, resp.Header.Get("X-Cache"), "MISS")
+	resBody = AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.SeriesResponse]{})
+	AssertEqual(t, len(resBody.Results), 1)
+	AssertEqual(t, resBody.Results[0].Slug, "existing-series")
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
@@ -952,6 +952,7 @@ func TestUpdateSection(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = sectionIfMatchFn(&sectionID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCase(t, http.MethodPut, tc.Path, tc)
 		})
@@ -1150,6 +1151,7 @@ func TestDeleteSection(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = sectionIfMatchFn(&sectionID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCaseWithPathFn(t, http.MethodDelete, tc)
 		})
@@ -1431,6 +1433,7 @@ func TestPublishSection(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
+		tc.IfMatchFn = sectionIfMatchFn(&sectionID)
 		t.Run(tc.Name, func(t *testing.T) {
 			PerformTestRequestCaseWithPathFn(t, http.MethodPatch, tc)
 		})
@@ -1439,3 +1442,159 @@ func TestPublishSection(t *testing.T) {
 	t.Cleanup(languagesCleanUp(t))
 	t.Cleanup(userCleanUp(t))
 }
+
+func TestReorderSections(t *testing.T) {
+	languagesCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	sectionIDs := make([]int32, 0, 3)
+	func() {
+		testDb := GetTestDatabase(t)
+		testServices := GetTestServices(t)
+
+		params := db.CreateLanguageParams{
+			Name:     "Rust",
+			Icon:     strings.TrimSpace(languageIcons["Rust"]),
+			AuthorID: testUser.ID,
+			Slug:     "rust",
+		}
+		if _, err := testDb.CreateLanguage(context.Background(), params); err != nil {
+			t.Fatal("Failed to create language", err)
+		}
+
+		if _, err := testDb.CreateSeries(context.Background(), db.CreateSeriesParams{
+			Title:        "Existing Series",
+			Slug:         "existing-series",
+			Description:  "Some description",
+			LanguageSlug: "rust",
+			AuthorID:     testUser.ID,
+		}); err != nil {
+			t.Fatal("Failed to create series", "error", err)
+		}
+
+		for _, title := range []string{"First Section", "Second Section", "Third Section"} {
+			section, serviceErr := testServices.CreateSection(context.Background(), services.CreateSectionOptions{
+				UserID:       testUser.ID,
+				Title:        title,
+				LanguageSlug: "rust",
+				SeriesSlug:   "existing-series",
+				Description:  "Some description",
+			})
+			if serviceErr != nil {
+				t.Fatal("Failed to create section", "serviceError", serviceErr)
+			}
+			sectionIDs = append(sectionIDs, section.ID)
+		}
+	}()
+
+	path := baseLanguagesPath + "/rust/series/existing-series/sections/order"
+	testCases := []TestRequestCase[dtos.ReorderSectionsBody]{
+		{
+			Name: "Should return 200 OK when it reorders the sections",
+			ReqFn: func(t *testing.T) (dtos.ReorderSectionsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderSectionsBody{
+					SectionIDs: []int32{sectionIDs[2], sectionIDs[0], sectionIDs[1]},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.ReorderSectionsBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, []dtos.SectionResponse{})
+				AssertEqual(t, len(resBody), len(req.SectionIDs))
+				for i, section := range resBody {
+					AssertEqual(t, section.ID, req.SectionIDs[i])
+					AssertEqual(t, section.Position, int16(i+1))
+				}
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when a section of the series is missing",
+			ReqFn: func(t *testing.T) (dtos.ReorderSectionsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderSectionsBody{SectionIDs: []int32{sectionIDs[1], sectionIDs[0]}}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.ReorderSectionsBody, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(
+					t,
+					resp,
+					"Section IDs must contain every section of the series exactly once",
+				)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the section ids are repeated",
+			ReqFn: func(t *testing.T) (dtos.ReorderSectionsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderSectionsBody{
+					SectionIDs: []int32{sectionIDs[0], sectionIDs[0], sectionIDs[1]},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.ReorderSectionsBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{{
+					Param:   "sectionIDs",
+					Message: exceptions.FieldErrMessageInvalid,
+				}})
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 428 PRECONDITION REQUIRED when the If-Match header is missing",
+			ReqFn: func(t *testing.T) (dtos.ReorderSectionsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderSectionsBody{SectionIDs: sectionIDs}, accessToken
+			},
+			ExpStatus: fiber.StatusPreconditionRequired,
+			AssertFn:  func(t *testing.T, _ dtos.ReorderSectionsBody, _ *http.Response) {},
+			Path:      path,
+			IfMatchFn: func(t *testing.T) string { return "" },
+		},
+		{
+			Name: "Should return 412 PRECONDITION FAILED with the current order when the version is stale",
+			ReqFn: func(t *testing.T) (dtos.ReorderSectionsBody, string) {
+				testUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderSectionsBody{SectionIDs: sectionIDs}, accessToken
+			},
+			ExpStatus: fiber.StatusPreconditionFailed,
+			AssertFn: func(t *testing.T, _ dtos.ReorderSectionsBody, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderETag), sectionsOrderIfMatchFn("existing-series")(t))
+				resBody := AssertTestResponseBody(t, resp, []dtos.SectionResponse{})
+				AssertEqual(t, len(resBody), len(sectionIDs))
+			},
+			Path:      path,
+			IfMatchFn: func(t *testing.T) string { return `"v1"` },
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not staff",
+			ReqFn: func(t *testing.T) (dtos.ReorderSectionsBody, string) {
+				testUser.IsStaff = false
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.ReorderSectionsBody{SectionIDs: sectionIDs}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.ReorderSectionsBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.IfMatchFn == nil {
+			tc.IfMatchFn = sectionsOrderIfMatchFn("existing-series")
+		}
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPatch, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
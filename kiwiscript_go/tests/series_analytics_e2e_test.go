@@ -0,0 +1,139 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+)
+
+func TestGetSeriesAnalytics(t *testing.T) {
+	languagesCleanUp(t)()
+	author := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	if err := GetTestDatabase(t).UpdateUserIsStaff(
+		context.Background(),
+		db.UpdateUserIsStaffParams{IsStaff: true, ID: author.ID},
+	); err != nil {
+		t.Fatal("Failed to update user is staff", "error", err)
+	}
+	author.IsStaff = true
+	otherStaff := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherStaff.IsStaff = true
+
+	lesson := createLessonCommentsTestLesson(t, author)
+	completeLearningStatsTestLesson(t, confirmTestUser(t, CreateTestUser(t, nil).ID).ID, lesson)
+	completeLearningStatsTestLesson(t, confirmTestUser(t, CreateTestUser(t, nil).ID).ID, lesson)
+	GetTestServices(t).RefreshSeriesAnalytics(context.Background(), uuid.NewString())
+
+	path := baseLanguagesPath + "/rust/series/rust-series/analytics"
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 200 OK with the aggregated analytics of the series",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesAnalyticsResponse{})
+				AssertEqual(t, resBody.To, time.Now().UTC().Format(time.DateOnly))
+				AssertEqual(t, resBody.Learners, 2)
+				AssertEqual(t, len(resBody.TopViewedLessons), 1)
+				AssertEqual(t, resBody.TopViewedLessons[0].ID, lesson.ID)
+				AssertEqual(t, resBody.TopViewedLessons[0].Completions, 2)
+				AssertNotEmpty(t, resBody.RefreshedAt)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK with the funnel of the series",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesAnalyticsFunnelResponse{})
+				AssertEqual(t, len(resBody.Sections), 1)
+				AssertEqual(t, resBody.Sections[0].Learners, 2)
+				AssertEqual(t, len(resBody.Sections[0].Lessons), 1)
+				AssertEqual(t, resBody.Sections[0].Lessons[0].Learners, 2)
+			},
+			Path: path + "/funnel",
+		},
+		{
+			Name: "Should return 200 OK with a zero filled weekly timeline",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesAnalyticsTimelineResponse{})
+				AssertEqual(t, resBody.Interval, "week")
+				AssertGreaterThan(t, len(resBody.Buckets), 3)
+
+				var learners int32
+				for _, bucket := range resBody.Buckets {
+					learners += bucket.Learners
+				}
+				AssertEqual(t, learners, 2)
+			},
+			Path: path + "/timeline?interval=week",
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the range is reversed",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "From date must not be after the to date")
+			},
+			Path: path + "?from=2024-10-10&to=2024-10-01",
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not the author of the series",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherStaff)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+}
@@ -0,0 +1,368 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func createSeriesReviewsTestSeries(t *testing.T, staffUser, testUser *db.User) {
+	testDb := GetTestDatabase(t)
+	testServices := GetTestServices(t)
+	ctx := context.Background()
+
+	prms := db.CreateLanguageParams{
+		Name:     "Rust",
+		Icon:     strings.TrimSpace(languageIcons["Rust"]),
+		AuthorID: staffUser.ID,
+		Slug:     "rust",
+	}
+	if _, err := testDb.CreateLanguage(ctx, prms); err != nil {
+		t.Fatal("Failed to create language", err)
+	}
+
+	series, err := testDb.CreateSeries(ctx, db.CreateSeriesParams{
+		LanguageSlug: "rust",
+		Title:        "Rust Series",
+		Slug:         "rust-series",
+		AuthorID:     staffUser.ID,
+		Description:  "Some cool rust series",
+	})
+	if err != nil {
+		t.Fatal("Failed to create series", err)
+	}
+
+	section, err := testDb.CreateSection(ctx, db.CreateSectionParams{
+		Title:        "Rust Section",
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+		Description:  "Some section",
+		AuthorID:     staffUser.ID,
+	})
+	if err != nil {
+		t.Fatal("Failed to create section", err)
+	}
+
+	lesson, err := testDb.CreateLesson(ctx, db.CreateLessonParams{
+		Title:        "Cool rust lesson",
+		AuthorID:     staffUser.ID,
+		SectionID:    section.ID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+	})
+	if err != nil {
+		t.Fatal("Failed to create lesson", err)
+	}
+
+	isPubLesPrms := db.UpdateLessonIsPublishedParams{
+		IsPublished: true,
+		ID:          lesson.ID,
+	}
+	if _, err := testDb.UpdateLessonIsPublished(ctx, isPubLesPrms); err != nil {
+		t.Fatal("Failed to update lesson is published", err)
+	}
+
+	isPubSecPrms := db.UpdateSectionIsPublishedParams{
+		IsPublished: true,
+		ID:          section.ID,
+	}
+	if _, err := testDb.UpdateSectionIsPublished(ctx, isPubSecPrms); err != nil {
+		t.Fatal("Failed to update section is published", err)
+	}
+
+	isPubSerPrms := db.UpdateSeriesIsPublishedParams{
+		IsPublished: true,
+		ID:          series.ID,
+	}
+	if _, err := testDb.UpdateSeriesIsPublished(ctx, isPubSerPrms); err != nil {
+		t.Fatal("Failed to update series is published", err)
+	}
+
+	langOpts := services.CreateOrUpdateLanguageProgressOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       testUser.ID,
+		LanguageSlug: "rust",
+	}
+	if _, _, _, serviceErr := testServices.CreateOrUpdateLanguageProgress(ctx, langOpts); serviceErr != nil {
+		t.Fatal("Failed to create language progress", serviceErr)
+	}
+
+	seriesOpts := services.CreateOrUpdateSeriesProgressOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       testUser.ID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+	}
+	if _, _, _, serviceErr := testServices.CreateOrUpdateSeriesProgress(ctx, seriesOpts); serviceErr != nil {
+		t.Fatal("Failed to create series progress", serviceErr)
+	}
+}
+
+func createTestSeriesReview(t *testing.T, userID int32, rating int16) *db.SeriesReviewModel {
+	testServices := GetTestServices(t)
+	review, serviceErr := testServices.CreateSeriesReview(context.Background(), services.CreateSeriesReviewOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       userID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+		Rating:       rating,
+		Review:       "Really good series",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create series review", serviceErr)
+	}
+
+	return review
+}
+
+func deleteTestSeriesReview(t *testing.T, reviewID int32) {
+	testServices := GetTestServices(t)
+	serviceErr := testServices.DeleteSeriesReview(context.Background(), services.DeleteSeriesReviewOptions{
+		RequestID:    uuid.NewString(),
+		IsStaff:      true,
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+		ReviewID:     reviewID,
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to delete series review", serviceErr)
+	}
+}
+
+func findTestSeriesRating(t *testing.T) (int32, int32) {
+	testDb := GetTestDatabase(t)
+	series, err := testDb.FindSeriesBySlugAndLanguageSlug(context.Background(), db.FindSeriesBySlugAndLanguageSlugParams{
+		Slug:         "rust-series",
+		LanguageSlug: "rust",
+	})
+	if err != nil {
+		t.Fatal("Failed to find series", err)
+	}
+
+	return series.ReviewsCount, series.RatingTotal
+}
+
+func TestCreateSeriesReview(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	createSeriesReviewsTestSeries(t, staffUser, testUser)
+
+	const reviewsPath = baseLanguagesPath + "/rust/series/rust-series/reviews"
+
+	testCases := []TestRequestCase[dtos.SeriesReviewBody]{
+		{
+			Name: "Should return 201 CREATED and update the series rating",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.SeriesReviewBody{Rating: 4, Review: "Really good series"}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, req dtos.SeriesReviewBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesReviewResponse{})
+				AssertEqual(t, resBody.Rating, req.Rating)
+				AssertEqual(t, resBody.Review, req.Review)
+				AssertEqual(t, resBody.Embedded.Author.ID, testUser.ID)
+
+				reviewsCount, ratingTotal := findTestSeriesRating(t)
+				AssertEqual(t, reviewsCount, 1)
+				AssertEqual(t, ratingTotal, 4)
+				deleteTestSeriesReview(t, resBody.ID)
+			},
+			Path: reviewsPath,
+		},
+		{
+			Name: "Should return 409 CONFLICT when the user already reviewed the series",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewBody, string) {
+				createTestSeriesReview(t, testUser.ID, 5)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.SeriesReviewBody{Rating: 4, Review: "Really good series"}, accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewBody, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Series already reviewed")
+				testDb := GetTestDatabase(t)
+				review, err := testDb.FindSeriesReviewByUserIDAndSeriesSlug(
+					context.Background(),
+					db.FindSeriesReviewByUserIDAndSeriesSlugParams{
+						UserID:     testUser.ID,
+						SeriesSlug: "rust-series",
+					},
+				)
+				if err != nil {
+					t.Fatal("Failed to find series review", err)
+				}
+				deleteTestSeriesReview(t, review.ID)
+			},
+			Path: reviewsPath,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user has not started the series",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherUser)
+				return dtos.SeriesReviewBody{Rating: 4, Review: "Really good series"}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: reviewsPath,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the rating is out of range",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.SeriesReviewBody{Rating: 6, Review: "Really good series"}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{
+					{Param: "rating", Message: exceptions.IntFieldErrMessageLte},
+				})
+			},
+			Path: reviewsPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewBody, string) {
+				return dtos.SeriesReviewBody{Rating: 4, Review: "Really good series"}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: reviewsPath,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the series does not exist",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.SeriesReviewBody{Rating: 4, Review: "Really good series"}, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewBody, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: baseLanguagesPath + "/rust/series/python-series/reviews",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestUpdateSeriesReviewIsHidden(t *testing.T) {
+	languagesCleanUp(t)()
+	staffUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	createSeriesReviewsTestSeries(t, staffUser, testUser)
+
+	var reviewID int32
+	beforeEach := func(t *testing.T) {
+		reviewID = createTestSeriesReview(t, testUser.ID, 3).ID
+	}
+	afterEach := func(t *testing.T) {
+		deleteTestSeriesReview(t, reviewID)
+	}
+	reviewPathFn := func() string {
+		return fmt.Sprintf("%s/rust/series/rust-series/reviews/%d/hide", baseLanguagesPath, reviewID)
+	}
+
+	testCases := []TestRequestCase[dtos.SeriesReviewIsHiddenBody]{
+		{
+			Name: "Should return 200 OK and remove the review from the series rating",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewIsHiddenBody, string) {
+				beforeEach(t)
+				staffUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return dtos.SeriesReviewIsHiddenBody{IsHidden: true}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewIsHiddenBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesReviewResponse{})
+				AssertEqual(t, resBody.IsHidden, true)
+
+				reviewsCount, ratingTotal := findTestSeriesRating(t)
+				AssertEqual(t, reviewsCount, 0)
+				AssertEqual(t, ratingTotal, 0)
+				afterEach(t)
+			},
+			PathFn: reviewPathFn,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not staff",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewIsHiddenBody, string) {
+				beforeEach(t)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.SeriesReviewIsHiddenBody{IsHidden: true}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewIsHiddenBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+
+				reviewsCount, ratingTotal := findTestSeriesRating(t)
+				AssertEqual(t, reviewsCount, 1)
+				AssertEqual(t, ratingTotal, 3)
+				afterEach(t)
+			},
+			PathFn: reviewPathFn,
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the review does not exist",
+			ReqFn: func(t *testing.T) (dtos.SeriesReviewIsHiddenBody, string) {
+				staffUser.IsStaff = true
+				accessToken, _ := GenerateTestAuthTokens(t, staffUser)
+				return dtos.SeriesReviewIsHiddenBody{IsHidden: true}, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ dtos.SeriesReviewIsHiddenBody, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			PathFn: func() string {
+				return baseLanguagesPath + "/rust/series/rust-series/reviews/987654/hide"
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodPatch, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
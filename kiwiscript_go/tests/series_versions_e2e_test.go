@@ -0,0 +1,235 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func createVersionedContent(t *testing.T) *db.User {
+	testDb := GetTestDatabase(t)
+	ctx := context.Background()
+
+	author := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	if err := testDb.UpdateUserIsStaff(ctx, db.UpdateUserIsStaffParams{IsStaff: true, ID: author.ID}); err != nil {
+		t.Fatal("Failed to update user is staff", "error", err)
+	}
+	author.IsStaff = true
+
+	section, lesson := createScheduledContent(t, author.ID)
+	if _, err := testDb.UpdateLessonIsPublished(ctx, db.UpdateLessonIsPublishedParams{
+		IsPublished: true,
+		ID:          lesson.ID,
+	}); err != nil {
+		t.Fatal("Failed to update lesson is published", "error", err)
+	}
+	if _, err := testDb.UpdateSectionIsPublished(ctx, db.UpdateSectionIsPublishedParams{
+		IsPublished: true,
+		ID:          section.ID,
+	}); err != nil {
+		t.Fatal("Failed to update section is published", "error", err)
+	}
+
+	updateVersionedSeriesIsPublished(t, author.ID, true)
+	return author
+}
+
+func updateVersionedSeriesIsPublished(t *testing.T, authorID int32, isPublished bool) {
+	testServices := GetTestServices(t)
+	if _, serviceErr := testServices.UpdateSeriesIsPublished(
+		context.Background(),
+		services.UpdateSeriesIsPublishedOptions{
+			RequestID:    uuid.NewString(),
+			UserID:       authorID,
+			LanguageSlug: "rust",
+			SeriesSlug:   "existing-series",
+			IsPublished:  isPublished,
+		},
+	); serviceErr != nil {
+		t.Fatal("Failed to update series is published", "serviceError", serviceErr)
+	}
+}
+
+func TestPublishSeriesVersion(t *testing.T) {
+	languagesCleanUp(t)()
+	author := createVersionedContent(t)
+	otherStaff := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	otherStaff.IsStaff = true
+
+	path := baseLanguagesPath + "/rust/series/existing-series/versions"
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 403 FORBIDDEN when the user is not the author of the series",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, otherStaff)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 201 CREATED with the next version of the series",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesVersionResponse{})
+				AssertEqual(t, resBody.Version, 2)
+				AssertEqual(t, resBody.Title, "Existing Series")
+				AssertEqual(t, resBody.SectionsCount, 1)
+				AssertEqual(t, resBody.LessonsCount, 1)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 400 BAD REQUEST when the series is not published",
+			ReqFn: func(t *testing.T) (string, string) {
+				updateVersionedSeriesIsPublished(t, author.ID, false)
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertValidationErrorWithoutFieldsResponse(t, resp, "Series must be published to release a new version")
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestMigrateSeriesProgressVersion(t *testing.T) {
+	languagesCleanUp(t)()
+	author := createVersionedContent(t)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+
+	func() {
+		testServices := GetTestServices(t)
+		ctx := context.Background()
+
+		if _, _, _, serviceErr := testServices.CreateOrUpdateLanguageProgress(
+			ctx,
+			services.CreateOrUpdateLanguageProgressOptions{
+				RequestID:    uuid.NewString(),
+				UserID:       testUser.ID,
+				LanguageSlug: "rust",
+			},
+		); serviceErr != nil {
+			t.Fatal("Failed to create language progress", "serviceError", serviceErr)
+		}
+
+		if _, _, _, serviceErr := testServices.CreateOrUpdateSeriesProgress(
+			ctx,
+			services.CreateOrUpdateSeriesProgressOptions{
+				RequestID:    uuid.NewString(),
+				UserID:       testUser.ID,
+				LanguageSlug: "rust",
+				SeriesSlug:   "existing-series",
+			},
+		); serviceErr != nil {
+			t.Fatal("Failed to create series progress", "serviceError", serviceErr)
+		}
+	}()
+
+	path := baseLanguagesPath + "/rust/series/existing-series/progress/version"
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 409 CONFLICT when the progress is already on the latest version",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Series progress is already on the latest version")
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 200 OK with the progress moved to the latest version",
+			ReqFn: func(t *testing.T) (string, string) {
+				testServices := GetTestServices(t)
+				if _, serviceErr := testServices.PublishSeriesVersion(
+					context.Background(),
+					services.PublishSeriesVersionOptions{
+						RequestID:    uuid.NewString(),
+						UserID:       author.ID,
+						LanguageSlug: "rust",
+						SeriesSlug:   "existing-series",
+					},
+				); serviceErr != nil {
+					t.Fatal("Failed to publish series version", "serviceError", serviceErr)
+				}
+
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesProgressVersionResponse{})
+				AssertEqual(t, resBody.Version, 2)
+				AssertEqual(t, resBody.LatestVersion, 2)
+				AssertEqual(t, resBody.CanMigrate, false)
+				AssertEqual(t, resBody.TotalLessons, 1)
+			},
+			Path: path,
+		},
+		{
+			Name: "Should return 403 FORBIDDEN when the user is a staff user",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, author)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+			Path: path,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
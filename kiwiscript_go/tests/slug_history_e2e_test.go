@@ -0,0 +1,214 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func createRenamedSeries(t *testing.T, authorID int32) {
+	testDb := GetTestDatabase(t)
+	testServices := GetTestServices(t)
+	ctx := context.Background()
+
+	if _, err := testDb.CreateLanguage(ctx, db.CreateLanguageParams{
+		Name:     "Rust",
+		Icon:     strings.TrimSpace(languageIcons["Rust"]),
+		AuthorID: authorID,
+		Slug:     "rust",
+	}); err != nil {
+		t.Fatal("Failed to create language", "error", err)
+	}
+
+	series, err := testDb.CreateSeries(ctx, db.CreateSeriesParams{
+		LanguageSlug: "rust",
+		Title:        "Rust Series",
+		Slug:         "rust-series",
+		AuthorID:     authorID,
+		Description:  "Some cool rust series",
+	})
+	if err != nil {
+		t.Fatal("Failed to create series", "error", err)
+	}
+
+	if _, err := testDb.UpdateSeriesIsPublished(ctx, db.UpdateSeriesIsPublishedParams{
+		IsPublished: true,
+		ID:          series.ID,
+	}); err != nil {
+		t.Fatal("Failed to update series is published", "error", err)
+	}
+
+	if _, serviceErr := testServices.UpdateSeries(ctx, services.UpdateSeriesOptions{
+		RequestID:    uuid.NewString(),
+		UserID:       authorID,
+		LanguageSlug: "rust",
+		SeriesSlug:   "rust-series",
+		Title:        "Renamed Series",
+		Description:  "Some cool rust series",
+	}); serviceErr != nil {
+		t.Fatal("Failed to update series", "serviceError", serviceErr)
+	}
+}
+
+func TestSeriesSlugRedirect(t *testing.T) {
+	languagesCleanUp(t)()
+	author := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	createRenamedSeries(t, author.ID)
+
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 301 MOVED PERMANENTLY to the canonical series slug",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusMovedPermanently,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertEqual(t, resp.Header.Get(fiber.HeaderLocation), baseLanguagesPath+"/rust/series/renamed-series")
+			},
+			Path: baseLanguagesPath + "/rust/series/rust-series",
+		},
+		{
+			Name: "Should return 301 MOVED PERMANENTLY keeping the rest of the path and query",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusMovedPermanently,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertEqual(
+					t,
+					resp.Header.Get(fiber.HeaderLocation),
+					baseLanguagesPath+"/rust/series/renamed-series/sections?limit=5",
+				)
+			},
+			Path: baseLanguagesPath + "/rust/series/rust-series/sections?limit=5",
+		},
+		{
+			Name: "Should return 200 OK with canonical links for the current slug",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.SeriesResponse{})
+				AssertEqual(t, resBody.Slug, "renamed-series")
+				AssertStringContains(t, resBody.Links.Self.Href, "/rust/series/renamed-series")
+			},
+			Path: baseLanguagesPath + "/rust/series/renamed-series",
+		},
+		{
+			Name: "Should return 404 NOT FOUND when the slug was never used",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			Path: baseLanguagesPath + "/rust/series/python-series",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodGet, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestLanguageSlugRedirect(t *testing.T) {
+	languagesCleanUp(t)()
+	author := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	createRenamedSeries(t, author.ID)
+
+	func() {
+		testServices := GetTestServices(t)
+		if _, serviceErr := testServices.UpdateLanguage(context.Background(), services.UpdateLanguageOptions{
+			RequestID: uuid.NewString(),
+			Slug:      "rust",
+			Name:      "Rustlang",
+			Icon:      strings.TrimSpace(languageIcons["Rust"]),
+		}); serviceErr != nil {
+			t.Fatal("Failed to update language", "serviceError", serviceErr)
+		}
+	}()
+
+	testCases := []struct {
+		method string
+		tc     TestRequestCase[string]
+	}{
+		{
+			method: http.MethodGet,
+			tc: TestRequestCase[string]{
+				Name: "Should return 301 MOVED PERMANENTLY to the canonical language and series slugs",
+				ReqFn: func(t *testing.T) (string, string) {
+					return "", ""
+				},
+				ExpStatus: fiber.StatusMovedPermanently,
+				AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+					AssertEqual(
+						t,
+						resp.Header.Get(fiber.HeaderLocation),
+						baseLanguagesPath+"/rustlang/series/renamed-series",
+					)
+				},
+				Path: baseLanguagesPath + "/rust/series/rust-series",
+			},
+		},
+		{
+			method: http.MethodPost,
+			tc: TestRequestCase[string]{
+				Name: "Should return 308 PERMANENT REDIRECT for writes to the old language slug",
+				ReqFn: func(t *testing.T) (string, string) {
+					accessToken, _ := GenerateTestAuthTokens(t, testUser)
+					return "", accessToken
+				},
+				ExpStatus: fiber.StatusPermanentRedirect,
+				AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+					AssertEqual(
+						t,
+						resp.Header.Get(fiber.HeaderLocation),
+						baseLanguagesPath+"/rustlang/series/renamed-series/progress",
+					)
+				},
+				Path: baseLanguagesPath + "/rust/series/renamed-series/progress",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, tc.method, tc.tc.Path, tc.tc)
+		})
+	}
+
+	t.Cleanup(languagesCleanUp(t))
+	t.Cleanup(userCleanUp(t))
+}
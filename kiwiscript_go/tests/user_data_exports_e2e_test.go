@@ -0,0 +1,83 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/paths"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+func TestRequestMyDataExport(t *testing.T) {
+	userCleanUp(t)()
+	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	exportPath := userPath + paths.MePath + paths.DataExportPath
+
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 202 ACCEPTED with a pending data export",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusAccepted,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.UserDataExportResponse{})
+				AssertNotEmpty(t, resBody.ID)
+				AssertEqual(t, resBody.Status, services.UserDataExportStatusPending)
+				AssertEqual(t, resBody.ExpiresAt, "")
+			},
+			Path: exportPath,
+		},
+		{
+			Name: "Should return 409 CONFLICT when a data export is already in progress",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusConflict,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertConflictResponse(t, resp, "Data export already in progress")
+			},
+			Path: exportPath,
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED when the user is not authenticated",
+			ReqFn: func(t *testing.T) (string, string) {
+				return "", ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+			Path: exportPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, tc.Path, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
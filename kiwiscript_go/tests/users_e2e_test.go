@@ -31,6 +31,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -386,6 +387,11 @@ func TestDeleteMe(t *testing.T) {
 			},
 			ExpStatus: http.StatusNoContent,
 			AssertFn: func(t *testing.T, _ dtos.DeleteUserBody, _ *http.Response) {
+				user, err := GetTestDatabase(t).FindUserById(context.Background(), testUser.ID)
+				if err != nil {
+					t.Fatal("Soft deleted user not found", err)
+				}
+				AssertEqual(t, user.DeletedAt.Valid, true)
 				afterEach(t)
 			},
 		},
@@ -439,6 +445,108 @@ func TestDeleteMe(t *testing.T) {
 	t.Cleanup(userCleanUp(t))
 }
 
+func TestPurgeDeletedUsers(t *testing.T) {
+	userCleanUp(t)()
+	userData := GenerateFakeUserData(t)
+	testUser := confirmTestUser(t, CreateTestUser(t, &userData).ID)
+	testServices := GetTestServices(t)
+	testDatabase := GetTestDatabase(t)
+	ctx := context.Background()
+
+	serviceErr := testServices.DeleteUser(ctx, services.DeleteUserOptions{
+		RequestID: uuid.NewString(),
+		ID:        testUser.ID,
+		Password:  userData.Password,
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to delete user", serviceErr)
+	}
+
+	testServices.PurgeDeletedUsers(ctx, uuid.NewString(), time.Hour)
+	if _, err := testDatabase.FindUserById(ctx, testUser.ID); err != nil {
+		t.Fatal("User purged before the grace period elapsed", err)
+	}
+
+	if _, err := testDatabase.RestoreUserById(ctx, testUser.ID); err != nil {
+		t.Fatal("Failed to restore user", err)
+	}
+	testServices.PurgeDeletedUsers(ctx, uuid.NewString(), 0)
+	if _, err := testDatabase.FindUserById(ctx, testUser.ID); err != nil {
+		t.Fatal("Restored user purged", err)
+	}
+
+	if err := testDatabase.SoftDeleteUserById(ctx, testUser.ID); err != nil {
+		t.Fatal("Failed to soft delete user", err)
+	}
+	testServices.PurgeDeletedUsers(ctx, uuid.NewString(), 0)
+	if _, err := testDatabase.FindUserById(ctx, testUser.ID); err == nil {
+		t.Fatal("User not purged after the grace period elapsed")
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestPurgeDeletedUsersTransfersOrganizations(t *testing.T) {
+	userCleanUp(t)()
+	owner := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	member := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testServices := GetTestServices(t)
+	testDatabase := GetTestDatabase(t)
+	ctx := context.Background()
+
+	sharedOrganization, serviceErr := testServices.CreateOrganization(ctx, services.CreateOrganizationOptions{
+		RequestID: uuid.NewString(),
+		UserID:    owner.ID,
+		Name:      "Shared Organization",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create organization", serviceErr)
+	}
+	if _, err := testDatabase.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+		OrganizationID: sharedOrganization.ID,
+		UserID:         member.ID,
+		Role:           db.OrganizationRoleMember,
+	}); err != nil {
+		t.Fatal("Failed to create organization member", err)
+	}
+	soloOrganization, serviceErr := testServices.CreateOrganization(ctx, services.CreateOrganizationOptions{
+		RequestID: uuid.NewString(),
+		UserID:    owner.ID,
+		Name:      "Solo Organization",
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create organization", serviceErr)
+	}
+
+	if err := testDatabase.SoftDeleteUserById(ctx, owner.ID); err != nil {
+		t.Fatal("Failed to soft delete user", err)
+	}
+	testServices.PurgeDeletedUsers(ctx, uuid.NewString(), 0)
+	if _, err := testDatabase.FindUserById(ctx, owner.ID); err == nil {
+		t.Fatal("User not purged after the grace period elapsed")
+	}
+
+	organization, err := testDatabase.FindOrganizationByID(ctx, sharedOrganization.ID)
+	if err != nil {
+		t.Fatal("Organization with other members deleted", err)
+	}
+	AssertEqual(t, organization.OwnerID, member.ID)
+	membership, err := testDatabase.FindOrganizationMember(ctx, db.FindOrganizationMemberParams{
+		OrganizationID: organization.ID,
+		UserID:         member.ID,
+	})
+	if err != nil {
+		t.Fatal("Failed to find organization member", err)
+	}
+	AssertEqual(t, membership.Role, db.OrganizationRoleAdmin)
+
+	if _, err := testDatabase.FindOrganizationByID(ctx, soloOrganization.ID); err == nil {
+		t.Fatal("Organization without other members not deleted")
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
 func TestCreateMyProfile(t *testing.T) {
 	userCleanUp(t)()
 	testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
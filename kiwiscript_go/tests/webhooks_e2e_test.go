@@ -0,0 +1,356 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/kiwiscript/kiwiscript_go/dtos"
+	"github.com/kiwiscript/kiwiscript_go/exceptions"
+	db "github.com/kiwiscript/kiwiscript_go/providers/database"
+	"github.com/kiwiscript/kiwiscript_go/providers/webhooks"
+	"github.com/kiwiscript/kiwiscript_go/services"
+)
+
+const baseWebhooksPath = "/api/v1/webhooks"
+
+const testWebhookSecret = "super-secret-webhook-key"
+
+func createTestWebhook(t *testing.T, userID int32, url string, events []string) *db.Webhook {
+	webhook, serviceErr := GetTestServices(t).CreateWebhook(context.Background(), services.CreateWebhookOptions{
+		RequestID: "test-request-id",
+		UserID:    userID,
+		URL:       url,
+		Secret:    testWebhookSecret,
+		Events:    events,
+	})
+	if serviceErr != nil {
+		t.Fatal("Failed to create webhook", serviceErr)
+	}
+
+	return webhook
+}
+
+func TestCreateWebhook(t *testing.T) {
+	userCleanUp(t)()
+
+	testCases := []TestRequestCase[dtos.CreateWebhookBody]{
+		{
+			Name: "Should return 201 CREATED when creating a webhook",
+			ReqFn: func(t *testing.T) (dtos.CreateWebhookBody, string) {
+				testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+				testUser.IsAdmin = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.CreateWebhookBody{
+					URL:    "https://hr.example.com/hooks/kiwiscript",
+					Secret: testWebhookSecret,
+					Events: []string{webhooks.EventCertificateIssued, webhooks.EventUserRegistered},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusCreated,
+			AssertFn: func(t *testing.T, req dtos.CreateWebhookBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.WebhookResponse{})
+				AssertEqual(t, req.URL, resBody.URL)
+				AssertEqual(t, len(req.Events), len(resBody.Events))
+				AssertEqual(t, true, resBody.IsActive)
+				AssertEqual(
+					t,
+					fmt.Sprintf("https://api.kiwiscript.com/api/v1/webhooks/%d", resBody.ID),
+					resBody.Links.Self.Href,
+				)
+				AssertEqual(
+					t,
+					fmt.Sprintf("https://api.kiwiscript.com/api/v1/webhooks/%d/deliveries", resBody.ID),
+					resBody.Links.Deliveries.Href,
+				)
+			},
+		},
+		{
+			Name: "Should return 403 FORBIDDEN if user is not admin",
+			ReqFn: func(t *testing.T) (dtos.CreateWebhookBody, string) {
+				testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.CreateWebhookBody{
+					URL:    "https://hr.example.com/hooks/kiwiscript",
+					Secret: testWebhookSecret,
+					Events: []string{webhooks.EventSeriesPublished},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusForbidden,
+			AssertFn: func(t *testing.T, _ dtos.CreateWebhookBody, resp *http.Response) {
+				AssertForbiddenResponse(t, resp)
+			},
+		},
+		{
+			Name: "Should return 401 UNAUTHORIZED if user is not authenticated",
+			ReqFn: func(t *testing.T) (dtos.CreateWebhookBody, string) {
+				return dtos.CreateWebhookBody{
+					URL:    "https://hr.example.com/hooks/kiwiscript",
+					Secret: testWebhookSecret,
+					Events: []string{webhooks.EventSeriesPublished},
+				}, ""
+			},
+			ExpStatus: fiber.StatusUnauthorized,
+			AssertFn: func(t *testing.T, _ dtos.CreateWebhookBody, resp *http.Response) {
+				AssertUnauthorizedResponse(t, resp)
+			},
+		},
+		{
+			Name: "Should return 400 BAD REQUEST if validation fails",
+			ReqFn: func(t *testing.T) (dtos.CreateWebhookBody, string) {
+				testUser := confirmTestUser(t, CreateTestUser(t, nil).ID)
+				testUser.IsAdmin = true
+				accessToken, _ := GenerateTestAuthTokens(t, testUser)
+				return dtos.CreateWebhookBody{
+					URL:    "not-a-url",
+					Secret: "short",
+					Events: []string{"lesson.viewed"},
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusBadRequest,
+			AssertFn: func(t *testing.T, _ dtos.CreateWebhookBody, resp *http.Response) {
+				AssertValidationErrorResponse(t, resp, []ValidationErrorAssertion{
+					{Param: "url", Message: exceptions.StrFieldErrMessageUrl},
+					{Param: "secret", Message: exceptions.StrFieldErrMessageMin},
+					{Param: "events[0]", Message: exceptions.FieldErrMessageInvalid},
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCase(t, http.MethodPost, baseWebhooksPath, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	userCleanUp(t)()
+
+	testAdmin := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testAdmin.IsAdmin = true
+	webhook := createTestWebhook(t, testAdmin.ID, "https://hr.example.com/hooks", []string{webhooks.EventUserRegistered})
+
+	testCases := []TestRequestCase[dtos.UpdateWebhookBody]{
+		{
+			Name: "Should return 200 OK when updating a webhook",
+			ReqFn: func(t *testing.T) (dtos.UpdateWebhookBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testAdmin)
+				return dtos.UpdateWebhookBody{
+					URL:      "https://lms.example.com/hooks",
+					Events:   []string{webhooks.EventCertificateIssued},
+					IsActive: false,
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusOK,
+			AssertFn: func(t *testing.T, req dtos.UpdateWebhookBody, resp *http.Response) {
+				resBody := AssertTestResponseBody(t, resp, dtos.WebhookResponse{})
+				AssertEqual(t, webhook.ID, resBody.ID)
+				AssertEqual(t, req.URL, resBody.URL)
+				AssertEqual(t, webhooks.EventCertificateIssued, resBody.Events[0])
+				AssertEqual(t, false, resBody.IsActive)
+
+				updated, err := GetTestDatabase(t).FindWebhookByID(context.Background(), webhook.ID)
+				if err != nil {
+					t.Fatal("Failed to find webhook", err)
+				}
+				AssertEqual(t, testWebhookSecret, updated.Secret)
+			},
+			PathFn: func() string {
+				return fmt.Sprintf("%s/%d", baseWebhooksPath, webhook.ID)
+			},
+		},
+		{
+			Name: "Should return 404 NOT FOUND if webhook does not exist",
+			ReqFn: func(t *testing.T) (dtos.UpdateWebhookBody, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testAdmin)
+				return dtos.UpdateWebhookBody{
+					URL:      "https://lms.example.com/hooks",
+					Events:   []string{webhooks.EventCertificateIssued},
+					IsActive: true,
+				}, accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ dtos.UpdateWebhookBody, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			PathFn: func() string {
+				return fmt.Sprintf("%s/%d", baseWebhooksPath, 987654321)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodPut, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	userCleanUp(t)()
+
+	testAdmin := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testAdmin.IsAdmin = true
+	webhook := createTestWebhook(t, testAdmin.ID, "https://hr.example.com/hooks", []string{webhooks.EventUserRegistered})
+
+	testCases := []TestRequestCase[string]{
+		{
+			Name: "Should return 204 NO CONTENT when deleting a webhook",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testAdmin)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusNoContent,
+			AssertFn: func(t *testing.T, _ string, _ *http.Response) {
+				if _, err := GetTestDatabase(t).FindWebhookByID(context.Background(), webhook.ID); err == nil {
+					t.Fatal("Webhook should have been deleted")
+				}
+			},
+			PathFn: func() string {
+				return fmt.Sprintf("%s/%d", baseWebhooksPath, webhook.ID)
+			},
+		},
+		{
+			Name: "Should return 404 NOT FOUND if webhook was already deleted",
+			ReqFn: func(t *testing.T) (string, string) {
+				accessToken, _ := GenerateTestAuthTokens(t, testAdmin)
+				return "", accessToken
+			},
+			ExpStatus: fiber.StatusNotFound,
+			AssertFn: func(t *testing.T, _ string, resp *http.Response) {
+				AssertNotFoundResponse(t, resp)
+			},
+			PathFn: func() string {
+				return fmt.Sprintf("%s/%d", baseWebhooksPath, webhook.ID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			PerformTestRequestCaseWithPathFn(t, http.MethodDelete, tc)
+		})
+	}
+
+	t.Cleanup(userCleanUp(t))
+}
+
+type receivedWebhook struct {
+	Event     string
+	Timestamp string
+	Signature string
+	Body      []byte
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	userCleanUp(t)()
+
+	received := make(chan receivedWebhook, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- receivedWebhook{
+			Event:     r.Header.Get(webhooks.HeaderEvent),
+			Timestamp: r.Header.Get(webhooks.HeaderTimestamp),
+			Signature: r.Header.Get(webhooks.HeaderSignature),
+			Body:      body,
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	testAdmin := confirmTestUser(t, CreateTestUser(t, nil).ID)
+	testAdmin.IsAdmin = true
+	webhook := createTestWebhook(t, testAdmin.ID, server.URL, []string{webhooks.EventUserRegistered})
+
+	CreateTestUser(t, nil)
+
+	// The registration only enqueues the delivery, the worker sends it
+	GetTestServices(t).ProcessDueWebhookDeliveries(context.Background(), uuid.NewString())
+
+	var delivery receivedWebhook
+	select {
+	case delivery = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Webhook was not delivered")
+	}
+
+	AssertEqual(t, webhooks.EventUserRegistered, delivery.Event)
+	AssertEqual(t, webhooks.Sign(testWebhookSecret, delivery.Timestamp, delivery.Body), delivery.Signature)
+
+	var deliveryID string
+	t.Run("Should return 200 OK with the delivery log", func(t *testing.T) {
+		accessToken, _ := GenerateTestAuthTokens(t, testAdmin)
+		resp := PerformTestRequest(
+			t,
+			GetTestApp(t),
+			0,
+			http.MethodGet,
+			fmt.Sprintf("%s/%d/deliveries", baseWebhooksPath, webhook.ID),
+			accessToken,
+			"application/json",
+			nil,
+		)
+		AssertTestStatusCode(t, resp, fiber.StatusOK)
+		resBody := AssertTestResponseBody(t, resp, dtos.PaginatedResponse[dtos.WebhookDeliveryResponse]{})
+		AssertEqual(t, int64(1), resBody.Count)
+		AssertEqual(t, services.WebhookDeliveryStatusSuccess, resBody.Results[0].Status)
+		AssertEqual(t, int16(http.StatusOK), resBody.Results[0].ResponseStatus)
+		deliveryID = resBody.Results[0].ID
+	})
+
+	t.Run("Should return 201 CREATED when redelivering a delivery", func(t *testing.T) {
+		accessToken, _ := GenerateTestAuthTokens(t, testAdmin)
+		resp := PerformTestRequest(
+			t,
+			GetTestApp(t),
+			0,
+			http.MethodPost,
+			fmt.Sprintf("%s/%d/deliveries/%s/redeliver", baseWebhooksPath, webhook.ID, deliveryID),
+			accessToken,
+			"application/json",
+			nil,
+		)
+		AssertTestStatusCode(t, resp, fiber.StatusCreated)
+		resBody := AssertTestResponseBody(t, resp, dtos.WebhookDeliveryResponse{})
+		AssertEqual(t, webhooks.EventUserRegistered, resBody.Event)
+		AssertEqual(t, services.WebhookDeliveryStatusSuccess, resBody.Status)
+
+		select {
+		case redelivery := <-received:
+			AssertEqual(t, string(delivery.Body), string(redelivery.Body))
+		case <-time.After(5 * time.Second):
+			t.Fatal("Webhook was not redelivered")
+		}
+	})
+
+	t.Cleanup(userCleanUp(t))
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2024 Afonso Barracha
+//
+// This file is part of KiwiScript.
+//
+// KiwiScript is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// KiwiScript is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with KiwiScript.  If not, see <https://www.gnu.org/licenses/>.
+
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Cursor is the keyset position of a row in a paginated list, it's handed
+// to clients as an opaque string so the sort keys can change without
+// breaking their links.
+type Cursor struct {
+	Position int16 `json:"p,omitempty"`
+	Time     int64 `json:"t,omitempty"`
+	ID       int32 `json:"i"`
+	Backward bool  `json:"b,omitempty"`
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+func NewPositionCursor(position int16, id int32, backward bool) *Cursor {
+	return &Cursor{Position: position, ID: id, Backward: backward}
+}
+
+func NewTimeCursor(t time.Time, id int32, backward bool) *Cursor {
+	return &Cursor{Time: t.UnixMicro(), ID: id, Backward: backward}
+}
+
+func (c *Cursor) GetTime() time.Time {
+	return time.UnixMicro(c.Time).UTC()
+}
+
+func (c *Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(str string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := new(Cursor)
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}